@@ -134,6 +134,7 @@ var (
 			upgradeclient.CancelProposalHandler,
 			certclient.ProposalHandler,
 			shieldclient.ProposalHandler,
+			shieldclient.AdminUpdateProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -317,6 +318,8 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.distrKeeper,
 		&app.certKeeper,
 		&app.stakingKeeper,
+		&app.shieldKeeper,
+		&app.oracleKeeper,
 		app.GetSubspace(cvmtypes.ModuleName),
 	)
 	app.oracleKeeper = oraclekeeper.NewKeeper(
@@ -326,6 +329,7 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.distrKeeper,
 		&app.stakingKeeper,
 		app.bankKeeper,
+		&app.cvmKeeper,
 		app.GetSubspace(oracletypes.ModuleName),
 	)
 	app.slashingKeeper = slashingkeeper.NewKeeper(
@@ -363,6 +367,7 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.bankKeeper,
 		&stakingKeeper,
 		&app.govKeeper,
+		&app.certKeeper,
 		app.GetSubspace(shieldtypes.ModuleName),
 	)
 	app.mintKeeper = mintkeeper.NewKeeper(
@@ -379,6 +384,9 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		),
 	)
 
+	// register the cert hooks
+	app.certKeeper = *app.certKeeper.SetHooks(app.shieldKeeper.Hooks())
+
 	// Create IBC Keeper
 	app.ibcKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.stakingKeeper, scopedIBCKeeper,
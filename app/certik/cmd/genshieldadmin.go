@@ -22,7 +22,7 @@ func AddGenesisShieldAdminCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-genesis-shield-admin [address]",
 		Short: "Add a genesis shield admin to genesis.json",
-		Long:  `Add a genesis shield admin to genesis.json. The provided shield admin must specify the account address. `,
+		Long:  `Add a genesis shield admin to genesis.json. The provided shield admin must specify the account address and is granted all shield roles. `,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := client.GetClientContextFromCmd(cmd)
@@ -44,7 +44,7 @@ func AddGenesisShieldAdminCmd(defaultNodeHome string) *cobra.Command {
 			}
 			shieldGenState := shieldtypes.GetGenesisStateFromAppState(cdc, appState)
 
-			shieldGenState.ShieldAdmin = addr.String()
+			shieldGenState.ShieldRoles = shieldGenState.ShieldRoles.WithAdmin(addr)
 
 			shieldGenStateBz := cdc.MustMarshalJSON(&shieldGenState)
 
@@ -115,6 +115,7 @@ func migrateOracle(oldState OracleGenesisState) *oracletypes.GenesisState {
 		}
 
 		newTasks[i] = oracletypes.Task{
+			Id:            uint64(i + 1),
 			Contract:      t.Contract,
 			Function:      t.Function,
 			BeginBlock:    t.BeginBlock,
@@ -127,6 +128,8 @@ func migrateOracle(oldState OracleGenesisState) *oracletypes.GenesisState {
 			ClosingBlock:  t.ClosingBlock,
 			WaitingBlocks: t.WaitingBlocks,
 			Status:        oracletypes.TaskStatus(t.Status),
+
+			AggregationStrategy: oracletypes.AggregationStrategyWeightedMean,
 		}
 	}
 
@@ -144,8 +147,16 @@ func migrateOracle(oldState OracleGenesisState) *oracletypes.GenesisState {
 			ThresholdScore:     oldState.TaskParams.ThresholdScore,
 			Epsilon1:           oldState.TaskParams.Epsilon1,
 			Epsilon2:           oldState.TaskParams.Epsilon2,
+
+			AllowedAggregationStrategies: oracletypes.AggregationStrategies,
+			TrimFraction:                 oracletypes.DefaultTrimFraction,
+			MajorityQuorum:               oracletypes.DefaultMajorityQuorum,
+			MinResponses:                 oracletypes.DefaultMinResponses,
+			MinResponseCollateral:        oracletypes.DefaultMinResponseCollateral,
+			InquiryFee:                   oracletypes.DefaultInquiryFee,
 		},
-		Withdraws: newWithdraws,
-		Tasks:     newTasks,
+		Withdraws:  newWithdraws,
+		Tasks:      newTasks,
+		NextTaskId: uint64(len(newTasks) + 1),
 	}
 }
@@ -44,6 +44,7 @@ import (
 	bankcli "github.com/certikfoundation/shentu/x/bank/client/cli"
 	"github.com/certikfoundation/shentu/x/crisis"
 	cvmcli "github.com/certikfoundation/shentu/x/cvm/client/cli"
+	oracleoperator "github.com/certikfoundation/shentu/x/oracle/client/operator"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -141,6 +142,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		queryCommand(),
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
+		oracleoperator.ServeCommand(),
 	)
 }
 
@@ -3,6 +3,7 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"testing"
@@ -41,6 +42,8 @@ import (
 	cert "github.com/certikfoundation/shentu/x/cert/types"
 	cvm "github.com/certikfoundation/shentu/x/cvm/types"
 	oracle "github.com/certikfoundation/shentu/x/oracle/types"
+	shieldkeeper "github.com/certikfoundation/shentu/x/shield/keeper"
+	shieldsim "github.com/certikfoundation/shentu/x/shield/simulation"
 	shield "github.com/certikfoundation/shentu/x/shield/types"
 	//"github.com/certikfoundation/shentu/x/staking"
 )
@@ -306,6 +309,51 @@ func TestAppStateDeterminism(t *testing.T) {
 	}
 }
 
+// TestShieldInvariants runs randomized sequences of shield operations
+// and asserts all shield invariants after every block.
+func TestShieldInvariants(t *testing.T) {
+	config := simapp.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.NumBlocks = 20
+	config.BlockSize = 50
+	config.Commit = true
+	config.ExportParamsPath = ""
+	config.ExportStatePath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = helpers.SimAppChainID
+
+	for _, seed := range []int64{1, 7, 42} {
+		config.Seed = seed
+
+		app := NewCertiKApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+		invariant := shieldkeeper.AllInvariants(app.shieldKeeper)
+
+		// Assert invariants on the state left by every block, including
+		// the blocks in which several shield operations are delivered.
+		var broken []string
+		app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			res := app.EndBlocker(ctx, req)
+			if msg, stop := invariant(ctx); stop {
+				broken = append(broken, fmt.Sprintf("block %d: %s", ctx.BlockHeight(), msg))
+			}
+			return res
+		})
+		require.NoError(t, app.LoadLatestVersion())
+		app.capabilityKeeper.InitializeAndSeal(app.NewUncachedContext(true, tmproto.Header{}))
+
+		operations := shieldsim.WeightedOperations(make(simtypes.AppParams), app.appCodec,
+			app.shieldKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper)
+
+		_, _, err := simulation.SimulateFromSeed(
+			t, ioutil.Discard, app.BaseApp, simapp.AppStateFn(app.Codec(), app.SimulationManager()),
+			RandomAccounts, operations, app.ModuleAccountAddrs(), config, app.Codec(),
+		)
+		require.NoError(t, err, "seed %d", seed)
+		require.Empty(t, broken, "seed %d", seed)
+	}
+}
+
 // RandomAccounts generates n random accounts
 func RandomAccounts(r *rand.Rand, n int) []simtypes.Account {
 	accs := make([]simtypes.Account, n)
@@ -134,6 +134,7 @@ var (
 			upgradeclient.CancelProposalHandler,
 			certclient.ProposalHandler,
 			shieldclient.ProposalHandler,
+			shieldclient.AdminUpdateProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -322,6 +323,8 @@ func NewSimApp(
 		app.DistrKeeper,
 		&app.CertKeeper,
 		&app.StakingKeeper,
+		&app.ShieldKeeper,
+		&app.OracleKeeper,
 		app.GetSubspace(cvmtypes.ModuleName),
 	)
 	app.OracleKeeper = oraclekeeper.NewKeeper(
@@ -331,6 +334,7 @@ func NewSimApp(
 		app.DistrKeeper,
 		&app.StakingKeeper,
 		app.BankKeeper,
+		&app.CVMKeeper,
 		app.GetSubspace(oracletypes.ModuleName),
 	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -368,6 +372,7 @@ func NewSimApp(
 		app.BankKeeper,
 		&stakingKeeper,
 		&app.GovKeeper,
+		&app.CertKeeper,
 		app.GetSubspace(shieldtypes.ModuleName),
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
@@ -384,6 +389,9 @@ func NewSimApp(
 		),
 	)
 
+	// register the cert hooks
+	app.CertKeeper = *app.CertKeeper.SetHooks(app.ShieldKeeper.Hooks())
+
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, scopedIBCKeeper,
@@ -314,7 +314,13 @@ func (k Keeper) RevokeCertificate(ctx sdk.Context, certificate types.Certificate
 	if !k.IsCertifier(ctx, revoker) {
 		return types.ErrUnqualifiedRevoker
 	}
-	return k.DeleteCertificate(ctx, certificate)
+	if err := k.DeleteCertificate(ctx, certificate); err != nil {
+		return err
+	}
+	if k.hooks != nil {
+		k.hooks.AfterCertificateRevoked(ctx, certificate)
+	}
+	return nil
 }
 
 // GetCertifiedIdentities returns a list of addresses certified as identities.
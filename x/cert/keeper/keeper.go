@@ -16,6 +16,7 @@ type Keeper struct {
 	cdc            codec.BinaryMarshaler
 	slashingKeeper types.SlashingKeeper
 	stakingKeeper  types.StakingKeeper
+	hooks          types.CertHooks
 }
 
 // NewKeeper creates a new instance of the certifier keeper.
@@ -28,6 +29,15 @@ func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, slashingKeeper
 	}
 }
 
+// SetHooks sets the certificate hooks.
+func (k *Keeper) SetHooks(ch types.CertHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set cert hooks twice")
+	}
+	k.hooks = ch
+	return k
+}
+
 // CertifyPlatform certifies a validator host platform by a certifier.
 func (k Keeper) CertifyPlatform(ctx sdk.Context, certifier sdk.AccAddress, validator cryptotypes.PubKey, description string) error {
 	if !k.IsCertifier(ctx, certifier) {
@@ -29,4 +29,9 @@ type (
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
 	}
+
+	// CertHooks defines the event hooks for certificates.
+	CertHooks interface {
+		AfterCertificateRevoked(ctx sdk.Context, certificate Certificate)
+	}
 )
@@ -40,13 +40,16 @@ type Keeper struct {
 	dk         types.DistributionKeeper
 	ck         types.CertKeeper
 	sk         types.StakingKeeper
+	shk        types.ShieldKeeper
+	ok         types.OracleKeeper
 	paramSpace types.ParamSubspace
 }
 
 // NewKeeper creates a new instance of the CVM keeper.
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
-	dk types.DistributionKeeper, ck types.CertKeeper, sk types.StakingKeeper, paramSpace types.ParamSubspace) Keeper {
+	dk types.DistributionKeeper, ck types.CertKeeper, sk types.StakingKeeper, shk types.ShieldKeeper, ok types.OracleKeeper,
+	paramSpace types.ParamSubspace) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		key:        key,
@@ -55,6 +58,8 @@ func NewKeeper(
 		dk:         dk,
 		ck:         ck,
 		sk:         sk,
+		shk:        shk,
+		ok:         ok,
 		paramSpace: paramSpace,
 	}
 }
@@ -140,7 +145,17 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 		ctx:        ctx,
 		certKeeper: k.ck,
 	}
-	options := registerCVMNative(cc, sequenceBytes)
+	sc := ShieldCallable{
+		ctx:          ctx,
+		shieldKeeper: k.shk,
+	}
+	oc := OracleCallable{
+		ctx:          ctx,
+		oracleKeeper: k.ok,
+		requests:     &[]oracleTaskRequest{},
+	}
+	options := registerCVMNative(cc, sc, oc, sequenceBytes)
+	requestState := newOracleTaskRequestState(cache)
 
 	newCVM := vm.NewCVM(options)
 	bc := NewBlockChain(ctx, k)
@@ -151,10 +166,10 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 			ret = code
 		} else {
 			wvm := wasm.New(options)
-			ret, err = wvm.Execute(cache, bc, NewEventSink(ctx), callParams, code)
+			ret, err = wvm.Execute(requestState, bc, NewEventSink(ctx), callParams, code)
 		}
 	} else {
-		ret, err = newCVM.Execute(cache, bc, NewEventSink(ctx), callParams, code)
+		ret, err = newCVM.Execute(requestState, bc, NewEventSink(ctx), callParams, code)
 	}
 	// Refund cannot exceed half of the total gas cost.
 	// Only refund when there is no error.
@@ -183,9 +198,16 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 		}
 		ret = calleeAddr.Bytes()
 	}
+	taskRequests, err := oc.takeTaskRequests(requestState, cache)
+	if err != nil {
+		return nil, types.ErrCodedError(errors.GetCode(err))
+	}
 	if err = cache.Sync(state); err != nil {
 		return nil, types.ErrCodedError(errors.GetCode(err))
 	}
+	if err = k.createOracleTasks(ctx, taskRequests); err != nil {
+		return nil, err
+	}
 
 	return ret, nil
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -26,6 +27,8 @@ import (
 	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	. "github.com/certikfoundation/shentu/x/cvm/keeper"
 	"github.com/certikfoundation/shentu/x/cvm/types"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
+	shieldtypes "github.com/certikfoundation/shentu/x/shield/types"
 )
 
 var (
@@ -734,4 +737,194 @@ func TestPrecompiles(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, app.CertKeeper.IsValidatorCertified(ctx, validator))
 	})
+
+	t.Run("deploy and call shield coverage native contract", func(t *testing.T) {
+		code, err := hex.DecodeString(TestShieldCoverageString)
+		require.Nil(t, err)
+
+		result, err := app.CVMKeeper.Tx(ctx, addrs[2], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.NotNil(t, result)
+		newContractAddress := sdk.AccAddress(result)
+
+		external := "ethereum:0xdAC17F958D2ee523a2206206994597C13D831ec7"
+		app.ShieldKeeper.SetPool(ctx, shieldtypes.Pool{
+			Id:            1,
+			Active:        true,
+			Shield:        sdk.NewInt(5e9),
+			CoveredAssets: []string{newContractAddress.String(), addrs[1].String(), external},
+		})
+		app.ShieldKeeper.SetPool(ctx, shieldtypes.Pool{
+			Id:            2,
+			Active:        true,
+			Shield:        sdk.NewInt(2e9),
+			CoveredAssets: []string{addrs[1].String()},
+		})
+		coverage := func(covered bool, amount int64) []byte {
+			output := binary.LeftPadWord256(big.NewInt(amount).Bytes())
+			if covered {
+				return append(binary.One256.Bytes(), output.Bytes()...)
+			}
+			return append(binary.Zero256.Bytes(), output.Bytes()...)
+		}
+
+		// empty input checks the calling contract
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, nil, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, coverage(true, 5e9), result)
+
+		// an ABI-encoded address is covered by the sum of its pools
+		input := binary.LeftPadWord256(addrs[1].Bytes()).Bytes()
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, coverage(true, 7e9), result)
+
+		input = binary.LeftPadWord256(addrs[2].Bytes()).Bytes()
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, coverage(false, 0), result)
+
+		// other inputs are asset identifiers
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, []byte(external), []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, coverage(true, 5e9), result)
+	})
+
+	t.Run("deploy and call oracle native contracts", func(t *testing.T) {
+		// contract addresses depend on the deployer sequence, which is not incremented here
+		deployers := simapp.AddTestAddrs(app, ctx, 5, sdk.NewInt(80000*1e6))
+		code, err := hex.DecodeString(TestOracleScoreString)
+		require.Nil(t, err)
+		result, err := app.CVMKeeper.Tx(ctx, deployers[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		scoreContract := sdk.AccAddress(result)
+
+		code, err = hex.DecodeString(TestOracleCreateTaskString)
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, deployers[1], nil, 1000, code, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		createContract := sdk.AccAddress(result)
+
+		target := []abi.Argument{{EVM: abi.EVMString{}}, {EVM: abi.EVMString{}}}
+		request := append(target, abi.Argument{EVM: abi.EVMUint{M: 256}}, abi.Argument{EVM: abi.EVMUint{M: 256}})
+		contract, function := "0x1234567890abcdef", "transfer"
+		bondDenom := app.StakingKeeper.BondDenom(ctx)
+		words := func(values ...uint64) []byte {
+			var output []byte
+			for _, value := range values {
+				output = append(output, binary.Uint64ToWord256(value).Bytes()...)
+			}
+			return output
+		}
+
+		// no task of the contract function has been aggregated yet
+		input, err := abi.Pack(target, contract, function)
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], scoreContract, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, words(0, 0, 0), result)
+
+		// the contract pays the bounty of the task it requests
+		input, err = abi.Pack(request, contract, function, uint64(600), uint64(5))
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], createContract, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, binary.One256.Bytes(), result)
+		task, err := app.OracleKeeper.GetLatestTask(ctx, contract, function)
+		require.Nil(t, err)
+		require.Equal(t, createContract.String(), task.Creator)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)), task.Bounty)
+		require.Equal(t, ctx.BlockHeight()+5, task.ClosingBlock)
+		require.Equal(t, int64(400), app.BankKeeper.GetBalance(ctx, createContract, bondDenom).Amount.Int64())
+
+		// requests are tracked in a reserved account kept out of the state, which cannot receive coins
+		requestAddr := sdk.AccAddress(engine.AddressFromName("shentu/oracle/task_requests").Bytes())
+		require.Nil(t, app.AccountKeeper.GetAccount(ctx, requestAddr))
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, requestAddr))
+		_, err = app.CVMKeeper.Tx(ctx, addrs[0], requestAddr, 100, nil, []*payload.ContractMeta{}, false, false, false)
+		require.NotNil(t, err)
+		require.True(t, app.BankKeeper.GetBalance(ctx, requestAddr, bondDenom).IsZero())
+
+		// the call fails without enough balance and no task is created
+		input, err = abi.Pack(request, contract, function, uint64(600), uint64(0))
+		require.Nil(t, err)
+		_, err = app.CVMKeeper.Tx(ctx, addrs[0], createContract, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.NotNil(t, err)
+		latest, err := app.OracleKeeper.GetLatestTask(ctx, contract, function)
+		require.Nil(t, err)
+		require.Equal(t, task.Id, latest.Id)
+
+		// requests with an empty contract or function, or waiting too long, are rejected without a bounty paid
+		for _, args := range [][]interface{}{
+			{"", function, uint64(100), uint64(5)},
+			{contract, "", uint64(100), uint64(5)},
+			{contract, function, uint64(100), uint64(oracletypes.MaxWaitingBlocks) + 1},
+			{contract, function, uint64(100), uint64(1) << 63},
+		} {
+			input, err = abi.Pack(request, args...)
+			require.Nil(t, err)
+			_, err = app.CVMKeeper.Tx(ctx, addrs[0], createContract, 0, input, []*payload.ContractMeta{}, false, false, false)
+			require.NotNil(t, err)
+			require.Equal(t, int64(400), app.BankKeeper.GetBalance(ctx, createContract, bondDenom).Amount.Int64())
+		}
+		latest, err = app.OracleKeeper.GetLatestTask(ctx, contract, function)
+		require.Nil(t, err)
+		require.Equal(t, task.Id, latest.Id)
+
+		// the result of an aggregated task is returned with its status and closing block
+		task.Status = oracletypes.TaskStatusSucceeded
+		task.Result = sdk.NewInt(80)
+		app.OracleKeeper.SetTask(ctx, task)
+		input, err = abi.Pack(target, contract, function)
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, addrs[0], scoreContract, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		require.Equal(t, words(80, uint64(oracletypes.TaskStatusSucceeded), uint64(task.ClosingBlock)), result)
+
+		// a request reverted by its caller does not create a task
+		code, err = hex.DecodeString(TestOracleCreateTaskRevertString)
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, deployers[2], nil, 1000, code, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		revertContract := sdk.AccAddress(result)
+		code, err = hex.DecodeString(fmt.Sprintf(TestForwardCallFormat, hex.EncodeToString(revertContract)))
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, deployers[3], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		forwardContract := sdk.AccAddress(result)
+
+		input, err = abi.Pack(request, contract, function, uint64(600), uint64(0))
+		require.Nil(t, err)
+		_, err = app.CVMKeeper.Tx(ctx, addrs[0], forwardContract, 0, input, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		latest, err = app.OracleKeeper.GetLatestTask(ctx, contract, function)
+		require.Nil(t, err)
+		require.Equal(t, task.Id, latest.Id)
+		require.Equal(t, int64(1000), app.BankKeeper.GetBalance(ctx, revertContract, bondDenom).Amount.Int64())
+
+		// requests cannot be made in read-only calls
+		input, err = abi.Pack(request, contract, function, uint64(100), uint64(0))
+		require.Nil(t, err)
+		_, err = app.CVMKeeper.Tx(ctx, addrs[0], createContract, 0, input, []*payload.ContractMeta{}, true, false, false)
+		require.NotNil(t, err)
+		require.Equal(t, int64(400), app.BankKeeper.GetBalance(ctx, createContract, bondDenom).Amount.Int64())
+
+		// callback contracts receive the task ID followed by the words returned by OracleScore
+		code, err = hex.DecodeString(TestOracleCallbackString)
+		require.Nil(t, err)
+		result, err = app.CVMKeeper.Tx(ctx, deployers[4], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		callbackContract := sdk.AccAddress(result)
+		oracleAddr := app.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+		require.Nil(t, app.CVMKeeper.CallOracleCallback(ctx, oracleAddr, callbackContract, task))
+		expected := [][]byte{words(task.Id), words(80), words(uint64(oracletypes.TaskStatusSucceeded)), words(uint64(task.ClosingBlock))}
+		for i, word := range expected {
+			value, err := app.CVMKeeper.GetStorage(ctx, crypto.MustAddressFromBytes(callbackContract), binary.Int64ToWord256(int64(i)))
+			require.Nil(t, err)
+			require.Equal(t, word, value)
+		}
+
+		// accounts without code cannot be called back
+		require.NotNil(t, app.CVMKeeper.CallOracleCallback(ctx, oracleAddr, addrs[1], task))
+	})
 }
@@ -1,19 +1,25 @@
 package keeper
 
 import (
+	gobin "encoding/binary"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
 
 	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	"github.com/certikfoundation/shentu/x/cvm/types"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
 )
 
 type CertificateCallable struct {
@@ -21,19 +27,47 @@ type CertificateCallable struct {
 	certKeeper types.CertKeeper
 }
 
+type ShieldCallable struct {
+	ctx          sdk.Context
+	shieldKeeper types.ShieldKeeper
+}
+
+type OracleCallable struct {
+	ctx          sdk.Context
+	oracleKeeper types.OracleKeeper
+	// requests are the oracle tasks requested by contracts during a call.
+	requests *[]oracleTaskRequest
+}
+
+// oracleTaskRequest is an oracle task requested by a contract, which is created once the call succeeds.
+type oracleTaskRequest struct {
+	caller   crypto.Address
+	contract string
+	function string
+	bounty   uint64
+	wait     int64
+}
+
 const (
 	// TODO: consolidate native contract gas consumption
 	GasBase int64 = 1000
+
+	// OracleCallbackSignature is the signature of the function called on the
+	// callback contracts of oracle inquiries when their tasks close.
+	OracleCallbackSignature = "oracleCallback(uint256,uint256,uint256,uint256)"
 )
 
 // registerCVMNative registers precompile contracts in CVM.
-func registerCVMNative(cc CertificateCallable, nonce []byte) engine.Options {
+func registerCVMNative(cc CertificateCallable, sc ShieldCallable, oc OracleCallable, nonce []byte) engine.Options {
 	return engine.Options{
 		Natives: native.MustDefaultNatives().
 			MustFunction("General", leftPadAddress(101), permission.None, cc.checkGeneral).
 			MustFunction("Proof", leftPadAddress(102), permission.None, cc.checkProof).
 			MustFunction("Compilation", leftPadAddress(103), permission.None, cc.checkCompilation).
-			MustFunction("CertifyValidator", leftPadAddress(104), permission.None, cc.certifyValidator),
+			MustFunction("CertifyValidator", leftPadAddress(104), permission.None, cc.certifyValidator).
+			MustFunction("ShieldCoverage", leftPadAddress(105), permission.None, sc.checkCoverage).
+			MustFunction("OracleScore", leftPadAddress(106), permission.None, oc.checkScore).
+			MustFunction("OracleCreateTask", leftPadAddress(107), permission.None, oc.createTask),
 		Nonce: nonce,
 	}
 }
@@ -106,6 +140,267 @@ func (cc CertificateCallable) certifyValidator(ctx native.Context) (output []byt
 	return []byte{0x01}, nil
 }
 
+// checkCoverage checks if an asset is covered by active Shield pools.
+// The input is empty for the calling contract, a 32-byte ABI-encoded
+// address, or an asset identifier such as an external contract. The
+// output is a word of 0x01 if covered, followed by a word of the total
+// shield of the covering pools.
+func (sc ShieldCallable) checkCoverage(ctx native.Context) (output []byte, err error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return nil, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	var asset string
+	switch {
+	case len(ctx.Input) == 0:
+		asset = sdk.AccAddress(ctx.Caller.Bytes()).String()
+	case len(ctx.Input) == binary.Word256Bytes && isZero(ctx.Input[:binary.Word256Bytes-crypto.AddressLength]):
+		addr, err := crypto.AddressFromBytes(ctx.Input[binary.Word256Bytes-crypto.AddressLength:])
+		if err != nil {
+			return nil, err
+		}
+		asset = sdk.AccAddress(addr.Bytes()).String()
+	default:
+		asset = string(ctx.Input)
+	}
+	poolIDs, shield := sc.shieldKeeper.GetAssetCoverage(sc.ctx, asset)
+	if shield.BigInt().BitLen() > 8*binary.Word256Bytes {
+		return nil, errors.Codes.IntegerOverflow
+	}
+	covered := binary.Zero256
+	if len(poolIDs) > 0 {
+		covered = binary.One256
+	}
+	amount := binary.LeftPadWord256(shield.BigInt().Bytes())
+	return append(covered.Bytes(), amount.Bytes()...), nil
+}
+
+// checkScore returns the latest aggregated security score of a contract function.
+// The input is the ABI encoding of the contract and the function strings. The
+// output is a word of the task result, a word of the task status and a word
+// of the closing block height, which are all zero if no task of the contract
+// function has been aggregated.
+func (oc OracleCallable) checkScore(ctx native.Context) (output []byte, err error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return nil, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	contract, function, _, err := unpackOracleInput(ctx.Input, 0)
+	if err != nil {
+		return nil, err
+	}
+	task, err := oc.oracleKeeper.GetLatestAggregatedTask(oc.ctx, contract, function)
+	if err != nil {
+		return make([]byte, 3*binary.Word256Bytes), nil
+	}
+	return oracleTaskWords(task), nil
+}
+
+// createTask requests an oracle task for a contract function with a bounty
+// paid from the balance of the calling contract. The input is the ABI encoding
+// of the contract and the function strings, the bounty amount and the number
+// of blocks to wait for responses, zero for the default and at most
+// MaxWaitingBlocks of the oracle module. The bounty is deducted right away,
+// and the task is created once the whole call succeeds, so that reverted
+// requests are dropped. The output is a word of 0x01.
+func (oc OracleCallable) createTask(ctx native.Context) (output []byte, err error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return nil, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	// The bounty is paid by the calling contract, not by the caller it is delegated by,
+	// and not with the value of the call, which cannot be held by native contracts.
+	if ctx.CallType == exec.CallTypeDelegate {
+		return nil, errors.Codes.PermissionDenied
+	}
+	if ctx.Value.Sign() != 0 {
+		return nil, errors.Codes.Overpayment
+	}
+	contract, function, words, err := unpackOracleInput(ctx.Input, 2)
+	if err != nil {
+		return nil, err
+	}
+	if contract == "" || function == "" {
+		return nil, errors.Codes.InvalidString
+	}
+	if !isZero(words[0][:binary.Word256Bytes-8]) || !isZero(words[1][:binary.Word256Bytes-8]) {
+		return nil, errors.Codes.IntegerOverflow
+	}
+	wait := binary.Uint64FromWord256(words[1])
+	if wait > uint64(oracletypes.MaxWaitingBlocks) {
+		return nil, errors.Codes.BlockNumberOutOfRange
+	}
+	request := oracleTaskRequest{
+		caller:   ctx.Caller,
+		contract: contract,
+		function: function,
+		bounty:   binary.Uint64FromWord256(words[0]),
+		wait:     int64(wait),
+	}
+
+	acc, err := ctx.State.CallFrame.GetAccount(ctx.Caller)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, errors.Codes.UnknownAddress
+	}
+	if err := acc.SubtractFromBalance(request.bounty); err != nil {
+		return nil, err
+	}
+	if err := ctx.State.CallFrame.UpdateAccount(acc); err != nil {
+		return nil, err
+	}
+	// The marker of the request is reverted along with the call frame.
+	if err := ctx.State.CallFrame.SetStorage(oracleTaskRequestAddress, oracleTaskRequestKey(len(*oc.requests)), binary.One256.Bytes()); err != nil {
+		return nil, err
+	}
+	*oc.requests = append(*oc.requests, request)
+	return binary.One256.Bytes(), nil
+}
+
+// takeTaskRequests returns the oracle task requests whose markers reached the
+// request state, giving their bounties back to the callers so that the bounties
+// can be collected when the tasks are created.
+func (oc OracleCallable) takeTaskRequests(requestState *oracleTaskRequestState, cache *acmstate.Cache) ([]oracleTaskRequest, error) {
+	var requests []oracleTaskRequest
+	for i, request := range *oc.requests {
+		if !requestState.markers[oracleTaskRequestKey(i)] {
+			continue
+		}
+		acc, err := cache.GetAccount(request.caller)
+		if err != nil {
+			return nil, err
+		}
+		if err := acc.AddToBalance(request.bounty); err != nil {
+			return nil, err
+		}
+		if err := cache.UpdateAccount(acc); err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// createOracleTasks creates the oracle tasks requested by contracts.
+func (k Keeper) createOracleTasks(ctx sdk.Context, requests []oracleTaskRequest) error {
+	taskParams := k.ok.GetTaskParams(ctx)
+	for _, request := range requests {
+		wait := request.wait
+		if wait == 0 {
+			wait = taskParams.AggregationWindow
+		}
+		creator := sdk.AccAddress(request.caller.Bytes())
+		bounty := sdk.NewCoins(sdk.NewCoin(k.sk.BondDenom(ctx), sdk.NewIntFromUint64(request.bounty)))
+		taskID, err := k.ok.CreateTask(ctx, request.contract, request.function, bounty, "",
+			ctx.BlockTime().Add(taskParams.ExpirationDuration), creator, wait, 0, oracletypes.AggregationStrategyNil)
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				oracletypes.TypeMsgCreateTask,
+				sdk.NewAttribute("task_id", strconv.FormatUint(taskID, 10)),
+				sdk.NewAttribute("contract", request.contract),
+				sdk.NewAttribute("function", request.function),
+				sdk.NewAttribute("bounty", bounty.String()),
+				sdk.NewAttribute("creator", creator.String()),
+				sdk.NewAttribute("windowSize", strconv.FormatInt(wait, 10)),
+				sdk.NewAttribute("closingHeight", strconv.FormatInt(ctx.BlockHeight()+wait, 10)),
+			),
+		)
+	}
+	return nil
+}
+
+// CallOracleCallback calls the oracle callback function of a contract with
+// the ID of a closed task, followed by the words returned by OracleScore.
+func (k Keeper) CallOracleCallback(ctx sdk.Context, caller, callee sdk.AccAddress, task oracletypes.Task) error {
+	code, err := k.GetCode(ctx, crypto.MustAddressFromBytes(callee))
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return types.ErrCodedError(errors.Codes.NonExistentAccount)
+	}
+	data := append(crypto.Keccak256([]byte(OracleCallbackSignature))[:4], binary.Uint64ToWord256(task.Id).Bytes()...)
+	data = append(data, oracleTaskWords(task)...)
+	_, err = k.Tx(ctx, caller, callee, 0, data, []*payload.ContractMeta{}, false, false, false)
+	return err
+}
+
+// oracleTaskWords returns a word of the result, a word of the status and
+// a word of the closing block height of a task.
+func oracleTaskWords(task oracletypes.Task) []byte {
+	result := binary.Zero256
+	if !task.Result.IsNil() {
+		result = binary.LeftPadWord256(task.Result.BigInt().Bytes())
+	}
+	output := append(result.Bytes(), binary.Uint64ToWord256(uint64(task.Status)).Bytes()...)
+	return append(output, binary.Uint64ToWord256(uint64(task.ClosingBlock)).Bytes()...)
+}
+
+// oracleTaskRequestKey returns the storage key of the marker of an oracle task request.
+func oracleTaskRequestKey(index int) binary.Word256 {
+	return binary.Uint64ToWord256(uint64(index))
+}
+
+// unpackOracleInput decodes an ABI-encoded contract string and function string,
+// followed by the given number of static words.
+func unpackOracleInput(input []byte, words int) (contract, function string, values []binary.Word256, err error) {
+	if len(input) < (2+words)*binary.Word256Bytes {
+		return "", "", nil, errors.Codes.InputOutOfBounds
+	}
+	if contract, err = unpackString(input, 0); err != nil {
+		return "", "", nil, err
+	}
+	if function, err = unpackString(input, 1); err != nil {
+		return "", "", nil, err
+	}
+	for i := 0; i < words; i++ {
+		values = append(values, binary.LeftPadWord256(input[(2+i)*binary.Word256Bytes:(3+i)*binary.Word256Bytes]))
+	}
+	return contract, function, values, nil
+}
+
+// unpackString decodes the ABI-encoded string whose offset is the word at the index of the input.
+func unpackString(input []byte, index int) (string, error) {
+	offsetWord := input[index*binary.Word256Bytes : (index+1)*binary.Word256Bytes]
+	if !isZero(offsetWord[:binary.Word256Bytes-8]) {
+		return "", errors.Codes.InputOutOfBounds
+	}
+	offset := gobin.BigEndian.Uint64(offsetWord[binary.Word256Bytes-8:])
+	if offset > uint64(len(input)) || uint64(len(input))-offset < binary.Word256Bytes {
+		return "", errors.Codes.InputOutOfBounds
+	}
+	lengthWord := input[offset : offset+binary.Word256Bytes]
+	if !isZero(lengthWord[:binary.Word256Bytes-8]) {
+		return "", errors.Codes.InputOutOfBounds
+	}
+	length := gobin.BigEndian.Uint64(lengthWord[binary.Word256Bytes-8:])
+	start := offset + binary.Word256Bytes
+	if length > uint64(len(input))-start {
+		return "", errors.Codes.InputOutOfBounds
+	}
+	return string(input[start : start+length]), nil
+}
+
+func isZero(bs []byte) bool {
+	for _, b := range bs {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func leftPadAddress(bs ...byte) crypto.Address {
 	return crypto.AddressFromWord256(binary.LeftPadWord256(bs))
 }
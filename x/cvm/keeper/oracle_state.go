@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+// oracleTaskRequestAddress is the reserved address of the account holding the
+// markers of the oracle tasks requested by contracts during a call.
+var oracleTaskRequestAddress = engine.AddressFromName("shentu/oracle/task_requests")
+
+// oracleTaskRequestState is the state the CVM executes calls against. It passes
+// everything through to the state cache of the call, except the account at
+// oracleTaskRequestAddress, whose storage is kept in memory. The markers set by
+// OracleCreateTask go through the call frames of the CVM, which only sync them
+// back once their calls succeed, so the markers left after the execution are
+// the ones of the requests which were not reverted. It implements
+// acmstate.ReaderWriter.
+type oracleTaskRequestState struct {
+	acmstate.ReaderWriter
+	markers map[binary.Word256]bool
+}
+
+// newOracleTaskRequestState returns a new request state on top of a state cache.
+func newOracleTaskRequestState(backend acmstate.ReaderWriter) *oracleTaskRequestState {
+	return &oracleTaskRequestState{
+		ReaderWriter: backend,
+		markers:      make(map[binary.Word256]bool),
+	}
+}
+
+// GetAccount returns an empty account at oracleTaskRequestAddress, so that
+// markers can be set in its storage, and the account of the backend otherwise.
+func (s *oracleTaskRequestState) GetAccount(address crypto.Address) (*acm.Account, error) {
+	if address == oracleTaskRequestAddress {
+		return &acm.Account{Address: address}, nil
+	}
+	return s.ReaderWriter.GetAccount(address)
+}
+
+// UpdateAccount rejects any balance or code sent to oracleTaskRequestAddress,
+// which cannot hold them.
+func (s *oracleTaskRequestState) UpdateAccount(account *acm.Account) error {
+	if account.Address != oracleTaskRequestAddress {
+		return s.ReaderWriter.UpdateAccount(account)
+	}
+	if account.Balance != 0 || len(account.EVMCode) != 0 || len(account.WASMCode) != 0 {
+		return errors.Errorf(errors.Codes.ReservedAddress,
+			"cannot update account at %v because that address is reserved for oracle task requests", account.Address)
+	}
+	return nil
+}
+
+// RemoveAccount rejects removing the account at oracleTaskRequestAddress.
+func (s *oracleTaskRequestState) RemoveAccount(address crypto.Address) error {
+	if address == oracleTaskRequestAddress {
+		return errors.Errorf(errors.Codes.ReservedAddress,
+			"cannot remove account at %v because that address is reserved for oracle task requests", address)
+	}
+	return s.ReaderWriter.RemoveAccount(address)
+}
+
+// GetStorage returns the marker of an oracle task request at
+// oracleTaskRequestAddress and the storage of the backend otherwise.
+func (s *oracleTaskRequestState) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	if address == oracleTaskRequestAddress {
+		if s.markers[key] {
+			return binary.One256.Bytes(), nil
+		}
+		return binary.Zero256.Bytes(), nil
+	}
+	return s.ReaderWriter.GetStorage(address, key)
+}
+
+// SetStorage sets the marker of an oracle task request at
+// oracleTaskRequestAddress and the storage of the backend otherwise.
+func (s *oracleTaskRequestState) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
+	if address == oracleTaskRequestAddress {
+		if binary.LeftPadWord256(value) == binary.Zero256 {
+			delete(s.markers, key)
+		} else {
+			s.markers[key] = true
+		}
+		return nil
+	}
+	return s.ReaderWriter.SetStorage(address, key, value)
+}
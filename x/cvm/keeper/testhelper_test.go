@@ -317,4 +317,28 @@ var (
 
 	TestCertifyValidatorString        = "60806040526040518060800160405280605381526020016103a16053913960009080519060200190610032929190610045565b5034801561003f57600080fd5b50610149565b828054610051906100e8565b90600052602060002090601f01602090048101928261007357600085556100ba565b82601f1061008c57805160ff19168380011785556100ba565b828001600101855582156100ba579182015b828111156100b957825182559160200191906001019061009e565b5b5090506100c791906100cb565b5090565b5b808211156100e45760008160009055506001016100cc565b5090565b6000600282049050600182168061010057607f821691505b602082108114156101145761011361011a565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b610249806101586000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c80633c1bf57b14610030575b600080fd5b61003861004e565b6040516100459190610130565b60405180910390f35b6060600080805461005e906101a1565b80601f016020809104026020016040519081016040528092919081815260200182805461008a906101a1565b80156100d75780601f106100ac576101008083540402835291602001916100d7565b820191906000526020600020905b8154815290600101906020018083116100ba57829003601f168201915b505050505090506001815160018282602086016000606861c350f1600183f35b600061010282610152565b61010c818561015d565b935061011c81856020860161016e565b61012581610202565b840191505092915050565b6000602082019050818103600083015261014a81846100f7565b905092915050565b600081519050919050565b600082825260208201905092915050565b60005b8381101561018c578082015181840152602081019050610171565b8381111561019b576000848401525b50505050565b600060028204905060018216806101b957607f821691505b602082108114156101cd576101cc6101d3565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000601f19601f830116905091905056fea26469706673582212206432f04b3863a71e348225305d55be91def584a696225e327741a5583432d26764736f6c63430008010033636f736d6f7376616c636f6e73707562317a636a647565707178687936383635686639306c776d636b6a756567666476716d797a6e6864366134646b6a72393070713061383266787867327171637066716174"
 	TestCertifyValidatorAbiJsonString = `[{"inputs":[],"name":"certifyValidator","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"}]`
+
+	// TestShieldCoverageString forwards its call data to the ShieldCoverage
+	// native contract and returns the 64-byte output.
+	TestShieldCoverageString = "6017600c60003960176000f33660006000376040600036600060695afa5060406000f3"
+
+	// TestOracleScoreString forwards its call data to the OracleScore native
+	// contract and returns the 96-byte output.
+	TestOracleScoreString = "6017600c60003960176000f336600060003760606000366000606a5afa5060606000f3"
+
+	// TestOracleCreateTaskString forwards its call data to the OracleCreateTask
+	// native contract and returns the 32-byte output.
+	TestOracleCreateTaskString = "6019600c60003960196000f3366000600037602060003660006000606b5af15060206000f3"
+
+	// TestOracleCreateTaskRevertString forwards its call data to the
+	// OracleCreateTask native contract and reverts.
+	TestOracleCreateTaskRevertString = "6019600c60003960196000f3366000600037602060003660006000606b5af15060006000fd"
+
+	// TestOracleCallbackString stores the four words following the function
+	// selector of its call data in the storage slots 0 to 3.
+	TestOracleCallbackString = "6019600c60003960196000f360043560005560243560015560443560025560643560035500"
+
+	// TestForwardCallFormat forwards its call data to the contract at the
+	// hex-encoded address and stops whether the call succeeds or not.
+	TestForwardCallFormat = "6028600c60003960286000f3366000600037600060003660006000" + "73%s" + "5af15000"
 )
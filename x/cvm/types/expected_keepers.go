@@ -1,9 +1,13 @@
 package types
 
 import (
+	"time"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
 )
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
@@ -51,3 +55,16 @@ type CertKeeper interface {
 type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
 }
+
+// ShieldKeeper defines the expected shield keeper
+type ShieldKeeper interface {
+	GetAssetCoverage(ctx sdk.Context, asset string) ([]uint64, sdk.Int)
+}
+
+// OracleKeeper defines the expected oracle keeper
+type OracleKeeper interface {
+	GetTaskParams(ctx sdk.Context) oracletypes.TaskParams
+	GetLatestAggregatedTask(ctx sdk.Context, contract, function string) (oracletypes.Task, error)
+	CreateTask(ctx sdk.Context, contract string, function string, bounty sdk.Coins, description string, expiration time.Time,
+		creator sdk.AccAddress, waitingBlocks int64, revealBlocks int64, aggregationStrategy oracletypes.AggregationStrategy) (uint64, error)
+}
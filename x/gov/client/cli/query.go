@@ -41,6 +41,7 @@ func GetQueryCmd() *cobra.Command {
 		cli.GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		cli.GetCmdQueryTally(),
+		GetCmdQueryClaimAssessments(),
 	)
 
 	return govQueryCmd
@@ -451,3 +452,48 @@ $ %[1]s query gov param deposit
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryClaimAssessments implements the command to query certifier assessments of a shield claim proposal.
+func GetCmdQueryClaimAssessments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-assessments [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query certifier assessments of a shield claim proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query certifier assessments of a shield claim proposal and their summary.
+
+Example:
+$ %[1]s query gov claim-assessments 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ClaimAssessments(
+				cmd.Context(),
+				&types.QueryClaimAssessmentsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,11 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/certikfoundation/shentu/x/gov/client/utils"
+	"github.com/certikfoundation/shentu/x/gov/types"
 )
 
 // Proposal flags
@@ -22,6 +24,8 @@ const (
 	flagVoter     = "voter"
 	flagDepositor = "depositor"
 	flagStatus    = "status"
+
+	flagLossVerified = "loss-verified"
 )
 
 // NewTxCmd returns the transaction commands for gov module.
@@ -66,6 +70,7 @@ $ %[1]s tx gov submit-proposal --title="Test Proposal" --description="My awesome
 	govTxCmd.AddCommand(
 		cli.NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdSubmitClaimAssessment(),
 		cmdSubmitProp,
 	)
 
@@ -125,3 +130,57 @@ $ %[1]s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdSubmitClaimAssessment implements submitting a certifier assessment of a shield claim proposal.
+func NewCmdSubmitClaimAssessment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assess-claim [proposal-id] [recommended-amount] [incident-report-hash]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a certifier assessment of a shield claim proposal before its voting begins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an assessment of a shield claim proposal as a certifier.
+The incident report hash is the hex-encoded SHA-256 digest of the incident report.
+A recommended amount can only be given when the loss is verified.
+
+Example:
+$ %[1]s tx gov assess-claim 1 1000uctk <incident-report-hash> --loss-verified --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s is not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			recommendedAmount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			lossVerified, err := cmd.Flags().GetBool(flagLossVerified)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitClaimAssessment(cliCtx.GetFromAddress(), proposalID, lossVerified, recommendedAmount, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagLossVerified, false, "whether the certifier has verified the claimed loss")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
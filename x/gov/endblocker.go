@@ -16,6 +16,7 @@ import (
 func removeInactiveProposals(ctx sdk.Context, k keeper.Keeper) {
 	k.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		k.DeleteProposalByProposalID(ctx, proposal.ProposalId)
+		k.DeleteClaimAssessments(ctx, proposal.ProposalId)
 		k.RefundDepositsByProposalID(ctx, proposal.ProposalId)
 
 		ctx.EventManager().EmitEvent(
@@ -35,6 +36,7 @@ func updateVeto(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal) {
 	if proposal.ProposalType() == shieldtypes.ProposalTypeShieldClaim {
 		c := proposal.GetContent().(*shieldtypes.ShieldClaimProposal)
 		k.ShieldKeeper.ClaimEnd(ctx, c.ProposalId, c.PoolId, c.Loss)
+		k.ShieldKeeper.UpdateClaimStatus(ctx, c.ProposalId, shieldtypes.ClaimStatusVetoed)
 	}
 }
 
@@ -45,8 +47,23 @@ func updateAbstain(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal) {
 		if err != nil {
 			panic(err)
 		}
-		k.ShieldKeeper.RestoreShield(ctx, c.PoolId, proposer, c.PurchaseId, c.Loss)
+		k.ShieldKeeper.RestoreShield(ctx, c.ProposalId, c.PoolId, proposer, c.PurchaseId, c.Loss)
 		k.ShieldKeeper.ClaimEnd(ctx, c.ProposalId, c.PoolId, c.Loss)
+		k.ShieldKeeper.UpdateClaimStatus(ctx, c.ProposalId, shieldtypes.ClaimStatusRejected)
+	}
+}
+
+// claimAssessmentAttributes returns the summary of certifier assessments
+// recorded when voting on a shield claim proposal began as event attributes.
+func claimAssessmentAttributes(proposal types.Proposal) []sdk.Attribute {
+	if proposal.ProposalType() != shieldtypes.ProposalTypeShieldClaim {
+		return nil
+	}
+	summary := proposal.ClaimAssessmentSummary
+	return []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyClaimAssessments, fmt.Sprintf("%d", summary.Assessments)),
+		sdk.NewAttribute(types.AttributeKeyClaimAssessmentsVerified, fmt.Sprintf("%d", summary.LossVerified)),
+		sdk.NewAttribute(types.AttributeKeyRecommendedAmount, summary.RecommendedAmount.String()),
 	}
 }
 
@@ -105,6 +122,7 @@ func processActiveProposal(ctx sdk.Context, k keeper.Keeper, proposal types.Prop
 	}
 
 	proposal.FinalTallyResult = tallyResults
+	assessmentAttributes := claimAssessmentAttributes(proposal)
 
 	k.SetProposal(ctx, proposal)
 	k.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
@@ -116,7 +134,7 @@ func processActiveProposal(ctx sdk.Context, k keeper.Keeper, proposal types.Prop
 			govTypes.EventTypeActiveProposal,
 			sdk.NewAttribute(govTypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
 			sdk.NewAttribute(govTypes.AttributeKeyProposalResult, tagValue),
-		),
+		).AppendAttributes(assessmentAttributes...),
 	)
 	return false
 }
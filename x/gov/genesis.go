@@ -43,6 +43,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak govTypes.AccountKeeper, bk
 		k.SetProposal(ctx, proposal)
 	}
 
+	for _, assessment := range data.ClaimAssessments {
+		k.SetClaimAssessment(ctx, assessment)
+	}
+
 	// add coins if not provided on genesis
 	if bk.GetAllBalances(ctx, moduleAcc.GetAddress()).IsZero() {
 		if err := bk.SetBalances(ctx, moduleAcc.GetAddress(), totalDeposits); err != nil {
@@ -66,6 +70,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	for _, proposal := range proposals {
 		genState.Deposits = append(genState.Deposits, k.GetDepositsByProposalID(ctx, proposal.ProposalId)...)
 		genState.Votes = append(genState.Votes, k.GetVotes(ctx, proposal.ProposalId)...)
+		genState.ClaimAssessments = append(genState.ClaimAssessments, k.GetClaimAssessments(ctx, proposal.ProposalId)...)
 	}
 	genState.StartingProposalId = startingProposalID
 	genState.Proposals = proposals
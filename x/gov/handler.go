@@ -29,6 +29,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Vote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitClaimAssessment:
+			res, err := msgServer.SubmitClaimAssessment(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", govtypes.ModuleName, msg)
 		}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/certikfoundation/shentu/x/gov/types"
+	shieldtypes "github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// AddClaimAssessment adds or replaces a certifier's or a shield claim
+// manager's assessment of a shield claim proposal. Assessments are
+// accepted only during the deposit period, before voting begins.
+func (k Keeper) AddClaimAssessment(ctx sdk.Context, assessment types.ClaimAssessment) error {
+	proposal, ok := k.GetProposal(ctx, assessment.ProposalId)
+	if !ok {
+		return sdkerrors.Wrapf(govtypes.ErrUnknownProposal, "%v", assessment.ProposalId)
+	}
+	claim, ok := proposal.GetContent().(*shieldtypes.ShieldClaimProposal)
+	if !ok {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidProposalType, "proposal %d is not a shield claim", assessment.ProposalId)
+	}
+	if proposal.Status != types.StatusDepositPeriod {
+		return sdkerrors.Wrapf(govtypes.ErrInactiveProposal, "claim review of proposal %d has ended", assessment.ProposalId)
+	}
+
+	certifier, err := sdk.AccAddressFromBech32(assessment.Certifier)
+	if err != nil {
+		return err
+	}
+	if !k.IsCertifier(ctx, certifier) && !k.ShieldKeeper.IsClaimManager(ctx, certifier) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "'%s' is not a certifier or a shield claim manager", certifier)
+	}
+	if !claim.Loss.IsAllGTE(assessment.RecommendedAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"recommended amount %s exceeds the claimed loss %s", assessment.RecommendedAmount, claim.Loss)
+	}
+
+	k.SetClaimAssessment(ctx, assessment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitClaimAssessment,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", assessment.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyCertifier, assessment.Certifier),
+			sdk.NewAttribute(types.AttributeKeyLossVerified, fmt.Sprintf("%t", assessment.LossVerified)),
+			sdk.NewAttribute(types.AttributeKeyRecommendedAmount, assessment.RecommendedAmount.String()),
+		),
+	)
+	return nil
+}
+
+// SetClaimAssessment sets a claim assessment to the store.
+func (k Keeper) SetClaimAssessment(ctx sdk.Context, assessment types.ClaimAssessment) {
+	certifier, err := sdk.AccAddressFromBech32(assessment.Certifier)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&assessment)
+	store.Set(types.ClaimAssessmentKey(assessment.ProposalId, certifier), bz)
+}
+
+// GetClaimAssessment gets the assessment of a proposal by a certifier.
+func (k Keeper) GetClaimAssessment(ctx sdk.Context, proposalID uint64, certifier sdk.AccAddress) (types.ClaimAssessment, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClaimAssessmentKey(proposalID, certifier))
+	if bz == nil {
+		return types.ClaimAssessment{}, false
+	}
+	var assessment types.ClaimAssessment
+	k.cdc.MustUnmarshalBinaryBare(bz, &assessment)
+	return assessment, true
+}
+
+// IterateClaimAssessments iterates over all the assessments of a proposal and performs a callback function.
+func (k Keeper) IterateClaimAssessments(ctx sdk.Context, proposalID uint64, cb func(assessment types.ClaimAssessment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimAssessmentsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var assessment types.ClaimAssessment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &assessment)
+
+		if cb(assessment) {
+			break
+		}
+	}
+}
+
+// GetClaimAssessments returns all the assessments of a proposal.
+func (k Keeper) GetClaimAssessments(ctx sdk.Context, proposalID uint64) (assessments types.ClaimAssessments) {
+	k.IterateClaimAssessments(ctx, proposalID, func(assessment types.ClaimAssessment) bool {
+		assessments = append(assessments, assessment)
+		return false
+	})
+	return
+}
+
+// DeleteClaimAssessments deletes all the assessments of a proposal.
+func (k Keeper) DeleteClaimAssessments(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, assessment := range k.GetClaimAssessments(ctx, proposalID) {
+		certifier, err := sdk.AccAddressFromBech32(assessment.Certifier)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.ClaimAssessmentKey(proposalID, certifier))
+	}
+}
@@ -278,3 +278,23 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// ClaimAssessments returns certifier assessments of a shield claim proposal
+func (q Keeper) ClaimAssessments(c context.Context, req *types.QueryClaimAssessmentsRequest) (*types.QueryClaimAssessmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := q.GetProposal(ctx, req.ProposalId); !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	assessments := q.GetClaimAssessments(ctx, req.ProposalId)
+
+	return &types.QueryClaimAssessmentsResponse{Assessments: assessments, Summary: assessments.Summary()}, nil
+}
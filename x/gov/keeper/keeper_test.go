@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"reflect"
 	"testing"
@@ -9,14 +10,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/certikfoundation/shentu/simapp"
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	. "github.com/certikfoundation/shentu/x/gov/keeper"
 	"github.com/certikfoundation/shentu/x/gov/types"
+	shieldtypes "github.com/certikfoundation/shentu/x/shield/types"
 )
 
 func TestKeeper_ProposeAndVote(t *testing.T) {
@@ -261,3 +265,76 @@ func TestKeeper_DepositOperation(t *testing.T) {
 		require.Equal(t, sdk.NewInt(79950*1e6).Int64(), addr3Amount.AmountOf(app.StakingKeeper.BondDenom(ctx)).Int64())
 	})
 }
+
+func TestKeeper_ClaimAssessments(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	app.CertKeeper.SetCertifier(ctx, certtypes.NewCertifier(addrs[1], "", addrs[1], ""))
+	app.CertKeeper.SetCertifier(ctx, certtypes.NewCertifier(addrs[2], "", addrs[2], ""))
+
+	loss := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	claim := shieldtypes.NewShieldClaimProposal(1, loss, 1, "evidence", "desc", addrs[0])
+	proposal, err := types.NewProposal(claim, 1, addrs[0], false, ctx.BlockTime(), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	hash := hex.EncodeToString(tmhash.Sum([]byte("incident report")))
+	recommend := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	}
+
+	// only certifiers and shield claim managers can assess claims
+	err = app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[0], true, recommend(500), hash))
+	require.Error(t, err)
+
+	// recommended amounts cannot exceed the loss
+	err = app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[1], true, recommend(2000), hash))
+	require.Error(t, err)
+
+	require.NoError(t, app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[1], true, recommend(500), hash)))
+	require.NoError(t, app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[2], true, recommend(1000), hash)))
+	require.Len(t, app.GovKeeper.GetClaimAssessments(ctx, 1), 2)
+
+	summary := app.GovKeeper.GetClaimAssessments(ctx, 1).Summary()
+	require.Equal(t, uint64(2), summary.Assessments)
+	require.Equal(t, uint64(2), summary.LossVerified)
+	require.Equal(t, recommend(750), summary.RecommendedAmount)
+
+	// resubmission replaces the previous assessment
+	require.NoError(t, app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[2], false, sdk.NewCoins(), hash)))
+	summary = app.GovKeeper.GetClaimAssessments(ctx, 1).Summary()
+	require.Equal(t, uint64(2), summary.Assessments)
+	require.Equal(t, uint64(1), summary.LossVerified)
+	require.Equal(t, recommend(500), summary.RecommendedAmount)
+
+	// shield claim managers can assess claims as well
+	app.ShieldKeeper.SetShieldRoles(ctx, shieldtypes.NewShieldRoles(nil, nil, []string{addrs[0].String()}))
+	require.NoError(t, app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[0], true, recommend(500), hash)))
+	require.Len(t, app.GovKeeper.GetClaimAssessments(ctx, 1), 3)
+
+	// the summary of the deposit period assessments is recorded once voting begins
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, types.StatusCertifierVotingPeriod, proposal.Status)
+	require.Equal(t, app.GovKeeper.GetClaimAssessments(ctx, 1).Summary(), proposal.ClaimAssessmentSummary)
+	require.Equal(t, uint64(3), proposal.ClaimAssessmentSummary.Assessments)
+
+	// assessments are closed during certifier voting
+	proposal.Status = types.StatusCertifierVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	err = app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[1], false, sdk.NewCoins(), hash))
+	require.Error(t, err)
+	require.Len(t, app.GovKeeper.GetClaimAssessments(ctx, 1), 3)
+
+	// and during validator voting
+	proposal.Status = types.StatusValidatorVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	err = app.GovKeeper.AddClaimAssessment(ctx, types.NewClaimAssessment(1, addrs[1], false, sdk.NewCoins(), hash))
+	require.Error(t, err)
+
+	app.GovKeeper.DeleteClaimAssessments(ctx, 1)
+	require.Empty(t, app.GovKeeper.GetClaimAssessments(ctx, 1))
+}
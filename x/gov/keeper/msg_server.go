@@ -97,7 +97,7 @@ func validateProposalByType(ctx sdk.Context, k Keeper, msg *types.MsgSubmitPropo
 			return certtypes.ErrRepeatedAlias
 		}
 
-	case shieldtypes.ShieldClaimProposal:
+	case *shieldtypes.ShieldClaimProposal:
 		// check initial deposit >= max(<loss>*ClaimDepositRate, MinimumClaimDeposit)
 		denom := k.BondDenom(ctx)
 		initialDepositAmount := msg.InitialDeposit.AmountOf(denom).ToDec()
@@ -135,7 +135,9 @@ func validateProposalByType(ctx sdk.Context, k Keeper, msg *types.MsgSubmitPropo
 		if purchase.ProtectionEndTime.Before(ctx.BlockTime()) {
 			return fmt.Errorf("after protection end time: %s", purchase.ProtectionEndTime)
 		}
-		return nil
+
+		// check the waiting period and deductible of the pool
+		return k.ShieldKeeper.CheckCoverageTerms(ctx, c.PoolId, purchase, c.Loss)
 
 	default:
 		return nil
@@ -151,7 +153,10 @@ func updateAfterSubmitProposal(ctx sdk.Context, k Keeper, proposal types.Proposa
 		if err != nil {
 			return err
 		}
-		return k.ShieldKeeper.SecureCollaterals(ctx, c.PoolId, proposerAddr, c.PurchaseId, c.Loss, lockPeriod)
+		if err := k.ShieldKeeper.SecureCollaterals(ctx, c.PoolId, proposerAddr, c.PurchaseId, c.Loss, lockPeriod); err != nil {
+			return err
+		}
+		k.ShieldKeeper.OpenClaim(ctx, c.ProposalId, c.PoolId, c.PurchaseId, proposerAddr, c.Loss)
 	}
 	return nil
 }
@@ -209,3 +214,27 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 
 	return &types.MsgDepositResponse{}, nil
 }
+
+func (k msgServer) SubmitClaimAssessment(goCtx context.Context, msg *types.MsgSubmitClaimAssessment) (*types.MsgSubmitClaimAssessmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	assessment := types.ClaimAssessment{
+		ProposalId:         msg.ProposalId,
+		Certifier:          msg.Certifier,
+		LossVerified:       msg.LossVerified,
+		RecommendedAmount:  msg.RecommendedAmount,
+		IncidentReportHash: msg.IncidentReportHash,
+	}
+	if err := k.Keeper.AddClaimAssessment(ctx, assessment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, govtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Certifier),
+		),
+	)
+
+	return &types.MsgSubmitClaimAssessmentResponse{}, nil
+}
@@ -174,6 +174,11 @@ func (k Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	oldDepositEndTime := proposal.DepositEndTime
 
+	if proposal.Status == types.StatusDepositPeriod && proposal.ProposalType() == shieldtypes.ProposalTypeShieldClaim {
+		// Claim assessments are closed once voting begins.
+		proposal.ClaimAssessmentSummary = k.GetClaimAssessments(ctx, proposal.ProposalId).Summary()
+	}
+
 	if proposal.HasSecurityVoting() && (proposal.Status != types.StatusCertifierVotingPeriod) {
 		// Special case: just for software upgrade, certifier update and shield claim proposals.
 		proposal.Status = types.StatusCertifierVotingPeriod
@@ -42,6 +42,12 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.ClaimAssessmentsKeyPrefix):
+			var assessmentA, assessmentB types.ClaimAssessment
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &assessmentA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &assessmentB)
+			return fmt.Sprintf("%v\n%v", assessmentA, assessmentB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
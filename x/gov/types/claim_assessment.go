@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// NewClaimAssessment creates a new ClaimAssessment instance.
+//nolint:interfacer
+func NewClaimAssessment(proposalID uint64, certifier sdk.AccAddress, lossVerified bool, recommendedAmount sdk.Coins, incidentReportHash string) ClaimAssessment {
+	return ClaimAssessment{
+		ProposalId:         proposalID,
+		Certifier:          certifier.String(),
+		LossVerified:       lossVerified,
+		RecommendedAmount:  recommendedAmount,
+		IncidentReportHash: incidentReportHash,
+	}
+}
+
+// String implements the Stringer interface.
+func (a ClaimAssessment) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// ValidateIncidentReportHash checks that the given incident report
+// hash is a hex-encoded SHA-256 digest.
+func ValidateIncidentReportHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != tmhash.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid incident report hash: %s", hash)
+	}
+	return nil
+}
+
+// ClaimAssessments is an array of assessments.
+type ClaimAssessments []ClaimAssessment
+
+// String implements the Stringer interface.
+func (as ClaimAssessments) String() string {
+	if len(as) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Claim assessments for proposal %d:", as[0].ProposalId)
+	for _, a := range as {
+		out += fmt.Sprintf("\n  %s: loss verified %t, recommended %s, incident report %s",
+			a.Certifier, a.LossVerified, a.RecommendedAmount, a.IncidentReportHash)
+	}
+	return out
+}
+
+// Summary summarizes the assessments. The recommended amount is the
+// mean of the amounts recommended by certifiers who verified the loss.
+func (as ClaimAssessments) Summary() ClaimAssessmentSummary {
+	summary := ClaimAssessmentSummary{
+		Assessments:       uint64(len(as)),
+		RecommendedAmount: sdk.NewCoins(),
+	}
+	total := sdk.NewCoins()
+	for _, a := range as {
+		if a.LossVerified {
+			summary.LossVerified++
+			total = total.Add(a.RecommendedAmount...)
+		}
+	}
+	if summary.LossVerified == 0 {
+		return summary
+	}
+	for _, coin := range total {
+		mean := coin.Amount.QuoRaw(int64(summary.LossVerified))
+		summary.RecommendedAmount = summary.RecommendedAmount.Add(sdk.NewCoin(coin.Denom, mean))
+	}
+	return summary
+}
+
+// String implements the Stringer interface.
+func (s ClaimAssessmentSummary) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// NewMsgSubmitClaimAssessment creates a new MsgSubmitClaimAssessment instance.
+//nolint:interfacer
+func NewMsgSubmitClaimAssessment(certifier sdk.AccAddress, proposalID uint64, lossVerified bool, recommendedAmount sdk.Coins, incidentReportHash string) *MsgSubmitClaimAssessment {
+	return &MsgSubmitClaimAssessment{
+		ProposalId:         proposalID,
+		Certifier:          certifier.String(),
+		LossVerified:       lossVerified,
+		RecommendedAmount:  recommendedAmount,
+		IncidentReportHash: incidentReportHash,
+	}
+}
+
+// Route implements Msg
+func (msg MsgSubmitClaimAssessment) Route() string { return govtypes.RouterKey }
+
+// Type implements Msg
+func (msg MsgSubmitClaimAssessment) Type() string { return TypeMsgSubmitClaimAssessment }
+
+// ValidateBasic implements Msg
+func (msg MsgSubmitClaimAssessment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Certifier); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Certifier)
+	}
+	if !msg.RecommendedAmount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.RecommendedAmount.String())
+	}
+	if !msg.LossVerified && !msg.RecommendedAmount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot recommend an amount for an unverified loss")
+	}
+	return ValidateIncidentReportHash(msg.IncidentReportHash)
+}
+
+// String implements the Stringer interface
+func (msg MsgSubmitClaimAssessment) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSubmitClaimAssessment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSubmitClaimAssessment) GetSigners() []sdk.AccAddress {
+	certifier, _ := sdk.AccAddressFromBech32(msg.Certifier)
+	return []sdk.AccAddress{certifier}
+}
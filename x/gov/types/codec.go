@@ -16,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitProposal{}, "gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, "gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "gov/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgSubmitClaimAssessment{}, "gov/MsgSubmitClaimAssessment", nil)
 	cdc.RegisterConcrete(&govtypes.TextProposal{}, "cosmos-sdk/TextProposal", nil)
 }
 
@@ -24,6 +25,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgVote{},
 		&MsgDeposit{},
+		&MsgSubmitClaimAssessment{},
 	)
 	registry.RegisterInterface(
 		"cosmos.gov.v1beta1.Content",
@@ -1,7 +1,15 @@
 package types
 
 const (
+	EventTypeSubmitClaimAssessment = "submit_claim_assessment"
+
 	AttributeKeyDepositor = "depositor"
 	AttributeKeyVoter     = "voter"
 	AttributeTxHash       = "txhash"
+
+	AttributeKeyCertifier                = "certifier"
+	AttributeKeyLossVerified             = "loss_verified"
+	AttributeKeyRecommendedAmount        = "recommended_amount"
+	AttributeKeyClaimAssessments         = "claim_assessments"
+	AttributeKeyClaimAssessmentsVerified = "claim_assessments_loss_verified"
 )
@@ -30,8 +30,12 @@ type ShieldKeeper interface {
 	GetPurchaseList(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress) (shieldtypes.PurchaseList, bool)
 	GetClaimProposalParams(ctx sdk.Context) shieldtypes.ClaimProposalParams
 	SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, purchaseID uint64, loss sdk.Coins, lockPeriod time.Duration) error
-	RestoreShield(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error
+	RestoreShield(ctx sdk.Context, proposalID, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error
 	ClaimEnd(ctx sdk.Context, id, poolID uint64, loss sdk.Coins)
+	IsClaimManager(ctx sdk.Context, addr sdk.AccAddress) bool
+	CheckCoverageTerms(ctx sdk.Context, poolID uint64, purchase shieldtypes.Purchase, loss sdk.Coins) error
+	OpenClaim(ctx sdk.Context, proposalID, poolID, purchaseID uint64, purchaser sdk.AccAddress, loss sdk.Coins)
+	UpdateClaimStatus(ctx sdk.Context, proposalID uint64, status shieldtypes.ClaimStatus)
 }
 
 type ParamSubspace interface {
@@ -56,6 +56,15 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	for _, assessment := range data.ClaimAssessments {
+		if _, err := sdk.AccAddressFromBech32(assessment.Certifier); err != nil {
+			return err
+		}
+		if err := ValidateIncidentReportHash(assessment.IncidentReportHash); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
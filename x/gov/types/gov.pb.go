@@ -101,6 +101,8 @@ type GenesisState struct {
 	VotingParams types.VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// claim_assessments defines all the certifier assessments of shield claim proposals present at genesis.
+	ClaimAssessments []ClaimAssessment `protobuf:"bytes,8,rep,name=claim_assessments,json=claimAssessments,proto3" json:"claim_assessments" yaml:"claim_assessments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -269,6 +271,7 @@ type Proposal struct {
 	TotalDeposit            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime         time.Time                                `protobuf:"bytes,10,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime           time.Time                                `protobuf:"bytes,11,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	ClaimAssessmentSummary  ClaimAssessmentSummary                   `protobuf:"bytes,12,opt,name=claim_assessment_summary,json=claimAssessmentSummary,proto3" json:"claim_assessment_summary" yaml:"claim_assessment_summary"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -342,6 +345,90 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// ClaimAssessment defines a certifier's pre-review assessment of a shield
+// claim proposal, submitted before the voting on the claim begins.
+type ClaimAssessment struct {
+	ProposalId         uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Certifier          string                                   `protobuf:"bytes,2,opt,name=certifier,proto3" json:"certifier,omitempty" yaml:"certifier"`
+	LossVerified       bool                                     `protobuf:"varint,3,opt,name=loss_verified,json=lossVerified,proto3" json:"loss_verified,omitempty" yaml:"loss_verified"`
+	RecommendedAmount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=recommended_amount,json=recommendedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recommended_amount" yaml:"recommended_amount"`
+	IncidentReportHash string                                   `protobuf:"bytes,5,opt,name=incident_report_hash,json=incidentReportHash,proto3" json:"incident_report_hash,omitempty" yaml:"incident_report_hash"`
+}
+
+func (m *ClaimAssessment) Reset()      { *m = ClaimAssessment{} }
+func (*ClaimAssessment) ProtoMessage() {}
+func (*ClaimAssessment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce5b0b452eb2673, []int{6}
+}
+func (m *ClaimAssessment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimAssessment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimAssessment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimAssessment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimAssessment.Merge(m, src)
+}
+func (m *ClaimAssessment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimAssessment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimAssessment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimAssessment proto.InternalMessageInfo
+
+// ClaimAssessmentSummary summarizes the certifier assessments of a shield
+// claim proposal when its voting begins.
+type ClaimAssessmentSummary struct {
+	Assessments  uint64 `protobuf:"varint,1,opt,name=assessments,proto3" json:"assessments,omitempty" yaml:"assessments"`
+	LossVerified uint64 `protobuf:"varint,2,opt,name=loss_verified,json=lossVerified,proto3" json:"loss_verified,omitempty" yaml:"loss_verified"`
+	// recommended_amount is the mean amount recommended by the certifiers
+	// who verified the loss.
+	RecommendedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recommended_amount,json=recommendedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recommended_amount" yaml:"recommended_amount"`
+}
+
+func (m *ClaimAssessmentSummary) Reset()      { *m = ClaimAssessmentSummary{} }
+func (*ClaimAssessmentSummary) ProtoMessage() {}
+func (*ClaimAssessmentSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce5b0b452eb2673, []int{7}
+}
+func (m *ClaimAssessmentSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimAssessmentSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimAssessmentSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimAssessmentSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimAssessmentSummary.Merge(m, src)
+}
+func (m *ClaimAssessmentSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimAssessmentSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimAssessmentSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimAssessmentSummary proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("shentu.gov.v1alpha1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*GenesisState)(nil), "shentu.gov.v1alpha1.GenesisState")
@@ -350,104 +437,122 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "shentu.gov.v1alpha1.TallyParams")
 	proto.RegisterType((*Proposal)(nil), "shentu.gov.v1alpha1.Proposal")
 	proto.RegisterType((*Vote)(nil), "shentu.gov.v1alpha1.Vote")
+	proto.RegisterType((*ClaimAssessment)(nil), "shentu.gov.v1alpha1.ClaimAssessment")
+	proto.RegisterType((*ClaimAssessmentSummary)(nil), "shentu.gov.v1alpha1.ClaimAssessmentSummary")
 }
 
 func init() { proto.RegisterFile("shentu/gov/v1alpha1/gov.proto", fileDescriptor_3ce5b0b452eb2673) }
 
 var fileDescriptor_3ce5b0b452eb2673 = []byte{
-	// 1472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x4f, 0x1b, 0x47,
-	0x1b, 0xf7, 0x1a, 0xf3, 0x35, 0xc6, 0xc4, 0x0c, 0x24, 0x18, 0x03, 0x5e, 0x67, 0x93, 0x57, 0x2f,
-	0x8a, 0x12, 0xfb, 0x85, 0xf7, 0x3d, 0xbc, 0xa2, 0x52, 0x25, 0x2f, 0x36, 0x89, 0x23, 0x0a, 0xee,
-	0xda, 0xa1, 0x52, 0x7b, 0xd8, 0x8e, 0xbd, 0x03, 0x4c, 0xb3, 0x1f, 0x96, 0x67, 0x8c, 0x40, 0xea,
-	0xa1, 0xc7, 0x88, 0x43, 0x95, 0x63, 0xa4, 0x0a, 0x29, 0x52, 0x6e, 0x91, 0xaa, 0x5e, 0x7a, 0xea,
-	0x5f, 0x10, 0xf5, 0x94, 0x63, 0x4e, 0x4e, 0x43, 0x2e, 0x51, 0x8e, 0x56, 0x6f, 0xbd, 0x54, 0xbb,
-	0x33, 0x6b, 0xef, 0x1a, 0x13, 0xd2, 0xf6, 0x04, 0xfb, 0x7c, 0xfc, 0x7e, 0xbf, 0x99, 0x79, 0xe6,
-	0x79, 0xc6, 0x60, 0x99, 0x1e, 0x60, 0x9b, 0xb5, 0xf3, 0xfb, 0xce, 0x61, 0xfe, 0x70, 0x15, 0x99,
-	0xcd, 0x03, 0xb4, 0xea, 0x7e, 0xe4, 0x9a, 0x2d, 0x87, 0x39, 0x70, 0x96, 0xbb, 0x73, 0xae, 0xc5,
-	0x77, 0xa7, 0x33, 0x0d, 0x87, 0x5a, 0x0e, 0xcd, 0xd7, 0x11, 0xc5, 0xf9, 0xc3, 0xd5, 0x3a, 0x66,
-	0x68, 0x35, 0xdf, 0x70, 0x88, 0xcd, 0x93, 0xd2, 0x4b, 0xc2, 0xcf, 0x31, 0xb9, 0xbb, 0x07, 0x99,
-	0x5e, 0xe0, 0x5e, 0xdd, 0xfb, 0xca, 0xf3, 0x0f, 0xe1, 0x9a, 0xdb, 0x77, 0xf6, 0x1d, 0x6e, 0x77,
-	0xff, 0x13, 0x56, 0x79, 0xdf, 0x71, 0xf6, 0x4d, 0x9c, 0xf7, 0xbe, 0xea, 0xed, 0xbd, 0x3c, 0x23,
-	0x16, 0xa6, 0x0c, 0x59, 0x4d, 0x1f, 0x71, 0x30, 0x00, 0xd9, 0xc7, 0xc2, 0x95, 0x19, 0x74, 0x19,
-	0xed, 0x16, 0x62, 0xc4, 0x11, 0x52, 0x95, 0xdf, 0x63, 0x60, 0xea, 0x2e, 0xb6, 0x31, 0x25, 0xb4,
-	0xca, 0x10, 0xc3, 0xf0, 0x73, 0x30, 0x47, 0x19, 0x6a, 0x31, 0x62, 0xef, 0xbb, 0x0a, 0x9b, 0x0e,
-	0x45, 0xa6, 0x4e, 0x8c, 0x94, 0x94, 0x95, 0x56, 0x62, 0xaa, 0xdc, 0xed, 0xc8, 0x8b, 0xc7, 0xc8,
-	0x32, 0xd7, 0x95, 0x61, 0x51, 0x8a, 0x06, 0x7d, 0x73, 0x45, 0x58, 0xcb, 0x06, 0xbc, 0x0f, 0x26,
-	0x0c, 0xdc, 0x74, 0x28, 0x61, 0x34, 0x15, 0xcd, 0x8e, 0xac, 0xc4, 0xd7, 0x96, 0x72, 0x43, 0xb6,
-	0x35, 0x57, 0xe4, 0x41, 0x6a, 0xf2, 0x45, 0x47, 0x8e, 0x3c, 0x7f, 0x2d, 0x4f, 0x08, 0x03, 0xd5,
-	0x7a, 0xf9, 0xf0, 0x53, 0x30, 0x7a, 0xe8, 0x30, 0x4c, 0x53, 0x23, 0x1e, 0xd0, 0xc2, 0x50, 0xa0,
-	0x5d, 0x87, 0x61, 0x35, 0x21, 0x50, 0x46, 0xdd, 0x2f, 0xaa, 0xf1, 0x34, 0xb8, 0x0d, 0x26, 0x7d,
-	0xbd, 0x34, 0x15, 0xf3, 0x30, 0x96, 0x87, 0x62, 0xf8, 0xfa, 0xd5, 0x19, 0x81, 0x33, 0xe9, 0x5b,
-	0xa8, 0xd6, 0x87, 0x80, 0x07, 0x60, 0x5a, 0x68, 0xd3, 0x9b, 0xa8, 0x85, 0x2c, 0x9a, 0x1a, 0xcd,
-	0x4a, 0x2b, 0xf1, 0x35, 0xe5, 0x43, 0x2b, 0xac, 0x78, 0x91, 0xea, 0xb2, 0x8b, 0xdc, 0xed, 0xc8,
-	0x57, 0xf9, 0x86, 0x86, 0x71, 0x14, 0x2d, 0x61, 0x04, 0xa3, 0x61, 0x03, 0x24, 0x0e, 0x1d, 0xbe,
-	0xe1, 0x9c, 0x68, 0xcc, 0x23, 0xca, 0xe6, 0x44, 0x05, 0x71, 0x22, 0xaf, 0xd8, 0xdc, 0x0d, 0x70,
-	0x8f, 0x80, 0xd3, 0x2c, 0x09, 0x9a, 0x39, 0x4e, 0x13, 0x02, 0x51, 0xb4, 0xa9, 0xc3, 0x40, 0x2c,
-	0xfc, 0x1a, 0x4c, 0x31, 0x64, 0x9a, 0xc7, 0x3e, 0xc7, 0xb8, 0xe0, 0x18, 0xb6, 0x98, 0x9a, 0x1b,
-	0x28, 0x38, 0x16, 0x05, 0xc7, 0x2c, 0xe7, 0x08, 0x62, 0x28, 0x5a, 0x9c, 0xf5, 0x23, 0xd7, 0x63,
-	0x4f, 0x9e, 0xca, 0x92, 0xf2, 0x2d, 0x18, 0x17, 0x7b, 0x01, 0x3f, 0x01, 0xe3, 0x62, 0xa1, 0x5e,
-	0x8d, 0xc5, 0xd7, 0x16, 0x87, 0xad, 0xc8, 0xaf, 0x8d, 0xd8, 0xcb, 0x8e, 0x2c, 0x69, 0x7e, 0x06,
-	0xbc, 0x05, 0xc6, 0xd9, 0x91, 0x7e, 0x80, 0xe8, 0x41, 0x2a, 0x9a, 0x95, 0x56, 0x26, 0xd5, 0x99,
-	0x6e, 0x47, 0x4e, 0x08, 0x11, 0x47, 0xae, 0x5d, 0xd1, 0xc6, 0xd8, 0xd1, 0x3d, 0x44, 0x0f, 0xd6,
-	0x27, 0x1e, 0x3d, 0x95, 0x23, 0xef, 0x9e, 0xca, 0x11, 0xe5, 0x8f, 0x11, 0x90, 0x08, 0x1d, 0x05,
-	0xfc, 0x45, 0x02, 0xb3, 0x16, 0xb1, 0x75, 0x62, 0x13, 0x46, 0x90, 0xa9, 0xf7, 0x15, 0xf1, 0x2a,
-	0x13, 0x8a, 0xdc, 0x0b, 0xdf, 0x93, 0xb4, 0xe1, 0x10, 0x5b, 0x75, 0xdc, 0x85, 0xbf, 0xef, 0xc8,
-	0xcb, 0x43, 0xb2, 0x6f, 0x3b, 0x16, 0x61, 0xd8, 0x6a, 0xb2, 0xe3, 0x6e, 0x47, 0x4e, 0x73, 0x51,
-	0x43, 0xc2, 0x94, 0xe7, 0xaf, 0xe5, 0x95, 0x7d, 0xc2, 0x0e, 0xda, 0xf5, 0x5c, 0xc3, 0xb1, 0x44,
-	0x47, 0x10, 0x7f, 0xee, 0x50, 0xe3, 0x61, 0x9e, 0x1d, 0x37, 0x31, 0xf5, 0xf8, 0xa8, 0x36, 0x63,
-	0x11, 0xbb, 0xcc, 0x01, 0xfc, 0x1d, 0xfc, 0x41, 0x02, 0x71, 0x17, 0xd7, 0x17, 0x1d, 0xbd, 0x4c,
-	0xb4, 0x2e, 0x44, 0x5f, 0x0d, 0x64, 0x85, 0xc4, 0xc2, 0xbe, 0xd8, 0xbf, 0x25, 0x12, 0x58, 0xc4,
-	0xf6, 0xd5, 0x7d, 0x2f, 0x01, 0x68, 0xa1, 0x23, 0xbd, 0x57, 0xde, 0xb8, 0x45, 0x1c, 0x23, 0x35,
-	0xe2, 0x9d, 0xf5, 0x42, 0x8e, 0xf7, 0xa7, 0x9c, 0xdf, 0x9f, 0x72, 0x45, 0xd1, 0x9f, 0xd4, 0x92,
-	0x10, 0xb9, 0x74, 0x3e, 0x39, 0xa4, 0x75, 0x41, 0x68, 0x3d, 0x17, 0xa5, 0x3c, 0x79, 0x2d, 0x4b,
-	0x5a, 0xd2, 0x42, 0x47, 0xfe, 0x59, 0x73, 0xf3, 0x4f, 0x51, 0x10, 0x0f, 0xd4, 0x2e, 0x2c, 0x82,
-	0x84, 0x81, 0xf7, 0x50, 0xdb, 0x64, 0xba, 0x57, 0xa8, 0xa2, 0x0c, 0xe5, 0x61, 0x65, 0x18, 0xc8,
-	0xd3, 0xa6, 0x44, 0x96, 0x67, 0x83, 0x0e, 0xb8, 0xd9, 0xc0, 0x2d, 0x46, 0xf6, 0x08, 0x6e, 0xe9,
-	0xed, 0xa6, 0x81, 0x18, 0xd6, 0x29, 0x6e, 0xb4, 0x5b, 0x84, 0x1d, 0xeb, 0x6e, 0xef, 0x11, 0xe0,
-	0xd1, 0x8f, 0x03, 0xcf, 0xf6, 0xc0, 0x1e, 0x78, 0x58, 0x55, 0x01, 0xe5, 0x36, 0x33, 0x4e, 0x48,
-	0xc0, 0xf5, 0xf3, 0x84, 0x0c, 0x3d, 0xc4, 0x41, 0xb6, 0x91, 0x8f, 0x63, 0x5b, 0x1e, 0x64, 0x73,
-	0x71, 0x7a, 0x54, 0xca, 0xb3, 0x09, 0x30, 0xe1, 0x77, 0x3f, 0xf7, 0xbe, 0x36, 0x1c, 0x9b, 0x61,
-	0xdb, 0xbf, 0xaf, 0x73, 0xe7, 0xce, 0xb0, 0x60, 0x1f, 0xab, 0xf1, 0x5f, 0x7f, 0xbe, 0x33, 0xbe,
-	0xc1, 0x03, 0x35, 0x3f, 0x03, 0xfe, 0x0f, 0xc4, 0x83, 0x43, 0x25, 0xea, 0x0d, 0x95, 0xd9, 0xf7,
-	0x1d, 0x39, 0x4a, 0x8c, 0x6e, 0x47, 0x9e, 0xe4, 0x67, 0xe9, 0x0e, 0x12, 0xd0, 0xec, 0x0f, 0x90,
-	0x2f, 0xc0, 0x18, 0x65, 0x88, 0xb5, 0xa9, 0xb7, 0x9e, 0xe9, 0xb5, 0x1b, 0x1f, 0xec, 0xd8, 0x55,
-	0x2f, 0x54, 0x4d, 0x77, 0x3b, 0xf2, 0x35, 0x8e, 0xd7, 0xa3, 0xe4, 0x28, 0x8a, 0x26, 0xe0, 0x60,
-	0x1d, 0xa4, 0x09, 0x15, 0x03, 0x0c, 0xb7, 0xf4, 0x86, 0xd3, 0xb6, 0x1b, 0xc4, 0xd4, 0x2d, 0x6c,
-	0xd5, 0x71, 0x2b, 0x15, 0xcb, 0x4a, 0x2b, 0x13, 0xea, 0xbf, 0xba, 0x1d, 0xf9, 0xba, 0xd0, 0x75,
-	0x61, 0xac, 0xa2, 0xcd, 0x13, 0x5a, 0x11, 0xbe, 0x0d, 0xee, 0xfa, 0xcc, 0xf3, 0xc0, 0x4d, 0x90,
-	0xec, 0x25, 0x21, 0xc3, 0x68, 0x61, 0xca, 0x67, 0xc4, 0xa4, 0xba, 0xd8, 0xed, 0xc8, 0xf3, 0x41,
-	0x85, 0xfd, 0x08, 0x45, 0xbb, 0xe2, 0x9b, 0x0a, 0xdc, 0x02, 0x9b, 0x00, 0xee, 0x11, 0x1b, 0x99,
-	0xfc, 0x64, 0xf5, 0x16, 0xa6, 0x6d, 0x93, 0x89, 0x21, 0x70, 0xf1, 0x01, 0x6b, 0x5e, 0x98, 0x7a,
-	0x5d, 0xf4, 0x67, 0x71, 0x59, 0xce, 0x03, 0x29, 0x5a, 0xd2, 0x33, 0x06, 0x92, 0xe0, 0x57, 0x20,
-	0x4e, 0xdb, 0x75, 0x8b, 0x30, 0xdd, 0x7d, 0x70, 0x88, 0x59, 0x90, 0x3e, 0x77, 0xda, 0x35, 0xff,
-	0x35, 0xa2, 0x66, 0x04, 0x8b, 0x68, 0x1f, 0x81, 0x64, 0xe5, 0xb1, 0x7b, 0x17, 0x01, 0xb7, 0xb8,
-	0x09, 0x90, 0x80, 0xa4, 0x7f, 0x5d, 0xb1, 0x6d, 0x70, 0x86, 0x89, 0x4b, 0x19, 0x6e, 0x08, 0x86,
-	0xf9, 0xf0, 0xc8, 0xf4, 0x11, 0x38, 0x8d, 0x3f, 0x91, 0x4b, 0xb6, 0xe1, 0x51, 0x3d, 0x92, 0x40,
-	0x82, 0x39, 0x2c, 0xd0, 0xd6, 0x27, 0x2f, 0xeb, 0x90, 0xf7, 0xc2, 0x33, 0x33, 0x94, 0xfd, 0xd7,
-	0x5a, 0xe1, 0x94, 0x97, 0xeb, 0x37, 0x43, 0x13, 0xcc, 0x88, 0xf9, 0xeb, 0xbd, 0x93, 0xf8, 0xb2,
-	0xc1, 0xa5, 0xcb, 0xbe, 0x29, 0xe4, 0xa4, 0x42, 0x23, 0xbc, 0x0f, 0xc1, 0xd7, 0x7d, 0x85, 0xdb,
-	0xab, 0xae, 0xd9, 0x5b, 0xf8, 0x1e, 0x10, 0xa6, 0xfe, 0x16, 0xc7, 0x2f, 0xe5, 0x52, 0x04, 0xd7,
-	0xb5, 0x10, 0x57, 0x78, 0x87, 0xc5, 0x4b, 0x44, 0x6c, 0xf0, 0x7a, 0xec, 0x9d, 0x3b, 0xd3, 0x0f,
-	0x41, 0xcc, 0x6d, 0x19, 0xf0, 0xff, 0x83, 0x03, 0x3d, 0x75, 0xc1, 0x13, 0x05, 0xff, 0xa3, 0x69,
-	0xfe, 0x44, 0x4c, 0xf3, 0x5b, 0x3f, 0x8e, 0x80, 0xe9, 0xf0, 0xdd, 0x87, 0x39, 0xb0, 0x58, 0xd1,
-	0x76, 0x2a, 0x3b, 0xd5, 0xc2, 0x96, 0x5e, 0xad, 0x15, 0x6a, 0x0f, 0xaa, 0xfa, 0x83, 0xed, 0x6a,
-	0xa5, 0xb4, 0x51, 0xde, 0x2c, 0x97, 0x8a, 0xc9, 0x48, 0x3a, 0x71, 0x72, 0x9a, 0x9d, 0xe4, 0xc1,
-	0xdb, 0xc4, 0xed, 0x69, 0x99, 0xc1, 0xf8, 0x62, 0xa9, 0xb2, 0x53, 0x2d, 0xd7, 0xf4, 0x4a, 0x49,
-	0x2b, 0xef, 0x14, 0x93, 0x52, 0x7a, 0xfe, 0xe4, 0x34, 0x3b, 0xcb, 0x53, 0x42, 0xf3, 0x04, 0x6e,
-	0x81, 0x7f, 0x0f, 0x26, 0x6f, 0x94, 0xb4, 0x9a, 0x4b, 0xa5, 0xe9, 0xbb, 0x3b, 0xb5, 0xf2, 0xf6,
-	0x5d, 0x1f, 0x25, 0x9a, 0x96, 0x4f, 0x4e, 0xb3, 0x8b, 0x1c, 0x65, 0xc3, 0xef, 0xb9, 0xe2, 0xb5,
-	0x76, 0x21, 0xda, 0x6e, 0x61, 0xab, 0x5c, 0x2c, 0xd4, 0x76, 0x06, 0xd1, 0x46, 0x82, 0x68, 0xbb,
-	0xc8, 0x24, 0x06, 0x62, 0x4e, 0x18, 0xed, 0x36, 0xb8, 0x36, 0x88, 0x56, 0x29, 0x54, 0xab, 0xa5,
-	0x62, 0x32, 0x96, 0x4e, 0x9e, 0x9c, 0x66, 0xa7, 0x78, 0x72, 0x05, 0x51, 0x8a, 0x0d, 0xf8, 0x1f,
-	0x90, 0x1a, 0x8c, 0xd6, 0x4a, 0xf7, 0x4b, 0x1b, 0xb5, 0x52, 0x31, 0x39, 0x9a, 0x86, 0x27, 0xa7,
-	0xd9, 0x69, 0x1e, 0xaf, 0xe1, 0x6f, 0x70, 0x83, 0xe1, 0xa1, 0xf8, 0x9b, 0x85, 0xf2, 0x56, 0xa9,
-	0x98, 0x1c, 0x0b, 0xe2, 0x6f, 0x22, 0x62, 0x62, 0x23, 0x1d, 0x7b, 0xf4, 0x2c, 0x13, 0x51, 0x6b,
-	0x2f, 0xde, 0x64, 0x22, 0xaf, 0xde, 0x64, 0x22, 0xdf, 0x9d, 0x65, 0x22, 0x2f, 0xce, 0x32, 0xd2,
-	0xcb, 0xb3, 0x8c, 0xf4, 0xdb, 0x59, 0x46, 0x7a, 0xfc, 0x36, 0x13, 0x79, 0xf9, 0x36, 0x13, 0x79,
-	0xf5, 0x36, 0x13, 0xf9, 0x32, 0x17, 0xbc, 0x61, 0xee, 0x4e, 0x3d, 0xdc, 0x73, 0xda, 0xb6, 0xe1,
-	0xbd, 0x0d, 0xf2, 0xe2, 0x37, 0xdb, 0x91, 0xf7, 0x0b, 0xcb, 0xbb, 0x6d, 0xf5, 0x31, 0xaf, 0x94,
-	0xff, 0xfb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x11, 0xf5, 0xaf, 0xd0, 0x0d, 0x00, 0x00,
+	// 1723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x92, 0xd4, 0x07, 0x87, 0xa2, 0x4d, 0x8d, 0x14, 0x79, 0x4d, 0x59, 0x5c, 0x7a, 0xe3,
+	0x22, 0x42, 0x9a, 0x90, 0xb5, 0x5a, 0xa0, 0x81, 0x8b, 0x16, 0xe0, 0x8a, 0x74, 0xc2, 0xc0, 0xb1,
+	0xd8, 0x25, 0xad, 0x02, 0xed, 0x61, 0xbb, 0xe2, 0x8e, 0xa4, 0xa9, 0xf7, 0x83, 0xd8, 0x19, 0x0a,
+	0x12, 0xd0, 0x43, 0x8f, 0x86, 0x0e, 0x41, 0x6e, 0x35, 0x50, 0x08, 0x30, 0xd0, 0x5b, 0x80, 0xa2,
+	0x2d, 0x90, 0x53, 0xff, 0x02, 0xa3, 0x27, 0x1f, 0x73, 0xa2, 0x1b, 0xf9, 0x92, 0xe6, 0xc8, 0x6b,
+	0x2f, 0xc5, 0x7c, 0x2c, 0xb9, 0x4b, 0xd2, 0x91, 0xd3, 0x1c, 0x7a, 0x92, 0xe6, 0xcd, 0x7b, 0xbf,
+	0xdf, 0x9b, 0x79, 0x6f, 0xde, 0x7b, 0x4b, 0xb0, 0x45, 0x8e, 0x91, 0x4f, 0x07, 0xb5, 0xa3, 0xe0,
+	0xa4, 0x76, 0x72, 0xd7, 0x76, 0xfb, 0xc7, 0xf6, 0x5d, 0xb6, 0xa8, 0xf6, 0xc3, 0x80, 0x06, 0x70,
+	0x4d, 0x6c, 0x57, 0x99, 0x24, 0xda, 0x2e, 0x95, 0x7b, 0x01, 0xf1, 0x02, 0x52, 0x3b, 0xb0, 0x09,
+	0xaa, 0x9d, 0xdc, 0x3d, 0x40, 0xd4, 0xbe, 0x5b, 0xeb, 0x05, 0xd8, 0x17, 0x46, 0xa5, 0x5b, 0x72,
+	0x5f, 0x60, 0x8a, 0xed, 0x31, 0x64, 0xe9, 0xa6, 0xd8, 0xb5, 0xf8, 0xaa, 0x26, 0x16, 0x72, 0x6b,
+	0xfd, 0x28, 0x38, 0x0a, 0x84, 0x9c, 0xfd, 0x27, 0xa5, 0xda, 0x51, 0x10, 0x1c, 0xb9, 0xa8, 0xc6,
+	0x57, 0x07, 0x83, 0xc3, 0x1a, 0xc5, 0x1e, 0x22, 0xd4, 0xf6, 0xfa, 0x11, 0xe2, 0xb4, 0x82, 0xed,
+	0x9f, 0xc9, 0xad, 0xf2, 0xf4, 0x96, 0x33, 0x08, 0x6d, 0x8a, 0x03, 0xe9, 0xaa, 0xfe, 0xef, 0x05,
+	0xb0, 0xf2, 0x21, 0xf2, 0x11, 0xc1, 0xa4, 0x43, 0x6d, 0x8a, 0xe0, 0x2f, 0xc1, 0x3a, 0xa1, 0x76,
+	0x48, 0xb1, 0x7f, 0xc4, 0x3c, 0xec, 0x07, 0xc4, 0x76, 0x2d, 0xec, 0xa8, 0x4a, 0x45, 0xd9, 0xce,
+	0x1a, 0xda, 0x68, 0xa8, 0x6d, 0x9e, 0xd9, 0x9e, 0x7b, 0x4f, 0x9f, 0xa7, 0xa5, 0x9b, 0x30, 0x12,
+	0xb7, 0xa5, 0xb4, 0xe5, 0xc0, 0x8f, 0xc1, 0xb2, 0x83, 0xfa, 0x01, 0xc1, 0x94, 0xa8, 0xe9, 0x4a,
+	0x66, 0x3b, 0xbf, 0x73, 0xab, 0x3a, 0xe7, 0x5a, 0xab, 0x0d, 0xa1, 0x64, 0x14, 0x9f, 0x0f, 0xb5,
+	0xd4, 0xe7, 0x2f, 0xb5, 0x65, 0x29, 0x20, 0xe6, 0xd8, 0x1e, 0xfe, 0x02, 0x2c, 0x9c, 0x04, 0x14,
+	0x11, 0x35, 0xc3, 0x81, 0x6e, 0xce, 0x05, 0xda, 0x0f, 0x28, 0x32, 0x0a, 0x12, 0x65, 0x81, 0xad,
+	0x88, 0x29, 0xcc, 0xe0, 0x43, 0x90, 0x8b, 0xfc, 0x25, 0x6a, 0x96, 0x63, 0x6c, 0xcd, 0xc5, 0x88,
+	0xfc, 0x37, 0x56, 0x25, 0x4e, 0x2e, 0x92, 0x10, 0x73, 0x02, 0x01, 0x8f, 0xc1, 0x35, 0xe9, 0x9b,
+	0xd5, 0xb7, 0x43, 0xdb, 0x23, 0xea, 0x42, 0x45, 0xd9, 0xce, 0xef, 0xe8, 0xdf, 0x76, 0xc2, 0x36,
+	0xd7, 0x34, 0xb6, 0x18, 0xf2, 0x68, 0xa8, 0xbd, 0x25, 0x2e, 0x34, 0x89, 0xa3, 0x9b, 0x05, 0x27,
+	0xae, 0x0d, 0x7b, 0xa0, 0x70, 0x12, 0x88, 0x0b, 0x17, 0x44, 0x8b, 0x9c, 0xa8, 0x52, 0x95, 0x19,
+	0x24, 0x88, 0x78, 0xb2, 0xb1, 0x0b, 0x60, 0x21, 0x10, 0x34, 0xb7, 0x24, 0xcd, 0xba, 0xa0, 0x49,
+	0x80, 0xe8, 0xe6, 0xca, 0x49, 0x4c, 0x17, 0xfe, 0x16, 0xac, 0x50, 0xdb, 0x75, 0xcf, 0x22, 0x8e,
+	0x25, 0xc9, 0x31, 0xef, 0x30, 0x5d, 0xa6, 0x28, 0x39, 0x36, 0x25, 0xc7, 0x9a, 0xe0, 0x88, 0x63,
+	0xe8, 0x66, 0x9e, 0x4e, 0x34, 0x21, 0x01, 0xab, 0x3d, 0xd7, 0xc6, 0x9e, 0x65, 0x13, 0x82, 0x08,
+	0xf1, 0x90, 0x4f, 0x89, 0xba, 0xcc, 0x03, 0x71, 0x67, 0x2e, 0xcd, 0x2e, 0xd3, 0xae, 0x8f, 0x95,
+	0x8d, 0x8a, 0xa4, 0x52, 0x05, 0xd5, 0x0c, 0x98, 0x6e, 0x16, 0x7b, 0x49, 0x13, 0x72, 0x2f, 0xfb,
+	0xf4, 0x99, 0xa6, 0xe8, 0xbf, 0x07, 0x4b, 0x32, 0x00, 0xf0, 0x67, 0x60, 0x49, 0xde, 0x2e, 0x4f,
+	0xec, 0xfc, 0xce, 0xe6, 0xbc, 0x6b, 0x8c, 0x12, 0x32, 0xfb, 0x62, 0xa8, 0x29, 0x66, 0x64, 0x01,
+	0xdf, 0x05, 0x4b, 0xf4, 0xd4, 0x3a, 0xb6, 0xc9, 0xb1, 0x9a, 0xae, 0x28, 0xdb, 0x39, 0x63, 0x75,
+	0x34, 0xd4, 0x0a, 0xf2, 0xe4, 0xa7, 0x4c, 0xae, 0x9b, 0x8b, 0xf4, 0xf4, 0x23, 0x9b, 0x1c, 0xdf,
+	0x5b, 0x7e, 0xf2, 0x4c, 0x4b, 0x7d, 0xfd, 0x4c, 0x4b, 0xe9, 0xff, 0xc9, 0x80, 0x42, 0x22, 0xfe,
+	0xf0, 0x1f, 0x0a, 0x58, 0xf3, 0xb0, 0x6f, 0x61, 0x1f, 0x53, 0x6c, 0xbb, 0xd6, 0xc4, 0x23, 0x91,
+	0xda, 0xd2, 0x23, 0x56, 0x65, 0xc6, 0x2e, 0xed, 0x06, 0xd8, 0x37, 0x02, 0x76, 0x05, 0xdf, 0x0c,
+	0xb5, 0xad, 0x39, 0xd6, 0xef, 0x05, 0x1e, 0xa6, 0xc8, 0xeb, 0xd3, 0xb3, 0xd1, 0x50, 0x2b, 0x09,
+	0xa7, 0xe6, 0xa8, 0xe9, 0x9f, 0xbf, 0xd4, 0xb6, 0x8f, 0x30, 0x3d, 0x1e, 0x1c, 0x54, 0x7b, 0x81,
+	0x27, 0xcb, 0x90, 0xfc, 0xf3, 0x3e, 0x71, 0x1e, 0xd7, 0xe8, 0x59, 0x1f, 0x11, 0xce, 0x47, 0xcc,
+	0x55, 0x0f, 0xfb, 0x2d, 0x01, 0x10, 0xdd, 0xe0, 0x9f, 0x14, 0x90, 0x67, 0xb8, 0x91, 0xd3, 0xe9,
+	0xab, 0x9c, 0xb6, 0xa4, 0xd3, 0x6f, 0xc5, 0xac, 0x12, 0xce, 0xc2, 0x89, 0xb3, 0xff, 0x93, 0x93,
+	0xc0, 0xc3, 0x7e, 0xe4, 0xdd, 0xa7, 0x0a, 0x80, 0x9e, 0x7d, 0x6a, 0x8d, 0xdf, 0x14, 0x0a, 0x71,
+	0xe0, 0xa8, 0x19, 0x1e, 0xeb, 0x9b, 0x55, 0x51, 0x14, 0xab, 0x51, 0x51, 0xac, 0x36, 0x64, 0x51,
+	0x34, 0x9a, 0xd2, 0xc9, 0x5b, 0xb3, 0xc6, 0x09, 0x5f, 0x6f, 0x4a, 0x5f, 0x67, 0xb4, 0xf4, 0xa7,
+	0x2f, 0x35, 0xc5, 0x2c, 0x7a, 0xf6, 0x69, 0x14, 0x6b, 0x21, 0xfe, 0x6b, 0x1a, 0xe4, 0x63, 0x0f,
+	0x06, 0x36, 0x40, 0xc1, 0x41, 0x87, 0xf6, 0xc0, 0xa5, 0x16, 0x7f, 0x1d, 0x32, 0x0d, 0xb5, 0x79,
+	0x69, 0x18, 0xb3, 0x33, 0x57, 0xa4, 0x15, 0x97, 0xc1, 0x00, 0xdc, 0xe9, 0xa1, 0x90, 0xe2, 0x43,
+	0x8c, 0x42, 0x6b, 0xd0, 0x77, 0x6c, 0x8a, 0x2c, 0x82, 0x7a, 0x83, 0x10, 0xd3, 0x33, 0x8b, 0x15,
+	0x3c, 0x09, 0x9e, 0x7e, 0x33, 0xf0, 0xca, 0x18, 0xec, 0x11, 0xc7, 0xea, 0x48, 0x28, 0x56, 0x41,
+	0x05, 0x21, 0x06, 0xb7, 0x67, 0x09, 0xa9, 0xfd, 0x18, 0xc5, 0xd9, 0x32, 0x6f, 0xc6, 0xb6, 0x35,
+	0xcd, 0xc6, 0x70, 0xc6, 0x54, 0xfa, 0x17, 0x39, 0xb0, 0x1c, 0x95, 0x5c, 0xf6, 0x5e, 0x7b, 0x81,
+	0x4f, 0x91, 0x1f, 0xbd, 0xd7, 0xf5, 0x99, 0x18, 0xd6, 0xfd, 0x33, 0x23, 0xff, 0xcf, 0x2f, 0xde,
+	0x5f, 0xda, 0x15, 0x8a, 0x66, 0x64, 0x01, 0x7f, 0x02, 0xf2, 0xf1, 0x4e, 0x96, 0xe6, 0x9d, 0x6c,
+	0xed, 0x9b, 0xa1, 0x96, 0xc6, 0xce, 0x68, 0xa8, 0xe5, 0x44, 0x2c, 0x59, 0xf7, 0x02, 0xfd, 0x49,
+	0xd7, 0xfa, 0x15, 0x58, 0x24, 0xd4, 0xa6, 0x03, 0xc2, 0xcf, 0x73, 0x6d, 0xe7, 0xed, 0x6f, 0x6d,
+	0x13, 0x1d, 0xae, 0x6a, 0x94, 0x46, 0x43, 0x6d, 0x43, 0xe0, 0x8d, 0x29, 0x05, 0x8a, 0x6e, 0x4a,
+	0x38, 0x78, 0x00, 0x4a, 0x98, 0xc8, 0xae, 0x89, 0x42, 0xab, 0x17, 0x0c, 0xfc, 0x1e, 0x76, 0x2d,
+	0x0f, 0x79, 0x07, 0x28, 0x54, 0xb3, 0x15, 0x65, 0x7b, 0xd9, 0xf8, 0xc1, 0x68, 0xa8, 0xdd, 0x96,
+	0x7e, 0xbd, 0x56, 0x57, 0x37, 0x6f, 0x60, 0xd2, 0x96, 0x7b, 0xbb, 0x62, 0xeb, 0x13, 0xbe, 0x03,
+	0xef, 0x83, 0xe2, 0xd8, 0xc8, 0x76, 0x9c, 0x10, 0x11, 0xd1, 0x98, 0x72, 0xc6, 0xe6, 0x68, 0xa8,
+	0xdd, 0x88, 0x7b, 0x38, 0xd1, 0xd0, 0xcd, 0xeb, 0x91, 0xa8, 0x2e, 0x24, 0xb0, 0x0f, 0xe0, 0x21,
+	0xf6, 0x6d, 0x57, 0x44, 0xd6, 0x0a, 0x11, 0x19, 0xb8, 0x54, 0x5d, 0xbc, 0x22, 0xc0, 0x26, 0x57,
+	0x33, 0x6e, 0xcb, 0x4a, 0x2d, 0x1f, 0xcb, 0x2c, 0x90, 0x6e, 0x16, 0xb9, 0x30, 0x66, 0x04, 0x7f,
+	0x03, 0xf2, 0x64, 0x70, 0xe0, 0x61, 0x6a, 0xb1, 0x29, 0x47, 0x36, 0xa0, 0xd2, 0x4c, 0xb4, 0xbb,
+	0xd1, 0x08, 0x64, 0x94, 0x25, 0x8b, 0x2c, 0x1f, 0x31, 0x63, 0xfd, 0x33, 0xf6, 0x16, 0x81, 0x90,
+	0x30, 0x03, 0x88, 0x41, 0x31, 0x7a, 0xae, 0xc8, 0x77, 0x04, 0xc3, 0xf2, 0x95, 0x0c, 0x6f, 0x4b,
+	0x86, 0x1b, 0xc9, 0x3e, 0x1d, 0x21, 0x08, 0x9a, 0x68, 0x0c, 0x68, 0xfa, 0x0e, 0xa7, 0x7a, 0xa2,
+	0x80, 0x02, 0x0d, 0x68, 0xac, 0xac, 0xe7, 0xae, 0xaa, 0x90, 0x1f, 0x25, 0x1b, 0x75, 0xc2, 0xfa,
+	0xbb, 0x95, 0xc2, 0x15, 0x6e, 0x1b, 0x15, 0x43, 0x17, 0xac, 0xca, 0xa6, 0xcf, 0x87, 0x33, 0x71,
+	0x6c, 0x70, 0xe5, 0xb1, 0xef, 0x24, 0x1b, 0xed, 0x0c, 0x84, 0x38, 0xf7, 0x75, 0x21, 0xef, 0x30,
+	0x31, 0x3f, 0xf8, 0x21, 0x90, 0xa2, 0xc9, 0x15, 0xe7, 0xaf, 0xe4, 0xd2, 0x25, 0xd7, 0x46, 0x82,
+	0x2b, 0x79, 0xc3, 0x72, 0xfc, 0x89, 0x2e, 0xf8, 0x53, 0x05, 0xa8, 0xd3, 0xcd, 0xdf, 0x22, 0x03,
+	0xcf, 0xb3, 0xc3, 0x33, 0x75, 0x85, 0x33, 0xfe, 0xf0, 0x4d, 0x06, 0x8a, 0x8e, 0x30, 0x31, 0xde,
+	0x91, 0x2e, 0x68, 0xf3, 0xe7, 0x8a, 0x08, 0x5a, 0x37, 0x37, 0x7a, 0x73, 0x01, 0xee, 0x65, 0xbf,
+	0x66, 0x43, 0xc6, 0x09, 0xc8, 0xb2, 0x1a, 0x06, 0x3f, 0x98, 0x9e, 0x30, 0xd4, 0xd7, 0x0c, 0x6a,
+	0xe8, 0x7b, 0x8d, 0x17, 0x4f, 0xa3, 0xf1, 0xe2, 0x6f, 0x19, 0x70, 0x7d, 0xea, 0x64, 0xf0, 0xa7,
+	0xc9, 0xc2, 0x27, 0x46, 0xf8, 0x8d, 0xc9, 0x5b, 0x49, 0x4c, 0xee, 0xf1, 0xda, 0xb7, 0x03, 0x72,
+	0xe3, 0xe2, 0x2c, 0x9d, 0x58, 0x1f, 0x0d, 0xb5, 0xa2, 0xbc, 0x9a, 0x68, 0x4b, 0x37, 0x27, 0x6a,
+	0xf0, 0xe7, 0xa0, 0xe0, 0x06, 0x84, 0x58, 0x27, 0x28, 0x64, 0x02, 0xd1, 0x6c, 0x97, 0x0d, 0x75,
+	0x92, 0xd0, 0x89, 0x6d, 0xdd, 0x5c, 0x61, 0xeb, 0x7d, 0xb9, 0x84, 0x7f, 0x54, 0x00, 0x0c, 0x51,
+	0x2f, 0xf0, 0x3c, 0xe4, 0x3b, 0xc8, 0xb1, 0x6c, 0x2f, 0x18, 0xf8, 0x54, 0xcd, 0x5e, 0xf5, 0x68,
+	0x3e, 0x49, 0x16, 0x99, 0x59, 0x88, 0xef, 0x38, 0xe9, 0xc4, 0x00, 0xea, 0xdc, 0x9e, 0x7d, 0x11,
+	0x61, 0xbf, 0x87, 0x1d, 0x96, 0x04, 0x21, 0xea, 0x07, 0x21, 0x15, 0xc1, 0x11, 0xf5, 0x34, 0xf6,
+	0x45, 0x34, 0x4f, 0x4b, 0x37, 0x61, 0x24, 0x36, 0xb9, 0x94, 0x87, 0x2d, 0xcb, 0x43, 0xf6, 0xf7,
+	0x34, 0xd8, 0x98, 0x9f, 0x8c, 0xf0, 0x03, 0x90, 0x8f, 0xcf, 0xc7, 0x33, 0x91, 0x4b, 0xcc, 0xbb,
+	0x71, 0xd5, 0xd9, 0x30, 0x88, 0x76, 0xf7, 0x3d, 0xc3, 0x90, 0xf9, 0xbf, 0x87, 0x41, 0x3c, 0xaf,
+	0x77, 0xff, 0x92, 0x01, 0xd7, 0x92, 0x3d, 0x17, 0x56, 0xc1, 0x66, 0xdb, 0xdc, 0x6b, 0xef, 0x75,
+	0xea, 0x0f, 0xac, 0x4e, 0xb7, 0xde, 0x7d, 0xd4, 0xb1, 0x1e, 0x3d, 0xec, 0xb4, 0x9b, 0xbb, 0xad,
+	0xfb, 0xad, 0x66, 0xa3, 0x98, 0x2a, 0x15, 0xce, 0x2f, 0x2a, 0x39, 0xa1, 0xfc, 0x10, 0xb3, 0x59,
+	0xa2, 0x3c, 0xad, 0xdf, 0x68, 0xb6, 0xf7, 0x3a, 0xad, 0xae, 0xd5, 0x6e, 0x9a, 0xad, 0xbd, 0x46,
+	0x51, 0x29, 0xdd, 0x38, 0xbf, 0xa8, 0xac, 0x09, 0x93, 0xc4, 0x1c, 0x07, 0x1f, 0x80, 0x77, 0xa6,
+	0x8d, 0x77, 0x9b, 0x66, 0x97, 0x51, 0x99, 0xd6, 0xfe, 0x5e, 0xb7, 0xf5, 0xf0, 0xc3, 0x08, 0x25,
+	0x5d, 0xd2, 0xce, 0x2f, 0x2a, 0x9b, 0x02, 0x65, 0x37, 0x7a, 0x27, 0xf2, 0xd3, 0xec, 0xb5, 0x68,
+	0xfb, 0xf5, 0x07, 0xad, 0x46, 0xbd, 0xbb, 0x37, 0x8d, 0x96, 0x89, 0xa3, 0xed, 0xdb, 0x2e, 0x76,
+	0x6c, 0x1a, 0x24, 0xd1, 0xde, 0x03, 0x1b, 0xd3, 0x68, 0xed, 0x7a, 0xa7, 0xd3, 0x6c, 0x14, 0xb3,
+	0xa5, 0xe2, 0xf9, 0x45, 0x65, 0x45, 0x18, 0xb7, 0x59, 0xba, 0x38, 0xf0, 0x47, 0x40, 0x9d, 0xd6,
+	0x36, 0x9b, 0x1f, 0x37, 0x77, 0xbb, 0xcd, 0x46, 0x71, 0xa1, 0x04, 0xcf, 0x2f, 0x2a, 0xd7, 0x84,
+	0xbe, 0x89, 0x7e, 0x87, 0x7a, 0x14, 0xcd, 0xc5, 0xbf, 0x5f, 0x6f, 0x3d, 0x68, 0x36, 0x8a, 0x8b,
+	0x71, 0xfc, 0xfb, 0x36, 0x76, 0x91, 0x53, 0xca, 0x3e, 0xf9, 0x73, 0x39, 0x65, 0x74, 0x9f, 0x7f,
+	0x55, 0x4e, 0x7d, 0xf9, 0x55, 0x39, 0xf5, 0x87, 0xcb, 0x72, 0xea, 0xf9, 0x65, 0x59, 0x79, 0x71,
+	0x59, 0x56, 0xfe, 0x75, 0x59, 0x56, 0x3e, 0x7b, 0x55, 0x4e, 0xbd, 0x78, 0x55, 0x4e, 0x7d, 0xf9,
+	0xaa, 0x9c, 0xfa, 0x75, 0x35, 0x9e, 0x18, 0xec, 0xa6, 0x1e, 0x1f, 0x06, 0x03, 0xdf, 0xe1, 0x33,
+	0x79, 0x4d, 0xfe, 0x40, 0x73, 0xca, 0x7f, 0x4e, 0xe1, 0x49, 0x72, 0xb0, 0xc8, 0x5b, 0xc8, 0x8f,
+	0xff, 0x3b, 0x00, 0x8e, 0xbb, 0x93, 0x5d, 0xbd, 0x11, 0x00, 0x00,
 }
 
 func (this *Proposal) Equal(that interface{}) bool {
@@ -507,6 +612,44 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if !this.ClaimAssessmentSummary.Equal(&that1.ClaimAssessmentSummary) {
+		return false
+	}
+	return true
+}
+func (this *ClaimAssessmentSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimAssessmentSummary)
+	if !ok {
+		that2, ok := that.(ClaimAssessmentSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Assessments != that1.Assessments {
+		return false
+	}
+	if this.LossVerified != that1.LossVerified {
+		return false
+	}
+	if len(this.RecommendedAmount) != len(that1.RecommendedAmount) {
+		return false
+	}
+	for i := range this.RecommendedAmount {
+		if !this.RecommendedAmount[i].Equal(&that1.RecommendedAmount[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -529,6 +672,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimAssessments) > 0 {
+		for iNdEx := len(m.ClaimAssessments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimAssessments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -789,21 +946,31 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
-	if err9 != nil {
-		return 0, err9
+	{
+		size, err := m.ClaimAssessmentSummary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i -= n9
-	i = encodeVarintGov(dAtA, i, uint64(n9))
 	i--
-	dAtA[i] = 0x5a
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	dAtA[i] = 0x62
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err10 != nil {
 		return 0, err10
 	}
 	i -= n10
 	i = encodeVarintGov(dAtA, i, uint64(n10))
 	i--
+	dAtA[i] = 0x5a
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
 	dAtA[i] = 0x52
 	if len(m.TotalDeposit) > 0 {
 		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
@@ -819,21 +986,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x4a
 		}
 	}
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
-	if err11 != nil {
-		return 0, err11
-	}
-	i -= n11
-	i = encodeVarintGov(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x42
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
 	if err12 != nil {
 		return 0, err12
 	}
 	i -= n12
 	i = encodeVarintGov(dAtA, i, uint64(n12))
 	i--
+	dAtA[i] = 0x42
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGov(dAtA, i, uint64(n13))
+	i--
 	dAtA[i] = 0x3a
 	{
 		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
@@ -929,6 +1096,119 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClaimAssessment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAssessment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAssessment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncidentReportHash) > 0 {
+		i -= len(m.IncidentReportHash)
+		copy(dAtA[i:], m.IncidentReportHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.IncidentReportHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RecommendedAmount) > 0 {
+		for iNdEx := len(m.RecommendedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecommendedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LossVerified {
+		i--
+		if m.LossVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Certifier) > 0 {
+		i -= len(m.Certifier)
+		copy(dAtA[i:], m.Certifier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Certifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAssessmentSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAssessmentSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAssessmentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecommendedAmount) > 0 {
+		for iNdEx := len(m.RecommendedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecommendedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LossVerified != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LossVerified))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Assessments != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Assessments))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -973,6 +1253,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.ClaimAssessments) > 0 {
+		for _, e := range m.ClaimAssessments {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1076,6 +1362,8 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ClaimAssessmentSummary.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1096,6 +1384,56 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *ClaimAssessment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Certifier)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.LossVerified {
+		n += 2
+	}
+	if len(m.RecommendedAmount) > 0 {
+		for _, e := range m.RecommendedAmount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.IncidentReportHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimAssessmentSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Assessments != 0 {
+		n += 1 + sovGov(uint64(m.Assessments))
+	}
+	if m.LossVerified != 0 {
+		n += 1 + sovGov(uint64(m.LossVerified))
+	}
+	if len(m.RecommendedAmount) > 0 {
+		for _, e := range m.RecommendedAmount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1351,27 +1689,61 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAssessments", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimAssessments = append(m.ClaimAssessments, ClaimAssessment{})
+			if err := m.ClaimAssessments[len(m.ClaimAssessments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -2165,6 +2537,39 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAssessmentSummary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAssessmentSummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2310,6 +2715,321 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClaimAssessment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAssessment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAssessment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LossVerified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedAmount = append(m.RecommendedAmount, types1.Coin{})
+			if err := m.RecommendedAmount[len(m.RecommendedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentReportHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncidentReportHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimAssessmentSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAssessmentSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAssessmentSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assessments", wireType)
+			}
+			m.Assessments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Assessments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossVerified", wireType)
+			}
+			m.LossVerified = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LossVerified |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedAmount = append(m.RecommendedAmount, types1.Coin{})
+			if err := m.RecommendedAmount[len(m.RecommendedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ClaimAssessmentsKeyPrefix is the prefix of certifier assessments of
+// shield claim proposals, following the key prefixes of the Cosmos gov module.
+var ClaimAssessmentsKeyPrefix = []byte{0x30}
+
+// ClaimAssessmentsKey gets the first part of the assessments key based on the proposalID.
+func ClaimAssessmentsKey(proposalID uint64) []byte {
+	return append(ClaimAssessmentsKeyPrefix, govtypes.GetProposalIDBytes(proposalID)...)
+}
+
+// ClaimAssessmentKey gets the key of the assessment of a proposal by a certifier.
+func ClaimAssessmentKey(proposalID uint64, certifier sdk.AccAddress) []byte {
+	return append(ClaimAssessmentsKey(proposalID), certifier.Bytes()...)
+}
@@ -15,6 +15,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
+	shieldtypes "github.com/certikfoundation/shentu/x/shield/types"
 )
 
 var fakeProposerAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -34,7 +38,7 @@ var (
 	proposals = []Proposal{
 		{any, 0, StatusDepositPeriod, false, fakeProposerAddress.String(),
 			govtypes.EmptyTallyResult(), times[0], times[1],
-			sdk.NewCoins(), time.Time{}, time.Time{}},
+			sdk.NewCoins(), time.Time{}, time.Time{}, ClaimAssessmentSummary{}},
 	}
 	strs = []string{proposals[0].String()}
 )
@@ -155,6 +159,31 @@ func TestProposal_String(t *testing.T) {
 	}
 }
 
+func TestProposal_HasSecurityVoting(t *testing.T) {
+	tests := []struct {
+		name    string
+		content govtypes.Content
+		want    bool
+	}{
+		{"text", govtypes.NewTextProposal("title", "desc"), false},
+		{"software upgrade", upgradetypes.NewSoftwareUpgradeProposal("title", "desc", upgradetypes.Plan{}), true},
+		{"certifier update", certtypes.NewCertifierUpdateProposal("title", "desc", fakeProposerAddress, "alias",
+			fakeProposerAddress, certtypes.Add), true},
+		{"shield claim", shieldtypes.NewShieldClaimProposal(1, sdk.NewCoins(), 1, "evidence", "desc",
+			fakeProposerAddress), true},
+		{"shield admin update", shieldtypes.NewShieldAdminUpdateProposal("title", "desc", shieldtypes.ShieldRoles{},
+			fakeProposerAddress), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewProposal(tt.content, 0, fakeProposerAddress, false, times[0], times[1])
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.HasSecurityVoting())
+		})
+	}
+}
+
 func TestValidProposalStatus(t *testing.T) {
 	type args struct {
 		status ProposalStatus
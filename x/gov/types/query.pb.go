@@ -818,6 +818,109 @@ func (m *QueryTallyResultResponse) GetTally() types.TallyResult {
 	return types.TallyResult{}
 }
 
+// QueryClaimAssessmentsRequest is the request type for the
+// Query/ClaimAssessments RPC method.
+type QueryClaimAssessmentsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryClaimAssessmentsRequest) Reset()         { *m = QueryClaimAssessmentsRequest{} }
+func (m *QueryClaimAssessmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimAssessmentsRequest) ProtoMessage()    {}
+func (*QueryClaimAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f945a4e1db5124e, []int{16}
+}
+func (m *QueryClaimAssessmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimAssessmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimAssessmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimAssessmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimAssessmentsRequest.Merge(m, src)
+}
+func (m *QueryClaimAssessmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimAssessmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimAssessmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimAssessmentsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimAssessmentsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryClaimAssessmentsResponse is the response type for the
+// Query/ClaimAssessments RPC method.
+type QueryClaimAssessmentsResponse struct {
+	// assessments defines the certifier assessments of the proposal.
+	Assessments []ClaimAssessment `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments"`
+	// summary defines the summary of the assessments.
+	Summary ClaimAssessmentSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary"`
+}
+
+func (m *QueryClaimAssessmentsResponse) Reset()         { *m = QueryClaimAssessmentsResponse{} }
+func (m *QueryClaimAssessmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimAssessmentsResponse) ProtoMessage()    {}
+func (*QueryClaimAssessmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f945a4e1db5124e, []int{17}
+}
+func (m *QueryClaimAssessmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimAssessmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimAssessmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimAssessmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimAssessmentsResponse.Merge(m, src)
+}
+func (m *QueryClaimAssessmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimAssessmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimAssessmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimAssessmentsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimAssessmentsResponse) GetAssessments() []ClaimAssessment {
+	if m != nil {
+		return m.Assessments
+	}
+	return nil
+}
+
+func (m *QueryClaimAssessmentsResponse) GetSummary() ClaimAssessmentSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return ClaimAssessmentSummary{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "shentu.gov.v1alpha1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "shentu.gov.v1alpha1.QueryProposalResponse")
@@ -835,75 +938,83 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "shentu.gov.v1alpha1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "shentu.gov.v1alpha1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "shentu.gov.v1alpha1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryClaimAssessmentsRequest)(nil), "shentu.gov.v1alpha1.QueryClaimAssessmentsRequest")
+	proto.RegisterType((*QueryClaimAssessmentsResponse)(nil), "shentu.gov.v1alpha1.QueryClaimAssessmentsResponse")
 }
 
 func init() { proto.RegisterFile("shentu/gov/v1alpha1/query.proto", fileDescriptor_9f945a4e1db5124e) }
 
 var fileDescriptor_9f945a4e1db5124e = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
+	// 1097 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x89, 0xd3, 0xda, 0x2f, 0x6d, 0x80, 0xd7, 0x00, 0xc6, 0x4a, 0xed, 0x68, 0x11,
-	0x69, 0xd2, 0x96, 0x5d, 0x39, 0x6d, 0x54, 0x29, 0x14, 0x50, 0x03, 0x2a, 0x8d, 0x40, 0x22, 0xb8,
-	0x51, 0x41, 0x1c, 0x88, 0x36, 0xf1, 0xb2, 0xb1, 0x70, 0x3c, 0x5b, 0xcf, 0xd8, 0x22, 0x0a, 0x11,
-	0x12, 0x27, 0x0e, 0x08, 0x21, 0x51, 0x09, 0x71, 0xf3, 0x01, 0x21, 0xf1, 0x9f, 0xf4, 0x58, 0x89,
-	0x0b, 0x27, 0x84, 0x12, 0x0e, 0x48, 0xf0, 0x37, 0x20, 0xb4, 0x33, 0x6f, 0xd6, 0xbb, 0xee, 0xd6,
-	0xde, 0x94, 0xaa, 0xb7, 0x78, 0xf6, 0xfb, 0xde, 0xfb, 0xbc, 0x1f, 0xf3, 0xa6, 0x85, 0xaa, 0xd8,
-	0xf5, 0xda, 0xb2, 0xeb, 0xf8, 0xbc, 0xe7, 0xf4, 0x6a, 0x6e, 0x2b, 0xd8, 0x75, 0x6b, 0xce, 0xdd,
-	0xae, 0xd7, 0xd9, 0xb7, 0x83, 0x0e, 0x97, 0x1c, 0xcf, 0x69, 0x81, 0xed, 0xf3, 0x9e, 0x6d, 0x04,
-	0xe5, 0x8b, 0x3b, 0x5c, 0xec, 0x71, 0xe1, 0x6c, 0xbb, 0xc2, 0xd3, 0x6a, 0xa7, 0x57, 0xdb, 0xf6,
-	0xa4, 0x5b, 0x73, 0x02, 0xd7, 0x6f, 0xb6, 0x5d, 0xd9, 0xe4, 0x6d, 0xed, 0xa0, 0x3c, 0xeb, 0x73,
-	0x9f, 0xab, 0x3f, 0x9d, 0xf0, 0x2f, 0x3a, 0x9d, 0xf3, 0x39, 0xf7, 0x5b, 0x9e, 0xe3, 0x06, 0x4d,
-	0xc7, 0x6d, 0xb7, 0xb9, 0x54, 0x26, 0xc2, 0x7c, 0x25, 0xff, 0x9a, 0x4a, 0x3b, 0x0e, 0x01, 0xf4,
-	0xd7, 0xf3, 0x69, 0xcc, 0xd1, 0x67, 0xeb, 0x1a, 0xcc, 0x7e, 0x10, 0x22, 0x6d, 0x74, 0x78, 0xc0,
-	0x85, 0xdb, 0xaa, 0x7b, 0x77, 0xbb, 0x9e, 0x90, 0x58, 0x85, 0xe9, 0x80, 0x8e, 0xb6, 0x9a, 0x8d,
-	0x12, 0x9b, 0x67, 0x8b, 0xf9, 0x3a, 0x98, 0xa3, 0xf5, 0x86, 0xf5, 0x11, 0x3c, 0x3f, 0x64, 0x28,
-	0x02, 0xde, 0x16, 0x1e, 0xbe, 0x09, 0x05, 0x23, 0x53, 0x66, 0xd3, 0xcb, 0xe7, 0xed, 0x94, 0xb2,
-	0xd8, 0xc6, 0x70, 0x2d, 0x7f, 0xff, 0xf7, 0x6a, 0xae, 0x1e, 0x19, 0x59, 0x7f, 0xb3, 0x21, 0xd7,
-	0xc2, 0x40, 0xbd, 0x07, 0xcf, 0x44, 0x50, 0x42, 0xba, 0xb2, 0x2b, 0x54, 0x84, 0x99, 0xe5, 0x97,
-	0x47, 0x46, 0xb8, 0xad, 0xa4, 0xf5, 0x99, 0x20, 0xf1, 0x1b, 0x67, 0x61, 0xaa, 0xc7, 0xa5, 0xd7,
-	0x29, 0x4d, 0xcc, 0xb3, 0xc5, 0x62, 0x5d, 0xff, 0xc0, 0x39, 0x28, 0x36, 0xbc, 0x80, 0x8b, 0xa6,
-	0xe4, 0x9d, 0xd2, 0xa4, 0xfa, 0x32, 0x38, 0xc0, 0x9b, 0x00, 0x83, 0x9e, 0x95, 0xf2, 0x2a, 0xbd,
-	0x05, 0x5b, 0x37, 0xc0, 0x0e, 0x1b, 0x6c, 0xeb, 0x71, 0xa0, 0x3e, 0xd8, 0x1b, 0xae, 0xef, 0x11,
-	0x7d, 0x3d, 0x66, 0xb9, 0x5a, 0xf8, 0xba, 0x5f, 0xcd, 0xfd, 0xd5, 0xaf, 0xe6, 0xac, 0x9f, 0x18,
-	0xbc, 0x30, 0x9c, 0x2d, 0x55, 0xf2, 0x06, 0x14, 0x0d, 0x72, 0x98, 0xe8, 0x64, 0xd6, 0x52, 0x0e,
-	0xac, 0xf0, 0x9d, 0x04, 0xef, 0x84, 0xe2, 0xbd, 0x30, 0x96, 0x57, 0xc7, 0x8f, 0x03, 0x5b, 0xb7,
-	0xe1, 0x59, 0x45, 0x79, 0x87, 0x4b, 0x2f, 0xeb, 0x8c, 0xa4, 0x57, 0x38, 0x96, 0xfb, 0x2d, 0x78,
-	0x2e, 0xe6, 0x94, 0xb2, 0xbe, 0x02, 0xf9, 0x50, 0x47, 0xb3, 0xf3, 0x52, 0x6a, 0xc2, 0xa1, 0x01,
-	0x25, 0xab, 0xc4, 0xd6, 0x17, 0x31, 0x4f, 0x22, 0x33, 0xdf, 0xcd, 0x94, 0xea, 0x3c, 0x46, 0x37,
-	0xad, 0x7b, 0x0c, 0x30, 0x1e, 0x9e, 0x32, 0x59, 0xd1, 0xe9, 0x9b, 0xde, 0x8d, 0x4d, 0x45, 0xab,
-	0x9f, 0x5c, 0xcf, 0x56, 0x88, 0x6a, 0xc3, 0xed, 0xb8, 0x7b, 0x89, 0xaa, 0xa8, 0x83, 0x2d, 0xb9,
-	0x1f, 0xe8, 0x32, 0x17, 0x43, 0xb3, 0xf0, 0x68, 0x73, 0x3f, 0xf0, 0xac, 0x7f, 0x19, 0x9c, 0x4b,
-	0xd8, 0x51, 0x3a, 0xef, 0xc2, 0xd9, 0x1e, 0x97, 0xcd, 0xb6, 0xbf, 0xa5, 0xc5, 0xd4, 0xa1, 0x79,
-	0x83, 0xa6, 0xd3, 0xd2, 0x4c, 0x77, 0x94, 0x50, 0x3b, 0xa0, 0xec, 0xce, 0xf4, 0x62, 0x67, 0xf8,
-	0x3e, 0xcc, 0xd0, 0xad, 0x32, 0xde, 0x74, 0xa2, 0x56, 0x6a, 0x91, 0xde, 0xd6, 0xd2, 0x84, 0xbf,
-	0xb3, 0x8d, 0xf8, 0x21, 0xae, 0xc3, 0x19, 0xe9, 0xb6, 0x5a, 0xfb, 0xc6, 0xdd, 0x24, 0xc1, 0xa5,
-	0xb9, 0xdb, 0x0c, 0x85, 0x09, 0x67, 0xd3, 0x72, 0x70, 0x64, 0x7d, 0x42, 0xf9, 0x53, 0xd4, 0xcc,
-	0xe3, 0x94, 0x58, 0x1d, 0x13, 0x43, 0xab, 0x23, 0x36, 0xf6, 0x9b, 0xb4, 0x73, 0x23, 0xff, 0x54,
-	0xe0, 0xeb, 0x70, 0x9a, 0xe4, 0x54, 0xda, 0xb9, 0x51, 0xc5, 0x20, 0x72, 0x63, 0x62, 0x7d, 0x99,
-	0xf4, 0xfa, 0xf4, 0x6f, 0x41, 0xdf, 0xec, 0xed, 0x01, 0x01, 0x25, 0xf6, 0x06, 0x14, 0x88, 0xd2,
-	0xdc, 0x85, 0x2c, 0x99, 0x45, 0x36, 0x4f, 0xee, 0x46, 0xac, 0xc2, 0x8b, 0x8a, 0x50, 0x0d, 0x40,
-	0xdd, 0x13, 0xdd, 0x56, 0xe6, 0xee, 0x5a, 0x1f, 0x42, 0xe9, 0x61, 0x5b, 0x4a, 0xf0, 0x35, 0x98,
-	0x52, 0x03, 0x44, 0x7d, 0xab, 0xa6, 0x5d, 0x89, 0x98, 0x9d, 0xb9, 0xef, 0xca, 0x66, 0xf9, 0x9f,
-	0x22, 0x4c, 0x29, 0xcf, 0xf8, 0x03, 0x83, 0x82, 0xd9, 0xe5, 0xb8, 0x94, 0x5a, 0xa2, 0xb4, 0xc7,
-	0xba, 0x7c, 0x31, 0x8b, 0x54, 0xa3, 0x5a, 0x57, 0xbf, 0xfa, 0xf5, 0xcf, 0xef, 0x27, 0x6c, 0xbc,
-	0xec, 0xa4, 0xfd, 0xc3, 0x20, 0x7a, 0x39, 0x9c, 0x83, 0x58, 0x35, 0x0e, 0xf1, 0x1b, 0x06, 0xc5,
-	0xe8, 0x81, 0xc2, 0x0c, 0xf1, 0xcc, 0xf8, 0x95, 0x2f, 0x65, 0xd2, 0x12, 0xdc, 0x82, 0x82, 0x9b,
-	0xc7, 0xca, 0x68, 0x38, 0xfc, 0x91, 0x41, 0x3e, 0x5c, 0x9c, 0xf8, 0xca, 0xa3, 0xbd, 0xc7, 0x5e,
-	0xaa, 0xf2, 0xc2, 0x38, 0x19, 0xc5, 0x5f, 0x53, 0xf1, 0xaf, 0xe3, 0xea, 0x49, 0x8a, 0xe3, 0xa8,
-	0xb5, 0xed, 0x1c, 0xa8, 0xd7, 0xed, 0x10, 0xef, 0x31, 0x98, 0x52, 0xef, 0x00, 0x8e, 0x89, 0x1a,
-	0x95, 0xe8, 0xc2, 0x58, 0x1d, 0xe1, 0xad, 0x2a, 0xbc, 0xab, 0xb8, 0x7c, 0x72, 0x3c, 0xfc, 0x96,
-	0xc1, 0x29, 0x5a, 0x95, 0x23, 0xe2, 0x25, 0x9e, 0x8a, 0xf2, 0xe2, 0x78, 0x21, 0x91, 0xd5, 0x14,
-	0xd9, 0x25, 0x5c, 0x4a, 0x27, 0x53, 0x62, 0xe7, 0x20, 0xf6, 0xee, 0x1c, 0xe2, 0x2f, 0x0c, 0x4e,
-	0xd3, 0x85, 0xc7, 0x11, 0x81, 0x92, 0x4b, 0xb8, 0xbc, 0x94, 0x41, 0x49, 0x4c, 0xeb, 0x8a, 0xe9,
-	0x2d, 0xbc, 0x71, 0xa2, 0x6a, 0x99, 0xa5, 0xe3, 0x1c, 0x44, 0xab, 0xfb, 0x10, 0xfb, 0x0c, 0x0a,
-	0x66, 0xab, 0xe1, 0x78, 0x04, 0x91, 0xe1, 0x62, 0x0e, 0x2f, 0x49, 0xeb, 0x75, 0x85, 0x7b, 0x0d,
-	0x57, 0x1e, 0x0b, 0x17, 0x7f, 0x66, 0x30, 0x1d, 0x5b, 0x31, 0x78, 0xf9, 0xd1, 0xa1, 0x1f, 0xde,
-	0x7e, 0xe5, 0x57, 0x33, 0xaa, 0xff, 0xd7, 0x20, 0xaa, 0x75, 0xb7, 0x76, 0xeb, 0xfe, 0x51, 0x85,
-	0x3d, 0x38, 0xaa, 0xb0, 0x3f, 0x8e, 0x2a, 0xec, 0xbb, 0xe3, 0x4a, 0xee, 0xc1, 0x71, 0x25, 0xf7,
-	0xdb, 0x71, 0x25, 0xf7, 0xb1, 0xed, 0x37, 0xe5, 0x6e, 0x77, 0xdb, 0xde, 0xe1, 0x7b, 0xce, 0x8e,
-	0xd7, 0x91, 0xcd, 0xcf, 0x3e, 0xe5, 0xdd, 0x76, 0x43, 0xad, 0x6e, 0x13, 0xe8, 0x73, 0x15, 0x2a,
-	0x9c, 0x20, 0xb1, 0x7d, 0x4a, 0xfd, 0x17, 0xe6, 0xca, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e,
-	0x59, 0xc9, 0x07, 0x97, 0x0d, 0x00, 0x00,
+	0x14, 0xc7, 0x3d, 0xf9, 0xd1, 0xd8, 0xcf, 0x6d, 0x28, 0xd3, 0x00, 0xc6, 0x4a, 0xec, 0x68, 0x81,
+	0x34, 0x69, 0xca, 0xae, 0xec, 0x36, 0xaa, 0x14, 0x4a, 0x4b, 0x52, 0x14, 0x1a, 0xb5, 0x12, 0xc1,
+	0x89, 0x0a, 0xe2, 0x40, 0xb4, 0x89, 0x97, 0x8d, 0x85, 0xbd, 0xb3, 0xdd, 0x59, 0x5b, 0x58, 0x21,
+	0x42, 0xe2, 0xc4, 0x01, 0x21, 0x24, 0x2a, 0x21, 0x6e, 0x39, 0x20, 0x24, 0x6e, 0x9c, 0xb9, 0x71,
+	0xeb, 0xb1, 0x12, 0x17, 0x4e, 0x08, 0x25, 0x1c, 0x90, 0xf8, 0x1f, 0x10, 0xda, 0x99, 0x37, 0xf6,
+	0xae, 0xbb, 0xb6, 0xd7, 0xa5, 0xea, 0x29, 0xf6, 0xcc, 0x7b, 0xdf, 0xf7, 0x79, 0xef, 0xcd, 0xcc,
+	0x73, 0xa0, 0xc8, 0x0f, 0x2c, 0xc7, 0x6f, 0x1a, 0x36, 0x6b, 0x19, 0xad, 0x92, 0x59, 0x77, 0x0f,
+	0xcc, 0x92, 0x71, 0xbf, 0x69, 0x79, 0x6d, 0xdd, 0xf5, 0x98, 0xcf, 0xe8, 0x05, 0x69, 0xa0, 0xdb,
+	0xac, 0xa5, 0x2b, 0x83, 0xfc, 0xa5, 0x7d, 0xc6, 0x1b, 0x8c, 0x1b, 0x7b, 0x26, 0xb7, 0xa4, 0xb5,
+	0xd1, 0x2a, 0xed, 0x59, 0xbe, 0x59, 0x32, 0x5c, 0xd3, 0xae, 0x39, 0xa6, 0x5f, 0x63, 0x8e, 0x14,
+	0xc8, 0xcf, 0xd8, 0xcc, 0x66, 0xe2, 0xa3, 0x11, 0x7c, 0xc2, 0xd5, 0x59, 0x9b, 0x31, 0xbb, 0x6e,
+	0x19, 0xa6, 0x5b, 0x33, 0x4c, 0xc7, 0x61, 0xbe, 0x70, 0xe1, 0x6a, 0x17, 0xf5, 0x25, 0x95, 0x14,
+	0x0e, 0x00, 0xe4, 0xee, 0x5c, 0x1c, 0x73, 0x67, 0x5b, 0xbb, 0x06, 0x33, 0xef, 0x05, 0x48, 0x5b,
+	0x1e, 0x73, 0x19, 0x37, 0xeb, 0x15, 0xeb, 0x7e, 0xd3, 0xe2, 0x3e, 0x2d, 0x42, 0xd6, 0xc5, 0xa5,
+	0xdd, 0x5a, 0x35, 0x47, 0xe6, 0xc9, 0xe2, 0x44, 0x05, 0xd4, 0xd2, 0x66, 0x55, 0xfb, 0x00, 0x5e,
+	0xe8, 0x71, 0xe4, 0x2e, 0x73, 0xb8, 0x45, 0x6f, 0x42, 0x5a, 0x99, 0x09, 0xb7, 0x6c, 0x79, 0x4e,
+	0x8f, 0x29, 0x8b, 0xae, 0x1c, 0xd7, 0x27, 0x1e, 0xfe, 0x51, 0x4c, 0x55, 0x3a, 0x4e, 0xda, 0x3f,
+	0xa4, 0x47, 0x9a, 0x2b, 0xa8, 0xbb, 0xf0, 0x5c, 0x07, 0x8a, 0xfb, 0xa6, 0xdf, 0xe4, 0x22, 0xc2,
+	0x74, 0xf9, 0x95, 0x81, 0x11, 0xb6, 0x85, 0x69, 0x65, 0xda, 0x8d, 0x7c, 0xa7, 0x33, 0x30, 0xd9,
+	0x62, 0xbe, 0xe5, 0xe5, 0xc6, 0xe6, 0xc9, 0x62, 0xa6, 0x22, 0xbf, 0xd0, 0x59, 0xc8, 0x54, 0x2d,
+	0x97, 0xf1, 0x9a, 0xcf, 0xbc, 0xdc, 0xb8, 0xd8, 0xe9, 0x2e, 0xd0, 0x0d, 0x80, 0x6e, 0xcf, 0x72,
+	0x13, 0x22, 0xbd, 0x05, 0x5d, 0x36, 0x40, 0x0f, 0x1a, 0xac, 0xcb, 0xe3, 0x80, 0x7d, 0xd0, 0xb7,
+	0x4c, 0xdb, 0x42, 0xfa, 0x4a, 0xc8, 0x73, 0x35, 0xfd, 0xe5, 0x71, 0x31, 0xf5, 0xf7, 0x71, 0x31,
+	0xa5, 0xfd, 0x40, 0xe0, 0xc5, 0xde, 0x6c, 0xb1, 0x92, 0x6b, 0x90, 0x51, 0xc8, 0x41, 0xa2, 0xe3,
+	0x49, 0x4b, 0xd9, 0xf5, 0xa2, 0xef, 0x44, 0x78, 0xc7, 0x04, 0xef, 0xc5, 0xa1, 0xbc, 0x32, 0x7e,
+	0x18, 0x58, 0xdb, 0x86, 0xf3, 0x82, 0xf2, 0x1e, 0xf3, 0xad, 0xa4, 0x67, 0x24, 0xbe, 0xc2, 0xa1,
+	0xdc, 0x6f, 0xc3, 0xf3, 0x21, 0x51, 0xcc, 0xfa, 0x0a, 0x4c, 0x04, 0x76, 0x78, 0x76, 0x5e, 0x8e,
+	0x4d, 0x38, 0x70, 0xc0, 0x64, 0x85, 0xb1, 0xf6, 0x59, 0x48, 0x89, 0x27, 0xe6, 0xdb, 0x88, 0xa9,
+	0xce, 0x13, 0x74, 0x53, 0x7b, 0x40, 0x80, 0x86, 0xc3, 0x63, 0x26, 0x2b, 0x32, 0x7d, 0xd5, 0xbb,
+	0xa1, 0xa9, 0x48, 0xeb, 0xa7, 0xd7, 0xb3, 0x15, 0xa4, 0xda, 0x32, 0x3d, 0xb3, 0x11, 0xa9, 0x8a,
+	0x58, 0xd8, 0xf5, 0xdb, 0xae, 0x2c, 0x73, 0xa6, 0x02, 0x72, 0x69, 0xa7, 0xed, 0x5a, 0xda, 0xbf,
+	0x04, 0x2e, 0x44, 0xfc, 0x30, 0x9d, 0x3b, 0x70, 0xae, 0xc5, 0xfc, 0x9a, 0x63, 0xef, 0x4a, 0x63,
+	0xec, 0xd0, 0xbc, 0x42, 0x93, 0x69, 0x49, 0xa6, 0x7b, 0xc2, 0x50, 0x0a, 0x60, 0x76, 0x67, 0x5b,
+	0xa1, 0x35, 0xfa, 0x2e, 0x4c, 0xe3, 0xad, 0x52, 0x6a, 0x32, 0x51, 0x2d, 0xb6, 0x48, 0x6f, 0x4b,
+	0xd3, 0x88, 0xde, 0xb9, 0x6a, 0x78, 0x91, 0x6e, 0xc2, 0x59, 0xdf, 0xac, 0xd7, 0xdb, 0x4a, 0x6e,
+	0x1c, 0xe1, 0xe2, 0xe4, 0x76, 0x02, 0xc3, 0x88, 0x58, 0xd6, 0xef, 0x2e, 0x69, 0x1f, 0x61, 0xfe,
+	0x18, 0x35, 0xf1, 0x71, 0x8a, 0x3c, 0x1d, 0x63, 0x3d, 0x4f, 0x47, 0xe8, 0xd8, 0xef, 0xc0, 0x4c,
+	0x54, 0x1f, 0x0b, 0x7c, 0x1d, 0xa6, 0xd0, 0x1c, 0x4b, 0x3b, 0x3b, 0xa8, 0x18, 0x48, 0xae, 0x5c,
+	0xb4, 0xcf, 0xa3, 0xaa, 0xcf, 0xfe, 0x16, 0x1c, 0xab, 0x77, 0xbb, 0x4b, 0x80, 0x89, 0xdd, 0x80,
+	0x34, 0x52, 0xaa, 0xbb, 0x90, 0x24, 0xb3, 0x8e, 0xcf, 0xd3, 0xbb, 0x11, 0xab, 0xf0, 0x92, 0x20,
+	0x14, 0x07, 0xa0, 0x62, 0xf1, 0x66, 0x3d, 0x71, 0x77, 0xb5, 0xf7, 0x21, 0xf7, 0xb8, 0x2f, 0x26,
+	0xf8, 0x06, 0x4c, 0x8a, 0x03, 0x84, 0x7d, 0x2b, 0xc6, 0x5d, 0x89, 0x90, 0x9f, 0xba, 0xef, 0xc2,
+	0x47, 0xbb, 0x09, 0xb3, 0x42, 0xf8, 0x56, 0xdd, 0xac, 0x35, 0xd6, 0x38, 0xb7, 0x38, 0x6f, 0x58,
+	0x4e, 0xf2, 0x06, 0x6a, 0xbf, 0x10, 0x98, 0xeb, 0xa3, 0x80, 0x7c, 0x77, 0x21, 0x6b, 0x76, 0x97,
+	0xb1, 0x07, 0xaf, 0xc6, 0xf6, 0xa0, 0x47, 0x43, 0xdd, 0x8f, 0x90, 0x3b, 0xbd, 0x03, 0x53, 0xbc,
+	0xd9, 0x68, 0x98, 0x5e, 0x1b, 0x7b, 0xb1, 0x9c, 0x44, 0x69, 0x5b, 0xba, 0xa8, 0x63, 0x8b, 0x0a,
+	0xe5, 0x9f, 0xb3, 0x30, 0x29, 0xe0, 0xe9, 0x77, 0x04, 0xd2, 0x6a, 0x92, 0xd1, 0xa5, 0x58, 0xc9,
+	0xb8, 0x9f, 0x2a, 0xf9, 0x4b, 0x49, 0x4c, 0x65, 0x21, 0xb4, 0xab, 0x5f, 0xfc, 0xf6, 0xd7, 0xb7,
+	0x63, 0x3a, 0xbd, 0x6c, 0xc4, 0xfd, 0x2c, 0xea, 0xcc, 0x4d, 0xe3, 0x30, 0x54, 0xf1, 0x23, 0xfa,
+	0x15, 0x81, 0x8c, 0x92, 0xe2, 0x34, 0x41, 0x3c, 0xd5, 0xbb, 0xfc, 0x72, 0x22, 0x5b, 0x84, 0x5b,
+	0x10, 0x70, 0xf3, 0xb4, 0x30, 0x18, 0x8e, 0x7e, 0x4f, 0x60, 0x22, 0x18, 0x1b, 0xf4, 0xb5, 0xfe,
+	0xea, 0xa1, 0x39, 0x9d, 0x5f, 0x18, 0x66, 0x86, 0xf1, 0xd7, 0x45, 0xfc, 0xeb, 0x74, 0x75, 0x94,
+	0xe2, 0x18, 0x62, 0x68, 0x19, 0x87, 0xc1, 0x1f, 0xef, 0x88, 0x3e, 0x20, 0x30, 0x19, 0x88, 0x72,
+	0x3a, 0x24, 0x6a, 0xa7, 0x44, 0x17, 0x87, 0xda, 0x21, 0xde, 0xaa, 0xc0, 0xbb, 0x4a, 0xcb, 0xa3,
+	0xe3, 0xd1, 0xaf, 0x09, 0x9c, 0xc1, 0x41, 0x31, 0x20, 0x5e, 0x64, 0x50, 0xe6, 0x17, 0x87, 0x1b,
+	0x22, 0x59, 0x49, 0x90, 0x2d, 0xd3, 0xa5, 0x78, 0x32, 0x61, 0x6c, 0x1c, 0x86, 0xa6, 0xee, 0x11,
+	0xfd, 0x89, 0xc0, 0x14, 0x3e, 0x77, 0x74, 0x40, 0xa0, 0xe8, 0x08, 0xca, 0x2f, 0x25, 0xb0, 0x44,
+	0xa6, 0x4d, 0xc1, 0x74, 0x8b, 0xae, 0x8d, 0x54, 0x2d, 0xf5, 0xe4, 0x1a, 0x87, 0x9d, 0xc1, 0x75,
+	0x44, 0x8f, 0x09, 0xa4, 0x51, 0x9e, 0xd3, 0xe1, 0x08, 0x3c, 0xc1, 0xc5, 0xec, 0x1d, 0x11, 0xda,
+	0x9b, 0x02, 0xf7, 0x1a, 0x5d, 0x79, 0x22, 0x5c, 0xfa, 0x23, 0x81, 0x6c, 0xe8, 0x81, 0xa5, 0x97,
+	0xfb, 0x87, 0x7e, 0xfc, 0xed, 0xcf, 0xbf, 0x9e, 0xd0, 0xfa, 0x7f, 0x1d, 0x44, 0xf1, 0xd8, 0xd3,
+	0x5f, 0x09, 0x9c, 0xef, 0x7d, 0xa6, 0x69, 0xa9, 0x7f, 0xfc, 0x3e, 0x43, 0x21, 0x5f, 0x1e, 0xc5,
+	0x05, 0xb9, 0x37, 0x04, 0xf7, 0x5b, 0xf4, 0xc6, 0x48, 0xdc, 0xfb, 0x81, 0xdc, 0x6e, 0xe8, 0xfd,
+	0x5f, 0xbf, 0xfd, 0xf0, 0xa4, 0x40, 0x1e, 0x9d, 0x14, 0xc8, 0x9f, 0x27, 0x05, 0xf2, 0xcd, 0x69,
+	0x21, 0xf5, 0xe8, 0xb4, 0x90, 0xfa, 0xfd, 0xb4, 0x90, 0xfa, 0x50, 0xb7, 0x6b, 0xfe, 0x41, 0x73,
+	0x4f, 0xdf, 0x67, 0x0d, 0x63, 0xdf, 0xf2, 0xfc, 0xda, 0x27, 0x1f, 0xb3, 0xa6, 0x53, 0x15, 0xc3,
+	0x57, 0x05, 0xfd, 0x54, 0x84, 0x0d, 0x6e, 0x01, 0xdf, 0x3b, 0x23, 0xfe, 0x09, 0xbd, 0xf2, 0xdf,
+	0x00, 0xac, 0x49, 0x21, 0x96, 0x59, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -934,6 +1045,8 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ClaimAssessments queries certifier assessments of a shield claim proposal.
+	ClaimAssessments(ctx context.Context, in *QueryClaimAssessmentsRequest, opts ...grpc.CallOption) (*QueryClaimAssessmentsResponse, error)
 }
 
 type queryClient struct {
@@ -1016,6 +1129,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ClaimAssessments(ctx context.Context, in *QueryClaimAssessmentsRequest, opts ...grpc.CallOption) (*QueryClaimAssessmentsResponse, error) {
+	out := new(QueryClaimAssessmentsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.gov.v1alpha1.Query/ClaimAssessments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1034,6 +1156,8 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ClaimAssessments queries certifier assessments of a shield claim proposal.
+	ClaimAssessments(context.Context, *QueryClaimAssessmentsRequest) (*QueryClaimAssessmentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1064,6 +1188,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ClaimAssessments(ctx context.Context, req *QueryClaimAssessmentsRequest) (*QueryClaimAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAssessments not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1213,6 +1340,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.gov.v1alpha1.Query/ClaimAssessments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimAssessments(ctx, req.(*QueryClaimAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "shentu.gov.v1alpha1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1249,6 +1394,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ClaimAssessments",
+			Handler:    _Query_ClaimAssessments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shentu/gov/v1alpha1/query.proto",
@@ -1876,6 +2025,81 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClaimAssessmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimAssessmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimAssessmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimAssessmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimAssessmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimAssessmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Assessments) > 0 {
+		for iNdEx := len(m.Assessments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assessments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2128,6 +2352,35 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClaimAssessmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryClaimAssessmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assessments) > 0 {
+		for _, e := range m.Assessments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3824,6 +4077,198 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClaimAssessmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimAssessmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimAssessmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimAssessmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimAssessmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimAssessmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assessments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assessments = append(m.Assessments, ClaimAssessment{})
+			if err := m.Assessments[len(m.Assessments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -246,6 +246,77 @@ func (m *MsgDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
+// MsgSubmitClaimAssessment defines a message to submit a certifier's
+// assessment of a shield claim proposal.
+type MsgSubmitClaimAssessment struct {
+	ProposalId         uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Certifier          string                                   `protobuf:"bytes,2,opt,name=certifier,proto3" json:"certifier,omitempty"`
+	LossVerified       bool                                     `protobuf:"varint,3,opt,name=loss_verified,json=lossVerified,proto3" json:"loss_verified,omitempty" yaml:"loss_verified"`
+	RecommendedAmount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=recommended_amount,json=recommendedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recommended_amount" yaml:"recommended_amount"`
+	IncidentReportHash string                                   `protobuf:"bytes,5,opt,name=incident_report_hash,json=incidentReportHash,proto3" json:"incident_report_hash,omitempty" yaml:"incident_report_hash"`
+}
+
+func (m *MsgSubmitClaimAssessment) Reset()      { *m = MsgSubmitClaimAssessment{} }
+func (*MsgSubmitClaimAssessment) ProtoMessage() {}
+func (*MsgSubmitClaimAssessment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5034648f58c6e59, []int{6}
+}
+func (m *MsgSubmitClaimAssessment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaimAssessment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSubmitClaimAssessment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaimAssessment.Merge(m, src)
+}
+func (m *MsgSubmitClaimAssessment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaimAssessment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaimAssessment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaimAssessment proto.InternalMessageInfo
+
+// MsgSubmitClaimAssessmentResponse defines the Msg/SubmitClaimAssessment response type.
+type MsgSubmitClaimAssessmentResponse struct {
+}
+
+func (m *MsgSubmitClaimAssessmentResponse) Reset()         { *m = MsgSubmitClaimAssessmentResponse{} }
+func (m *MsgSubmitClaimAssessmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaimAssessmentResponse) ProtoMessage()    {}
+func (*MsgSubmitClaimAssessmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5034648f58c6e59, []int{7}
+}
+func (m *MsgSubmitClaimAssessmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaimAssessmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSubmitClaimAssessmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaimAssessmentResponse.Merge(m, src)
+}
+func (m *MsgSubmitClaimAssessmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaimAssessmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaimAssessmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaimAssessmentResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "shentu.gov.v1alpha1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "shentu.gov.v1alpha1.MsgSubmitProposalResponse")
@@ -253,52 +324,64 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "shentu.gov.v1alpha1.MsgVoteResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "shentu.gov.v1alpha1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "shentu.gov.v1alpha1.MsgDepositResponse")
+	proto.RegisterType((*MsgSubmitClaimAssessment)(nil), "shentu.gov.v1alpha1.MsgSubmitClaimAssessment")
+	proto.RegisterType((*MsgSubmitClaimAssessmentResponse)(nil), "shentu.gov.v1alpha1.MsgSubmitClaimAssessmentResponse")
 }
 
 func init() { proto.RegisterFile("shentu/gov/v1alpha1/tx.proto", fileDescriptor_f5034648f58c6e59) }
 
 var fileDescriptor_f5034648f58c6e59 = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0x13, 0x41,
-	0x10, 0xbd, 0xb3, 0x43, 0x42, 0x36, 0x52, 0x42, 0x16, 0x2b, 0x72, 0x2c, 0xeb, 0xce, 0x3a, 0xf1,
-	0xe1, 0x26, 0x7b, 0xd8, 0x48, 0x14, 0xe9, 0x70, 0x50, 0x24, 0x22, 0x2c, 0x90, 0x23, 0x51, 0xd0,
-	0x44, 0x67, 0x7b, 0x73, 0x3e, 0xc5, 0xde, 0x39, 0x79, 0xf7, 0xac, 0xb8, 0xa3, 0xa4, 0x42, 0x29,
-	0x11, 0x55, 0x6a, 0x3a, 0x24, 0x7e, 0x44, 0x44, 0x95, 0x32, 0x05, 0x32, 0xc8, 0x69, 0x80, 0x32,
-	0xbf, 0x00, 0xdd, 0x7e, 0x24, 0x56, 0x3e, 0x08, 0x48, 0xa9, 0xec, 0x99, 0x37, 0xef, 0x79, 0xdf,
-	0xcc, 0x78, 0x50, 0x91, 0x77, 0x28, 0x13, 0x89, 0x1f, 0xc2, 0xc0, 0x1f, 0x54, 0x82, 0x6e, 0xdc,
-	0x09, 0x2a, 0xbe, 0xd8, 0x25, 0x71, 0x1f, 0x04, 0xe0, 0xbb, 0x0a, 0x25, 0x21, 0x0c, 0x88, 0x41,
-	0x0b, 0x4e, 0x0b, 0x78, 0x0f, 0xb8, 0xdf, 0x0c, 0x38, 0xf5, 0x07, 0x95, 0x26, 0x15, 0x41, 0xc5,
-	0x6f, 0x41, 0xc4, 0x14, 0xa9, 0x50, 0xd4, 0xb8, 0x92, 0x54, 0x70, 0x2a, 0xa0, 0xd0, 0x65, 0x85,
-	0x6e, 0xc9, 0xc8, 0x57, 0x81, 0x86, 0x72, 0x21, 0x84, 0xa0, 0xf2, 0xe9, 0x37, 0x43, 0x08, 0x01,
-	0xc2, 0x2e, 0xf5, 0x65, 0xd4, 0x4c, 0xb6, 0xfd, 0x80, 0x0d, 0x15, 0xe4, 0x7d, 0xcc, 0xa0, 0xc5,
-	0x3a, 0x0f, 0x37, 0x93, 0x66, 0x2f, 0x12, 0xaf, 0xfa, 0x10, 0x03, 0x0f, 0xba, 0x78, 0x1d, 0xcd,
-	0xb4, 0x80, 0x09, 0xca, 0x44, 0xde, 0x2e, 0xd9, 0xe5, 0xb9, 0x6a, 0x8e, 0x28, 0x09, 0x62, 0x24,
-	0xc8, 0x53, 0x36, 0xac, 0x2d, 0x7d, 0xfd, 0xb2, 0x82, 0xf5, 0xef, 0xa7, 0xcf, 0x5b, 0x53, 0x9c,
-	0x86, 0x21, 0xe3, 0xf7, 0x36, 0x5a, 0x88, 0x58, 0x24, 0xa2, 0xa0, 0xbb, 0xd5, 0xa6, 0x31, 0xf0,
-	0x48, 0xe4, 0x33, 0xa5, 0x6c, 0x79, 0xae, 0xba, 0x4c, 0x34, 0x2f, 0x6d, 0x01, 0xd1, 0x1e, 0xc9,
-	0x1a, 0x44, 0xac, 0xb6, 0x71, 0x30, 0x72, 0xad, 0x93, 0x91, 0xbb, 0x34, 0x0c, 0x7a, 0xdd, 0x55,
-	0xef, 0x1c, 0xdf, 0xfb, 0xf4, 0xdd, 0x2d, 0x87, 0x91, 0xe8, 0x24, 0x4d, 0xd2, 0x82, 0x9e, 0xb6,
-	0xaf, 0x3f, 0x56, 0x78, 0x7b, 0xc7, 0x17, 0xc3, 0x98, 0x72, 0x29, 0xc5, 0x1b, 0xf3, 0x9a, 0xfd,
-	0x4c, 0x91, 0x71, 0x01, 0xdd, 0x8e, 0xa5, 0x49, 0xda, 0xcf, 0x67, 0x4b, 0x76, 0x79, 0xb6, 0x71,
-	0x1a, 0xaf, 0xde, 0x79, 0xb7, 0xef, 0x5a, 0x1f, 0xf6, 0x5d, 0xeb, 0xe7, 0xbe, 0x6b, 0xbd, 0xfd,
-	0x56, 0xb2, 0xbc, 0x16, 0x5a, 0xbe, 0xd0, 0x9b, 0x06, 0xe5, 0x31, 0x30, 0x4e, 0xf1, 0x3a, 0x9a,
-	0x8b, 0x75, 0x6e, 0x2b, 0x6a, 0xcb, 0x3e, 0x4d, 0xd5, 0xee, 0xff, 0x1e, 0xb9, 0x93, 0xe9, 0x93,
-	0x91, 0x8b, 0x95, 0x8d, 0x89, 0xa4, 0xd7, 0x40, 0x26, 0x7a, 0xde, 0xf6, 0x3e, 0xdb, 0x68, 0xa6,
-	0xce, 0xc3, 0xd7, 0x20, 0x6e, 0x4c, 0x13, 0xe7, 0xd0, 0xad, 0x01, 0x08, 0xda, 0xcf, 0x67, 0xa4,
-	0x47, 0x15, 0xe0, 0x27, 0x68, 0x1a, 0x62, 0x11, 0x01, 0x93, 0xd6, 0xe7, 0xab, 0x0e, 0x99, 0x98,
-	0x9d, 0x19, 0x41, 0xfa, 0x8e, 0x97, 0xb2, 0xaa, 0xa1, 0xab, 0x2f, 0x69, 0xcc, 0x22, 0x5a, 0xd0,
-	0x4f, 0x36, 0xed, 0xf0, 0x7e, 0xd9, 0x08, 0xd5, 0x79, 0x68, 0x1a, 0x7d, 0x53, 0x4e, 0x8a, 0x68,
-	0x56, 0x0f, 0x1e, 0x8c, 0x9b, 0xb3, 0x04, 0x6e, 0xa1, 0xe9, 0xa0, 0x07, 0x09, 0x13, 0xf9, 0xec,
-	0x75, 0x5b, 0xf5, 0x28, 0xdd, 0xaa, 0xff, 0xda, 0x1d, 0x2d, 0x7d, 0x89, 0xfd, 0x1c, 0xc2, 0x67,
-	0x56, 0x4d, 0x07, 0xaa, 0x7b, 0x19, 0x94, 0xad, 0xf3, 0x10, 0x77, 0xd0, 0xfc, 0xb9, 0xbf, 0xd3,
-	0x03, 0x72, 0xc9, 0x11, 0x20, 0x17, 0x56, 0xab, 0x40, 0xfe, 0xad, 0xee, 0x74, 0x05, 0x37, 0xd0,
-	0x94, 0x5c, 0x9b, 0xe2, 0x55, 0xbc, 0x14, 0x2d, 0xdc, 0xfb, 0x1b, 0x7a, 0xaa, 0xb5, 0x89, 0x66,
-	0xcc, 0xec, 0xdc, 0xab, 0x08, 0xba, 0xa0, 0xf0, 0xf0, 0x9a, 0x02, 0x23, 0x5a, 0x7b, 0x71, 0x30,
-	0x76, 0xec, 0xc3, 0xb1, 0x63, 0x1f, 0x8d, 0x1d, 0xfb, 0xc7, 0xd8, 0xb1, 0xf7, 0x8e, 0x1d, 0xeb,
-	0xf0, 0xd8, 0xb1, 0x8e, 0x8e, 0x1d, 0xeb, 0x0d, 0x99, 0x1c, 0x05, 0xed, 0x8b, 0x68, 0x67, 0x1b,
-	0x12, 0xd6, 0x0e, 0xd2, 0xa5, 0xf3, 0xf5, 0x51, 0xdd, 0x95, 0x37, 0x50, 0x8e, 0xa5, 0x39, 0x2d,
-	0x8f, 0xcf, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11, 0x6e, 0x9e, 0x88, 0x71, 0x05, 0x00,
-	0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbd, 0x6f, 0xdb, 0x46,
+	0x14, 0x27, 0x2d, 0x7f, 0x9e, 0x5b, 0xbb, 0xbe, 0xaa, 0x06, 0xa5, 0x0a, 0xa4, 0x40, 0xf4, 0x43,
+	0x8b, 0xc9, 0x4a, 0x45, 0x3b, 0x18, 0xe8, 0x60, 0xb9, 0x30, 0x12, 0x23, 0x42, 0x12, 0x1a, 0xf0,
+	0x90, 0x45, 0xa0, 0xc8, 0x33, 0x45, 0x58, 0xbc, 0x23, 0x78, 0x27, 0xc1, 0x1a, 0x02, 0x64, 0xcc,
+	0x94, 0x64, 0x4b, 0x90, 0xc9, 0x73, 0xb6, 0x00, 0x99, 0x33, 0x1b, 0x99, 0x3c, 0x7a, 0x08, 0x94,
+	0x40, 0x5e, 0x92, 0x8c, 0xfa, 0x0b, 0x02, 0xf2, 0x8e, 0xb2, 0x62, 0xc9, 0x76, 0x0c, 0x78, 0x92,
+	0xde, 0xd7, 0x8f, 0xf7, 0xfb, 0xbd, 0x77, 0xef, 0x40, 0x81, 0x36, 0x11, 0x66, 0x6d, 0xd3, 0x23,
+	0x1d, 0xb3, 0x53, 0xb6, 0x5b, 0x61, 0xd3, 0x2e, 0x9b, 0xec, 0xc0, 0x08, 0x23, 0xc2, 0x08, 0xfc,
+	0x99, 0x47, 0x0d, 0x8f, 0x74, 0x8c, 0x34, 0x9a, 0x57, 0x1d, 0x42, 0x03, 0x42, 0xcd, 0x86, 0x4d,
+	0x91, 0xd9, 0x29, 0x37, 0x10, 0xb3, 0xcb, 0xa6, 0x43, 0x7c, 0xcc, 0x8b, 0xf2, 0x05, 0x11, 0xe7,
+	0x90, 0x3c, 0x1c, 0x03, 0xf0, 0x68, 0x8e, 0x47, 0xeb, 0x89, 0x65, 0x72, 0x43, 0x84, 0xb2, 0x1e,
+	0xf1, 0x08, 0xf7, 0xc7, 0xff, 0xd2, 0x02, 0x8f, 0x10, 0xaf, 0x85, 0xcc, 0xc4, 0x6a, 0xb4, 0xf7,
+	0x4c, 0x1b, 0x77, 0x79, 0x48, 0x7f, 0x39, 0x05, 0x56, 0x6a, 0xd4, 0xdb, 0x69, 0x37, 0x02, 0x9f,
+	0xdd, 0x8b, 0x48, 0x48, 0xa8, 0xdd, 0x82, 0x5b, 0x60, 0xce, 0x21, 0x98, 0x21, 0xcc, 0x14, 0xb9,
+	0x28, 0x97, 0x16, 0x2b, 0x59, 0x83, 0x43, 0x18, 0x29, 0x84, 0xb1, 0x81, 0xbb, 0xd5, 0xd5, 0x77,
+	0x6f, 0xd6, 0xa0, 0xf8, 0x7e, 0x7c, 0xbc, 0x4d, 0x5e, 0x63, 0xa5, 0xc5, 0xf0, 0x89, 0x0c, 0x96,
+	0x7d, 0xec, 0x33, 0xdf, 0x6e, 0xd5, 0x5d, 0x14, 0x12, 0xea, 0x33, 0x65, 0xaa, 0x98, 0x29, 0x2d,
+	0x56, 0x72, 0x86, 0xa8, 0x8b, 0x25, 0x30, 0x04, 0x47, 0x63, 0x93, 0xf8, 0xb8, 0xba, 0x7d, 0xd4,
+	0xd3, 0xa4, 0x41, 0x4f, 0x5b, 0xed, 0xda, 0x41, 0x6b, 0x5d, 0x3f, 0x57, 0xaf, 0xbf, 0xfa, 0xa0,
+	0x95, 0x3c, 0x9f, 0x35, 0xdb, 0x0d, 0xc3, 0x21, 0x81, 0xa0, 0x2f, 0x7e, 0xd6, 0xa8, 0xbb, 0x6f,
+	0xb2, 0x6e, 0x88, 0x68, 0x02, 0x45, 0xad, 0x25, 0x51, 0xfd, 0x3f, 0x2f, 0x86, 0x79, 0x30, 0x1f,
+	0x26, 0x24, 0x51, 0xa4, 0x64, 0x8a, 0x72, 0x69, 0xc1, 0x1a, 0xda, 0xeb, 0x3f, 0x3d, 0x3e, 0xd4,
+	0xa4, 0x17, 0x87, 0x9a, 0xf4, 0xe9, 0x50, 0x93, 0x1e, 0xbd, 0x2f, 0x4a, 0xba, 0x03, 0x72, 0x63,
+	0xda, 0x58, 0x88, 0x86, 0x04, 0x53, 0x04, 0xb7, 0xc0, 0x62, 0x28, 0x7c, 0x75, 0xdf, 0x4d, 0x74,
+	0x9a, 0xae, 0xfe, 0xfe, 0xa5, 0xa7, 0x8d, 0xba, 0x07, 0x3d, 0x0d, 0x72, 0x1a, 0x23, 0x4e, 0xdd,
+	0x02, 0xa9, 0x75, 0xdb, 0xd5, 0x5f, 0xcb, 0x60, 0xae, 0x46, 0xbd, 0x5d, 0xc2, 0x6e, 0x0c, 0x13,
+	0x66, 0xc1, 0x4c, 0x87, 0x30, 0x14, 0x29, 0x53, 0x09, 0x47, 0x6e, 0xc0, 0x7f, 0xc1, 0x2c, 0x09,
+	0x99, 0x4f, 0x70, 0x42, 0x7d, 0xa9, 0xa2, 0x1a, 0x23, 0xbd, 0x4b, 0x5b, 0x10, 0x9f, 0xe3, 0x6e,
+	0x92, 0x65, 0x89, 0xec, 0x09, 0xc2, 0xac, 0x80, 0x65, 0x71, 0xe4, 0x54, 0x0e, 0xfd, 0xb3, 0x0c,
+	0x40, 0x8d, 0x7a, 0xa9, 0xd0, 0x37, 0xc5, 0xa4, 0x00, 0x16, 0x44, 0xe3, 0x49, 0xca, 0xe6, 0xcc,
+	0x01, 0x1d, 0x30, 0x6b, 0x07, 0xa4, 0x8d, 0x99, 0x92, 0xb9, 0x6a, 0xaa, 0xfe, 0x8a, 0xa7, 0xea,
+	0x5a, 0xb3, 0x23, 0xa0, 0x27, 0xd0, 0xcf, 0x02, 0x78, 0x46, 0x75, 0xa8, 0xc0, 0xdb, 0x0c, 0x50,
+	0x86, 0xe3, 0xb2, 0xd9, 0xb2, 0xfd, 0x60, 0x83, 0x52, 0x44, 0x69, 0x80, 0xf0, 0x8d, 0xea, 0xe1,
+	0xa0, 0x88, 0xf9, 0x7b, 0xfe, 0xb0, 0xbb, 0x67, 0x0e, 0xf8, 0x1f, 0xf8, 0xb1, 0x45, 0x28, 0xad,
+	0x77, 0x50, 0x14, 0x3b, 0xdc, 0xa4, 0xd1, 0xf3, 0x55, 0x65, 0xd0, 0xd3, 0xb2, 0x1c, 0xf8, 0x9b,
+	0xb0, 0x6e, 0xfd, 0x10, 0xdb, 0xbb, 0xc2, 0x84, 0xcf, 0x65, 0x00, 0x23, 0xe4, 0x90, 0x20, 0x40,
+	0xd8, 0x45, 0x6e, 0x5d, 0x68, 0x3b, 0x7d, 0x95, 0xb6, 0x35, 0x71, 0x63, 0x73, 0xfc, 0x1b, 0xe3,
+	0x10, 0xd7, 0xbb, 0xb4, 0x2b, 0x23, 0x00, 0x1b, 0x49, 0x3d, 0xbc, 0x0f, 0xb2, 0x3e, 0x76, 0x7c,
+	0x17, 0x61, 0x56, 0x8f, 0x50, 0x48, 0x22, 0x56, 0x6f, 0xda, 0xb4, 0xa9, 0xcc, 0xc4, 0x0a, 0x54,
+	0xb5, 0x41, 0x4f, 0xfb, 0x35, 0xdd, 0x16, 0xe3, 0x59, 0xba, 0x05, 0x53, 0xb7, 0x95, 0x78, 0x6f,
+	0xd9, 0xb4, 0x39, 0xa1, 0xad, 0x3a, 0x28, 0x5e, 0xd4, 0xbf, 0xb4, 0xc9, 0x95, 0xa7, 0x19, 0x90,
+	0xa9, 0x51, 0x0f, 0x36, 0xc1, 0xd2, 0xb9, 0x9d, 0xf9, 0x87, 0x31, 0x61, 0xd3, 0x1b, 0x63, 0xfb,
+	0x23, 0x6f, 0x7c, 0x5f, 0xde, 0x70, 0xcf, 0x6c, 0x83, 0xe9, 0x64, 0x37, 0x14, 0x2e, 0xaa, 0x8b,
+	0xa3, 0xf9, 0xdf, 0x2e, 0x8b, 0x0e, 0xb1, 0x76, 0xc0, 0x5c, 0x7a, 0x41, 0xb5, 0x8b, 0x0a, 0x44,
+	0x42, 0xfe, 0xcf, 0x2b, 0x12, 0x86, 0xa0, 0x0f, 0xc1, 0x2f, 0x93, 0x67, 0x7e, 0xed, 0x72, 0xa6,
+	0xe7, 0xd2, 0xf3, 0xff, 0x5c, 0x2b, 0x3d, 0xfd, 0x7c, 0xf5, 0xce, 0x51, 0x5f, 0x95, 0x8f, 0xfb,
+	0xaa, 0x7c, 0xd2, 0x57, 0xe5, 0x8f, 0x7d, 0x55, 0x7e, 0x76, 0xaa, 0x4a, 0xc7, 0xa7, 0xaa, 0x74,
+	0x72, 0xaa, 0x4a, 0x0f, 0x8c, 0xd1, 0xa9, 0x8b, 0xef, 0xc9, 0xfe, 0x1e, 0x69, 0x63, 0xd7, 0x8e,
+	0x17, 0x9b, 0x29, 0x1e, 0xee, 0x83, 0xe4, 0x9d, 0x4d, 0x26, 0xb0, 0x31, 0x9b, 0x3c, 0x70, 0x7f,
+	0x7f, 0x1d, 0x00, 0x8e, 0xb7, 0xe5, 0x55, 0xd5, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -319,6 +402,9 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// SubmitClaimAssessment defines a method for a certifier to assess a shield
+	// claim proposal before its voting begins.
+	SubmitClaimAssessment(ctx context.Context, in *MsgSubmitClaimAssessment, opts ...grpc.CallOption) (*MsgSubmitClaimAssessmentResponse, error)
 }
 
 type msgClient struct {
@@ -356,6 +442,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) SubmitClaimAssessment(ctx context.Context, in *MsgSubmitClaimAssessment, opts ...grpc.CallOption) (*MsgSubmitClaimAssessmentResponse, error) {
+	out := new(MsgSubmitClaimAssessmentResponse)
+	err := c.cc.Invoke(ctx, "/shentu.gov.v1alpha1.Msg/SubmitClaimAssessment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -364,6 +459,9 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// SubmitClaimAssessment defines a method for a certifier to assess a shield
+	// claim proposal before its voting begins.
+	SubmitClaimAssessment(context.Context, *MsgSubmitClaimAssessment) (*MsgSubmitClaimAssessmentResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -379,6 +477,9 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) SubmitClaimAssessment(ctx context.Context, req *MsgSubmitClaimAssessment) (*MsgSubmitClaimAssessmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitClaimAssessment not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -438,6 +539,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitClaimAssessment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitClaimAssessment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitClaimAssessment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.gov.v1alpha1.Msg/SubmitClaimAssessment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitClaimAssessment(ctx, req.(*MsgSubmitClaimAssessment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "shentu.gov.v1alpha1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -454,6 +573,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "SubmitClaimAssessment",
+			Handler:    _Msg_SubmitClaimAssessment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shentu/gov/v1alpha1/tx.proto",
@@ -678,6 +801,95 @@ func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitClaimAssessment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitClaimAssessment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaimAssessment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncidentReportHash) > 0 {
+		i -= len(m.IncidentReportHash)
+		copy(dAtA[i:], m.IncidentReportHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IncidentReportHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RecommendedAmount) > 0 {
+		for iNdEx := len(m.RecommendedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecommendedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LossVerified {
+		i--
+		if m.LossVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Certifier) > 0 {
+		i -= len(m.Certifier)
+		copy(dAtA[i:], m.Certifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Certifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitClaimAssessmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitClaimAssessmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaimAssessmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -783,6 +995,44 @@ func (m *MsgDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitClaimAssessment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Certifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LossVerified {
+		n += 2
+	}
+	if len(m.RecommendedAmount) > 0 {
+		for _, e := range m.RecommendedAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.IncidentReportHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitClaimAssessmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1383,6 +1633,249 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitClaimAssessment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaimAssessment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaimAssessment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LossVerified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedAmount = append(m.RecommendedAmount, types1.Coin{})
+			if err := m.RecommendedAmount[len(m.RecommendedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentReportHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncidentReportHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitClaimAssessmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaimAssessmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaimAssessmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,33 +5,46 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/certikfoundation/shentu/common"
 	"github.com/certikfoundation/shentu/x/oracle/keeper"
+	"github.com/certikfoundation/shentu/x/oracle/types"
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if ctx.BlockHeight() == common.Update2Height {
+		if !k.HasSlashingParams(ctx) {
+			k.SetSlashingParams(ctx, types.DefaultSlashingParams())
+		}
+		// Tasks stored before tasks had IDs are re-keyed by ID.
+		k.MigrateTaskIDs(ctx)
+	}
 	k.FinalizeMatureWithdraws(ctx)
 }
 
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	closingTaskIDs := k.GetClosingTaskIDs(ctx, ctx.BlockHeight())
 	for _, taskID := range closingTaskIDs {
-		err := k.Aggregate(ctx, taskID.Contract, taskID.Function)
+		err := k.Aggregate(ctx, taskID)
 		if err != nil {
 			continue
 		}
-		task, err := k.GetTask(ctx, taskID.Contract, taskID.Function)
+		task, err := k.GetTask(ctx, taskID)
 		if err != nil {
 			continue
 		}
+		k.HandleUnrevealedCommits(ctx, task)
+		k.HandleResponseDeviations(ctx, task)
+		k.HandleInquiries(ctx, task)
 
-		if err := k.DistributeBounty(ctx, task); err != nil {
-			// TODO
-			continue
+		// Bounties of failed tasks and of tasks without valid responses are refunded to the creators.
+		if task.Status == types.TaskStatusFailed || k.DistributeBounty(ctx, task) != nil {
+			refundTaskBounty(ctx, k, task)
 		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				"aggregate_task",
+				sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
 				sdk.NewAttribute("contract", task.Contract),
 				sdk.NewAttribute("function", task.Function),
 				sdk.NewAttribute("begin_block_height", strconv.FormatInt(task.BeginBlock, 10)),
@@ -48,3 +61,33 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 	k.DeleteClosingTaskIDs(ctx, ctx.BlockHeight())
 }
+
+// refundTaskBounty refunds the bounty of a task to its creator. A failed refund does not halt the chain:
+// the bounty is kept in the module account and the failure is reported in an event.
+func refundTaskBounty(ctx sdk.Context, k keeper.Keeper, task types.Task) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.RefundBounty(cacheCtx, task); err != nil {
+		ctx.Logger().Error("failed to refund task bounty", "task_id", task.Id, "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"refund_task_bounty_failed",
+				sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+				sdk.NewAttribute("creator", task.Creator),
+				sdk.NewAttribute("bounty", task.Bounty.String()),
+				sdk.NewAttribute("error", err.Error()),
+			),
+		)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"refund_task_bounty",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("creator", task.Creator),
+			sdk.NewAttribute("bounty", task.Bounty.String()),
+		),
+	)
+}
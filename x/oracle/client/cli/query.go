@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -14,7 +15,12 @@ import (
 )
 
 const (
-	FlagOperator = "operator"
+	FlagOperator        = "operator"
+	FlagStatus          = "status"
+	FlagCreator         = "creator"
+	FlagMinClosingBlock = "min-closing-block"
+	FlagMaxClosingBlock = "max-closing-block"
+	FlagMinBounty       = "min-bounty"
 )
 
 // GetQueryCmd returns the cli query commands for this module.
@@ -27,8 +33,12 @@ func GetQueryCmd() *cobra.Command {
 	oracleQueryCmds.AddCommand(
 		GetCmdOperator(),
 		GetCmdOperators(),
+		GetCmdSlashes(),
 		GetCmdWithdraws(),
 		GetCmdTask(),
+		GetCmdTasks(),
+		GetCmdPendingTasks(),
+		GetCmdTaskHistory(),
 		GetCmdResponse(),
 	)
 
@@ -69,6 +79,40 @@ func GetCmdOperator() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSlashes returns the operator slashing history query command.
+func GetCmdSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashes <address>",
+		Short: "Get the slashing history of an operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Slashes(
+				cmd.Context(),
+				&types.QuerySlashesRequest{Address: address.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdOperators returns the operators query command.
 func GetCmdOperators() *cobra.Command {
 	cmd := &cobra.Command{
@@ -127,8 +171,148 @@ func GetCmdWithdraws() *cobra.Command {
 // GetCmdTask returns the task query command.
 func GetCmdTask() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "task <flags>",
+		Use:   "task <task_id>",
 		Short: "Get task information",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			taskID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Task(
+				cmd.Context(),
+				&types.QueryTaskRequest{TaskId: taskID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTasks returns the tasks query command.
+func GetCmdTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tasks [<flags>]",
+		Short: "Get tasks filtered by status, creator, closing block range and minimum bounty",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			taskStatus := types.TaskStatusNil
+			if statusStr := viper.GetString(FlagStatus); statusStr != "" {
+				taskStatus, err = types.TaskStatusFromString(statusStr)
+				if err != nil {
+					return err
+				}
+			}
+			creator := viper.GetString(FlagCreator)
+			if creator != "" {
+				if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+					return err
+				}
+			}
+			minBounty := viper.GetString(FlagMinBounty)
+			if minBounty != "" {
+				if _, err := sdk.ParseCoinsNormalized(minBounty); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Tasks(
+				cmd.Context(),
+				&types.QueryTasksRequest{
+					Status:          taskStatus,
+					Creator:         creator,
+					MinClosingBlock: viper.GetInt64(FlagMinClosingBlock),
+					MaxClosingBlock: viper.GetInt64(FlagMaxClosingBlock),
+					MinBounty:       minBounty,
+					Pagination:      pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "tasks with status (pending|succeeded|failed)")
+	cmd.Flags().String(FlagCreator, "", "tasks created by address")
+	cmd.Flags().Int64(FlagMinClosingBlock, 0, "tasks closing at or after block height")
+	cmd.Flags().Int64(FlagMaxClosingBlock, 0, "tasks closing at or before block height")
+	cmd.Flags().String(FlagMinBounty, "", "tasks with at least the bounty")
+	flags.AddPaginationFlagsToCmd(cmd, "tasks")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPendingTasks returns the query command for tasks pending for an operator.
+func GetCmdPendingTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-tasks <operator_address>",
+		Short: "Get pending tasks which still expect a response, commit or reveal from an operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingTasksForOperator(
+				cmd.Context(),
+				&types.QueryPendingTasksForOperatorRequest{OperatorAddress: address.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending tasks")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTaskHistory returns the task history query command.
+func GetCmdTaskHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "task-history <flags>",
+		Short: "Get all tasks of a contract function in the order of creation",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientQueryContext(cmd)
@@ -146,9 +330,9 @@ func GetCmdTask() *cobra.Command {
 				return fmt.Errorf("function is required")
 			}
 
-			res, err := queryClient.Task(
+			res, err := queryClient.TaskHistory(
 				cmd.Context(),
-				&types.QueryTaskRequest{Contract: contract, Function: function},
+				&types.QueryTaskHistoryRequest{Contract: contract, Function: function},
 			)
 			if err != nil {
 				return err
@@ -167,9 +351,9 @@ func GetCmdTask() *cobra.Command {
 // GetCmdResponse returns the response query command.
 func GetCmdResponse() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "response <flags>",
+		Use:   "response <task_id> <flags>",
 		Short: "Get response information",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -177,13 +361,9 @@ func GetCmdResponse() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(cliCtx)
 
-			contract := viper.GetString(FlagContract)
-			if contract == "" {
-				return fmt.Errorf("contract address is required")
-			}
-			function := viper.GetString(FlagFunction)
-			if function == "" {
-				return fmt.Errorf("function is required")
+			taskID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
 			}
 			operatorStr := viper.GetString(FlagOperator)
 			if operatorStr == "" {
@@ -196,7 +376,7 @@ func GetCmdResponse() *cobra.Command {
 
 			res, err := queryClient.Response(
 				cmd.Context(),
-				&types.QueryResponseRequest{Contract: contract, Function: function, OperatorAddress: operatorAddress.String()},
+				&types.QueryResponseRequest{TaskId: taskID, OperatorAddress: operatorAddress.String()},
 			)
 			if err != nil {
 				return err
@@ -206,8 +386,6 @@ func GetCmdResponse() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(FlagContract, "", "Provide the contract address")
-	cmd.Flags().String(FlagFunction, "", "Provide the function")
 	cmd.Flags().String(FlagOperator, "", "Provide the operator")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
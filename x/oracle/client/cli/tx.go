@@ -25,6 +25,12 @@ const (
 	FlagWait          = "wait"
 	FlagName          = "name"
 	FlagValidDuration = "valid"
+	FlagReveal        = "reveal"
+	FlagSalt          = "salt"
+	FlagTaskID        = "task-id"
+	FlagAggregation   = "aggregation"
+	FlagConfidence    = "confidence"
+	FlagCallback      = "callback"
 )
 
 var FlagForce bool
@@ -44,6 +50,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdClaimReward(),
 		GetCmdCreateTask(),
 		GetCmdRespondToTask(),
+		GetCmdCommitToTask(),
+		GetCmdRevealToTask(),
 		GetCmdInquiry(),
 		GetCmdDeleteTask(),
 	)
@@ -269,8 +277,17 @@ func GetCmdCreateTask() *cobra.Command {
 			wait := viper.GetInt64(FlagWait)
 			hours := viper.GetInt64(FlagValidDuration)
 			validDuration := time.Duration(hours) * time.Hour
+			reveal := viper.GetInt64(FlagReveal)
+			aggregationStrategy := types.AggregationStrategyNil
+			if aggregation := viper.GetString(FlagAggregation); aggregation != "" {
+				aggregationStrategy, err = types.AggregationStrategyFromString(aggregation)
+				if err != nil {
+					return err
+				}
+			}
 
-			msg := types.NewMsgCreateTask(contract, function, bounty, description, from, wait, validDuration)
+			msg := types.NewMsgCreateTask(contract, function, bounty, description, from, wait, validDuration, reveal,
+				aggregationStrategy)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -285,6 +302,8 @@ func GetCmdCreateTask() *cobra.Command {
 	cmd.Flags().String(FlagDescription, "", "description of the task")
 	cmd.Flags().String(FlagWait, "0", "number of blocks between task creation and aggregation")
 	cmd.Flags().String(FlagValidDuration, "0", "valid duration of the task result")
+	cmd.Flags().String(FlagReveal, "0", "number of blocks to reveal committed responses after the wait, 0 to take plain responses")
+	cmd.Flags().String(FlagAggregation, "", "aggregation strategy of the responses (weighted-mean|weighted-median|trimmed-mean|quorum-majority), weighted-mean by default")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -308,21 +327,18 @@ func GetCmdRespondToTask() *cobra.Command {
 				return err
 			}
 
-			contract := viper.GetString(FlagContract)
-			if contract == "" {
-				return fmt.Errorf("contract address is required to respond to a task")
-			}
-			function := viper.GetString(FlagFunction)
-			if function == "" {
-				return fmt.Errorf("function is required to respond to a task")
+			taskID := viper.GetUint64(FlagTaskID)
+			if taskID == 0 {
+				return fmt.Errorf("task ID is required to respond to a task")
 			}
 			scoreStr := viper.GetString(FlagScore)
 			if scoreStr == "" {
 				return fmt.Errorf("score is required to respond to a task")
 			}
 			score := viper.GetInt64(FlagScore)
+			confidence := viper.GetInt64(FlagConfidence)
 
-			msg := types.NewMsgTaskResponse(contract, function, score, from)
+			msg := types.NewMsgTaskResponse(taskID, score, confidence, from)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -331,9 +347,9 @@ func GetCmdRespondToTask() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(FlagContract, "", "contract address")
-	cmd.Flags().String(FlagFunction, "", "function")
+	cmd.Flags().String(FlagTaskID, "", "task ID")
 	cmd.Flags().String(FlagScore, "", "score")
+	cmd.Flags().String(FlagConfidence, "0", "confidence in the score from 1 to 100, 0 for the maximum confidence")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -372,7 +388,7 @@ func GetCmdInquiry() *cobra.Command {
 				return fmt.Errorf("txhash is required to inquiry a task")
 			}
 
-			msg := types.NewMsgInquiryTask(contract, function, txhash, from)
+			msg := types.NewMsgInquiryTask(contract, function, txhash, from, viper.GetString(FlagCallback))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -383,6 +399,7 @@ func GetCmdInquiry() *cobra.Command {
 	cmd.Flags().String(FlagContract, "", "contract address")
 	cmd.Flags().String(FlagFunction, "", "function")
 	cmd.Flags().String(FlagTxhash, "", "txhash")
+	cmd.Flags().String(FlagCallback, "", "contract called with the result of the task if it is pending")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -406,17 +423,12 @@ func GetCmdDeleteTask() *cobra.Command {
 				return err
 			}
 
-			contract := viper.GetString(FlagContract)
-			if contract == "" {
-				return fmt.Errorf("contract address is required to delete a task")
-			}
-
-			function := viper.GetString(FlagFunction)
-			if function == "" {
-				return fmt.Errorf("function is required to delete a task")
+			taskID := viper.GetUint64(FlagTaskID)
+			if taskID == 0 {
+				return fmt.Errorf("task ID is required to delete a task")
 			}
 			force := FlagForce
-			msg := types.NewMsgDeleteTask(contract, function, force, from)
+			msg := types.NewMsgDeleteTask(taskID, force, from)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -424,10 +436,108 @@ func GetCmdDeleteTask() *cobra.Command {
 			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
 		},
 	}
-	cmd.Flags().String(FlagContract, "", "contract address")
-	cmd.Flags().String(FlagFunction, "", "function")
+	cmd.Flags().String(FlagTaskID, "", "task ID")
 	cmd.Flags().BoolVarP(&FlagForce, "force", "f", false, "compulsory delete")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// GetCmdCommitToTask returns command to commit a response to a task.
+func GetCmdCommitToTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-to-task <flags>",
+		Short: "Commit the hash of a response to a task, to be revealed with the same score and salt",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			from := cliCtx.GetFromAddress()
+			if err := txf.AccountRetriever().EnsureExists(cliCtx, from); err != nil {
+				return err
+			}
+
+			taskID := viper.GetUint64(FlagTaskID)
+			if taskID == 0 {
+				return fmt.Errorf("task ID is required to commit to a task")
+			}
+			scoreStr := viper.GetString(FlagScore)
+			if scoreStr == "" {
+				return fmt.Errorf("score is required to commit to a task")
+			}
+			score := viper.GetInt64(FlagScore)
+			salt := viper.GetString(FlagSalt)
+			if len(salt) < types.MinSaltLength {
+				return fmt.Errorf("salt of at least %d characters is required to commit to a task", types.MinSaltLength)
+			}
+			confidence := viper.GetInt64(FlagConfidence)
+
+			msg := types.NewMsgCommitTaskResponse(taskID, types.ResponseCommitHash(from, score, confidence, salt), from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTaskID, "", "task ID")
+	cmd.Flags().String(FlagScore, "", "score")
+	cmd.Flags().String(FlagSalt, "", "secret salt hiding the score until it is revealed")
+	cmd.Flags().String(FlagConfidence, "0", "confidence in the score from 1 to 100, 0 for the maximum confidence")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevealToTask returns command to reveal a committed response to a task.
+func GetCmdRevealToTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-to-task <flags>",
+		Short: "Reveal the score and salt of a response committed to a task",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			from := cliCtx.GetFromAddress()
+			if err := txf.AccountRetriever().EnsureExists(cliCtx, from); err != nil {
+				return err
+			}
+
+			taskID := viper.GetUint64(FlagTaskID)
+			if taskID == 0 {
+				return fmt.Errorf("task ID is required to reveal to a task")
+			}
+			scoreStr := viper.GetString(FlagScore)
+			if scoreStr == "" {
+				return fmt.Errorf("score is required to reveal to a task")
+			}
+			score := viper.GetInt64(FlagScore)
+			salt := viper.GetString(FlagSalt)
+			confidence := viper.GetInt64(FlagConfidence)
+
+			msg := types.NewMsgRevealTaskResponse(taskID, score, confidence, salt, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTaskID, "", "task ID")
+	cmd.Flags().String(FlagScore, "", "score")
+	cmd.Flags().String(FlagSalt, "", "salt used in the commit")
+	cmd.Flags().String(FlagConfidence, "0", "confidence in the score from 1 to 100, 0 for the maximum confidence")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,170 @@
+package operator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+// ScoringBackend scores the smart contract function of a task, along with its confidence in the score
+// from 1 to 100, or 0 for the maximum confidence.
+type ScoringBackend interface {
+	Score(ctx context.Context, task types.Task) (score, confidence int64, err error)
+}
+
+// ScoreRequest is the task information sent to the exec and http backends.
+type ScoreRequest struct {
+	TaskID       uint64 `json:"task_id"`
+	Contract     string `json:"contract"`
+	Function     string `json:"function"`
+	Description  string `json:"description"`
+	Creator      string `json:"creator"`
+	ClosingBlock int64  `json:"closing_block"`
+}
+
+// ScoreResponse is the response expected from the http backend.
+type ScoreResponse struct {
+	Score      *int64 `json:"score"`
+	Confidence int64  `json:"confidence"`
+}
+
+// NewScoreRequest returns the score request of a task.
+func NewScoreRequest(task types.Task) ScoreRequest {
+	return ScoreRequest{
+		TaskID:       task.Id,
+		Contract:     task.Contract,
+		Function:     task.Function,
+		Description:  task.Description,
+		Creator:      task.Creator,
+		ClosingBlock: task.ClosingBlock,
+	}
+}
+
+// NewScoringBackend returns the scoring backend of a configuration.
+func NewScoringBackend(config BackendConfig) (ScoringBackend, error) {
+	switch config.Type {
+	case BackendStub:
+		return StubBackend{score: config.Score, confidence: config.Confidence}, nil
+	case BackendExec:
+		return ExecBackend{command: config.Command, args: config.Args, timeout: config.Timeout}, nil
+	case BackendHTTP:
+		return HTTPBackend{url: config.URL, client: &http.Client{Timeout: config.Timeout}}, nil
+	default:
+		return nil, fmt.Errorf("unknown backend type %q", config.Type)
+	}
+}
+
+// validateScore returns error if a score or a confidence returned by a backend is out of range.
+func validateScore(score, confidence int64) error {
+	if score < types.MinScore.Int64() || score > types.MaxScore.Int64() {
+		return fmt.Errorf("score %d is out of range [%s, %s]", score, types.MinScore, types.MaxScore)
+	}
+	return types.ValidateConfidence(confidence)
+}
+
+// StubBackend always returns the same score and confidence, for local testing.
+type StubBackend struct {
+	score      int64
+	confidence int64
+}
+
+// Score returns the configured score and confidence.
+func (b StubBackend) Score(_ context.Context, _ types.Task) (int64, int64, error) {
+	return b.score, b.confidence, nil
+}
+
+// ExecBackend runs a local executable with the contract and the function of the task as its last arguments
+// and the JSON score request as its standard input, and reads the score, optionally followed by
+// the confidence, from its standard output.
+type ExecBackend struct {
+	command string
+	args    []string
+	timeout time.Duration
+}
+
+// Score runs the executable to score a task.
+func (b ExecBackend) Score(ctx context.Context, task types.Task) (int64, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+
+	input, err := json.Marshal(NewScoreRequest(task))
+	if err != nil {
+		return 0, 0, err
+	}
+	args := append(append([]string{}, b.args...), task.Contract, task.Function)
+	cmd := exec.CommandContext(ctx, b.command, args...)
+	cmd.Stdin = bytes.NewReader(input)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w: %s", b.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	fields := strings.Fields(string(output))
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, 0, fmt.Errorf("%s: invalid score output %q", b.command, strings.TrimSpace(string(output)))
+	}
+	score, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: invalid score output: %w", b.command, err)
+	}
+	confidence := int64(0)
+	if len(fields) == 2 {
+		if confidence, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("%s: invalid confidence output: %w", b.command, err)
+		}
+	}
+	return score, confidence, validateScore(score, confidence)
+}
+
+// HTTPBackend posts the JSON score request to an endpoint and reads the score and the optional confidence
+// from the JSON response.
+type HTTPBackend struct {
+	url    string
+	client *http.Client
+}
+
+// Score calls the endpoint to score a task.
+func (b HTTPBackend) Score(ctx context.Context, task types.Task) (int64, int64, error) {
+	body, err := json.Marshal(NewScoreRequest(task))
+	if err != nil {
+		return 0, 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("%s: unexpected status %s: %s", b.url, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var scoreResp ScoreResponse
+	if err := json.Unmarshal(respBody, &scoreResp); err != nil {
+		return 0, 0, fmt.Errorf("%s: invalid score response: %w", b.url, err)
+	}
+	if scoreResp.Score == nil {
+		return 0, 0, fmt.Errorf("%s: score is missing from the response", b.url)
+	}
+	return *scoreResp.Score, scoreResp.Confidence, validateScore(*scoreResp.Score, scoreResp.Confidence)
+}
@@ -0,0 +1,156 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+var testTask = types.Task{Id: 7, Contract: "0xcontract", Function: "func", ClosingBlock: 50}
+
+func TestStubBackend(t *testing.T) {
+	config := DefaultConfig().Backend
+	config.Score = 60
+	config.Confidence = 80
+	backend, err := NewScoringBackend(config)
+	require.NoError(t, err)
+	score, confidence, err := backend.Score(context.Background(), testTask)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), score)
+	require.Equal(t, int64(80), confidence)
+
+	_, err = NewScoringBackend(BackendConfig{Type: "unknown"})
+	require.Error(t, err)
+}
+
+func TestExecBackend(t *testing.T) {
+	tests := []struct {
+		name           string
+		script         string
+		wantScore      int64
+		wantConfidence int64
+		wantErr        bool
+	}{
+		{"score", "echo 60", 60, 0, false},
+		{"score and confidence", "echo 60 80", 60, 80, false},
+		{"arguments", `[ "$1 $2" = "0xcontract func" ] && echo 70`, 70, 0, false},
+		{"standard input", `grep -q '"task_id":7' && echo 70`, 70, 0, false},
+		{"failure", "echo failed >&2; exit 1", 0, 0, true},
+		{"invalid score", "echo high", 0, 0, true},
+		{"invalid confidence", "echo 60 high", 0, 0, true},
+		{"too many fields", "echo 60 80 90", 0, 0, true},
+		{"score out of range", "echo 101", 0, 0, true},
+		{"confidence out of range", "echo 60 101", 0, 0, true},
+		{"timeout", "exec sleep 5", 0, 0, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// the contract and the function follow the script name, which is $0
+			backend, err := NewScoringBackend(BackendConfig{
+				Type:    BackendExec,
+				Command: "sh",
+				Args:    []string{"-c", tc.script, "score"},
+				Timeout: time.Second,
+			})
+			require.NoError(t, err)
+			score, confidence, err := backend.Score(context.Background(), testTask)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantScore, score)
+			require.Equal(t, tc.wantConfidence, confidence)
+		})
+	}
+}
+
+func TestHTTPBackend(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		body           string
+		wantScore      int64
+		wantConfidence int64
+		wantErr        bool
+	}{
+		{"score", http.StatusOK, `{"score": 60}`, 60, 0, false},
+		{"score and confidence", http.StatusOK, `{"score": 60, "confidence": 80}`, 60, 80, false},
+		{"unexpected status", http.StatusInternalServerError, `{"score": 60}`, 0, 0, true},
+		{"invalid response", http.StatusOK, `score`, 0, 0, true},
+		{"missing score", http.StatusOK, `{"confidence": 80}`, 0, 0, true},
+		{"score out of range", http.StatusOK, `{"score": 101}`, 0, 0, true},
+		{"confidence out of range", http.StatusOK, `{"score": 60, "confidence": 101}`, 0, 0, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req ScoreRequest
+				if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil ||
+					req != NewScoreRequest(testTask) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer server.Close()
+
+			backend, err := NewScoringBackend(BackendConfig{Type: BackendHTTP, URL: server.URL, Timeout: time.Second})
+			require.NoError(t, err)
+			score, confidence, err := backend.Score(context.Background(), testTask)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantScore, score)
+			require.Equal(t, tc.wantConfidence, confidence)
+		})
+	}
+}
+
+func TestCommitStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oracle-operator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "data", "commits.json")
+
+	store, err := loadCommitStore(path)
+	require.NoError(t, err)
+	_, ok := store.get(1)
+	require.False(t, ok)
+
+	salt, err := newSalt()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(salt), types.MinSaltLength)
+	commit := committedResponse{Score: 60, Confidence: 80, Salt: salt, ClosingBlock: 10}
+	require.NoError(t, store.set(1, commit))
+	require.NoError(t, store.set(2, committedResponse{Score: 70, Salt: salt, ClosingBlock: 20}))
+
+	// committed responses survive a restart until their tasks close
+	store, err = loadCommitStore(path)
+	require.NoError(t, err)
+	loaded, ok := store.get(1)
+	require.True(t, ok)
+	require.Equal(t, commit, loaded)
+
+	require.NoError(t, store.prune(11))
+	store, err = loadCommitStore(path)
+	require.NoError(t, err)
+	_, ok = store.get(1)
+	require.False(t, ok)
+	_, ok = store.get(2)
+	require.True(t, ok)
+}
@@ -0,0 +1,83 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const (
+	FlagConfig = "config"
+
+	defaultConfigFile = "oracle-operator.toml"
+)
+
+// ServeCommand returns the command running the oracle operator daemon.
+func ServeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-operator",
+		Short: "Run an oracle operator daemon responding to pending tasks",
+		Long: fmt.Sprintf(`Run an oracle operator daemon which polls the tasks pending for the operator of the --from key,
+scores them with the scoring backend configured in a TOML file and responds to them before their closing block.
+Responses to commit-reveal tasks are committed, saved in the commits file of the configuration,
+and revealed after the commit phase.
+
+The configuration is read from $HOME/config/%s by default, and a configuration using
+the stub backend, which always responds with the same score, is written there if it does not exist.`, defaultConfigFile),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if cliCtx.GetFromAddress().Empty() {
+				return fmt.Errorf("--%s is required to sign the responses", flags.FlagFrom)
+			}
+
+			configPath, err := cmd.Flags().GetString(FlagConfig)
+			if err != nil {
+				return err
+			}
+			if configPath == "" {
+				configPath = filepath.Join(cliCtx.HomeDir, "config", defaultConfigFile)
+			}
+			config, err := LoadConfig(configPath)
+			if err != nil {
+				return fmt.Errorf("failed to load %s: %w", configPath, err)
+			}
+			backend, err := NewScoringBackend(config.Backend)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "oracle-operator")
+
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			return NewDaemon(cliCtx, txf, config, backend, logger).Run(ctx)
+		},
+	}
+
+	cmd.Flags().String(FlagConfig, "", fmt.Sprintf("Path to the TOML configuration file (default $HOME/config/%s)", defaultConfigFile))
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,96 @@
+package operator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// saltBytes is the number of random bytes of the salt of a committed response.
+const saltBytes = 16
+
+// committedResponse is a response committed to a commit-reveal task, to be revealed after its commit phase.
+type committedResponse struct {
+	Score        int64  `json:"score"`
+	Confidence   int64  `json:"confidence"`
+	Salt         string `json:"salt"`
+	ClosingBlock int64  `json:"closing_block"`
+}
+
+// commitStore keeps the committed responses in a JSON file until their tasks close,
+// so that they can be revealed after a restart of the daemon.
+type commitStore struct {
+	path    string
+	commits map[uint64]committedResponse
+}
+
+// loadCommitStore reads the committed responses from a file, which is created when a response is committed.
+func loadCommitStore(path string) (*commitStore, error) {
+	store := &commitStore{path: path, commits: make(map[uint64]committedResponse)}
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &store.commits); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// get returns the response committed to a task.
+func (s *commitStore) get(taskID uint64) (committedResponse, bool) {
+	commit, ok := s.commits[taskID]
+	return commit, ok
+}
+
+// set records the response committed to a task and saves the store.
+func (s *commitStore) set(taskID uint64, commit committedResponse) error {
+	s.commits[taskID] = commit
+	return s.save()
+}
+
+// prune deletes the responses committed to the tasks closed before a block height.
+func (s *commitStore) prune(height int64) error {
+	pruned := false
+	for id, commit := range s.commits {
+		if commit.ClosingBlock < height {
+			delete(s.commits, id)
+			pruned = true
+		}
+	}
+	if !pruned {
+		return nil
+	}
+	return s.save()
+}
+
+// save writes the store to a temporary file and renames it, so that the file is never partially written.
+func (s *commitStore) save() error {
+	bz, err := json.Marshal(s.commits)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	tmpPath := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, s.path)
+}
+
+// newSalt returns a random salt hiding a committed response.
+func newSalt() (string, error) {
+	bz := make([]byte, saltBytes)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
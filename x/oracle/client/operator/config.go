@@ -0,0 +1,186 @@
+// Package operator implements a reference oracle operator daemon, which polls the
+// tasks pending for an operator, scores them with a pluggable backend and responds to them.
+package operator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+// Scoring backend types.
+const (
+	BackendStub = "stub"
+	BackendExec = "exec"
+	BackendHTTP = "http"
+)
+
+// Config is the configuration of the oracle operator daemon.
+type Config struct {
+	// PollInterval is the interval between two queries of pending tasks.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// MaxRetries is the maximum number of retries of a failed response to a task.
+	MaxRetries int `mapstructure:"max_retries"`
+	// InclusionBlocks is the number of blocks to wait for a broadcast transaction to be included
+	// before it is considered dropped and retried.
+	InclusionBlocks int64 `mapstructure:"inclusion_blocks"`
+	// CommitsFile is the file keeping the responses committed to commit-reveal tasks until they are revealed,
+	// relative to the home directory unless it is absolute.
+	CommitsFile string        `mapstructure:"commits_file"`
+	Backend     BackendConfig `mapstructure:"backend"`
+}
+
+// BackendConfig is the configuration of the scoring backend.
+type BackendConfig struct {
+	// Type is the type of the backend, one of stub, exec and http.
+	Type string `mapstructure:"type"`
+	// Score and Confidence are the score and the confidence always returned by the stub backend.
+	Score      int64 `mapstructure:"score"`
+	Confidence int64 `mapstructure:"confidence"`
+	// Command and Args are the executable and its arguments run by the exec backend.
+	Command string   `mapstructure:"command"`
+	Args    []string `mapstructure:"args"`
+	// URL is the endpoint called by the http backend.
+	URL string `mapstructure:"url"`
+	// Timeout is the maximum duration of a scoring request.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default configuration, which uses the stub backend.
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:    5 * time.Second,
+		MaxRetries:      3,
+		InclusionBlocks: 5,
+		CommitsFile:     filepath.Join("data", "oracle-operator-commits.json"),
+		Backend: BackendConfig{
+			Type:       BackendStub,
+			Score:      types.MaxScore.Int64(),
+			Confidence: types.MaxConfidence,
+			Args:       []string{},
+			Timeout:    30 * time.Second,
+		},
+	}
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.PollInterval <= 0 {
+		return fmt.Errorf("poll_interval must be positive")
+	}
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("max_retries cannot be negative")
+	}
+	if c.InclusionBlocks <= 0 {
+		return fmt.Errorf("inclusion_blocks must be positive")
+	}
+	if c.CommitsFile == "" {
+		return fmt.Errorf("commits_file is required")
+	}
+	switch c.Backend.Type {
+	case BackendStub:
+		if c.Backend.Score < types.MinScore.Int64() || c.Backend.Score > types.MaxScore.Int64() {
+			return fmt.Errorf("stub backend score must be between %s and %s", types.MinScore, types.MaxScore)
+		}
+		if err := types.ValidateConfidence(c.Backend.Confidence); err != nil {
+			return fmt.Errorf("stub backend confidence: %w", err)
+		}
+	case BackendExec:
+		if c.Backend.Command == "" {
+			return fmt.Errorf("exec backend requires a command")
+		}
+	case BackendHTTP:
+		if c.Backend.URL == "" {
+			return fmt.Errorf("http backend requires a url")
+		}
+	default:
+		return fmt.Errorf("unknown backend type %q", c.Backend.Type)
+	}
+	if c.Backend.Timeout <= 0 {
+		return fmt.Errorf("backend timeout must be positive")
+	}
+	return nil
+}
+
+const defaultConfigTemplate = `# Interval between two queries of the tasks pending for the operator.
+poll_interval = "{{ .PollInterval }}"
+
+# Maximum number of retries of a failed response to a task.
+max_retries = {{ .MaxRetries }}
+
+# Number of blocks to wait for a transaction to be included before it is considered dropped and retried.
+inclusion_blocks = {{ .InclusionBlocks }}
+
+# File keeping the responses committed to commit-reveal tasks until they are revealed,
+# relative to the home directory unless it is absolute. Losing it before a reveal gets the operator slashed.
+commits_file = "{{ .CommitsFile }}"
+
+[backend]
+
+# Scoring backend, one of:
+#   stub - always responds with the configured score and confidence, for local testing
+#   exec - runs command with args, followed by the contract and the function of the task;
+#          the task is written to its standard input as JSON and the score, optionally followed by
+#          the confidence, is read from its standard output
+#   http - posts the task as JSON to url and reads the score from the "score" field of the JSON response,
+#          and the confidence from its optional "confidence" field
+# Confidences range from 1 to 100, and 0 stands for the maximum confidence.
+type = "{{ .Backend.Type }}"
+
+score = {{ .Backend.Score }}
+confidence = {{ .Backend.Confidence }}
+
+command = "{{ .Backend.Command }}"
+args = [{{ range $i, $arg := .Backend.Args }}{{ if $i }}, {{ end }}"{{ $arg }}"{{ end }}]
+
+url = "{{ .Backend.URL }}"
+
+# Maximum duration of a scoring request.
+timeout = "{{ .Backend.Timeout }}"
+`
+
+// WriteConfigFile writes a configuration to a TOML file.
+func WriteConfigFile(path string, config Config) error {
+	tmpl, err := template.New("oracleOperatorConfig").Parse(defaultConfigTemplate)
+	if err != nil {
+		return err
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, config); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buffer.Bytes(), 0644)
+}
+
+// LoadConfig reads the configuration from a TOML file.
+// A file with the default configuration is written if it does not exist.
+func LoadConfig(path string) (Config, error) {
+	config := DefaultConfig()
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := WriteConfigFile(path, config); err != nil {
+			return config, err
+		}
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return config, err
+	}
+	if err := v.Unmarshal(&config); err != nil {
+		return config, err
+	}
+	return config, config.Validate()
+}
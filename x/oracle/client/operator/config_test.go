@@ -0,0 +1,87 @@
+package operator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(config *Config)
+		wantErr  bool
+	}{
+		{"default", func(config *Config) {}, false},
+		{"zero poll interval", func(config *Config) { config.PollInterval = 0 }, true},
+		{"negative max retries", func(config *Config) { config.MaxRetries = -1 }, true},
+		{"zero inclusion blocks", func(config *Config) { config.InclusionBlocks = 0 }, true},
+		{"no commits file", func(config *Config) { config.CommitsFile = "" }, true},
+		{"stub score out of range", func(config *Config) { config.Backend.Score = 101 }, true},
+		{"stub confidence out of range", func(config *Config) { config.Backend.Confidence = 101 }, true},
+		{"exec without command", func(config *Config) { config.Backend.Type = BackendExec }, true},
+		{"exec", func(config *Config) {
+			config.Backend.Type = BackendExec
+			config.Backend.Command = "score"
+		}, false},
+		{"http without url", func(config *Config) { config.Backend.Type = BackendHTTP }, true},
+		{"http", func(config *Config) {
+			config.Backend.Type = BackendHTTP
+			config.Backend.URL = "http://localhost:8080"
+		}, false},
+		{"unknown backend", func(config *Config) { config.Backend.Type = "unknown" }, true},
+		{"zero timeout", func(config *Config) { config.Backend.Timeout = 0 }, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DefaultConfig()
+			tc.malleate(&config)
+			if tc.wantErr {
+				require.Error(t, config.Validate())
+			} else {
+				require.NoError(t, config.Validate())
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oracle-operator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config", defaultConfigFile)
+
+	// the default configuration is written if the file does not exist
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, DefaultConfig(), config)
+	require.FileExists(t, path)
+
+	// and a written configuration is read back
+	config.PollInterval = time.Minute
+	config.InclusionBlocks = 10
+	config.Backend = BackendConfig{
+		Type:    BackendExec,
+		Command: "score",
+		Args:    []string{"--network", "mainnet"},
+		Timeout: time.Second,
+	}
+	require.NoError(t, WriteConfigFile(path, config))
+	loaded, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, config, loaded)
+
+	// invalid configurations are rejected
+	config.Backend.Command = ""
+	require.NoError(t, WriteConfigFile(path, config))
+	_, err = LoadConfig(path)
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("poll_interval = ["), 0644))
+	_, err = LoadConfig(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,336 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+// pageLimit is the number of pending tasks queried at a time.
+const pageLimit = 100
+
+// Daemon polls the tasks pending for an operator, scores them with a scoring backend
+// and responds to them before their closing block, committing and revealing the responses
+// to commit-reveal tasks.
+type Daemon struct {
+	cliCtx  client.Context
+	txf     tx.Factory
+	config  Config
+	backend ScoringBackend
+	logger  log.Logger
+	commits *commitStore
+
+	// sent records the transactions broadcast for a task until they are included,
+	// to avoid duplicate transactions and to retry the dropped ones.
+	sent map[uint64]sentTx
+	// failures counts the failed attempts to respond to a task.
+	failures map[uint64]int
+	// skipped records the tasks the daemon does not respond to, to only log them once.
+	skipped map[uint64]bool
+}
+
+// sentTx is a transaction broadcast for a task.
+type sentTx struct {
+	txHash string
+	height int64
+	commit bool
+}
+
+// NewDaemon returns a new oracle operator daemon signing with the from key of the client context.
+func NewDaemon(cliCtx client.Context, txf tx.Factory, config Config, backend ScoringBackend, logger log.Logger) *Daemon {
+	return &Daemon{
+		cliCtx:   cliCtx,
+		txf:      txf,
+		config:   config,
+		backend:  backend,
+		logger:   logger,
+		sent:     make(map[uint64]sentTx),
+		failures: make(map[uint64]int),
+		skipped:  make(map[uint64]bool),
+	}
+}
+
+// Run polls and responds to pending tasks until the context is done.
+func (d *Daemon) Run(ctx context.Context) error {
+	operator := d.cliCtx.GetFromAddress()
+	queryClient := types.NewQueryClient(d.cliCtx)
+	if _, err := queryClient.Operator(ctx, &types.QueryOperatorRequest{Address: operator.String()}); err != nil {
+		return fmt.Errorf("%s is not an oracle operator: %w", operator, err)
+	}
+
+	commitsPath := d.config.CommitsFile
+	if !filepath.IsAbs(commitsPath) {
+		commitsPath = filepath.Join(d.cliCtx.HomeDir, commitsPath)
+	}
+	commits, err := loadCommitStore(commitsPath)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", commitsPath, err)
+	}
+	d.commits = commits
+	d.logger.Info("started oracle operator", "operator", operator.String(), "backend", d.config.Backend.Type)
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.poll(ctx); err != nil {
+			d.logger.Error("failed to poll pending tasks", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			d.logger.Info("stopped oracle operator")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll responds to all the tasks currently pending for the operator.
+func (d *Daemon) poll(ctx context.Context) error {
+	status, err := d.cliCtx.Client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	height := status.SyncInfo.LatestBlockHeight
+
+	tasks, err := d.pendingTasks(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[uint64]bool)
+	for _, task := range tasks {
+		pending[task.Id] = true
+		if ctx.Err() != nil {
+			return nil
+		}
+		d.handleTask(ctx, task, height)
+	}
+
+	// A task is no longer pending for the operator once its response, or its commit until the reveal phase,
+	// is included, or once it is closed.
+	for id, sent := range d.sent {
+		if !pending[id] {
+			d.confirm(id, sent)
+			delete(d.sent, id)
+		}
+	}
+	for id := range d.failures {
+		if !pending[id] {
+			delete(d.failures, id)
+		}
+	}
+	for id := range d.skipped {
+		if !pending[id] {
+			delete(d.skipped, id)
+		}
+	}
+	if err := d.commits.prune(height); err != nil {
+		d.logger.Error("failed to prune committed responses", "err", err)
+	}
+	return nil
+}
+
+// pendingTasks queries all the tasks pending for the operator.
+func (d *Daemon) pendingTasks(ctx context.Context) ([]types.Task, error) {
+	queryClient := types.NewQueryClient(d.cliCtx)
+	var tasks []types.Task
+	var nextKey []byte
+	for {
+		res, err := queryClient.PendingTasksForOperator(ctx, &types.QueryPendingTasksForOperatorRequest{
+			OperatorAddress: d.cliCtx.GetFromAddress().String(),
+			Pagination:      &query.PageRequest{Key: nextKey, Limit: pageLimit},
+		})
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, res.Tasks...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return tasks, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// confirm logs whether a transaction broadcast for a task which is no longer pending was included.
+func (d *Daemon) confirm(taskID uint64, sent sentTx) {
+	res, err := authclient.QueryTx(d.cliCtx, sent.txHash)
+	switch {
+	case err != nil:
+		d.logger.Error("transaction was not included before the task closed", "task_id", taskID,
+			"txhash", sent.txHash, "err", err)
+	case res.Code != 0:
+		d.logger.Error("transaction failed", "task_id", taskID, "txhash", sent.txHash, "code", res.Code, "log", res.RawLog)
+	default:
+		d.logger.Info("transaction included", "task_id", taskID, "txhash", sent.txHash, "height", res.Height)
+	}
+}
+
+// skip records a task the daemon does not respond to.
+func (d *Daemon) skip(task types.Task, reason string) {
+	if !d.skipped[task.Id] {
+		d.skipped[task.Id] = true
+		d.logger.Info("skipped task", "task_id", task.Id, "reason", reason)
+	}
+}
+
+// fail records a failed attempt to respond to a task.
+func (d *Daemon) fail(task types.Task, msg string, err error) {
+	d.failures[task.Id]++
+	d.logger.Error(msg, "task_id", task.Id, "attempt", d.failures[task.Id], "err", err)
+}
+
+// hasCommitted returns true if the operator has a commit included in a task.
+func (d *Daemon) hasCommitted(task types.Task) bool {
+	for _, commit := range task.Commits {
+		if commit.Operator == d.cliCtx.GetFromAddress().String() {
+			return true
+		}
+	}
+	return false
+}
+
+// handleTask responds to a task, or commits or reveals a response to a commit-reveal task, unless a transaction
+// for the task is waiting to be included, it is too late to respond, or the maximum number of retries is reached.
+func (d *Daemon) handleTask(ctx context.Context, task types.Task, height int64) {
+	if d.skipped[task.Id] {
+		return
+	}
+	if sent, ok := d.sent[task.Id]; ok {
+		switch {
+		case sent.commit && d.hasCommitted(task):
+			d.logger.Info("transaction included", "task_id", task.Id, "txhash", sent.txHash)
+		case height <= sent.height+d.config.InclusionBlocks:
+			return
+		default:
+			// Query the account sequence again, since the dropped transaction may have left a gap.
+			d.txf = d.txf.WithSequence(0)
+			d.fail(task, "transaction was not included", fmt.Errorf("%s", sent.txHash))
+		}
+		delete(d.sent, task.Id)
+	}
+	if d.failures[task.Id] > d.config.MaxRetries {
+		d.skip(task, "maximum number of retries reached")
+		return
+	}
+
+	operator := d.cliCtx.GetFromAddress()
+	var msg sdk.Msg
+	switch {
+	case !task.IsCommitReveal():
+		// The response has to be included in a block no later than the closing block.
+		if height >= task.ClosingBlock {
+			d.skip(task, "too late to respond before the closing block")
+			return
+		}
+		score, confidence, err := d.backend.Score(ctx, task)
+		if err != nil {
+			d.fail(task, "failed to score task", err)
+			return
+		}
+		msg = types.NewMsgTaskResponse(task.Id, score, confidence, operator)
+
+	case d.hasCommitted(task):
+		commit, ok := d.commits.get(task.Id)
+		if !ok {
+			d.skip(task, "committed response is unknown")
+			return
+		}
+		if height >= task.ClosingBlock {
+			d.skip(task, "too late to reveal before the closing block")
+			return
+		}
+		msg = types.NewMsgRevealTaskResponse(task.Id, commit.Score, commit.Confidence, commit.Salt, operator)
+
+	default:
+		if height >= task.CommitClosingBlock() {
+			d.skip(task, "too late to commit before the end of the commit phase")
+			return
+		}
+		// A retried commit reuses the saved response, in case the previous commit was included after all.
+		commit, ok := d.commits.get(task.Id)
+		if !ok {
+			score, confidence, err := d.backend.Score(ctx, task)
+			if err != nil {
+				d.fail(task, "failed to score task", err)
+				return
+			}
+			salt, err := newSalt()
+			if err != nil {
+				d.fail(task, "failed to generate salt", err)
+				return
+			}
+			commit = committedResponse{Score: score, Confidence: confidence, Salt: salt, ClosingBlock: task.ClosingBlock}
+			// The response is saved before it is committed, so that it can always be revealed.
+			if err := d.commits.set(task.Id, commit); err != nil {
+				d.fail(task, "failed to save committed response", err)
+				return
+			}
+		}
+		hash := types.ResponseCommitHash(operator, commit.Score, commit.Confidence, commit.Salt)
+		msg = types.NewMsgCommitTaskResponse(task.Id, hash, operator)
+	}
+
+	res, err := d.broadcast(msg)
+	if err != nil {
+		d.fail(task, "failed to broadcast transaction", err)
+		return
+	}
+	d.sent[task.Id] = sentTx{txHash: res.TxHash, height: height, commit: task.IsCommitReveal() && !d.hasCommitted(task)}
+	d.logger.Info("broadcast transaction", "task_id", task.Id, "msg", msg.Type(), "txhash", res.TxHash)
+}
+
+// broadcast signs and broadcasts a transaction with the messages, keeping track of the account sequence.
+func (d *Daemon) broadcast(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	txf, err := tx.PrepareFactory(d.cliCtx, d.txf)
+	if err != nil {
+		return nil, err
+	}
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(d.cliCtx.QueryWithData, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, d.cliCtx.GetFromName(), txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := d.cliCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.cliCtx.BroadcastTx(txBytes)
+	if err == nil && res.Code != 0 {
+		err = fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	if err != nil {
+		// Query the account sequence again for the next transaction.
+		d.txf = d.txf.WithSequence(0)
+		return nil, err
+	}
+	d.txf = txf.WithSequence(txf.Sequence() + 1)
+	return res, nil
+}
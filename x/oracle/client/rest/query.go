@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -15,10 +16,14 @@ import (
 
 func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/operator/{address}", types.QuerierRoute), operatorHandler(cliCtx)).Methods("Get")
+	r.HandleFunc(fmt.Sprintf("/%s/operator/{address}/slashes", types.QuerierRoute), slashesHandler(cliCtx)).Methods("Get")
 	r.HandleFunc(fmt.Sprintf("/%s/operators", types.QuerierRoute), operatorsHandler(cliCtx)).Methods("Get")
 	r.HandleFunc(fmt.Sprintf("/%s/withdraws", types.QuerierRoute), withdrawsHandler(cliCtx)).Methods("Get")
 
 	r.HandleFunc(fmt.Sprintf("/%s/task", types.QuerierRoute), taskHandler(cliCtx)).Methods("Get")
+	r.HandleFunc(fmt.Sprintf("/%s/tasks", types.QuerierRoute), tasksHandler(cliCtx)).Methods("Get")
+	r.HandleFunc(fmt.Sprintf("/%s/operator/{address}/pending_tasks", types.QuerierRoute), pendingTasksHandler(cliCtx)).Methods("Get")
+	r.HandleFunc(fmt.Sprintf("/%s/task_history", types.QuerierRoute), taskHistoryHandler(cliCtx)).Methods("Get")
 	r.HandleFunc(fmt.Sprintf("/%s/response", types.QuerierRoute), responseHandler(cliCtx)).Methods("Get")
 }
 
@@ -44,6 +49,28 @@ func operatorHandler(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func slashesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		address := vars["address"]
+
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QuerySlashes, address)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func operatorsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -89,16 +116,13 @@ func taskHandler(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		contract := r.URL.Query().Get("contract")
-		if contract == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "contract is require to submit a task")
-		}
-		function := r.URL.Query().Get("function")
-		if function == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "function is require to submit a task")
+		taskID, err := strconv.ParseUint(r.URL.Query().Get("task_id"), 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 
-		params := types.NewQueryTaskParams(contract, function)
+		params := types.NewQueryTaskParams(taskID)
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -116,7 +140,116 @@ func taskHandler(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
-func responseHandler(cliCtx client.Context) http.HandlerFunc {
+func tasksHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 100)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		status := types.TaskStatusNil
+		if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+			status, err = types.TaskStatusFromString(statusStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		var creatorAddress sdk.AccAddress
+		if creator := r.URL.Query().Get("creator"); creator != "" {
+			creatorAddress, err = sdk.AccAddressFromBech32(creator)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		var minClosingBlock, maxClosingBlock int64
+		if minClosingBlockStr := r.URL.Query().Get("min_closing_block"); minClosingBlockStr != "" {
+			minClosingBlock, err = strconv.ParseInt(minClosingBlockStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if maxClosingBlockStr := r.URL.Query().Get("max_closing_block"); maxClosingBlockStr != "" {
+			maxClosingBlock, err = strconv.ParseInt(maxClosingBlockStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		var minBounty sdk.Coins
+		if minBountyStr := r.URL.Query().Get("min_bounty"); minBountyStr != "" {
+			minBounty, err = sdk.ParseCoinsNormalized(minBountyStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryTasksParams(page, limit, status, creatorAddress, minClosingBlock, maxClosingBlock, minBounty)
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTasks)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func pendingTasksHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 100)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		operatorAddress, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryPendingTasksParams(page, limit, operatorAddress)
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingTasks)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func taskHistoryHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
@@ -125,13 +258,45 @@ func responseHandler(cliCtx client.Context) http.HandlerFunc {
 
 		contract := r.URL.Query().Get("contract")
 		if contract == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "contract is require to respond to a task")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "contract is required to query task history")
+			return
 		}
 		function := r.URL.Query().Get("function")
 		if function == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "function is require to respond to a task")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "function is required to query task history")
+			return
+		}
+
+		params := types.NewQueryTaskHistoryParams(contract, function)
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaskHistory)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func responseHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		taskID, err := strconv.ParseUint(r.URL.Query().Get("task_id"), 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
-		var err error
 		var operatorAddress sdk.AccAddress
 		if operator := r.URL.Query().Get("operator"); operator != "" {
 			operatorAddress, err = sdk.AccAddressFromBech32(operator)
@@ -141,7 +306,7 @@ func responseHandler(cliCtx client.Context) http.HandlerFunc {
 			}
 		}
 
-		params := types.NewQueryResponseParams(contract, function, operatorAddress)
+		params := types.NewQueryResponseParams(taskID, operatorAddress)
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -20,6 +20,7 @@ type inquiryTaskReq struct {
 	Contract string            `json:"contract"`
 	Function string            `json:"function"`
 	TxHash   string            `json:"txhash"`
+	Callback string            `json:"callback"`
 }
 
 type createOperatorReq struct {
@@ -61,19 +62,36 @@ type createTaskReq struct {
 	Description   string            `json:"description"`
 	Wait          string            `json:"wait"`
 	ValidDuration string            `json:"valid_duration"`
+	RevealBlocks  string            `json:"reveal_blocks"`
+	Aggregation   string            `json:"aggregation"`
 }
 
 type respondToTaskReq struct {
+	BaseReq    resttypes.BaseReq `json:"base_req"`
+	TaskID     string            `json:"task_id"`
+	Score      string            `json:"score"`
+	Confidence string            `json:"confidence"`
+	Operator   string            `json:"operator"`
+}
+
+type commitToTaskReq struct {
 	BaseReq  resttypes.BaseReq `json:"base_req"`
-	Contract string            `json:"contract"`
-	Function string            `json:"function"`
-	Score    string            `json:"score"`
+	TaskID   string            `json:"task_id"`
+	Hash     string            `json:"hash"`
 	Operator string            `json:"operator"`
 }
 
+type revealToTaskReq struct {
+	BaseReq    resttypes.BaseReq `json:"base_req"`
+	TaskID     string            `json:"task_id"`
+	Score      string            `json:"score"`
+	Confidence string            `json:"confidence"`
+	Salt       string            `json:"salt"`
+	Operator   string            `json:"operator"`
+}
+
 type deleteTaskReq struct {
-	BaseReq  resttypes.BaseReq `json:"base_req"`
-	Contract string            `json:"contract"`
-	Function string            `json:"function"`
-	Force    string            `json:"force"`
+	BaseReq resttypes.BaseReq `json:"base_req"`
+	TaskID  string            `json:"task_id"`
+	Force   string            `json:"force"`
 }
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -25,6 +26,8 @@ func registerTxHandlers(cliCtx client.Context, r *mux.Router) {
 
 	r.HandleFunc(fmt.Sprintf("/%s/create-task", types.ModuleName), createTaskHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/respond-to-task", types.ModuleName), respondToTaskHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/commit-to-task", types.ModuleName), commitToTaskHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/reveal-to-task", types.ModuleName), revealToTaskHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/inquiry-task", types.ModuleName), inquireTaskHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/delete-task", types.ModuleName), deleteTaskHandler(cliCtx)).Methods("POST")
 }
@@ -73,7 +76,26 @@ func createTaskHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 		validDuration := time.Duration(hours) * time.Hour
 
-		msg := types.NewMsgCreateTask(req.Contract, req.Function, bounty, req.Description, creator, wait, validDuration)
+		var revealBlocks int64
+		if req.RevealBlocks != "" {
+			revealBlocks, err = strconv.ParseInt(req.RevealBlocks, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		aggregationStrategy := types.AggregationStrategyNil
+		if req.Aggregation != "" {
+			aggregationStrategy, err = types.AggregationStrategyFromString(req.Aggregation)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := types.NewMsgCreateTask(req.Contract, req.Function, bounty, req.Description, creator, wait, validDuration,
+			revealBlocks, aggregationStrategy)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -103,7 +125,7 @@ func inquireTaskHandler(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgInquiryTask(req.Contract, req.Function, req.TxHash, inquirer)
+		msg := types.NewMsgInquiryTask(req.Contract, req.Function, req.TxHash, inquirer, req.Callback)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -288,19 +310,125 @@ func respondToTaskHandler(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		taskID, err := strconv.ParseUint(req.TaskID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		score, err := strconv.ParseInt(req.Score, 10, 64)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		confidence := int64(0)
+		if req.Confidence != "" {
+			confidence, err = strconv.ParseInt(req.Confidence, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
 		operator, err := sdk.AccAddressFromBech32(req.Operator)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		msg := types.NewMsgTaskResponse(req.Contract, req.Function, score, operator)
+		msg := types.NewMsgTaskResponse(taskID, score, confidence, operator)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
+
+func commitToTaskHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req commitToTaskReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		taskID, err := strconv.ParseUint(req.TaskID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		hash, err := hex.DecodeString(req.Hash)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCommitTaskResponse(taskID, hash, operator)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
+
+func revealToTaskHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revealToTaskReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		taskID, err := strconv.ParseUint(req.TaskID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		score, err := strconv.ParseInt(req.Score, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		confidence := int64(0)
+		if req.Confidence != "" {
+			confidence, err = strconv.ParseInt(req.Confidence, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevealTaskResponse(taskID, score, confidence, req.Salt, operator)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -323,6 +451,12 @@ func deleteTaskHandler(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		taskID, err := strconv.ParseUint(req.TaskID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		deleter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -334,7 +468,7 @@ func deleteTaskHandler(cliCtx client.Context) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgDeleteTask(req.Contract, req.Function, force, deleter)
+		msg := types.NewMsgDeleteTask(taskID, force, deleter)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
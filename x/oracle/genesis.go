@@ -15,6 +15,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	taskParams := data.TaskParams
 	withdraws := data.Withdraws
 	tasks := data.Tasks
+	slashingParams := data.SlashingParams
 
 	for _, operator := range operators {
 		k.SetOperator(ctx, operator)
@@ -23,15 +24,44 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	k.SetTotalCollateral(ctx, totalCollateral)
 	k.SetLockedPoolParams(ctx, *poolParams)
 	k.SetTaskParams(ctx, *taskParams)
+	if slashingParams == nil {
+		k.SetSlashingParams(ctx, types.DefaultSlashingParams())
+	} else {
+		k.SetSlashingParams(ctx, *slashingParams)
+	}
 
 	for _, withdraw := range withdraws {
 		withdraw.DueBlock += ctx.BlockHeight()
 		k.SetWithdraw(ctx, withdraw)
 	}
 
+	nextTaskID := data.NextTaskId
+	if nextTaskID == 0 {
+		nextTaskID = 1
+	}
 	for _, task := range tasks {
+		if task.Id == 0 {
+			task.Id = nextTaskID
+			nextTaskID++
+		}
 		k.UpdateAndSetTask(ctx, task)
 	}
+	k.SetNextTaskID(ctx, nextTaskID)
+
+	for _, deviations := range data.Deviations {
+		for i := range deviations.Heights {
+			deviations.Heights[i] += ctx.BlockHeight()
+		}
+		k.SetOperatorDeviations(ctx, deviations)
+	}
+
+	for _, slash := range data.Slashes {
+		k.AddSlash(ctx, slash)
+	}
+
+	for _, inquiry := range data.Inquiries {
+		k.AddInquiry(ctx, inquiry)
+	}
 }
 
 // ExportGenesis extracts all data from store to genesis state.
@@ -45,5 +75,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 
 	tasks := k.UpdateAndGetAllTasks(ctx)
 
-	return types.NewGenesisState(operators, totalCollateral, poolParams, taskParams, withdraws, tasks)
+	slashingParams := k.GetSlashingParams(ctx)
+	deviationsList := k.GetAllOperatorDeviations(ctx)
+	for _, deviations := range deviationsList {
+		for i := range deviations.Heights {
+			deviations.Heights[i] -= ctx.BlockHeight()
+		}
+	}
+	slashes := k.GetAllSlashes(ctx)
+	inquiries := k.GetAllInquiries(ctx)
+
+	return types.NewGenesisState(operators, totalCollateral, poolParams, taskParams, withdraws, tasks,
+		slashingParams, deviationsList, slashes, k.GetNextTaskID(ctx), inquiries)
 }
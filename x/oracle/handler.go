@@ -44,6 +44,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.TaskResponse(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCommitTaskResponse:
+			res, err := msgServer.CommitTaskResponse(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevealTaskResponse:
+			res, err := msgServer.RevealTaskResponse(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgInquiryTask:
 			res, err := msgServer.InquiryTask(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
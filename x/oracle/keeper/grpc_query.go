@@ -7,7 +7,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/certikfoundation/shentu/x/oracle/types"
 )
@@ -54,14 +56,14 @@ func (q Keeper) Withdraws(c context.Context, req *types.QueryWithdrawsRequest) (
 	return &types.QueryWithdrawsResponse{Withdraws: q.GetAllWithdraws(ctx)}, nil
 }
 
-// Task queries a task given its contract and function.
+// Task queries a task given its ID.
 func (q Keeper) Task(c context.Context, req *types.QueryTaskRequest) (*types.QueryTaskResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	task, err := q.GetTask(ctx, req.Contract, req.Function)
+	task, err := q.GetTask(ctx, req.TaskId)
 	if err != nil {
 		return nil, err
 	}
@@ -69,15 +71,102 @@ func (q Keeper) Task(c context.Context, req *types.QueryTaskRequest) (*types.Que
 	return &types.QueryTaskResponse{Task: task}, nil
 }
 
-// Response queries a response based on its task contract, task function,
-// and operator address.
+// Tasks queries tasks filtered by status, creator, closing block range and minimum bounty.
+func (q Keeper) Tasks(c context.Context, req *types.QueryTasksRequest) (*types.QueryTasksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var creator sdk.AccAddress
+	var err error
+	if req.Creator != "" {
+		creator, err = sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var minBounty sdk.Coins
+	if req.MinBounty != "" {
+		minBounty, err = sdk.ParseCoinsNormalized(req.MinBounty)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	params := types.NewQueryTasksParams(0, 0, req.Status, creator, req.MinClosingBlock, req.MaxClosingBlock, minBounty)
+	store, indexed := q.tasksStore(ctx, params)
+
+	var tasks []types.Task
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		task, err := q.getTaskFromStore(ctx, value, indexed)
+		if err != nil || !params.Matches(task) {
+			return false, nil
+		}
+		if accumulate {
+			tasks = append(tasks, task)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+}
+
+// PendingTasksForOperator queries the pending tasks which still expect a response, commit or reveal from an operator.
+func (q Keeper) PendingTasksForOperator(c context.Context, req *types.QueryPendingTasksForOperatorRequest) (*types.QueryPendingTasksForOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	operator, err := sdk.AccAddressFromBech32(req.OperatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only tasks in the closing block queue are yet to be aggregated.
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.ClosingTaskStoreKeyPrefix)
+
+	var tasks []types.Task
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		task, err := q.getTaskFromStore(ctx, value, true)
+		if err != nil || !isPendingForOperator(ctx, task, operator.String()) {
+			return false, nil
+		}
+		if accumulate {
+			tasks = append(tasks, task)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPendingTasksForOperatorResponse{Tasks: tasks, Pagination: pageRes}, nil
+}
+
+// TaskHistory queries all tasks of a contract function in the order of creation.
+func (q Keeper) TaskHistory(c context.Context, req *types.QueryTaskHistoryRequest) (*types.QueryTaskHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTaskHistoryResponse{Tasks: q.GetTasksByTarget(ctx, req.Contract, req.Function)}, nil
+}
+
+// Response queries a response based on its task ID and operator address.
 func (q Keeper) Response(c context.Context, req *types.QueryResponseRequest) (*types.QueryResponseResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	task, err := q.GetTask(ctx, req.Contract, req.Function)
+	task, err := q.GetTask(ctx, req.TaskId)
 	if err != nil {
 		return nil, err
 	}
@@ -89,3 +178,18 @@ func (q Keeper) Response(c context.Context, req *types.QueryResponseRequest) (*t
 	}
 	return &types.QueryResponseResponse{}, fmt.Errorf("there is no response from this operator")
 }
+
+// Slashes queries the slashing history of an operator.
+func (q Keeper) Slashes(c context.Context, req *types.QuerySlashesRequest) (*types.QuerySlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesResponse{Slashes: q.GetSlashes(ctx, address)}, nil
+}
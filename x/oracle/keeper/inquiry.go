@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+// AddInquiry appends an inquiry to the inquiries of its task.
+func (k Keeper) AddInquiry(ctx sdk.Context, inquiry types.Inquiry) {
+	inquiries := append(k.GetInquiries(ctx, inquiry.TaskId), inquiry)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.Inquiries{Inquiries: inquiries})
+	ctx.KVStore(k.storeKey).Set(types.InquiryStoreKey(inquiry.TaskId), bz)
+}
+
+// GetInquiries returns the inquiries of a pending task.
+func (k Keeper) GetInquiries(ctx sdk.Context, taskID uint64) []types.Inquiry {
+	var inquiries types.Inquiries
+	bz := ctx.KVStore(k.storeKey).Get(types.InquiryStoreKey(taskID))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &inquiries)
+	}
+	return inquiries.Inquiries
+}
+
+// DeleteInquiries deletes the inquiries of a task.
+func (k Keeper) DeleteInquiries(ctx sdk.Context, taskID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.InquiryStoreKey(taskID))
+}
+
+// GetAllInquiries returns the inquiries of all pending tasks.
+func (k Keeper) GetAllInquiries(ctx sdk.Context) (inquiries []types.Inquiry) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InquiryStoreKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var taskInquiries types.Inquiries
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &taskInquiries)
+		inquiries = append(inquiries, taskInquiries.Inquiries...)
+	}
+	return inquiries
+}
+
+// InquireTask charges an inquirer the inquiry fee for the latest task of a contract function and returns
+// the task with the fee charged. The fee of a closed task is split among the operators of its valid responses,
+// while the fee of a pending task is held along with the callback contract until the task closes.
+// The gas of a callback is charged to the inquiry, and a task takes at most MaxInquiryCallbacks callbacks.
+func (k Keeper) InquireTask(ctx sdk.Context, contract, function, txHash string, inquirer sdk.AccAddress,
+	callback string) (types.Task, sdk.Coins, error) {
+	task, err := k.GetLatestTask(ctx, contract, function)
+	if err != nil {
+		return types.Task{}, nil, err
+	}
+
+	if task.Status == types.TaskStatusPending && callback != "" {
+		callbacks := 0
+		for _, inquiry := range k.GetInquiries(ctx, task.Id) {
+			if inquiry.Callback != "" {
+				callbacks++
+			}
+		}
+		if callbacks >= types.MaxInquiryCallbacks {
+			return types.Task{}, nil, types.ErrTooManyCallbacks
+		}
+		ctx.GasMeter().ConsumeGas(types.InquiryCallbackGasLimit, "inquiry callback")
+	}
+
+	fee := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), k.GetTaskParams(ctx).InquiryFee))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, inquirer, types.ModuleName, fee); err != nil {
+		return types.Task{}, nil, err
+	}
+
+	if task.Status == types.TaskStatusPending {
+		k.AddInquiry(ctx, types.Inquiry{
+			TaskId:   task.Id,
+			Inquirer: inquirer.String(),
+			Fee:      fee,
+			Callback: callback,
+			TxHash:   txHash,
+		})
+		return task, fee, nil
+	}
+
+	remainder := k.distributeInquiryFee(ctx, task, fee)
+	if err := k.refundInquiryFee(ctx, inquirer, remainder); err != nil {
+		return types.Task{}, nil, err
+	}
+	return task, fee.Sub(remainder), nil
+}
+
+// HandleInquiries splits the fees of the inquiries of a closed task among the operators of its valid responses
+// and calls the callback contracts of the inquiries with the result of the task.
+func (k Keeper) HandleInquiries(ctx sdk.Context, task types.Task) {
+	for _, inquiry := range k.GetInquiries(ctx, task.Id) {
+		inquirerAddr, err := sdk.AccAddressFromBech32(inquiry.Inquirer)
+		if err != nil {
+			panic(err)
+		}
+		remainder := k.distributeInquiryFee(ctx, task, inquiry.Fee)
+		// A failed refund does not halt the chain: the remainder is kept in the module account
+		// and the failure is reported in an event.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.refundInquiryFee(cacheCtx, inquirerAddr, remainder); err != nil {
+			ctx.Logger().Error("failed to refund inquiry fee", "task_id", task.Id, "inquirer", inquiry.Inquirer, "err", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"refund_inquiry_fee_failed",
+					sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+					sdk.NewAttribute("inquirer", inquiry.Inquirer),
+					sdk.NewAttribute("amount", remainder.String()),
+					sdk.NewAttribute("error", err.Error()),
+				),
+			)
+		} else {
+			writeCache()
+		}
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("inquirer", inquiry.Inquirer),
+			sdk.NewAttribute("txhash", inquiry.TxHash),
+			sdk.NewAttribute("fee", inquiry.Fee.Sub(remainder).String()),
+			sdk.NewAttribute("callback", inquiry.Callback),
+		}
+		if inquiry.Callback != "" {
+			callbackAddr, err := sdk.AccAddressFromBech32(inquiry.Callback)
+			if err == nil {
+				err = k.callInquiryCallback(ctx, task, callbackAddr)
+			}
+			if err != nil {
+				attributes = append(attributes, sdk.NewAttribute("callback_error", err.Error()))
+			}
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent("close_inquiry", attributes...))
+	}
+	k.DeleteInquiries(ctx, task.Id)
+}
+
+// distributeInquiryFee splits an inquiry fee held by the module account among the operators of the valid
+// responses of a succeeded task, in proportion to their shares of the bounty, and returns the remainder
+// which could not be split.
+func (k Keeper) distributeInquiryFee(ctx sdk.Context, task types.Task, fee sdk.Coins) sdk.Coins {
+	if task.Status != types.TaskStatusSucceeded {
+		return fee
+	}
+	taskParams := k.GetTaskParams(ctx)
+	slashingParams := k.GetSlashingParams(ctx)
+	var operators []sdk.AccAddress
+	var shares []sdk.Int
+	totalShare := sdk.ZeroInt()
+	for _, response := range task.Responses {
+		share, ok := k.validResponseShare(ctx, task, response, taskParams, slashingParams)
+		if !ok || !share.IsPositive() {
+			continue
+		}
+		operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
+		if err != nil {
+			panic(err)
+		}
+		operators = append(operators, operatorAddr)
+		shares = append(shares, share)
+		totalShare = totalShare.Add(share)
+	}
+	if len(operators) == 0 {
+		return fee
+	}
+
+	remainder := fee
+	for i, operatorAddr := range operators {
+		var rewards []sdk.Coin
+		for _, coin := range fee {
+			rewards = append(rewards, sdk.NewCoin(coin.Denom, coin.Amount.Mul(shares[i]).Quo(totalShare)))
+		}
+		reward := sdk.NewCoins(rewards...)
+		if reward.IsZero() {
+			continue
+		}
+		if err := k.AddReward(ctx, operatorAddr, reward); err != nil {
+			continue
+		}
+		remainder = remainder.Sub(reward)
+	}
+	return remainder
+}
+
+// refundInquiryFee returns the remainder of an inquiry fee to the inquirer.
+func (k Keeper) refundInquiryFee(ctx sdk.Context, inquirer sdk.AccAddress, remainder sdk.Coins) error {
+	if remainder.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, inquirer, remainder)
+}
+
+// callInquiryCallback calls a callback contract with the result of a task from the module account, within
+// the callback gas limit. The state changes and events of a failed callback are discarded.
+func (k Keeper) callInquiryCallback(ctx sdk.Context, task types.Task, callback sdk.AccAddress) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(types.InquiryCallbackGasLimit)).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.ErrOutOfGas
+		}
+	}()
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.cvmKeeper.CallOracleCallback(cacheCtx, moduleAddr, callback, task); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
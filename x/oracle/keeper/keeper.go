@@ -14,11 +14,12 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
+	cvmKeeper     types.CVMKeeper
 	paramSpace    types.ParamSubspace
 }
 
 func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, authKeeper types.AccountKeeper, distriKeeper types.DistrKeeper,
-	stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, paramSpace types.ParamSubspace) Keeper {
+	stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, cvmKeeper types.CVMKeeper, paramSpace types.ParamSubspace) Keeper {
 	return Keeper{
 		cdc:           cdc,
 		paramSpace:    paramSpace,
@@ -27,6 +28,7 @@ func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, authKeeper type
 		distrKeeper:   distriKeeper,
 		stakingKeeper: stakingKeeper,
 		bankKeeper:    bankKeeper,
+		cvmKeeper:     cvmKeeper,
 	}
 }
 
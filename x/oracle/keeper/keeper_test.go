@@ -0,0 +1,401 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/certikfoundation/shentu/common"
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/oracle"
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+func TestMigrateTaskIDs(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// tasks stored by contract function, before tasks had IDs
+	legacyKey := func(task types.Task) []byte {
+		return append(append(types.TaskStoreKeyPrefix, []byte(task.Contract)...), []byte(task.Function)...)
+	}
+	closed := types.NewTask(0, "0xclosed", "func", 5, nil, "", ctx.BlockTime(), addrs[0], 10, 0, 0, types.AggregationStrategyNil)
+	closed.Status = types.TaskStatusSucceeded
+	pending := types.NewTask(0, "0xpending", "func", 8, nil, "", ctx.BlockTime(), addrs[1], 20, 12, 0, types.AggregationStrategyNil)
+	for _, task := range []types.Task{pending, closed} {
+		store.Set(legacyKey(task), cdc.MustMarshalBinaryLengthPrefixed(&task))
+	}
+	// and the closing block queue stored as lists of tasks by little endian block heights
+	legacyClosingKey := make([]byte, 8)
+	binary.LittleEndian.PutUint64(legacyClosingKey, uint64(pending.ClosingBlock))
+	legacyClosingKey = append(types.ClosingTaskStoreKeyPrefix, legacyClosingKey...)
+	closingTaskIDs := types.TaskIDs{TaskIds: []types.TaskID{{Contract: pending.Contract, Function: pending.Function}}}
+	store.Set(legacyClosingKey, cdc.MustMarshalBinaryLengthPrefixed(&closingTaskIDs))
+	app.OracleKeeper.AddSlash(ctx, types.NewSlash(addrs[1], sdk.Coins{}, 10, closed, sdk.NewInt(10)))
+
+	upgradeCtx := ctx.WithBlockHeight(common.Update2Height)
+	oracle.BeginBlocker(upgradeCtx, app.OracleKeeper)
+
+	// tasks get IDs in the order of creation
+	require.False(t, store.Has(legacyKey(closed)))
+	require.False(t, store.Has(legacyKey(pending)))
+	require.Equal(t, uint64(3), app.OracleKeeper.GetNextTaskID(ctx))
+	task, err := app.OracleKeeper.GetTask(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, closed.Contract, task.Contract)
+	task, err = app.OracleKeeper.GetTask(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, pending.Contract, task.Contract)
+
+	// and are indexed by their contract functions and creators
+	tasks := app.OracleKeeper.GetTasksByTarget(ctx, pending.Contract, pending.Function)
+	require.Len(t, tasks, 1)
+	require.Equal(t, uint64(2), tasks[0].Id)
+	tasks = app.OracleKeeper.GetTasksByCreator(ctx, addrs[0])
+	require.Len(t, tasks, 1)
+	require.Equal(t, uint64(1), tasks[0].Id)
+
+	// the closing block queue and slashes refer to the tasks by ID
+	require.False(t, store.Has(legacyClosingKey))
+	require.Equal(t, []uint64{2}, app.OracleKeeper.GetClosingTaskIDs(ctx, pending.ClosingBlock))
+	slashes := app.OracleKeeper.GetSlashes(ctx, addrs[1])
+	require.Len(t, slashes, 1)
+	require.Equal(t, uint64(1), slashes[0].TaskId)
+
+	// tasks with IDs are left unchanged
+	oracle.BeginBlocker(upgradeCtx, app.OracleKeeper)
+	require.Equal(t, uint64(3), app.OracleKeeper.GetNextTaskID(ctx))
+	require.Len(t, app.OracleKeeper.GetAllTasks(ctx), 2)
+}
+
+func TestTasksByLongTarget(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(80000*1e6))
+
+	// the key of a contract function whose length overflows a byte
+	// must not start with the key of a shorter contract function
+	longContract := "a\x01f" + strings.Repeat("x", 254)
+	for _, target := range [][2]string{{"a", "f"}, {longContract, "g"}} {
+		_, err := app.OracleKeeper.CreateTask(ctx, target[0], target[1], sdk.Coins{}, "", ctx.BlockTime(), addrs[0],
+			10, 0, types.AggregationStrategyNil)
+		require.NoError(t, err)
+	}
+	require.Len(t, app.OracleKeeper.GetTasksByTarget(ctx, "a", "f"), 1)
+	require.Len(t, app.OracleKeeper.GetTasksByTarget(ctx, longContract, "g"), 1)
+}
+
+func TestQueryTasksPagination(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+	require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addrs[0], collateral, addrs[0], "operator"))
+
+	// tasks closing at decreasing blocks, one of which the operator responds to
+	for _, waitingBlocks := range []int64{30, 20, 10} {
+		_, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[1],
+			waitingBlocks, 0, types.AggregationStrategyNil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, app.OracleKeeper.RespondToTask(ctx, 2, 50, types.MaxConfidence, addrs[0]))
+
+	// pending tasks are paged in the order of their closing blocks
+	var ids []uint64
+	var nextKey []byte
+	for {
+		res, err := app.OracleKeeper.PendingTasksForOperator(sdk.WrapSDKContext(ctx), &types.QueryPendingTasksForOperatorRequest{
+			OperatorAddress: addrs[0].String(),
+			Pagination:      &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		for _, task := range res.Tasks {
+			ids = append(ids, task.Id)
+		}
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+	require.Equal(t, []uint64{3, 1}, ids)
+
+	res, err := app.OracleKeeper.Tasks(sdk.WrapSDKContext(ctx), &types.QueryTasksRequest{
+		Status:     types.TaskStatusPending,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Tasks, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = app.OracleKeeper.Tasks(sdk.WrapSDKContext(ctx), &types.QueryTasksRequest{
+		Creator:         addrs[1].String(),
+		MaxClosingBlock: ctx.BlockHeight() + 20,
+		Pagination:      &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Tasks, 2)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+}
+
+func TestDeviatedResponseShare(t *testing.T) {
+	for _, confidence := range []int64{10, 50, types.MaxConfidence} {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+		addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+		bondDenom := app.StakingKeeper.BondDenom(ctx)
+		collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+		for _, addr := range addrs[:2] {
+			require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addr, collateral, addr, "operator"))
+		}
+
+		id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[2],
+			10, 0, types.AggregationStrategyNil)
+		require.NoError(t, err)
+		// a correct response and a response on the same side deviating beyond the threshold
+		require.NoError(t, app.OracleKeeper.RespondToTask(ctx, id, 60, confidence, addrs[0]))
+		require.NoError(t, app.OracleKeeper.RespondToTask(ctx, id, 95, confidence, addrs[1]))
+
+		task, err := app.OracleKeeper.GetTask(ctx, id)
+		require.NoError(t, err)
+		task.Status = types.TaskStatusSucceeded
+		task.Result = sdk.NewInt(60)
+		task.Bounty = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000))
+		for i, response := range task.Responses {
+			task.Responses[i].Weight = response.ConfidenceWeighted(collateral.AmountOf(bondDenom))
+		}
+		require.NoError(t, app.OracleKeeper.DistributeBounty(ctx, task))
+
+		correct, err := app.OracleKeeper.GetOperator(ctx, addrs[0])
+		require.NoError(t, err)
+		deviated, err := app.OracleKeeper.GetOperator(ctx, addrs[1])
+		require.NoError(t, err)
+		require.True(t, correct.AccumulatedRewards.IsAllPositive())
+		require.True(t, deviated.AccumulatedRewards.IsZero())
+
+		// the deviated response counts toward slashing whatever its confidence
+		app.OracleKeeper.HandleResponseDeviations(ctx, task)
+		require.Empty(t, app.OracleKeeper.GetOperatorDeviations(ctx, addrs[0]).Heights)
+		require.Len(t, app.OracleKeeper.GetOperatorDeviations(ctx, addrs[1]).Heights, 1)
+	}
+}
+
+func TestRevealConfidence(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+	require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addrs[0], collateral, addrs[0], "operator"))
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[1],
+		10, 5, types.AggregationStrategyNil)
+	require.NoError(t, err)
+	salt := strings.Repeat("s", types.MinSaltLength)
+	hash := types.ResponseCommitHash(addrs[0], 60, 80, salt)
+	require.NoError(t, app.OracleKeeper.CommitToTask(ctx, id, hash, addrs[0]))
+
+	// the confidence is committed along with the score
+	task, err := app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+	revealCtx := ctx.WithBlockHeight(task.CommitClosingBlock() + 1)
+	err = app.OracleKeeper.RevealToTask(revealCtx, id, 60, types.MaxConfidence, salt, addrs[0])
+	require.Equal(t, types.ErrInvalidReveal, err)
+	require.NoError(t, app.OracleKeeper.RevealToTask(revealCtx, id, 60, 80, salt, addrs[0]))
+}
+
+func TestInquireTask(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+	for _, addr := range addrs[:2] {
+		require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addr, collateral, addr, "operator"))
+	}
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[2],
+		10, 0, types.AggregationStrategyNil)
+	require.NoError(t, err)
+	require.NoError(t, app.OracleKeeper.RespondToTask(ctx, id, 60, types.MaxConfidence, addrs[0]))
+	require.NoError(t, app.OracleKeeper.RespondToTask(ctx, id, 95, types.MaxConfidence, addrs[1]))
+
+	// inquiries with callbacks are charged the gas of the callbacks and limited per task
+	for i := 0; i < types.MaxInquiryCallbacks; i++ {
+		inquiryCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, _, err := app.OracleKeeper.InquireTask(inquiryCtx, "0xcontract", "func", "txhash", addrs[3], addrs[2].String())
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, inquiryCtx.GasMeter().GasConsumed(), types.InquiryCallbackGasLimit)
+	}
+	_, _, err = app.OracleKeeper.InquireTask(ctx, "0xcontract", "func", "txhash", addrs[3], addrs[2].String())
+	require.Equal(t, types.ErrTooManyCallbacks, err)
+	_, _, err = app.OracleKeeper.InquireTask(ctx, "0xcontract", "func", "txhash", addrs[3], "")
+	require.NoError(t, err)
+
+	// the fees are split among the valid responses only
+	task, err := app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+	task.Status = types.TaskStatusSucceeded
+	task.Result = sdk.NewInt(60)
+	for i, response := range task.Responses {
+		task.Responses[i].Weight = response.ConfidenceWeighted(collateral.AmountOf(bondDenom))
+	}
+	app.OracleKeeper.HandleInquiries(ctx, task)
+	correct, err := app.OracleKeeper.GetOperator(ctx, addrs[0])
+	require.NoError(t, err)
+	deviated, err := app.OracleKeeper.GetOperator(ctx, addrs[1])
+	require.NoError(t, err)
+	inquiryFee := app.OracleKeeper.GetTaskParams(ctx).InquiryFee
+	require.Equal(t, inquiryFee.MulRaw(int64(types.MaxInquiryCallbacks)+1), correct.AccumulatedRewards.AmountOf(bondDenom))
+	require.True(t, deviated.AccumulatedRewards.IsZero())
+
+	// a refund the module account falls short of is reported without halting the chain
+	oracleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balance := app.BankKeeper.GetAllBalances(ctx, oracleAddr)
+	app.OracleKeeper.AddInquiry(ctx, types.Inquiry{
+		TaskId:   id,
+		Inquirer: addrs[3].String(),
+		Fee:      balance.Add(balance...),
+	})
+	task.Status = types.TaskStatusFailed
+	failCtx := ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { app.OracleKeeper.HandleInquiries(failCtx, task) })
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, oracleAddr))
+	var failed bool
+	for _, event := range failCtx.EventManager().Events() {
+		failed = failed || event.Type == "refund_inquiry_fee_failed"
+	}
+	require.True(t, failed)
+}
+
+func TestTrimmedResponseShare(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 6, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+	for _, addr := range addrs[:5] {
+		require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addr, collateral, addr, "operator"))
+	}
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[5],
+		10, 0, types.AggregationStrategyTrimmedMean)
+	require.NoError(t, err)
+	for i, score := range []int64{50, 60, 70, 80, 90} {
+		require.NoError(t, app.OracleKeeper.RespondToTask(ctx, id, score, types.MaxConfidence, addrs[i]))
+	}
+	require.NoError(t, app.OracleKeeper.Aggregate(ctx, id))
+
+	task, err := app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskStatusSucceeded, task.Status)
+	require.Equal(t, int64(70), task.Result.Int64())
+	task.Bounty = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000))
+	require.NoError(t, app.OracleKeeper.DistributeBounty(ctx, task))
+
+	// the lowest and the highest scores are trimmed from the result and get no reward
+	for i, addr := range addrs[:5] {
+		operator, err := app.OracleKeeper.GetOperator(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, i != 0 && i != 4, operator.AccumulatedRewards.IsAllPositive(), "reward of response %d", i)
+	}
+}
+
+func TestQuorumLowConfidence(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+	require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addrs[0], collateral, addrs[0], "operator"))
+	taskParams := app.OracleKeeper.GetTaskParams(ctx)
+	taskParams.MinResponseCollateral = sdk.NewInt(types.DefaultMinimumCollateral)
+	app.OracleKeeper.SetTaskParams(ctx, taskParams)
+
+	// the quorum counts the collateral of the operator, not its weight scaled by the confidence
+	id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[1],
+		10, 0, types.AggregationStrategyNil)
+	require.NoError(t, err)
+	require.NoError(t, app.OracleKeeper.RespondToTask(ctx, id, 60, 40, addrs[0]))
+	require.NoError(t, app.OracleKeeper.Aggregate(ctx, id))
+	task, err := app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskStatusSucceeded, task.Status)
+	require.Equal(t, int64(types.DefaultMinimumCollateral*40/types.MaxConfidence), task.Responses[0].Weight.Int64())
+}
+
+func TestRefundBountyFailure(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	bounty := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000))
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", bounty, "", ctx.BlockTime(), addrs[0],
+		10, 0, types.AggregationStrategyNil)
+	require.NoError(t, err)
+	// a bounty the module account falls short of
+	task, err := app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+	task.Bounty = bounty.Add(bounty...)
+	app.OracleKeeper.SetTask(ctx, task)
+
+	// the failed task closes without halting the chain, and its bounty is kept in the module account
+	oracleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balance := app.BankKeeper.GetAllBalances(ctx, oracleAddr)
+	closeCtx := ctx.WithBlockHeight(task.ClosingBlock).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { oracle.EndBlocker(closeCtx, app.OracleKeeper) })
+	task, err = app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskStatusFailed, task.Status)
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, oracleAddr))
+	var failed bool
+	for _, event := range closeCtx.EventManager().Events() {
+		failed = failed || event.Type == "refund_task_bounty_failed"
+	}
+	require.True(t, failed)
+}
+
+func TestUnrevealedCommitsSlashingDisabled(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	collateral := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultMinimumCollateral))
+	require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addrs[0], collateral, addrs[0], "operator"))
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "0xcontract", "func", sdk.Coins{}, "", ctx.BlockTime(), addrs[1],
+		10, 5, types.AggregationStrategyNil)
+	require.NoError(t, err)
+	hash := types.ResponseCommitHash(addrs[0], 60, types.MaxConfidence, strings.Repeat("s", types.MinSaltLength))
+	require.NoError(t, app.OracleKeeper.CommitToTask(ctx, id, hash, addrs[0]))
+	task, err := app.OracleKeeper.GetTask(ctx, id)
+	require.NoError(t, err)
+
+	// slashing is disabled without a maximum number of deviations, even with a positive slash fraction
+	slashingParams := app.OracleKeeper.GetSlashingParams(ctx)
+	slashingParams.MaxDeviations = 0
+	app.OracleKeeper.SetSlashingParams(ctx, slashingParams)
+	app.OracleKeeper.HandleUnrevealedCommits(ctx, task)
+	amount, err := app.OracleKeeper.GetCollateralAmount(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, collateral.AmountOf(bondDenom), amount)
+	require.Empty(t, app.OracleKeeper.GetSlashes(ctx, addrs[0]))
+
+	// and the unrevealed commit is slashed once it is enabled
+	slashingParams.MaxDeviations = types.DefaultMaxDeviations
+	app.OracleKeeper.SetSlashingParams(ctx, slashingParams)
+	app.OracleKeeper.HandleUnrevealedCommits(ctx, task)
+	require.Len(t, app.OracleKeeper.GetSlashes(ctx, addrs[0]), 1)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"strconv"
 	"time"
 
@@ -159,13 +160,15 @@ func (k msgServer) CreateTask(goCtx context.Context, msg *types.MsgCreateTask) (
 		expiration = ctx.BlockTime().Add(msg.ValidDuration)
 	}
 
-	if err := k.Keeper.CreateTask(ctx, msg.Contract, msg.Function, msg.Bounty, msg.Description,
-		expiration, creatorAddr, windowSize); err != nil {
+	taskID, err := k.Keeper.CreateTask(ctx, msg.Contract, msg.Function, msg.Bounty, msg.Description,
+		expiration, creatorAddr, windowSize+msg.RevealBlocks, msg.RevealBlocks, msg.AggregationStrategy)
+	if err != nil {
 		return nil, err
 	}
 
 	createTaskEvent := sdk.NewEvent(
 		types.TypeMsgCreateTask,
+		sdk.NewAttribute("task_id", strconv.FormatUint(taskID, 10)),
 		sdk.NewAttribute("contract", msg.Contract),
 		sdk.NewAttribute("function", msg.Function),
 		sdk.NewAttribute("bounty", msg.Bounty.String()),
@@ -173,11 +176,12 @@ func (k msgServer) CreateTask(goCtx context.Context, msg *types.MsgCreateTask) (
 		sdk.NewAttribute("expiration", expiration.String()),
 		sdk.NewAttribute("creator", msg.Creator),
 		sdk.NewAttribute("windowSize", strconv.FormatInt(windowSize, 10)),
-		sdk.NewAttribute("closingHeight", strconv.FormatInt(ctx.BlockHeight()+windowSize, 10)),
+		sdk.NewAttribute("revealBlocks", strconv.FormatInt(msg.RevealBlocks, 10)),
+		sdk.NewAttribute("closingHeight", strconv.FormatInt(ctx.BlockHeight()+windowSize+msg.RevealBlocks, 10)),
 	)
 	ctx.EventManager().EmitEvent(createTaskEvent)
 
-	return &types.MsgCreateTaskResponse{}, nil
+	return &types.MsgCreateTaskResponse{TaskId: taskID}, nil
 }
 
 func (k msgServer) TaskResponse(goCtx context.Context, msg *types.MsgTaskResponse) (*types.MsgTaskResponseResponse, error) {
@@ -188,15 +192,15 @@ func (k msgServer) TaskResponse(goCtx context.Context, msg *types.MsgTaskRespons
 		return nil, err
 	}
 
-	if err := k.Keeper.RespondToTask(ctx, msg.Contract, msg.Function, msg.Score, operatorAddr); err != nil {
+	if err := k.Keeper.RespondToTask(ctx, msg.TaskId, msg.Score, msg.Confidence, operatorAddr); err != nil {
 		return nil, err
 	}
 
 	respondToTaskEvent := sdk.NewEvent(
 		types.TypeMsgRespondToTask,
-		sdk.NewAttribute("contract", msg.Contract),
-		sdk.NewAttribute("function", msg.Function),
+		sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
 		sdk.NewAttribute("score", strconv.FormatInt(msg.Score, 10)),
+		sdk.NewAttribute("confidence", strconv.FormatInt(msg.Confidence, 10)),
 		sdk.NewAttribute("operator", msg.Operator),
 	)
 	ctx.EventManager().EmitEvent(respondToTaskEvent)
@@ -204,26 +208,88 @@ func (k msgServer) TaskResponse(goCtx context.Context, msg *types.MsgTaskRespons
 	return &types.MsgTaskResponseResponse{}, nil
 }
 
+func (k msgServer) CommitTaskResponse(goCtx context.Context, msg *types.MsgCommitTaskResponse) (*types.MsgCommitTaskResponseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CommitToTask(ctx, msg.TaskId, msg.Hash, operatorAddr); err != nil {
+		return nil, err
+	}
+
+	commitToTaskEvent := sdk.NewEvent(
+		types.TypeMsgCommitToTask,
+		sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
+		sdk.NewAttribute("hash", hex.EncodeToString(msg.Hash)),
+		sdk.NewAttribute("operator", msg.Operator),
+	)
+	ctx.EventManager().EmitEvent(commitToTaskEvent)
+
+	return &types.MsgCommitTaskResponseResponse{}, nil
+}
+
+func (k msgServer) RevealTaskResponse(goCtx context.Context, msg *types.MsgRevealTaskResponse) (*types.MsgRevealTaskResponseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevealToTask(ctx, msg.TaskId, msg.Score, msg.Confidence, msg.Salt, operatorAddr); err != nil {
+		return nil, err
+	}
+
+	revealToTaskEvent := sdk.NewEvent(
+		types.TypeMsgRevealToTask,
+		sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
+		sdk.NewAttribute("score", strconv.FormatInt(msg.Score, 10)),
+		sdk.NewAttribute("confidence", strconv.FormatInt(msg.Confidence, 10)),
+		sdk.NewAttribute("operator", msg.Operator),
+	)
+	ctx.EventManager().EmitEvent(revealToTaskEvent)
+
+	return &types.MsgRevealTaskResponseResponse{}, nil
+}
+
 func (k msgServer) InquiryTask(goCtx context.Context, msg *types.MsgInquiryTask) (*types.MsgInquiryTaskResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	task, err := k.Keeper.GetTask(ctx, msg.Contract, msg.Function)
+	inquirerAddr, err := sdk.AccAddressFromBech32(msg.Inquirer)
+	if err != nil {
+		return nil, err
+	}
+
+	task, fee, err := k.Keeper.InquireTask(ctx, msg.Contract, msg.Function, msg.TxHash, inquirerAddr, msg.Callback)
 	if err != nil {
 		return nil, err
 	}
 
 	InquiryTaskEvent := sdk.NewEvent(
 		types.TypeMsgInquireTask,
+		sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
 		sdk.NewAttribute("contract", msg.Contract),
 		sdk.NewAttribute("function", msg.Function),
 		sdk.NewAttribute("txhash", msg.TxHash),
 		sdk.NewAttribute("inquirer", msg.Inquirer),
 		sdk.NewAttribute("result", strconv.FormatUint(task.Result.Uint64(), 10)),
+		sdk.NewAttribute("status", task.Status.String()),
 		sdk.NewAttribute("expiration", task.Expiration.String()),
+		sdk.NewAttribute("fee", fee.String()),
+		sdk.NewAttribute("callback", msg.Callback),
 	)
 	ctx.EventManager().EmitEvent(InquiryTaskEvent)
 
-	return &types.MsgInquiryTaskResponse{}, nil
+	return &types.MsgInquiryTaskResponse{
+		TaskId:       task.Id,
+		Result:       task.Result,
+		Status:       task.Status,
+		ClosingBlock: task.ClosingBlock,
+		Fee:          fee,
+	}, nil
 }
 
 func (k msgServer) DeleteTask(goCtx context.Context, msg *types.MsgDeleteTask) (*types.MsgDeleteTaskResponse, error) {
@@ -234,14 +300,13 @@ func (k msgServer) DeleteTask(goCtx context.Context, msg *types.MsgDeleteTask) (
 		return nil, err
 	}
 
-	if err := k.RemoveTask(ctx, msg.Contract, msg.Function, msg.Force, deleterAddr); err != nil {
+	if err := k.RemoveTask(ctx, msg.TaskId, msg.Force, deleterAddr); err != nil {
 		return nil, err
 	}
 
 	DeleteTaskEvent := sdk.NewEvent(
 		types.TypeMsgDeleteTask,
-		sdk.NewAttribute("contract", msg.Contract),
-		sdk.NewAttribute("function", msg.Function),
+		sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
 		sdk.NewAttribute("creator", msg.Deleter),
 		sdk.NewAttribute("expired", strconv.FormatBool(msg.Force)),
 	)
@@ -105,6 +105,7 @@ func (k Keeper) RemoveOperator(ctx sdk.Context, address sdk.AccAddress) error {
 		operator.AccumulatedRewards); err != nil {
 		return err
 	}
+	k.DeleteOperatorDeviations(ctx, address)
 	return k.DeleteOperator(ctx, address)
 }
 
@@ -203,5 +204,8 @@ func (k Keeper) GetCollateralAmount(ctx sdk.Context, address sdk.AccAddress) (sd
 	if err != nil {
 		return sdk.NewInt(0), err
 	}
+	if operator.Collateral.Empty() {
+		return sdk.NewInt(0), nil
+	}
 	return operator.Collateral[0].Amount, nil
 }
@@ -29,3 +29,20 @@ func (k Keeper) GetLockedPoolParams(ctx sdk.Context) types.LockedPoolParams {
 	k.paramSpace.Get(ctx, types.ParamsStoreKeyPoolParams, &poolParams)
 	return poolParams
 }
+
+// SetSlashingParams sets the current slashing params to the global param store.
+func (k Keeper) SetSlashingParams(ctx sdk.Context, slashingParams types.SlashingParams) {
+	k.paramSpace.Set(ctx, types.ParamsStoreKeySlashingParams, &slashingParams)
+}
+
+// GetSlashingParams gets the current slashing params from the global param store.
+func (k Keeper) GetSlashingParams(ctx sdk.Context) types.SlashingParams {
+	var slashingParams types.SlashingParams
+	k.paramSpace.Get(ctx, types.ParamsStoreKeySlashingParams, &slashingParams)
+	return slashingParams
+}
+
+// HasSlashingParams returns true if the slashing params are set in the global param store.
+func (k Keeper) HasSlashingParams(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.ParamsStoreKeySlashingParams)
+}
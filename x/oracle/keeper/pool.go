@@ -14,6 +14,15 @@ func (k Keeper) CollectBounty(ctx sdk.Context, value sdk.Coins, creator sdk.AccA
 	return nil
 }
 
+// RefundBounty returns the bounty of a task to its creator.
+func (k Keeper) RefundBounty(ctx sdk.Context, task types.Task) error {
+	creatorAddr, err := sdk.AccAddressFromBech32(task.Creator)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, task.Bounty)
+}
+
 // SetTotalCollateral sets total collateral to store.
 func (k Keeper) SetTotalCollateral(ctx sdk.Context, collateral sdk.Coins) {
 	store := ctx.KVStore(k.storeKey)
@@ -13,11 +13,15 @@ import (
 )
 
 const (
-	QueryOperator  = "operator"
-	QueryOperators = "operators"
-	QueryWithdraws = "withdraws"
-	QueryTask      = "task"
-	QueryResponse  = "response"
+	QueryOperator     = "operator"
+	QueryOperators    = "operators"
+	QueryWithdraws    = "withdraws"
+	QueryTask         = "task"
+	QueryTasks        = "tasks"
+	QueryPendingTasks = "pending_tasks"
+	QueryTaskHistory  = "task_history"
+	QueryResponse     = "response"
+	QuerySlashes      = "slashes"
 )
 
 // NewQuerier is the module level router for state queries.
@@ -32,8 +36,16 @@ func NewQuerier(keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier
 			return queryWithdraws(ctx, path[1:], keeper, legacyQuerierCdc)
 		case QueryTask:
 			return queryTask(ctx, path[1:], req, keeper, legacyQuerierCdc)
+		case QueryTasks:
+			return queryTasks(ctx, path[1:], req, keeper, legacyQuerierCdc)
+		case QueryPendingTasks:
+			return queryPendingTasks(ctx, path[1:], req, keeper, legacyQuerierCdc)
+		case QueryTaskHistory:
+			return queryTaskHistory(ctx, path[1:], req, keeper, legacyQuerierCdc)
 		case QueryResponse:
 			return queryResponse(ctx, path[1:], req, keeper, legacyQuerierCdc)
+		case QuerySlashes:
+			return querySlashes(ctx, path[1:], keeper, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -102,7 +114,7 @@ func queryResponse(ctx sdk.Context, path []string, req abci.RequestQuery, k Keep
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	task, err := k.GetTask(ctx, params.Contract, params.Function)
+	task, err := k.GetTask(ctx, params.TaskID)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +147,7 @@ func queryTask(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper,
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	task, err := k.GetTask(ctx, params.Contract, params.Function)
+	task, err := k.GetTask(ctx, params.TaskID)
 	if err != nil {
 		return nil, err
 	}
@@ -145,3 +157,72 @@ func queryTask(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper,
 	}
 	return res, nil
 }
+
+// queryTasks returns information of the tasks matching the filters.
+func queryTasks(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryTasksParams
+	err = legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	tasks := k.GetTasksFiltered(ctx, params)
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, tasks)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryPendingTasks returns information of the tasks pending for an operator.
+func queryPendingTasks(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryPendingTasksParams
+	err = legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	tasks := k.GetPendingTasksForOperator(ctx, params)
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, tasks)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryTaskHistory returns information of all tasks of a contract function.
+func queryTaskHistory(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryTaskHistoryParams
+	err = legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, k.GetTasksByTarget(ctx, params.Contract, params.Function))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// querySlashes returns the slashing history of an operator.
+func querySlashes(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, k.GetSlashes(ctx, address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
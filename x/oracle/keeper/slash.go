@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+// SetOperatorDeviations sets the recent deviation heights of an operator.
+func (k Keeper) SetOperatorDeviations(ctx sdk.Context, deviations types.OperatorDeviations) {
+	store := ctx.KVStore(k.storeKey)
+	addr, err := sdk.AccAddressFromBech32(deviations.Operator)
+	if err != nil {
+		panic(err)
+	}
+	if len(deviations.Heights) == 0 {
+		store.Delete(types.DeviationStoreKey(addr))
+		return
+	}
+	store.Set(types.DeviationStoreKey(addr), k.cdc.MustMarshalBinaryLengthPrefixed(&deviations))
+}
+
+// GetOperatorDeviations gets the recent deviation heights of an operator.
+func (k Keeper) GetOperatorDeviations(ctx sdk.Context, address sdk.AccAddress) types.OperatorDeviations {
+	deviations := types.OperatorDeviations{Operator: address.String()}
+	bz := ctx.KVStore(k.storeKey).Get(types.DeviationStoreKey(address))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deviations)
+	}
+	return deviations
+}
+
+// DeleteOperatorDeviations deletes the recent deviation heights of an operator.
+func (k Keeper) DeleteOperatorDeviations(ctx sdk.Context, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.DeviationStoreKey(address))
+}
+
+// GetAllOperatorDeviations gets the recent deviation heights of all operators.
+func (k Keeper) GetAllOperatorDeviations(ctx sdk.Context) (deviationsList []types.OperatorDeviations) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeviationStoreKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deviations types.OperatorDeviations
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deviations)
+		deviationsList = append(deviationsList, deviations)
+	}
+	return
+}
+
+// AddSlash appends a slash to the slashing history of its operator.
+func (k Keeper) AddSlash(ctx sdk.Context, slash types.Slash) {
+	addr, err := sdk.AccAddressFromBech32(slash.Operator)
+	if err != nil {
+		panic(err)
+	}
+	slashes := append(k.GetSlashes(ctx, addr), slash)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.Slashes{Slashes: slashes})
+	ctx.KVStore(k.storeKey).Set(types.SlashStoreKey(addr), bz)
+}
+
+// GetSlashes gets the slashing history of an operator.
+func (k Keeper) GetSlashes(ctx sdk.Context, address sdk.AccAddress) []types.Slash {
+	var slashes types.Slashes
+	bz := ctx.KVStore(k.storeKey).Get(types.SlashStoreKey(address))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &slashes)
+	}
+	return slashes.Slashes
+}
+
+// GetAllSlashes gets the slashing history of all operators.
+func (k Keeper) GetAllSlashes(ctx sdk.Context) (slashes []types.Slash) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashStoreKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var operatorSlashes types.Slashes
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &operatorSlashes)
+		slashes = append(slashes, operatorSlashes.Slashes...)
+	}
+	return
+}
+
+// HandleResponseDeviations records the responses of a succeeded task
+// deviating from its result beyond the threshold whatever their confidence,
+// and slashes operators whose deviations within the window reach the maximum.
+func (k Keeper) HandleResponseDeviations(ctx sdk.Context, task types.Task) {
+	params := k.GetSlashingParams(ctx)
+	if task.Status != types.TaskStatusSucceeded || !params.IsEnabled() {
+		return
+	}
+	for _, response := range task.Responses {
+		if !params.IsDeviated(response.Score, task.Result) {
+			continue
+		}
+		operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
+		if err != nil {
+			panic(err)
+		}
+		if !k.IsOperator(ctx, operatorAddr) {
+			continue
+		}
+
+		deviations := k.GetOperatorDeviations(ctx, operatorAddr)
+		heights := []int64{}
+		for _, height := range deviations.Heights {
+			if height > ctx.BlockHeight()-params.DeviationWindow {
+				heights = append(heights, height)
+			}
+		}
+		deviations.Heights = append(heights, ctx.BlockHeight())
+		if int64(len(deviations.Heights)) < params.MaxDeviations {
+			k.SetOperatorDeviations(ctx, deviations)
+			continue
+		}
+
+		amount, err := k.SlashOperator(ctx, operatorAddr, params.SlashFraction)
+		if err != nil {
+			panic(err)
+		}
+		k.DeleteOperatorDeviations(ctx, operatorAddr)
+		k.AddSlash(ctx, types.NewSlash(operatorAddr, amount, ctx.BlockHeight(), task, response.Score))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"slash_operator",
+				sdk.NewAttribute("operator", response.Operator),
+				sdk.NewAttribute("amount", amount.String()),
+				sdk.NewAttribute("deviations", strconv.Itoa(len(deviations.Heights))),
+				sdk.NewAttribute("contract", task.Contract),
+				sdk.NewAttribute("function", task.Function),
+				sdk.NewAttribute("score", response.Score.String()),
+				sdk.NewAttribute("result", task.Result.String()),
+			),
+		)
+	}
+}
+
+// HandleUnrevealedCommits slashes operators who committed responses to
+// a task but never revealed them, unless slashing is disabled.
+func (k Keeper) HandleUnrevealedCommits(ctx sdk.Context, task types.Task) {
+	params := k.GetSlashingParams(ctx)
+	if !params.IsEnabled() {
+		return
+	}
+	for _, commit := range task.Commits {
+		if commit.Revealed {
+			continue
+		}
+		operatorAddr, err := sdk.AccAddressFromBech32(commit.Operator)
+		if err != nil {
+			panic(err)
+		}
+		if !k.IsOperator(ctx, operatorAddr) {
+			continue
+		}
+
+		amount, err := k.SlashOperator(ctx, operatorAddr, params.SlashFraction)
+		if err != nil {
+			panic(err)
+		}
+		k.AddSlash(ctx, types.NewUnrevealedSlash(operatorAddr, amount, ctx.BlockHeight(), task))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"slash_operator",
+				sdk.NewAttribute("operator", commit.Operator),
+				sdk.NewAttribute("amount", amount.String()),
+				sdk.NewAttribute("contract", task.Contract),
+				sdk.NewAttribute("function", task.Function),
+				sdk.NewAttribute("unrevealed", "true"),
+			),
+		)
+	}
+}
+
+// SlashOperator slashes a fraction of an operator's collateral to the community pool.
+func (k Keeper) SlashOperator(ctx sdk.Context, address sdk.AccAddress, fraction sdk.Dec) (sdk.Coins, error) {
+	operator, err := k.GetOperator(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	amount, _ := sdk.NewDecCoinsFromCoins(operator.Collateral...).MulDecTruncate(fraction).TruncateDecimal()
+	if amount.IsZero() {
+		return amount, nil
+	}
+	operator.Collateral = operator.Collateral.Sub(amount)
+	k.SetOperator(ctx, operator)
+	if err := k.ReduceTotalCollateral(ctx, amount); err != nil {
+		return nil, err
+	}
+	if err := k.FundCommunityPool(ctx, amount); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
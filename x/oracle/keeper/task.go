@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/binary"
+	"sort"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/certikfoundation/shentu/x/oracle/types"
 )
@@ -12,16 +18,47 @@ var (
 	amplifier = sdk.NewInt(1000000)
 )
 
-// SetTask sets a task in KVStore.
+// SetNextTaskID sets the ID of the next task to be created.
+func (k Keeper) SetNextTaskID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, id)
+	store.Set(types.NextTaskIDStoreKey(), bz)
+}
+
+// GetNextTaskID returns the ID of the next task to be created.
+func (k Keeper) GetNextTaskID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextTaskIDStoreKey())
+	if bz == nil {
+		return 1
+	}
+	return binary.LittleEndian.Uint64(bz)
+}
+
+// SetTask sets a task in KVStore, indexed by its contract function and its creator.
 func (k Keeper) SetTask(ctx sdk.Context, task types.Task) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.TaskStoreKey(task.Contract, task.Function), k.cdc.MustMarshalBinaryLengthPrefixed(&task))
+	store.Set(types.TaskStoreKey(task.Id), k.cdc.MustMarshalBinaryLengthPrefixed(&task))
+
+	creatorAddr, err := sdk.AccAddressFromBech32(task.Creator)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.TargetTaskStoreKey(task.Contract, task.Function, task.Id), types.TaskIDBytes(task.Id))
+	store.Set(types.CreatorTaskStoreKey(creatorAddr, task.Id), types.TaskIDBytes(task.Id))
 }
 
-// DeleteTask deletes a task from KVStore.
+// DeleteTask deletes a task and its indexes from KVStore.
 func (k Keeper) DeleteTask(ctx sdk.Context, task types.Task) error {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.TaskStoreKey(task.Contract, task.Function))
+	store.Delete(types.TaskStoreKey(task.Id))
+
+	creatorAddr, err := sdk.AccAddressFromBech32(task.Creator)
+	if err != nil {
+		return err
+	}
+	store.Delete(types.TargetTaskStoreKey(task.Contract, task.Function, task.Id))
+	store.Delete(types.CreatorTaskStoreKey(creatorAddr, task.Id))
 	return nil
 }
 
@@ -34,9 +71,9 @@ func (k Keeper) UpdateAndSetTask(ctx sdk.Context, task types.Task) {
 	}
 }
 
-// GetTask returns a task given contract and function.
-func (k Keeper) GetTask(ctx sdk.Context, contract, function string) (types.Task, error) {
-	TaskData := ctx.KVStore(k.storeKey).Get(types.TaskStoreKey(contract, function))
+// GetTask returns a task given its ID.
+func (k Keeper) GetTask(ctx sdk.Context, id uint64) (types.Task, error) {
+	TaskData := ctx.KVStore(k.storeKey).Get(types.TaskStoreKey(id))
 	if TaskData == nil {
 		return types.Task{}, types.ErrTaskNotExists
 	}
@@ -45,58 +82,208 @@ func (k Keeper) GetTask(ctx sdk.Context, contract, function string) (types.Task,
 	return task, nil
 }
 
-// SetClosingBlockStore sets the store of the aggregation block for a task.
-func (k Keeper) SetClosingBlockStore(ctx sdk.Context, task types.Task) {
-	store := ctx.KVStore(k.storeKey)
+// getTasksByIndex returns the tasks whose IDs are stored under an index prefix, in the order of creation.
+func (k Keeper) getTasksByIndex(ctx sdk.Context, prefix []byte) (tasks []types.Task) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		task, err := k.GetTask(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		tasks = append(tasks, task)
+	}
+	return
+}
 
-	newTaskID := types.TaskID{Contract: task.Contract, Function: task.Function}
-	taskIDs := append(k.GetClosingTaskIDs(ctx, task.ClosingBlock), newTaskID)
+// GetTasksByTarget returns the tasks of a contract function, in the order of creation.
+func (k Keeper) GetTasksByTarget(ctx sdk.Context, contract, function string) []types.Task {
+	return k.getTasksByIndex(ctx, types.TargetTasksStoreKey(contract, function))
+}
 
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.TaskIDs{TaskIds: taskIDs})
-	store.Set(types.ClosingTaskIDsStoreKey(task.ClosingBlock), bz)
+// GetTasksByCreator returns the tasks created by an address, in the order of creation.
+func (k Keeper) GetTasksByCreator(ctx sdk.Context, creator sdk.AccAddress) []types.Task {
+	return k.getTasksByIndex(ctx, types.CreatorTasksStoreKey(creator))
 }
 
-// GetClosingTaskIDs returns a list of task IDs by the closing block.
-func (k Keeper) GetClosingTaskIDs(ctx sdk.Context, closingBlock int64) []types.TaskID {
-	closingTaskIDsData := ctx.KVStore(k.storeKey).Get(types.ClosingTaskIDsStoreKey(closingBlock))
+// GetLatestTask returns the most recently created task of a contract function.
+func (k Keeper) GetLatestTask(ctx sdk.Context, contract, function string) (types.Task, error) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.TargetTasksStoreKey(contract, function))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.Task{}, types.ErrTaskNotExists
+	}
+	return k.GetTask(ctx, binary.BigEndian.Uint64(iterator.Value()))
+}
 
-	var taskIDsProto types.TaskIDs
-	if closingTaskIDsData != nil {
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(closingTaskIDsData, &taskIDsProto)
+// GetLatestAggregatedTask returns the most recently created task of a contract function which is no longer pending.
+func (k Keeper) GetLatestAggregatedTask(ctx sdk.Context, contract, function string) (types.Task, error) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.TargetTasksStoreKey(contract, function))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		task, err := k.GetTask(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if err == nil && task.Status != types.TaskStatusPending {
+			return task, nil
+		}
 	}
-	return taskIDsProto.TaskIds
+	return types.Task{}, types.ErrTaskNotExists
 }
 
-// DeleteClosingTaskIDs deletes stores for task IDs closed at given block.
+// SetClosingBlockStore adds a task to the closing block queue of its aggregation block.
+func (k Keeper) SetClosingBlockStore(ctx sdk.Context, task types.Task) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClosingTaskStoreKey(task.ClosingBlock, task.Id), types.TaskIDBytes(task.Id))
+}
+
+// GetClosingTaskIDs returns the IDs of the tasks closing at a block, in the order of creation.
+func (k Keeper) GetClosingTaskIDs(ctx sdk.Context, closingBlock int64) (ids []uint64) {
+	k.iterateStore(ctx, types.ClosingTasksStoreKey(closingBlock), func(_, value []byte) {
+		ids = append(ids, binary.BigEndian.Uint64(value))
+	})
+	return
+}
+
+// DeleteClosingTaskIDs deletes the closing block queue of a block.
 func (k Keeper) DeleteClosingTaskIDs(ctx sdk.Context, closingBlock int64) {
-	ctx.KVStore(k.storeKey).Delete(types.ClosingTaskIDsStoreKey(closingBlock))
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	k.iterateStore(ctx, types.ClosingTasksStoreKey(closingBlock), func(key, _ []byte) {
+		keys = append(keys, key)
+	})
+	for _, key := range keys {
+		store.Delete(key)
+	}
 }
 
-// CreateTask creates a new task.
-func (k Keeper) CreateTask(ctx sdk.Context, contract string, function string, bounty sdk.Coins,
-	description string, expiration time.Time, creator sdk.AccAddress, waitingBlocks int64) error {
-	task, err := k.GetTask(ctx, contract, function)
-	if err == nil {
-		if task.ClosingBlock > ctx.BlockHeight() {
-			return types.ErrTaskNotClosed
+// IterateClosingTasks iterates over the tasks in the closing block queue, which are the
+// tasks yet to be aggregated, in the order of their closing blocks.
+func (k Keeper) IterateClosingTasks(ctx sdk.Context, callback func(task types.Task) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClosingTaskStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		task, err := k.GetTask(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if err != nil {
+			continue
 		}
-		if err := k.DeleteTask(ctx, task); err != nil {
-			return err
+		if callback(task) {
+			break
 		}
 	}
+}
+
+// MigrateTaskIDs assigns IDs, in the order of creation, to the tasks stored
+// by contract function before tasks had IDs, and re-keys the tasks, their
+// indexes, the closing block queue and the slashes for them by task ID.
+func (k Keeper) MigrateTaskIDs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var legacyKeys [][]byte
+	var tasks []types.Task
+	k.iterateStore(ctx, types.TaskStoreKeyPrefix, func(key, value []byte) {
+		var task types.Task
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &task)
+		if task.Id == 0 {
+			legacyKeys = append(legacyKeys, key)
+			tasks = append(tasks, task)
+		}
+	})
+	if len(tasks) == 0 {
+		return
+	}
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].BeginBlock < tasks[j].BeginBlock
+	})
+	ids := make(map[[2]string]uint64)
+	nextID := k.GetNextTaskID(ctx)
+	for _, task := range tasks {
+		task.Id = nextID
+		nextID++
+		ids[[2]string{task.Contract, task.Function}] = task.Id
+		k.SetTask(ctx, task)
+	}
+	k.SetNextTaskID(ctx, nextID)
+
+	// The closing block queue was stored as lists of tasks keyed by little endian block heights.
+	var legacyQueueKeys, keys, values [][]byte
+	k.iterateStore(ctx, types.ClosingTaskStoreKeyPrefix, func(key, value []byte) {
+		if len(key) != len(types.ClosingTaskStoreKeyPrefix)+8 {
+			return
+		}
+		legacyQueueKeys = append(legacyQueueKeys, key)
+		closingBlock := int64(binary.LittleEndian.Uint64(key[len(types.ClosingTaskStoreKeyPrefix):]))
+		var taskIDs types.TaskIDs
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &taskIDs)
+		for _, taskID := range taskIDs.TaskIds {
+			id := taskID.Id
+			if id == 0 {
+				id = ids[[2]string{taskID.Contract, taskID.Function}]
+			}
+			keys = append(keys, types.ClosingTaskStoreKey(closingBlock, id))
+			values = append(values, types.TaskIDBytes(id))
+		}
+	})
+	k.iterateStore(ctx, types.SlashStoreKeyPrefix, func(key, value []byte) {
+		var slashes types.Slashes
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &slashes)
+		for i, slash := range slashes.Slashes {
+			if slash.TaskId == 0 {
+				slashes.Slashes[i].TaskId = ids[[2]string{slash.Contract, slash.Function}]
+			}
+		}
+		keys = append(keys, key)
+		values = append(values, k.cdc.MustMarshalBinaryLengthPrefixed(&slashes))
+	})
+	for _, key := range legacyQueueKeys {
+		store.Delete(key)
+	}
+	for i := range keys {
+		store.Set(keys[i], values[i])
+	}
+}
+
+// iterateStore calls a callback function with copies of the keys and the
+// values stored under a prefix, so that the callback may retain them.
+func (k Keeper) iterateStore(ctx sdk.Context, prefix []byte, callback func(key, value []byte)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		callback(append([]byte{}, iterator.Key()...), iterator.Value())
+	}
+}
+
+// CreateTask creates a new task and returns its ID. Tasks of the same
+// contract function may run concurrently, and closed tasks are kept as
+// the history of the contract function.
+func (k Keeper) CreateTask(ctx sdk.Context, contract string, function string, bounty sdk.Coins,
+	description string, expiration time.Time, creator sdk.AccAddress, waitingBlocks int64, revealBlocks int64,
+	aggregationStrategy types.AggregationStrategy) (uint64, error) {
+	if aggregationStrategy == types.AggregationStrategyNil {
+		aggregationStrategy = types.DefaultAggregationStrategy
+	}
+	if !k.GetTaskParams(ctx).IsAggregationStrategyAllowed(aggregationStrategy) {
+		return 0, sdkerrors.Wrapf(types.ErrAggregationStrategyNotAllowed, "%s", aggregationStrategy)
+	}
+
+	id := k.GetNextTaskID(ctx)
 	closingBlock := ctx.BlockHeight() + waitingBlocks
-	task = types.NewTask(contract, function, ctx.BlockHeight(), bounty, description, expiration, creator, closingBlock, waitingBlocks)
+	task := types.NewTask(id, contract, function, ctx.BlockHeight(), bounty, description, expiration, creator, closingBlock,
+		waitingBlocks, revealBlocks, aggregationStrategy)
 	k.SetTask(ctx, task)
+	k.SetNextTaskID(ctx, id+1)
 	k.SetClosingBlockStore(ctx, task)
 	if err := k.CollectBounty(ctx, bounty, creator); err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return id, nil
 }
 
 // RemoveTask removes a task from kvstore if it is closed, expired and requested by its creator.
-func (k Keeper) RemoveTask(ctx sdk.Context, contract, function string, force bool, creator sdk.AccAddress) error {
-	task, err := k.GetTask(ctx, contract, function)
+func (k Keeper) RemoveTask(ctx sdk.Context, id uint64, force bool, creator sdk.AccAddress) error {
+	task, err := k.GetTask(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -158,6 +345,90 @@ func (k Keeper) UpdateAndGetAllTasks(ctx sdk.Context) (tasks []types.Task) {
 	return
 }
 
+// paginateTasks returns the page of tasks selected by page and limit.
+func paginateTasks(tasks []types.Task, page, limit int) []types.Task {
+	start, end := client.Paginate(len(tasks), page, limit, 100)
+	if start < 0 || end < 0 {
+		return []types.Task{}
+	}
+	return tasks[start:end]
+}
+
+// tasksStore returns the store to look up the tasks matching the parameters in, which is the
+// creator index or the closing block queue of pending tasks if possible, and whether its values
+// are task IDs rather than tasks.
+func (k Keeper) tasksStore(ctx sdk.Context, params types.QueryTasksParams) (sdk.KVStore, bool) {
+	store := ctx.KVStore(k.storeKey)
+	switch {
+	case len(params.Creator) != 0:
+		return prefix.NewStore(store, types.CreatorTasksStoreKey(params.Creator)), true
+	case params.Status == types.TaskStatusPending:
+		return prefix.NewStore(store, types.ClosingTaskStoreKeyPrefix), true
+	default:
+		return prefix.NewStore(store, types.TaskStoreKeyPrefix), false
+	}
+}
+
+// getTaskFromStore returns the task stored as a value of a store returned by tasksStore.
+func (k Keeper) getTaskFromStore(ctx sdk.Context, value []byte, indexed bool) (types.Task, error) {
+	if indexed {
+		return k.GetTask(ctx, binary.BigEndian.Uint64(value))
+	}
+	var task types.Task
+	err := k.cdc.UnmarshalBinaryLengthPrefixed(value, &task)
+	return task, err
+}
+
+// GetTasksFiltered returns the page of tasks matching the filters selected by page and limit.
+func (k Keeper) GetTasksFiltered(ctx sdk.Context, params types.QueryTasksParams) []types.Task {
+	filteredTasks := []types.Task{}
+	store, indexed := k.tasksStore(ctx, params)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		task, err := k.getTaskFromStore(ctx, iterator.Value(), indexed)
+		if err == nil && params.Matches(task) {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+	return paginateTasks(filteredTasks, params.Page, params.Limit)
+}
+
+// isPendingForOperator returns true if a task still expects an action from an operator,
+// which is a response or a commit if the operator has not answered yet, or a reveal of its commit.
+func isPendingForOperator(ctx sdk.Context, task types.Task, operator string) bool {
+	if task.Status != types.TaskStatusPending || ctx.BlockHeight() > task.ClosingBlock {
+		return false
+	}
+	for _, response := range task.Responses {
+		if response.Operator == operator {
+			return false
+		}
+	}
+	if !task.IsCommitReveal() {
+		return true
+	}
+	for _, commit := range task.Commits {
+		if commit.Operator == operator {
+			return ctx.BlockHeight() > task.CommitClosingBlock()
+		}
+	}
+	return ctx.BlockHeight() <= task.CommitClosingBlock()
+}
+
+// GetPendingTasksForOperator returns the page of tasks pending for an operator selected by page
+// and limit, in the order of their closing blocks.
+func (k Keeper) GetPendingTasksForOperator(ctx sdk.Context, params types.QueryPendingTasksParams) []types.Task {
+	pendingTasks := []types.Task{}
+	k.IterateClosingTasks(ctx, func(task types.Task) bool {
+		if isPendingForOperator(ctx, task, params.Operator.String()) {
+			pendingTasks = append(pendingTasks, task)
+		}
+		return false
+	})
+	return paginateTasks(pendingTasks, params.Page, params.Limit)
+}
+
 // IsValidResponse returns error if a response is not valid.
 func (k Keeper) IsValidResponse(ctx sdk.Context, task types.Task, response types.Response) error {
 	if ctx.BlockHeight() > task.ClosingBlock {
@@ -175,32 +446,108 @@ func (k Keeper) IsValidResponse(ctx sdk.Context, task types.Task, response types
 }
 
 // RespondToTask records the response from an operator for a task.
-func (k Keeper) RespondToTask(ctx sdk.Context, contract string, function string, score int64, operatorAddress sdk.AccAddress) error {
+func (k Keeper) RespondToTask(ctx sdk.Context, id uint64, score, confidence int64, operatorAddress sdk.AccAddress) error {
+	if !k.IsOperator(ctx, operatorAddress) {
+		return types.ErrUnqualifiedOperator
+	}
+
+	task, err := k.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if task.IsCommitReveal() {
+		return types.ErrCommitRequired
+	}
+
+	response := types.NewResponse(sdk.NewInt(score), confidence, operatorAddress)
+	err = k.IsValidResponse(ctx, task, response)
+	if err != nil {
+		return err
+	}
+
+	task.Responses = append(task.Responses, response)
+	k.SetTask(ctx, task)
+
+	return nil
+}
+
+// CommitToTask records the hash of a response from an operator during the commit phase of a task.
+func (k Keeper) CommitToTask(ctx sdk.Context, id uint64, hash []byte, operatorAddress sdk.AccAddress) error {
+	if !k.IsOperator(ctx, operatorAddress) {
+		return types.ErrUnqualifiedOperator
+	}
+
+	task, err := k.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !task.IsCommitReveal() {
+		return types.ErrCommitNotAccepted
+	}
+	if ctx.BlockHeight() > task.CommitClosingBlock() {
+		return types.ErrCommitClosed
+	}
+	for _, commit := range task.Commits {
+		if commit.Operator == operatorAddress.String() {
+			return types.ErrDuplicateResponse
+		}
+	}
+
+	task.Commits = append(task.Commits, types.NewResponseCommit(hash, operatorAddress))
+	k.SetTask(ctx, task)
+
+	return nil
+}
+
+// RevealToTask records the response from an operator matching its commit during the reveal phase of a task.
+func (k Keeper) RevealToTask(ctx sdk.Context, id uint64, score, confidence int64, salt string, operatorAddress sdk.AccAddress) error {
 	if !k.IsOperator(ctx, operatorAddress) {
 		return types.ErrUnqualifiedOperator
 	}
 
-	task, err := k.GetTask(ctx, contract, function)
+	task, err := k.GetTask(ctx, id)
 	if err != nil {
 		return err
 	}
+	if !task.IsCommitReveal() {
+		return types.ErrCommitNotAccepted
+	}
+	if ctx.BlockHeight() <= task.CommitClosingBlock() {
+		return types.ErrRevealNotStarted
+	}
 
-	response := types.NewResponse(sdk.NewInt(score), operatorAddress)
+	index := -1
+	for i, commit := range task.Commits {
+		if commit.Operator == operatorAddress.String() {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return types.ErrCommitNotFound
+	}
+	if !bytes.Equal(task.Commits[index].Hash, types.ResponseCommitHash(operatorAddress, score, confidence, salt)) {
+		return types.ErrInvalidReveal
+	}
+
+	response := types.NewResponse(sdk.NewInt(score), confidence, operatorAddress)
 	err = k.IsValidResponse(ctx, task, response)
 	if err != nil {
 		return err
 	}
 
+	task.Commits[index].Revealed = true
 	task.Responses = append(task.Responses, response)
 	k.SetTask(ctx, task)
 
 	return nil
 }
 
-// Aggregate does an aggregation of responses for a task and updated task result.
-func (k Keeper) Aggregate(ctx sdk.Context, contract, function string) error {
+// Aggregate does an aggregation of responses for a task with its aggregation strategy and updates the task result.
+// The task fails if its responses do not reach the quorum of the task parameters.
+func (k Keeper) Aggregate(ctx sdk.Context, id uint64) error {
 	taskParams := k.GetTaskParams(ctx)
-	task, err := k.GetTask(ctx, contract, function)
+	task, err := k.GetTask(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -209,9 +556,16 @@ func (k Keeper) Aggregate(ctx sdk.Context, contract, function string) error {
 		return types.ErrTaskClosed
 	}
 
-	result := taskParams.AggregationResult
-	totalCollateral := sdk.NewInt(0)
-	minScoreCollateral := sdk.NewInt(0)
+	// Tasks created before aggregation strategies were introduced use the default one.
+	if task.AggregationStrategy == types.AggregationStrategyNil {
+		task.AggregationStrategy = types.DefaultAggregationStrategy
+	}
+	aggregator, err := types.NewAggregator(task.AggregationStrategy)
+	if err != nil {
+		return err
+	}
+
+	collaterals := make([]sdk.Int, len(task.Responses))
 	for i, response := range task.Responses {
 		operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
 		if err != nil {
@@ -219,162 +573,107 @@ func (k Keeper) Aggregate(ctx sdk.Context, contract, function string) error {
 		}
 		amount, err := k.GetCollateralAmount(ctx, operatorAddr)
 		if err != nil {
-			continue
-		}
-		result = result.Add(response.Score.Mul(amount))
-		task.Responses[i].Weight = amount
-		totalCollateral = totalCollateral.Add(amount)
-		if response.Score.Equal(types.MinScore) {
-			minScoreCollateral = minScoreCollateral.Add(amount)
+			amount = sdk.NewInt(0)
 		}
+		collaterals[i] = amount
+		task.Responses[i].Weight = response.ConfidenceWeighted(amount)
 	}
 
-	if totalCollateral.IsPositive() {
-		if minScoreCollateral.MulRaw(3).GTE(totalCollateral) {
-			result = minScoreCollateral
-			for i, response := range task.Responses {
-				if !response.Score.Equal(types.MinScore) {
-					task.Responses[i].Weight = sdk.NewInt(0)
-				}
-			}
-		} else {
-			result = result.Quo(totalCollateral)
-		}
+	if !taskParams.IsQuorumReached(collaterals) {
+		task.Result = taskParams.AggregationResult
+		task.Status = types.TaskStatusFailed
+	} else if result, ok := aggregator.Aggregate(task.Responses, taskParams); ok {
+		task.Result = result
 		task.Status = types.TaskStatusSucceeded
 	} else {
+		task.Result = taskParams.AggregationResult
 		task.Status = types.TaskStatusFailed
 	}
-	task.Result = result
 	k.SetTask(ctx, task)
 	return nil
 }
 
+// validResponseShare returns the share of the bounty of a task a response is valid for, and false if it is not.
+// The share is weighted by the collateral of the operator and by the confidence of the response.
+// Responses left out of the result by the aggregator, and responses deviating from the result
+// beyond the deviation threshold, get no share, whatever their confidence.
+func (k Keeper) validResponseShare(ctx sdk.Context, task types.Task, response types.Response,
+	taskParams types.TaskParams, slashingParams types.SlashingParams) (sdk.Int, bool) {
+	if !response.Weight.IsPositive() {
+		return sdk.Int{}, false
+	}
+	operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
+	if err != nil {
+		panic(err)
+	}
+	collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
+	if err != nil {
+		return sdk.Int{}, false
+	}
+
+	var share sdk.Int
+	switch {
+	case task.Result.Equal(types.MinScore):
+		if !response.Score.Equal(types.MinScore) {
+			return sdk.Int{}, false
+		}
+		share = collateral
+	case task.Result.LT(taskParams.ThresholdScore):
+		if !response.Score.LT(taskParams.ThresholdScore) {
+			return sdk.Int{}, false
+		}
+		share = amplifier.Mul(collateral).Quo(response.Score.Add(taskParams.Epsilon1))
+	default:
+		if !response.Score.GTE(taskParams.ThresholdScore) {
+			return sdk.Int{}, false
+		}
+		share = amplifier.Mul(collateral).Quo(types.MaxScore.Sub(response.Score).Add(taskParams.Epsilon2))
+	}
+
+	if slashingParams.IsDeviated(response.Score, task.Result) {
+		return sdk.Int{}, false
+	}
+	return share.MulRaw(response.EffectiveConfidence()).QuoRaw(types.MaxConfidence), true
+}
+
 // TotalValidTaskCollateral calculates the total amount of valid collateral of a task.
 func (k Keeper) TotalValidTaskCollateral(ctx sdk.Context, task types.Task) sdk.Int {
 	taskParams := k.GetTaskParams(ctx)
+	slashingParams := k.GetSlashingParams(ctx)
 	totalValidTaskCollateral := sdk.NewInt(0)
-	if task.Result.Equal(types.MinScore) {
-		for _, response := range task.Responses {
-			if response.Score.Equal(types.MinScore) {
-				operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
-				if err != nil {
-					panic(err)
-				}
-				collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
-				if err != nil {
-					continue
-				}
-				totalValidTaskCollateral = totalValidTaskCollateral.Add(collateral)
-			}
-		}
-	} else if task.Result.LT(taskParams.ThresholdScore) {
-		for _, response := range task.Responses {
-			if response.Score.LT(taskParams.ThresholdScore) {
-				operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
-				if err != nil {
-					panic(err)
-				}
-				collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
-				if err != nil {
-					continue
-				}
-				totalValidTaskCollateral = totalValidTaskCollateral.Add(
-					amplifier.Mul(collateral).Quo(response.Score.Add(taskParams.Epsilon1)),
-				)
-			}
-		}
-	} else {
-		for _, response := range task.Responses {
-			if response.Score.GTE(taskParams.ThresholdScore) {
-				operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
-				if err != nil {
-					panic(err)
-				}
-				collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
-				if err != nil {
-					continue
-				}
-				totalValidTaskCollateral = totalValidTaskCollateral.Add(
-					amplifier.Mul(collateral).Quo(types.MaxScore.Sub(response.Score).Add(taskParams.Epsilon2)),
-				)
-			}
+	for _, response := range task.Responses {
+		if share, ok := k.validResponseShare(ctx, task, response, taskParams, slashingParams); ok {
+			totalValidTaskCollateral = totalValidTaskCollateral.Add(share)
 		}
 	}
 	return totalValidTaskCollateral
 }
 
-// TODO: this is a simplified version (without confidence calculation)
-
-// DistributeBounty distributes bounty to operators based on responses and the aggregation result.
+// DistributeBounty distributes bounty to operators based on responses, their confidences and the aggregation result.
 func (k Keeper) DistributeBounty(ctx sdk.Context, task types.Task) error {
 	taskParams := k.GetTaskParams(ctx)
+	slashingParams := k.GetSlashingParams(ctx)
 	totalValidTaskCollateral := k.TotalValidTaskCollateral(ctx, task)
 	if totalValidTaskCollateral.IsZero() {
 		return types.ErrTaskFailed
 	}
 
 	for _, bounty := range task.Bounty {
-		if task.Result.Equal(types.MinScore) {
-			for i, response := range task.Responses {
-				if response.Score.Equal(types.MinScore) {
-					operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
-					if err != nil {
-						panic(err)
-					}
-					collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
-					if err != nil {
-						continue
-					}
-					amount := bounty.Amount.Mul(collateral).Quo(totalValidTaskCollateral)
-					reward := sdk.NewCoins(sdk.NewCoin(bounty.Denom, amount))
-					if err := k.AddReward(ctx, operatorAddr, reward); err != nil {
-						continue
-					}
-					task.Responses[i].Reward = reward
-				}
+		for i, response := range task.Responses {
+			share, ok := k.validResponseShare(ctx, task, response, taskParams, slashingParams)
+			if !ok {
+				continue
 			}
-		} else if task.Result.LT(taskParams.ThresholdScore) {
-			for i, response := range task.Responses {
-				if response.Score.LT(taskParams.ThresholdScore) {
-					operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
-					if err != nil {
-						panic(err)
-					}
-					collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
-					if err != nil {
-						continue
-					}
-					amount := bounty.Amount.Mul(
-						amplifier.Mul(collateral).Quo(response.Score.Add(taskParams.Epsilon1)),
-					).Quo(totalValidTaskCollateral)
-					reward := sdk.NewCoins(sdk.NewCoin(task.Bounty[0].Denom, amount))
-					if err := k.AddReward(ctx, operatorAddr, reward); err != nil {
-						continue
-					}
-					task.Responses[i].Reward = reward
-				}
+			operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
+			if err != nil {
+				panic(err)
 			}
-		} else {
-			for i, response := range task.Responses {
-				if response.Score.GTE(taskParams.ThresholdScore) {
-					operatorAddr, err := sdk.AccAddressFromBech32(response.Operator)
-					if err != nil {
-						panic(err)
-					}
-					collateral, err := k.GetCollateralAmount(ctx, operatorAddr)
-					if err != nil {
-						continue
-					}
-					amount := bounty.Amount.Mul(
-						amplifier.Mul(collateral).Quo(types.MaxScore.Sub(response.Score).Add(taskParams.Epsilon2)),
-					).Quo(totalValidTaskCollateral)
-					reward := sdk.NewCoins(sdk.NewCoin(bounty.Denom, amount))
-					if err := k.AddReward(ctx, operatorAddr, reward); err != nil {
-						continue
-					}
-					task.Responses[i].Reward = reward
-				}
+			amount := bounty.Amount.Mul(share).Quo(totalValidTaskCollateral)
+			reward := sdk.NewCoins(sdk.NewCoin(bounty.Denom, amount))
+			if err := k.AddReward(ctx, operatorAddr, reward); err != nil {
+				continue
 			}
+			task.Responses[i].Reward = reward
 		}
 	}
 	k.SetTask(ctx, task)
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -39,11 +40,31 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &taskB)
 			return fmt.Sprintf("%v\n%v", taskA, taskB)
 
-		case bytes.Equal(kvA.Key[:1], types.ClosingTaskStoreKeyPrefix):
-			var taskIDsA, taskIDsB types.TaskIDs
-			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &taskIDsA)
-			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &taskIDsB)
-			return fmt.Sprintf("%v\n%v", taskIDsA.TaskIds, taskIDsB.TaskIds)
+		case bytes.Equal(kvA.Key[:1], types.DeviationStoreKeyPrefix):
+			var deviationsA, deviationsB types.OperatorDeviations
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &deviationsA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &deviationsB)
+			return fmt.Sprintf("%v\n%v", deviationsA, deviationsB)
+
+		case bytes.Equal(kvA.Key[:1], types.SlashStoreKeyPrefix):
+			var slashesA, slashesB types.Slashes
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &slashesA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &slashesB)
+			return fmt.Sprintf("%v\n%v", slashesA.Slashes, slashesB.Slashes)
+
+		case bytes.Equal(kvA.Key[:1], types.NextTaskIDKey):
+			return fmt.Sprintf("%v\n%v", binary.LittleEndian.Uint64(kvA.Value), binary.LittleEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ClosingTaskStoreKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.TargetTaskStoreKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.CreatorTaskStoreKeyPrefix):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.InquiryStoreKeyPrefix):
+			var inquiriesA, inquiriesB types.Inquiries
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &inquiriesA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &inquiriesB)
+			return fmt.Sprintf("%v\n%v", inquiriesA.Inquiries, inquiriesB.Inquiries)
 
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
@@ -1,6 +1,7 @@
 package simulation_test
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -43,6 +44,7 @@ func TestDecodeStore(t *testing.T) {
 	totalCollateral := RandomCoins(1000000)
 
 	task := types.Task{
+		Id:            uint64(rand.Int63n(1000) + 1),
 		Contract:      RandomString(30),
 		Function:      RandomString(15),
 		Bounty:        RandomCoins(100000),
@@ -56,10 +58,29 @@ func TestDecodeStore(t *testing.T) {
 		Status:        types.TaskStatus(rand.Intn(4)),
 	}
 
-	taskIDs := []types.TaskID{
+	deviations := types.OperatorDeviations{
+		Operator: operator.Address,
+		Heights:  []int64{rand.Int63n(1000), rand.Int63n(1000) + 1000},
+	}
+
+	slashes := []types.Slash{
 		{
+			Operator: operator.Address,
+			Amount:   RandomCoins(1000),
+			Height:   rand.Int63n(10000),
 			Contract: task.Contract,
 			Function: task.Function,
+			Score:    sdk.NewInt(rand.Int63n(100)),
+			Result:   task.Result,
+		},
+	}
+
+	inquiries := []types.Inquiry{
+		{
+			TaskId:   task.Id,
+			Inquirer: withdraw.Address,
+			Fee:      RandomCoins(1000),
+			TxHash:   "txhash",
 		},
 	}
 
@@ -67,13 +88,20 @@ func TestDecodeStore(t *testing.T) {
 	require.NoError(t, err)
 	withdrawAddr, err := sdk.AccAddressFromBech32(withdraw.Address)
 	require.NoError(t, err)
+	nextTaskIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nextTaskIDBytes, task.Id+1)
 	KVPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.OperatorStoreKey(operatorAddr), Value: cdc.MustMarshalBinaryLengthPrefixed(&operator)},
 			{Key: types.WithdrawStoreKey(withdrawAddr, withdraw.DueBlock), Value: cdc.MustMarshalBinaryLengthPrefixed(&withdraw)},
 			{Key: types.TotalCollateralKey(), Value: cdc.MustMarshalBinaryLengthPrefixed(&types.CoinsProto{Coins: totalCollateral})},
-			{Key: types.TaskStoreKey(task.Contract, task.Function), Value: cdc.MustMarshalBinaryLengthPrefixed(&task)},
-			{Key: types.ClosingTaskIDsStoreKey(task.ClosingBlock), Value: cdc.MustMarshalBinaryLengthPrefixed(&types.TaskIDs{TaskIds: taskIDs})},
+			{Key: types.TaskStoreKey(task.Id), Value: cdc.MustMarshalBinaryLengthPrefixed(&task)},
+			{Key: types.ClosingTaskStoreKey(task.ClosingBlock, task.Id), Value: types.TaskIDBytes(task.Id)},
+			{Key: types.DeviationStoreKey(operatorAddr), Value: cdc.MustMarshalBinaryLengthPrefixed(&deviations)},
+			{Key: types.SlashStoreKey(operatorAddr), Value: cdc.MustMarshalBinaryLengthPrefixed(&types.Slashes{Slashes: slashes})},
+			{Key: types.NextTaskIDStoreKey(), Value: nextTaskIDBytes},
+			{Key: types.TargetTaskStoreKey(task.Contract, task.Function, task.Id), Value: types.TaskIDBytes(task.Id)},
+			{Key: types.InquiryStoreKey(task.Id), Value: cdc.MustMarshalBinaryLengthPrefixed(&types.Inquiries{Inquiries: inquiries})},
 		},
 	}
 
@@ -85,7 +113,12 @@ func TestDecodeStore(t *testing.T) {
 		{"Withdraw", fmt.Sprintf("%v\n%v", withdraw, withdraw)},
 		{"TotalCollateral", fmt.Sprintf("%s\n%s", totalCollateral, totalCollateral)},
 		{"Task", fmt.Sprintf("%v\n%v", task, task)},
-		{"TaskIDs", fmt.Sprintf("%v\n%v", taskIDs, taskIDs)},
+		{"ClosingTask", fmt.Sprintf("%v\n%v", task.Id, task.Id)},
+		{"Deviations", fmt.Sprintf("%v\n%v", deviations, deviations)},
+		{"Slashes", fmt.Sprintf("%v\n%v", slashes, slashes)},
+		{"NextTaskID", fmt.Sprintf("%v\n%v", task.Id+1, task.Id+1)},
+		{"TargetTask", fmt.Sprintf("%v\n%v", task.Id, task.Id)},
+		{"Inquiries", fmt.Sprintf("%v\n%v", inquiries, inquiries)},
 		{"other", ""},
 	}
 
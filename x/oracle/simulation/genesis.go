@@ -26,6 +26,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 			taskParams = GenTaskParams(r)
 		})
 
+	var slashingParams types.SlashingParams
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.ParamsStoreKeySlashingParams), &slashingParams, simState.Rand,
+		func(r *rand.Rand) {
+			slashingParams = GenSlashingParams(r)
+		})
+
 	gs := types.NewGenesisState(
 		nil,
 		nil,
@@ -33,6 +40,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		taskParams,
 		nil,
 		nil,
+		slashingParams,
+		nil,
+		nil,
+		1,
+		nil,
 	)
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gs)
@@ -55,5 +67,33 @@ func GenTaskParams(r *rand.Rand) types.TaskParams {
 		ThresholdScore:     sdk.NewInt(r.Int63n(100)),
 		Epsilon1:           sdk.NewInt(r.Int63n(10)),
 		Epsilon2:           sdk.NewInt(r.Int63n(10) + 90),
+
+		AllowedAggregationStrategies: GenAllowedAggregationStrategies(r),
+		TrimFraction:                 sdk.NewDecWithPrec(r.Int63n(50), 2),
+		MajorityQuorum:               sdk.NewDecWithPrec(r.Int63n(50)+51, 2),
+		MinResponses:                 r.Int63n(3),
+		MinResponseCollateral:        sdk.NewInt(r.Int63n(1000)),
+		InquiryFee:                   sdk.NewInt(r.Int63n(1000)),
+	}
+}
+
+// GenAllowedAggregationStrategies returns a random subset of the aggregation strategies.
+func GenAllowedAggregationStrategies(r *rand.Rand) []types.AggregationStrategy {
+	var strategies []types.AggregationStrategy
+	for _, strategy := range types.AggregationStrategies {
+		if r.Intn(2) == 0 {
+			strategies = append(strategies, strategy)
+		}
+	}
+	return strategies
+}
+
+// GenSlashingParams returns a randomized SlashingParams object.
+func GenSlashingParams(r *rand.Rand) types.SlashingParams {
+	return types.SlashingParams{
+		DeviationThreshold: sdk.NewInt(r.Int63n(100)),
+		DeviationWindow:    r.Int63n(100),
+		MaxDeviations:      r.Int63n(5),
+		SlashFraction:      sdk.NewDecWithPrec(r.Int63n(100), 3),
 	}
 }
@@ -90,22 +90,23 @@ func SimulateMsgCreateOperator(k keeper.Keeper, ak types.AccountKeeper, bk types
 		}
 
 		stdOperator := types.NewOperator(operator.Address, operator.Address, collateral, nil, "an operator")
+		slashCount := len(k.GetSlashes(ctx, operator.Address))
 		futureOperations := []simtypes.FutureOperation{
 			{
 				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 0, 20),
-				Op:          SimulateMsgAddCollateral(k, ak, bk, &stdOperator, operator.PrivKey),
+				Op:          SimulateMsgAddCollateral(k, ak, bk, &stdOperator, &slashCount, operator.PrivKey),
 			},
 			{
 				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 0, 20),
-				Op:          SimulateMsgReduceCollateral(k, ak, bk, &stdOperator, operator.PrivKey),
+				Op:          SimulateMsgReduceCollateral(k, ak, bk, &stdOperator, &slashCount, operator.PrivKey),
 			},
 			{
 				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 0, 20),
-				Op:          SimulateMsgWithdrawReward(k, ak, bk, &stdOperator, operator.PrivKey),
+				Op:          SimulateMsgWithdrawReward(k, ak, bk, &stdOperator, &slashCount, operator.PrivKey),
 			},
 			{
 				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 20, 25),
-				Op:          SimulateMsgRemoveOperator(k, ak, bk, &stdOperator, operator.PrivKey),
+				Op:          SimulateMsgRemoveOperator(k, ak, bk, &stdOperator, &slashCount, operator.PrivKey),
 			},
 		}
 
@@ -115,7 +116,7 @@ func SimulateMsgCreateOperator(k keeper.Keeper, ak types.AccountKeeper, bk types
 
 // SimulateMsgAddCollateral generates a MsgAddCollateral object with all of its fields randomized.
 func SimulateMsgAddCollateral(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, stdOperator *types.Operator,
-	operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
+	slashCount *int, operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		stdOperatorAddr, err := sdk.AccAddressFromBech32(stdOperator.Address)
@@ -127,6 +128,7 @@ func SimulateMsgAddCollateral(k keeper.Keeper, ak types.AccountKeeper, bk types.
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddCollateral, err.Error()), nil, err
 		}
 
+		syncSlashes(ctx, k, stdOperator, slashCount)
 		if err := checkConsistency(operator, *stdOperator); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddCollateral, err.Error()), nil, err
 		}
@@ -172,7 +174,7 @@ func SimulateMsgAddCollateral(k keeper.Keeper, ak types.AccountKeeper, bk types.
 
 // SimulateMsgReduceCollateral generates a MsgReduceCollateral object with all of its fields randomized.
 func SimulateMsgReduceCollateral(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, stdOperator *types.Operator,
-	operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
+	slashCount *int, operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		stdOperatorAddr, err := sdk.AccAddressFromBech32(stdOperator.Address)
@@ -184,6 +186,7 @@ func SimulateMsgReduceCollateral(k keeper.Keeper, ak types.AccountKeeper, bk typ
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReduceCollateral, err.Error()), nil, err
 		}
 
+		syncSlashes(ctx, k, stdOperator, slashCount)
 		if err := checkConsistency(operator, *stdOperator); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReduceCollateral, err.Error()), nil, err
 		}
@@ -234,7 +237,7 @@ func SimulateMsgReduceCollateral(k keeper.Keeper, ak types.AccountKeeper, bk typ
 
 // SimulateMsgRemoveOperator generates a MsgRemoveOperator object with all of its fields randomized.
 func SimulateMsgRemoveOperator(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper,
-	stdOperator *types.Operator, operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
+	stdOperator *types.Operator, slashCount *int, operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		stdOperatorAddr, err := sdk.AccAddressFromBech32(stdOperator.Address)
@@ -246,6 +249,7 @@ func SimulateMsgRemoveOperator(k keeper.Keeper, ak types.AccountKeeper, bk types
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveOperator, err.Error()), nil, err
 		}
 
+		syncSlashes(ctx, k, stdOperator, slashCount)
 		if err := checkConsistency(operator, *stdOperator); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveOperator, err.Error()), nil, err
 		}
@@ -288,7 +292,7 @@ func SimulateMsgRemoveOperator(k keeper.Keeper, ak types.AccountKeeper, bk types
 
 // SimulateMsgWithdrawReward generates a MsgWithdrawReward object with all of its fields randomized.
 func SimulateMsgWithdrawReward(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper,
-	stdOperator *types.Operator, operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
+	stdOperator *types.Operator, slashCount *int, operatorPrivKey cryptotypes.PrivKey) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		stdOperatorAddr, err := sdk.AccAddressFromBech32(stdOperator.Address)
@@ -300,6 +304,7 @@ func SimulateMsgWithdrawReward(k keeper.Keeper, ak types.AccountKeeper, bk types
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawReward, err.Error()), nil, err
 		}
 
+		syncSlashes(ctx, k, stdOperator, slashCount)
 		if err := checkConsistency(operator, *stdOperator); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawReward, err.Error()), nil, err
 		}
@@ -340,6 +345,19 @@ func SimulateMsgWithdrawReward(k keeper.Keeper, ak types.AccountKeeper, bk types
 	}
 }
 
+// syncSlashes applies the slashes of an operator since the last sync to its expected collateral.
+func syncSlashes(ctx sdk.Context, k keeper.Keeper, stdOperator *types.Operator, slashCount *int) {
+	operatorAddr, err := sdk.AccAddressFromBech32(stdOperator.Address)
+	if err != nil {
+		panic(err)
+	}
+	slashes := k.GetSlashes(ctx, operatorAddr)
+	for _, slash := range slashes[*slashCount:] {
+		stdOperator.Collateral = stdOperator.Collateral.Sub(slash.Amount)
+	}
+	*slashCount = len(slashes)
+}
+
 func checkConsistency(operator1, operator2 types.Operator) error {
 	if operator1.Address != operator2.Address || operator1.Proposer != operator2.Proposer ||
 		!operator1.Collateral.IsEqual(operator2.Collateral) || operator1.Name != operator2.Name {
@@ -360,8 +378,17 @@ func SimulateMsgCreateTask(ak types.AccountKeeper, k keeper.Keeper, bk types.Ban
 		creatorAcc := ak.GetAccount(ctx, creator.Address)
 		bounty := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, creatorAcc.GetAddress()))
 		wait := simtypes.RandIntBetween(r, 5, 20)
+		reveal := 0
+		if r.Intn(2) == 0 {
+			reveal = simtypes.RandIntBetween(r, 3, 8)
+		}
+
+		strategies := append([]types.AggregationStrategy{types.DefaultAggregationStrategy},
+			k.GetTaskParams(ctx).AllowedAggregationStrategies...)
+		strategy := strategies[r.Intn(len(strategies))]
 
-		msg := types.NewMsgCreateTask(contract, function, bounty, description, creator.Address, int64(wait), time.Duration(0))
+		msg := types.NewMsgCreateTask(contract, function, bounty, description, creator.Address, int64(wait),
+			time.Duration(0), int64(reveal), strategy)
 
 		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, creatorAcc.GetAddress()).Sub(bounty))
 		if err != nil {
@@ -383,6 +410,7 @@ func SimulateMsgCreateTask(ak types.AccountKeeper, k keeper.Keeper, bk types.Ban
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
 		}
 
+		taskID := k.GetNextTaskID(ctx)
 		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
@@ -391,19 +419,39 @@ func SimulateMsgCreateTask(ak types.AccountKeeper, k keeper.Keeper, bk types.Ban
 		futureOperations := []simtypes.FutureOperation{
 			{
 				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 0, 20),
-				Op:          SimulateMsgInquiryTask(ak, bk, contract, function),
+				Op:          SimulateMsgInquiryTask(ak, k, bk, contract, function),
 			},
 			{
-				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 20, 25),
-				Op:          SimulateMsgDeleteTask(ak, bk, contract, function, creator),
+				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 20, 25) + reveal,
+				Op:          SimulateMsgDeleteTask(ak, bk, taskID, creator),
 			},
 		}
 
+		commitClosingBlock := int(ctx.BlockHeight()) + wait
 		for _, acc := range accs {
-			if k.IsOperator(ctx, acc.Address) && simtypes.RandIntBetween(r, 0, 100) < 10 {
+			if !k.IsOperator(ctx, acc.Address) || simtypes.RandIntBetween(r, 0, 100) >= 10 {
+				continue
+			}
+			if reveal == 0 {
 				futureOperations = append(futureOperations, simtypes.FutureOperation{
 					BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 0, wait),
-					Op:          SimulateMsgTaskResponse(ak, k, bk, contract, function, acc),
+					Op:          SimulateMsgTaskResponse(ak, k, bk, taskID, acc),
+				})
+				continue
+			}
+
+			// queued operations cannot queue more operations, so reveals are scheduled along with commits
+			score := r.Int63n(100) + 1
+			confidence := r.Int63n(types.MaxConfidence + 1)
+			salt := simtypes.RandStringOfLength(r, types.MinSaltLength)
+			futureOperations = append(futureOperations, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 0, wait),
+				Op:          SimulateMsgCommitTaskResponse(ak, k, bk, taskID, score, confidence, salt, acc),
+			})
+			if simtypes.RandIntBetween(r, 0, 100) < 90 {
+				futureOperations = append(futureOperations, simtypes.FutureOperation{
+					BlockHeight: simtypes.RandIntBetween(r, commitClosingBlock+1, commitClosingBlock+reveal+1),
+					Op:          SimulateMsgRevealTaskResponse(ak, k, bk, taskID, score, confidence, salt, acc),
 				})
 			}
 		}
@@ -413,16 +461,37 @@ func SimulateMsgCreateTask(ak types.AccountKeeper, k keeper.Keeper, bk types.Ban
 }
 
 // SimulateMsgInquiryTask generates a MsgInquiryTask object with all of its fields randomized.
-func SimulateMsgInquiryTask(ak types.AccountKeeper, bk types.BankKeeper, contract, function string) simtypes.Operation {
+func SimulateMsgInquiryTask(ak types.AccountKeeper, k keeper.Keeper, bk types.BankKeeper, contract, function string) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		txHash := simtypes.RandStringOfLength(r, 20)
 		inquirer, _ := simtypes.RandomAcc(r, accs)
+		callback := ""
+		if r.Intn(2) == 0 {
+			callbackAcc, _ := simtypes.RandomAcc(r, accs)
+			callback = callbackAcc.Address.String()
+		}
+		if task, err := k.GetLatestTask(ctx, contract, function); err == nil && callback != "" {
+			callbacks := 0
+			for _, inquiry := range k.GetInquiries(ctx, task.Id) {
+				if inquiry.Callback != "" {
+					callbacks++
+				}
+			}
+			if callbacks >= types.MaxInquiryCallbacks {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInquireTask, "too many callbacks"), nil, nil
+			}
+		}
 
-		msg := types.NewMsgInquiryTask(contract, function, txHash, inquirer.Address)
+		msg := types.NewMsgInquiryTask(contract, function, txHash, inquirer.Address, callback)
 
 		inquirerAcc := ak.GetAccount(ctx, inquirer.Address)
-		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, inquirerAcc.GetAddress()))
+		inquiryFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, k.GetTaskParams(ctx).InquiryFee))
+		spendable, hasNeg := bk.SpendableCoins(ctx, inquirerAcc.GetAddress()).SafeSub(inquiryFee)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "not enough coins for the inquiry fee"), nil, nil
+		}
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
 		}
@@ -452,7 +521,7 @@ func SimulateMsgInquiryTask(ak types.AccountKeeper, bk types.BankKeeper, contrac
 }
 
 // SimulateMsgTaskResponse generates a MsgTaskResponse object with all of its fields randomized.
-func SimulateMsgTaskResponse(ak types.AccountKeeper, k keeper.Keeper, bk types.BankKeeper, contract, function string,
+func SimulateMsgTaskResponse(ak types.AccountKeeper, k keeper.Keeper, bk types.BankKeeper, taskID uint64,
 	simAcc simtypes.Account) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
@@ -461,8 +530,9 @@ func SimulateMsgTaskResponse(ak types.AccountKeeper, k keeper.Keeper, bk types.B
 		}
 
 		score := r.Int63n(100) + 1
+		confidence := r.Int63n(types.MaxConfidence + 1)
 
-		msg := types.NewMsgTaskResponse(contract, function, score, simAcc.Address)
+		msg := types.NewMsgTaskResponse(taskID, score, confidence, simAcc.Address)
 
 		operatorAcc := ak.GetAccount(ctx, simAcc.Address)
 		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, operatorAcc.GetAddress()))
@@ -494,11 +564,106 @@ func SimulateMsgTaskResponse(ak types.AccountKeeper, k keeper.Keeper, bk types.B
 	}
 }
 
+// SimulateMsgCommitTaskResponse generates a MsgCommitTaskResponse object committing a score, a confidence and a salt.
+func SimulateMsgCommitTaskResponse(ak types.AccountKeeper, k keeper.Keeper, bk types.BankKeeper, taskID uint64,
+	score, confidence int64, salt string, simAcc simtypes.Account) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
+		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsOperator(ctx, simAcc.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCommitToTask, "not an operator"), nil, nil
+		}
+
+		msg := types.NewMsgCommitTaskResponse(taskID, types.ResponseCommitHash(simAcc.Address, score, confidence, salt), simAcc.Address)
+
+		operatorAcc := ak.GetAccount(ctx, simAcc.Address)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, operatorAcc.GetAddress()))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCommitToTask, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{operatorAcc.GetAccountNumber()},
+			[]uint64{operatorAcc.GetSequence()},
+			simAcc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevealTaskResponse generates a MsgRevealTaskResponse object revealing a committed response.
+func SimulateMsgRevealTaskResponse(ak types.AccountKeeper, k keeper.Keeper, bk types.BankKeeper, taskID uint64,
+	score, confidence int64, salt string, simAcc simtypes.Account) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
+		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsOperator(ctx, simAcc.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevealToTask, "not an operator"), nil, nil
+		}
+		task, err := k.GetTask(ctx, taskID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevealToTask, err.Error()), nil, nil
+		}
+		committed := false
+		for _, commit := range task.Commits {
+			if commit.Operator == simAcc.Address.String() {
+				committed = true
+			}
+		}
+		if !committed {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevealToTask, "no commit to reveal"), nil, nil
+		}
+
+		msg := types.NewMsgRevealTaskResponse(taskID, score, confidence, salt, simAcc.Address)
+
+		operatorAcc := ak.GetAccount(ctx, simAcc.Address)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, operatorAcc.GetAddress()))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevealToTask, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{operatorAcc.GetAccountNumber()},
+			[]uint64{operatorAcc.GetSequence()},
+			simAcc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgDeleteTask generates a MsgDeleteTask object with all of its fields randomized.
-func SimulateMsgDeleteTask(ak types.AccountKeeper, bk types.BankKeeper, contract, function string, creator simtypes.Account) simtypes.Operation {
+func SimulateMsgDeleteTask(ak types.AccountKeeper, bk types.BankKeeper, taskID uint64, creator simtypes.Account) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (
 		simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		msg := types.NewMsgDeleteTask(contract, function, true, creator.Address)
+		msg := types.NewMsgDeleteTask(taskID, true, creator.Address)
 
 		creatorAcc := ak.GetAccount(ctx, creator.Address)
 		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, creatorAcc.GetAddress()))
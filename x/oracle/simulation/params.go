@@ -26,5 +26,12 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return string(bz)
 			},
 		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySlashingParams),
+			func(r *rand.Rand) string {
+				bz, _ := json.Marshal(GenSlashingParams(r))
+				return string(bz)
+			},
+		),
 	}
 }
@@ -0,0 +1,189 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultAggregationStrategy is the strategy of tasks created without one, which is always allowed.
+const DefaultAggregationStrategy = AggregationStrategyWeightedMean
+
+// AggregationStrategies are all the supported aggregation strategies.
+var AggregationStrategies = []AggregationStrategy{
+	AggregationStrategyWeightedMean,
+	AggregationStrategyWeightedMedian,
+	AggregationStrategyTrimmedMean,
+	AggregationStrategyQuorumMajority,
+}
+
+// Aggregator aggregates the responses to a task, weighted by the collateral of their operators, into a result.
+type Aggregator interface {
+	// Aggregate returns the result of the responses and false if they cannot produce a result.
+	// The weights of the responses left out of the result are set to zero.
+	Aggregate(responses Responses, params TaskParams) (sdk.Int, bool)
+}
+
+// NewAggregator returns the aggregator of a strategy.
+func NewAggregator(strategy AggregationStrategy) (Aggregator, error) {
+	switch strategy {
+	case AggregationStrategyWeightedMean:
+		return WeightedMeanAggregator{}, nil
+	case AggregationStrategyWeightedMedian:
+		return WeightedMedianAggregator{}, nil
+	case AggregationStrategyTrimmedMean:
+		return TrimmedMeanAggregator{}, nil
+	case AggregationStrategyQuorumMajority:
+		return QuorumMajorityAggregator{}, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "%s", strategy)
+	}
+}
+
+// AggregationStrategyFromString returns the aggregation strategy given its name,
+// one of weighted-mean, weighted-median, trimmed-mean and quorum-majority.
+func AggregationStrategyFromString(str string) (AggregationStrategy, error) {
+	switch str {
+	case "weighted-mean":
+		return AggregationStrategyWeightedMean, nil
+	case "weighted-median":
+		return AggregationStrategyWeightedMedian, nil
+	case "trimmed-mean":
+		return AggregationStrategyTrimmedMean, nil
+	case "quorum-majority":
+		return AggregationStrategyQuorumMajority, nil
+	default:
+		return AggregationStrategyNil, sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "%s", str)
+	}
+}
+
+// totalWeight returns the total weight of responses.
+func totalWeight(responses Responses) sdk.Int {
+	total := sdk.NewInt(0)
+	for _, response := range responses {
+		total = total.Add(response.Weight)
+	}
+	return total
+}
+
+// weightedMean returns the mean of the scores of responses weighted by their weights.
+func weightedMean(responses Responses) (sdk.Int, bool) {
+	total := totalWeight(responses)
+	if !total.IsPositive() {
+		return sdk.Int{}, false
+	}
+	sum := sdk.NewInt(0)
+	for _, response := range responses {
+		sum = sum.Add(response.Score.Mul(response.Weight))
+	}
+	return sum.Quo(total), true
+}
+
+// sortedByScore returns the indexes of responses sorted by ascending score.
+func sortedByScore(responses Responses) []int {
+	indexes := make([]int, len(responses))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return responses[indexes[i]].Score.LT(responses[indexes[j]].Score)
+	})
+	return indexes
+}
+
+// WeightedMeanAggregator takes the weighted mean of the scores, unless at least 1/3 of the weight
+// responded with the minimum score, in which case the result is the minimum score.
+type WeightedMeanAggregator struct{}
+
+// Aggregate implements Aggregator.
+func (WeightedMeanAggregator) Aggregate(responses Responses, _ TaskParams) (sdk.Int, bool) {
+	total := totalWeight(responses)
+	if !total.IsPositive() {
+		return sdk.Int{}, false
+	}
+	minScoreWeight := sdk.NewInt(0)
+	for _, response := range responses {
+		if response.Score.Equal(MinScore) {
+			minScoreWeight = minScoreWeight.Add(response.Weight)
+		}
+	}
+
+	if minScoreWeight.MulRaw(3).GTE(total) {
+		for i, response := range responses {
+			if !response.Score.Equal(MinScore) {
+				responses[i].Weight = sdk.NewInt(0)
+			}
+		}
+		return MinScore, true
+	}
+	return weightedMean(responses)
+}
+
+// WeightedMedianAggregator takes the lowest score at which the cumulated weight reaches half of the total weight.
+type WeightedMedianAggregator struct{}
+
+// Aggregate implements Aggregator.
+func (WeightedMedianAggregator) Aggregate(responses Responses, _ TaskParams) (sdk.Int, bool) {
+	total := totalWeight(responses)
+	if !total.IsPositive() {
+		return sdk.Int{}, false
+	}
+	cumulated := sdk.NewInt(0)
+	for _, i := range sortedByScore(responses) {
+		cumulated = cumulated.Add(responses[i].Weight)
+		if cumulated.MulRaw(2).GTE(total) {
+			return responses[i].Score, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// TrimmedMeanAggregator leaves out the TrimFraction of the responses with the lowest scores
+// and the TrimFraction of the responses with the highest scores, and takes the weighted mean of the others.
+type TrimmedMeanAggregator struct{}
+
+// Aggregate implements Aggregator.
+func (TrimmedMeanAggregator) Aggregate(responses Responses, params TaskParams) (sdk.Int, bool) {
+	trimmed := params.TrimFraction.MulInt64(int64(len(responses))).TruncateInt64()
+	indexes := sortedByScore(responses)
+	for j, i := range indexes {
+		if int64(j) < trimmed || int64(j) >= int64(len(indexes))-trimmed {
+			responses[i].Weight = sdk.NewInt(0)
+		}
+	}
+	return weightedMean(responses)
+}
+
+// QuorumMajorityAggregator splits the responses by the threshold score and requires the side with the majority
+// of the weight to hold at least MajorityQuorum of the total weight. The result is the weighted mean of that side.
+type QuorumMajorityAggregator struct{}
+
+// Aggregate implements Aggregator.
+func (QuorumMajorityAggregator) Aggregate(responses Responses, params TaskParams) (sdk.Int, bool) {
+	total := totalWeight(responses)
+	if !total.IsPositive() {
+		return sdk.Int{}, false
+	}
+	secureWeight := sdk.NewInt(0)
+	for _, response := range responses {
+		if response.Score.GTE(params.ThresholdScore) {
+			secureWeight = secureWeight.Add(response.Weight)
+		}
+	}
+	secure := secureWeight.MulRaw(2).GT(total)
+	majorityWeight := secureWeight
+	if !secure {
+		majorityWeight = total.Sub(secureWeight)
+	}
+	if sdk.NewDecFromInt(majorityWeight).LT(params.MajorityQuorum.MulInt(total)) {
+		return sdk.Int{}, false
+	}
+
+	for i, response := range responses {
+		if response.Score.GTE(params.ThresholdScore) != secure {
+			responses[i].Weight = sdk.NewInt(0)
+		}
+	}
+	return weightedMean(responses)
+}
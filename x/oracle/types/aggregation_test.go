@@ -0,0 +1,104 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newWeightedResponses(scoresAndWeights ...int64) Responses {
+	var responses Responses
+	for i := 0; i+1 < len(scoresAndWeights); i += 2 {
+		responses = append(responses, Response{
+			Score:  sdk.NewInt(scoresAndWeights[i]),
+			Weight: sdk.NewInt(scoresAndWeights[i+1]),
+		})
+	}
+	return responses
+}
+
+func TestAggregators(t *testing.T) {
+	params := DefaultTaskParams()
+
+	tests := []struct {
+		name      string
+		strategy  AggregationStrategy
+		responses Responses
+		result    int64
+		ok        bool
+		weights   []int64
+	}{
+		{"mean", AggregationStrategyWeightedMean, newWeightedResponses(90, 100, 60, 300), 67, true, []int64{100, 300}},
+		{"mean unbiased", AggregationStrategyWeightedMean, newWeightedResponses(90, 1), 90, true, []int64{1}},
+		{"mean veto", AggregationStrategyWeightedMean, newWeightedResponses(0, 100, 90, 200), 0, true, []int64{100, 0}},
+		{"mean no weight", AggregationStrategyWeightedMean, newWeightedResponses(90, 0), 0, false, []int64{0}},
+		{"median", AggregationStrategyWeightedMedian, newWeightedResponses(10, 100, 90, 300, 80, 50), 90, true, []int64{100, 300, 50}},
+		{"median tie", AggregationStrategyWeightedMedian, newWeightedResponses(10, 100, 90, 100), 10, true, []int64{100, 100}},
+		{"trimmed", AggregationStrategyTrimmedMean, newWeightedResponses(0, 100, 50, 100, 60, 100, 70, 100, 100, 100), 60, true, []int64{0, 100, 100, 100, 0}},
+		{"trimmed few", AggregationStrategyTrimmedMean, newWeightedResponses(0, 100, 100, 100), 50, true, []int64{100, 100}},
+		{"majority secure", AggregationStrategyQuorumMajority, newWeightedResponses(80, 300, 90, 100, 10, 100), 82, true, []int64{300, 100, 0}},
+		{"majority insecure", AggregationStrategyQuorumMajority, newWeightedResponses(20, 300, 40, 100, 90, 100), 25, true, []int64{300, 100, 0}},
+		{"majority no quorum", AggregationStrategyQuorumMajority, newWeightedResponses(80, 300, 10, 200), 0, false, []int64{300, 200}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregator, err := NewAggregator(tt.strategy)
+			require.NoError(t, err)
+
+			result, ok := aggregator.Aggregate(tt.responses, params)
+			require.Equal(t, tt.ok, ok)
+			if ok {
+				require.Equal(t, tt.result, result.Int64())
+			}
+			for i, weight := range tt.weights {
+				require.Equal(t, weight, tt.responses[i].Weight.Int64(), "weight of response %d", i)
+			}
+		})
+	}
+}
+
+func TestNewAggregator(t *testing.T) {
+	for _, strategy := range AggregationStrategies {
+		_, err := NewAggregator(strategy)
+		require.NoError(t, err)
+	}
+	_, err := NewAggregator(AggregationStrategyNil)
+	require.Error(t, err)
+}
+
+func TestIsQuorumReached(t *testing.T) {
+	params := DefaultTaskParams()
+	params.MinResponses = 2
+	params.MinResponseCollateral = sdk.NewInt(300)
+	collaterals := func(amounts ...int64) []sdk.Int {
+		var collaterals []sdk.Int
+		for _, amount := range amounts {
+			collaterals = append(collaterals, sdk.NewInt(amount))
+		}
+		return collaterals
+	}
+
+	require.True(t, params.IsQuorumReached(collaterals(100, 200)))
+	require.False(t, params.IsQuorumReached(collaterals(300)))
+	require.False(t, params.IsQuorumReached(collaterals(300, 0)))
+	require.False(t, params.IsQuorumReached(collaterals(100, 100)))
+	require.True(t, DefaultTaskParams().IsQuorumReached(collaterals(1)))
+	require.False(t, DefaultTaskParams().IsQuorumReached(nil))
+}
+
+func TestConfidenceWeighted(t *testing.T) {
+	response := Response{Score: sdk.NewInt(90)}
+	require.Equal(t, MaxConfidence, response.EffectiveConfidence())
+	require.Equal(t, int64(300), response.ConfidenceWeighted(sdk.NewInt(300)).Int64())
+
+	response.Confidence = 40
+	require.Equal(t, int64(120), response.ConfidenceWeighted(sdk.NewInt(300)).Int64())
+
+	require.NoError(t, ValidateConfidence(0))
+	require.NoError(t, ValidateConfidence(MaxConfidence))
+	require.Error(t, ValidateConfidence(-1))
+	require.Error(t, ValidateConfidence(MaxConfidence+1))
+}
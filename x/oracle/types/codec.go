@@ -17,6 +17,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgWithdrawReward{}, "oracle/WithdrawReward", nil)
 	cdc.RegisterConcrete(MsgCreateTask{}, "oracle/CreateTask", nil)
 	cdc.RegisterConcrete(MsgTaskResponse{}, "oracle/RespondToTask", nil)
+	cdc.RegisterConcrete(MsgCommitTaskResponse{}, "oracle/CommitToTask", nil)
+	cdc.RegisterConcrete(MsgRevealTaskResponse{}, "oracle/RevealToTask", nil)
 	cdc.RegisterConcrete(MsgInquiryTask{}, "oracle/InquiryTask", nil)
 	cdc.RegisterConcrete(MsgDeleteTask{}, "oracle/DeleteTask", nil)
 }
@@ -31,6 +33,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawReward{},
 		&MsgCreateTask{},
 		&MsgTaskResponse{},
+		&MsgCommitTaskResponse{},
+		&MsgRevealTaskResponse{},
 		&MsgInquiryTask{},
 		&MsgDeleteTask{},
 	)
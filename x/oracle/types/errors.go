@@ -14,6 +14,7 @@ var (
 	ErrNoEnoughCollateral      = sdkerrors.Register(ModuleName, 107, "collateral not enough")
 	ErrInvalidPoolParams       = sdkerrors.Register(ModuleName, 108, "invalid pool params")
 	ErrInvalidTaskParams       = sdkerrors.Register(ModuleName, 109, "invalid task params")
+	ErrInvalidSlashingParams   = sdkerrors.Register(ModuleName, 110, "invalid slashing params")
 
 	ErrTaskNotExists       = sdkerrors.Register(ModuleName, 201, "task does not exist")
 	ErrUnqualifiedOperator = sdkerrors.Register(ModuleName, 202, "operator is not qualified")
@@ -25,6 +26,21 @@ var (
 	ErrNotFinished         = sdkerrors.Register(ModuleName, 208, "the task is on going")
 	ErrTaskFailed          = sdkerrors.Register(ModuleName, 209, "task failed")
 	ErrInvalidScore        = sdkerrors.Register(ModuleName, 210, "invalid score")
+	ErrCommitRequired      = sdkerrors.Register(ModuleName, 211, "task only accepts committed responses")
+	ErrCommitNotAccepted   = sdkerrors.Register(ModuleName, 212, "task does not accept committed responses")
+	ErrCommitClosed        = sdkerrors.Register(ModuleName, 213, "commit phase of the task is closed")
+	ErrRevealNotStarted    = sdkerrors.Register(ModuleName, 214, "reveal phase of the task has not started")
+	ErrCommitNotFound      = sdkerrors.Register(ModuleName, 215, "no committed response from this operator")
+	ErrInvalidReveal       = sdkerrors.Register(ModuleName, 216, "revealed response does not match the commit")
+	ErrInvalidCommit       = sdkerrors.Register(ModuleName, 217, "invalid response commit")
+	ErrInvalidTaskID       = sdkerrors.Register(ModuleName, 218, "invalid task ID")
+	ErrInvalidTaskStatus   = sdkerrors.Register(ModuleName, 219, "invalid task status")
+
+	ErrInvalidAggregationStrategy    = sdkerrors.Register(ModuleName, 220, "invalid aggregation strategy")
+	ErrAggregationStrategyNotAllowed = sdkerrors.Register(ModuleName, 221, "aggregation strategy is not allowed")
+	ErrInvalidConfidence             = sdkerrors.Register(ModuleName, 222, "invalid confidence")
+	ErrInvalidInquiry                = sdkerrors.Register(ModuleName, 223, "invalid inquiry")
+	ErrTooManyCallbacks              = sdkerrors.Register(ModuleName, 224, "too many callbacks for the task")
 
 	ErrInconsistentOperators = sdkerrors.Register(ModuleName, 301, "two operators not consistent")
 )
@@ -8,6 +8,7 @@ import (
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
+	Has(ctx sdk.Context, key []byte) bool
 }
 
 type AccountKeeper interface {
@@ -28,3 +29,7 @@ type DistrKeeper interface {
 type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) (res string)
 }
+
+type CVMKeeper interface {
+	CallOracleCallback(ctx sdk.Context, caller, callee sdk.AccAddress, task Task) error
+}
@@ -2,11 +2,13 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState constructs a GenesisState object.
 func NewGenesisState(operators []Operator, totalCollateral sdk.Coins, poolParams LockedPoolParams, taskParams TaskParams,
-	withdraws []Withdraw, tasks []Task) GenesisState {
+	withdraws []Withdraw, tasks []Task, slashingParams SlashingParams, deviations []OperatorDeviations, slashes []Slash,
+	nextTaskID uint64, inquiries []Inquiry) GenesisState {
 	return GenesisState{
 		Operators:       operators,
 		TotalCollateral: totalCollateral,
@@ -14,12 +16,18 @@ func NewGenesisState(operators []Operator, totalCollateral sdk.Coins, poolParams
 		TaskParams:      &taskParams,
 		Withdraws:       withdraws,
 		Tasks:           tasks,
+		SlashingParams:  &slashingParams,
+		Deviations:      deviations,
+		Slashes:         slashes,
+		NextTaskId:      nextTaskID,
+		Inquiries:       inquiries,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object.
 func DefaultGenesisState() *GenesisState {
-	state := NewGenesisState(nil, nil, DefaultLockedPoolParams(), DefaultTaskParams(), nil, nil)
+	state := NewGenesisState(nil, nil, DefaultLockedPoolParams(), DefaultTaskParams(), nil, nil,
+		DefaultSlashingParams(), nil, nil, 1, nil)
 	return &state
 }
 
@@ -42,5 +50,38 @@ func ValidateGenesis(gs GenesisState) error {
 	if gs.PoolParams.LockedInBlocks < 0 || gs.PoolParams.MinimumCollateral < 0 {
 		panic(ErrInvalidPoolParams)
 	}
+	if gs.SlashingParams != nil {
+		if err := gs.SlashingParams.Validate(); err != nil {
+			return err
+		}
+	}
+	taskIDs := make(map[uint64]bool)
+	for _, task := range gs.Tasks {
+		if task.Id == 0 {
+			continue
+		}
+		if taskIDs[task.Id] || (gs.NextTaskId > 0 && task.Id >= gs.NextTaskId) {
+			return sdkerrors.Wrapf(ErrInvalidTaskID, "task ID %d", task.Id)
+		}
+		taskIDs[task.Id] = true
+	}
+	for _, inquiry := range gs.Inquiries {
+		if !taskIDs[inquiry.TaskId] {
+			return sdkerrors.Wrapf(ErrInvalidInquiry, "task ID %d", inquiry.TaskId)
+		}
+		if _, err := sdk.AccAddressFromBech32(inquiry.Inquirer); err != nil {
+			return err
+		}
+	}
+	for _, deviations := range gs.Deviations {
+		if _, err := sdk.AccAddressFromBech32(deviations.Operator); err != nil {
+			return err
+		}
+	}
+	for _, slash := range gs.Slashes {
+		if _, err := sdk.AccAddressFromBech32(slash.Operator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -32,6 +32,11 @@ type GenesisState struct {
 	TaskParams      *TaskParams                              `protobuf:"bytes,4,opt,name=task_params,json=taskParams,proto3" json:"task_params,omitempty" yaml:"task_params"`
 	Withdraws       []Withdraw                               `protobuf:"bytes,5,rep,name=withdraws,proto3" json:"withdraws" yaml:"withdraws"`
 	Tasks           []Task                                   `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks" yaml:"tasks"`
+	SlashingParams  *SlashingParams                          `protobuf:"bytes,7,opt,name=slashing_params,json=slashingParams,proto3" json:"slashing_params,omitempty" yaml:"slashing_params"`
+	Deviations      []OperatorDeviations                     `protobuf:"bytes,8,rep,name=deviations,proto3" json:"deviations" yaml:"deviations"`
+	Slashes         []Slash                                  `protobuf:"bytes,9,rep,name=slashes,proto3" json:"slashes" yaml:"slashes"`
+	NextTaskId      uint64                                   `protobuf:"varint,10,opt,name=next_task_id,json=nextTaskId,proto3" json:"next_task_id,omitempty" yaml:"next_task_id"`
+	Inquiries       []Inquiry                                `protobuf:"bytes,11,rep,name=inquiries,proto3" json:"inquiries" yaml:"inquiries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,37 +81,46 @@ func init() {
 }
 
 var fileDescriptor_6713fe00b3140e8c = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x6d, 0xfa, 0x47, 0xe0, 0x54, 0xa2, 0xb2, 0xaa, 0x62, 0x2a, 0x64, 0x47, 0x07, 0x43,
-	0x16, 0xee, 0x94, 0xb2, 0x75, 0x74, 0x07, 0x90, 0x8a, 0x44, 0x65, 0x90, 0x40, 0x30, 0x54, 0x6f,
-	0x9c, 0x23, 0xb1, 0x7c, 0xf1, 0x6b, 0xf9, 0x2e, 0x94, 0x7e, 0x03, 0x46, 0xf8, 0x06, 0x9d, 0xf9,
-	0x24, 0x1d, 0x3b, 0x32, 0x05, 0x94, 0x2c, 0x88, 0xb1, 0x9f, 0x00, 0xf9, 0xee, 0xe2, 0x44, 0x85,
-	0x64, 0xb2, 0x25, 0xff, 0xee, 0xf7, 0xbc, 0xaf, 0x9f, 0xf3, 0x9e, 0xc8, 0x21, 0x2f, 0xd4, 0x98,
-	0x61, 0x05, 0xa9, 0xe0, 0xec, 0x53, 0x17, 0x44, 0x39, 0x84, 0x2e, 0x1b, 0xf0, 0x82, 0xcb, 0x4c,
-	0xd2, 0xb2, 0x42, 0x85, 0xfe, 0xbe, 0xa1, 0xa8, 0xa1, 0xe8, 0x9c, 0x3a, 0xd8, 0x1b, 0xe0, 0x00,
-	0x35, 0xc2, 0xea, 0x37, 0x43, 0x1f, 0x84, 0x29, 0xca, 0x11, 0x4a, 0xd6, 0x03, 0x59, 0x1b, 0x7b,
-	0x5c, 0x41, 0x97, 0xa5, 0x98, 0x15, 0xf6, 0xfb, 0xe3, 0x15, 0x99, 0xd6, 0xae, 0x21, 0xf2, 0x67,
-	0xd3, 0xdb, 0x79, 0x6e, 0x86, 0x78, 0xad, 0x40, 0x71, 0xff, 0x9d, 0x77, 0x0f, 0x4b, 0x5e, 0x81,
-	0xc2, 0x4a, 0x06, 0x6e, 0x7b, 0xa3, 0xd3, 0x3a, 0x6c, 0xd3, 0xff, 0xcf, 0x45, 0x5f, 0x59, 0x30,
-	0x0e, 0xae, 0x26, 0x91, 0x73, 0x33, 0x89, 0x76, 0x2f, 0x60, 0x24, 0x8e, 0x48, 0x23, 0x20, 0xc9,
-	0x42, 0xe6, 0x7f, 0x73, 0xbd, 0x5d, 0x85, 0x0a, 0xc4, 0x59, 0x8a, 0x42, 0x80, 0xe2, 0x15, 0x88,
-	0xe0, 0x8e, 0x4e, 0x78, 0x48, 0xcd, 0x2e, 0xb4, 0xde, 0x85, 0xda, 0x5d, 0xe8, 0x31, 0x66, 0x45,
-	0x7c, 0x62, 0xd5, 0x0f, 0x8c, 0xfa, 0xb6, 0x80, 0x7c, 0xff, 0x19, 0x75, 0x06, 0x99, 0x1a, 0x8e,
-	0x7b, 0x34, 0xc5, 0x11, 0xb3, 0xff, 0xc4, 0x3c, 0x9e, 0xca, 0x7e, 0xce, 0xd4, 0x45, 0xc9, 0xa5,
-	0x76, 0xc9, 0xe4, 0xbe, 0x3e, 0x7e, 0xdc, 0x9c, 0xf6, 0xc1, 0x6b, 0x95, 0x88, 0xe2, 0xac, 0x84,
-	0x0a, 0x46, 0x32, 0xd8, 0x68, 0xbb, 0x9d, 0xd6, 0x61, 0x67, 0xd5, 0xbe, 0x2f, 0x31, 0xcd, 0x79,
-	0xff, 0x14, 0x51, 0x9c, 0x6a, 0x3e, 0xde, 0xbf, 0x99, 0x44, 0xbe, 0x19, 0x6c, 0x49, 0x43, 0x12,
-	0xaf, 0x6c, 0x18, 0xff, 0x83, 0xd7, 0x52, 0x20, 0xf3, 0x79, 0xc4, 0xa6, 0x8e, 0x20, 0xab, 0x22,
-	0xde, 0x80, 0xcc, 0xff, 0x95, 0x2f, 0x09, 0x48, 0xe2, 0xa9, 0x86, 0xa9, 0xdb, 0x3a, 0xcf, 0xd4,
-	0xb0, 0x5f, 0xc1, 0xb9, 0x0c, 0xb6, 0xd6, 0xb7, 0xf5, 0xd6, 0x82, 0xb7, 0xdb, 0x6a, 0x04, 0x24,
-	0x59, 0xc8, 0xfc, 0x17, 0xde, 0x56, 0x9d, 0x23, 0x83, 0x6d, 0x6d, 0x7d, 0xb4, 0x6e, 0xe0, 0x78,
-	0xcf, 0x1a, 0x77, 0x16, 0xe3, 0x4a, 0x92, 0x18, 0xc1, 0xd1, 0xdd, 0x2f, 0x97, 0x91, 0xf3, 0xfb,
-	0x32, 0x72, 0xe2, 0x93, 0xab, 0x69, 0xe8, 0x5e, 0x4f, 0x43, 0xf7, 0xd7, 0x34, 0x74, 0xbf, 0xce,
-	0x42, 0xe7, 0x7a, 0x16, 0x3a, 0x3f, 0x66, 0xa1, 0xf3, 0xbe, 0xbb, 0x5c, 0x21, 0xaf, 0x54, 0x96,
-	0x7f, 0xc4, 0x71, 0xd1, 0x07, 0x95, 0x61, 0xc1, 0xec, 0x3d, 0xfe, 0x3c, 0xbf, 0xc9, 0xba, 0xd1,
-	0xde, 0xb6, 0xbe, 0xc0, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xf3, 0x12, 0xcf, 0x5b,
-	0x03, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xf6, 0xdf, 0x9d, 0xb6, 0x61, 0xa6, 0x2e, 0x9b, 0x20, 0xa9, 0x0c, 0x42, 0x15,
+	0x12, 0x89, 0x3a, 0x4e, 0xec, 0x98, 0x21, 0xc1, 0x34, 0xa4, 0x4d, 0x19, 0xd2, 0x10, 0x1c, 0x26,
+	0x37, 0x31, 0xad, 0x95, 0x34, 0x0e, 0xb1, 0xbb, 0x3f, 0xdf, 0x80, 0x23, 0x7c, 0x83, 0x49, 0xdc,
+	0xf8, 0x24, 0x3b, 0xee, 0xc8, 0xa9, 0xa0, 0xed, 0xc2, 0xb9, 0x9f, 0x00, 0xc5, 0x76, 0xd3, 0x50,
+	0x68, 0x39, 0xb5, 0x51, 0x9e, 0xf7, 0xf7, 0x3c, 0xef, 0xfb, 0xc6, 0x06, 0x8f, 0x78, 0x87, 0x24,
+	0xa2, 0xe7, 0xb2, 0x0c, 0x07, 0x31, 0x71, 0x4f, 0x9b, 0x38, 0x4e, 0x3b, 0xb8, 0xe9, 0xb6, 0x49,
+	0x42, 0x38, 0xe5, 0x4e, 0x9a, 0x31, 0xc1, 0x60, 0x4d, 0xa9, 0x1c, 0xa5, 0x72, 0x86, 0xaa, 0xad,
+	0xf5, 0x36, 0x6b, 0x33, 0x29, 0x71, 0xf3, 0x7f, 0x4a, 0xbd, 0x65, 0x05, 0x8c, 0x77, 0x19, 0x77,
+	0x5b, 0x98, 0xe7, 0xc4, 0x16, 0x11, 0xb8, 0xe9, 0x06, 0x8c, 0x26, 0xfa, 0xfd, 0xc3, 0x09, 0x9e,
+	0x9a, 0x2e, 0x45, 0xe8, 0xeb, 0x22, 0x58, 0x7e, 0xa9, 0x42, 0x1c, 0x09, 0x2c, 0x08, 0x7c, 0x0b,
+	0x96, 0x58, 0x4a, 0x32, 0x2c, 0x58, 0xc6, 0x4d, 0xa3, 0x3e, 0xd3, 0xa8, 0x6e, 0xd7, 0x9d, 0x7f,
+	0xe7, 0x72, 0x0e, 0xb4, 0xd0, 0x33, 0xaf, 0xfa, 0x76, 0x65, 0xd0, 0xb7, 0xd7, 0x2e, 0x70, 0x37,
+	0xde, 0x41, 0x05, 0x00, 0xf9, 0x23, 0x18, 0xfc, 0x62, 0x80, 0x35, 0xc1, 0x04, 0x8e, 0x4f, 0x02,
+	0x16, 0xc7, 0x58, 0x90, 0x0c, 0xc7, 0xe6, 0x1d, 0xe9, 0xb0, 0xe9, 0xa8, 0x5e, 0x9c, 0xbc, 0x17,
+	0x47, 0xf7, 0xe2, 0xec, 0x32, 0x9a, 0x78, 0xfb, 0x1a, 0xbd, 0xa1, 0xd0, 0xe3, 0x00, 0xf4, 0xed,
+	0x87, 0xdd, 0x68, 0x53, 0xd1, 0xe9, 0xb5, 0x9c, 0x80, 0x75, 0x5d, 0x3d, 0x13, 0xf5, 0xf3, 0x94,
+	0x87, 0x91, 0x2b, 0x2e, 0x52, 0xc2, 0x25, 0x8b, 0xfb, 0xab, 0xb2, 0x7c, 0xb7, 0xa8, 0x86, 0x18,
+	0x54, 0x53, 0xc6, 0xe2, 0x93, 0x14, 0x67, 0xb8, 0xcb, 0xcd, 0x99, 0xba, 0xd1, 0xa8, 0x6e, 0x37,
+	0x26, 0xf5, 0xfb, 0x9a, 0x05, 0x11, 0x09, 0x0f, 0x19, 0x8b, 0x0f, 0xa5, 0xde, 0xab, 0x0d, 0xfa,
+	0x36, 0x54, 0xc1, 0x4a, 0x18, 0xe4, 0x83, 0xb4, 0xd0, 0xc0, 0xf7, 0xa0, 0x2a, 0x30, 0x8f, 0x86,
+	0x16, 0xb3, 0xd2, 0x02, 0x4d, 0xb2, 0x78, 0x83, 0x79, 0xf4, 0x37, 0xbc, 0x04, 0x40, 0x3e, 0x10,
+	0x85, 0x26, 0xdf, 0xd6, 0x19, 0x15, 0x9d, 0x30, 0xc3, 0x67, 0xdc, 0x9c, 0x9b, 0xbe, 0xad, 0x63,
+	0x2d, 0x1c, 0xdf, 0x56, 0x01, 0x40, 0xfe, 0x08, 0x06, 0x5f, 0x81, 0xb9, 0xdc, 0x87, 0x9b, 0xf3,
+	0x92, 0x7a, 0x7f, 0x5a, 0x60, 0x6f, 0x5d, 0x13, 0x97, 0x47, 0x71, 0x39, 0xf2, 0x15, 0x00, 0x46,
+	0x60, 0x95, 0xc7, 0x98, 0x77, 0x68, 0xd2, 0x1e, 0x0e, 0x61, 0x41, 0x0e, 0xe1, 0xf1, 0x24, 0xe6,
+	0x91, 0x96, 0xeb, 0x41, 0x6c, 0x0d, 0xfa, 0x76, 0x4d, 0x91, 0xc7, 0x40, 0xc8, 0x5f, 0xe1, 0x7f,
+	0x68, 0x21, 0x01, 0x20, 0x24, 0xa7, 0x14, 0x0b, 0xca, 0x12, 0x6e, 0x2e, 0xca, 0xec, 0x4f, 0xfe,
+	0xf7, 0xfd, 0xbe, 0x28, 0x2a, 0xbc, 0x4d, 0xdd, 0xc9, 0x5d, 0xe5, 0x37, 0x62, 0x21, 0xbf, 0x04,
+	0x86, 0x07, 0x60, 0x41, 0x1a, 0x13, 0x6e, 0x2e, 0x49, 0x8f, 0x07, 0x53, 0x7b, 0xf1, 0x6a, 0x1a,
+	0xbb, 0x52, 0x6a, 0x83, 0x70, 0xe4, 0x0f, 0x29, 0xf0, 0x39, 0x58, 0x4e, 0xc8, 0xb9, 0x38, 0x91,
+	0x9b, 0xa6, 0xa1, 0x09, 0xea, 0x46, 0x63, 0xd6, 0xdb, 0x18, 0xf4, 0xed, 0x7b, 0xaa, 0xa4, 0xfc,
+	0x16, 0xf9, 0x20, 0x7f, 0xcc, 0x47, 0xbf, 0x17, 0xc2, 0x63, 0xb0, 0x44, 0x93, 0x8f, 0x3d, 0x9a,
+	0x51, 0xc2, 0xcd, 0xaa, 0x4c, 0x63, 0x4f, 0x4a, 0xb3, 0x27, 0x85, 0x17, 0xe3, 0x9f, 0x40, 0x51,
+	0x8f, 0xfc, 0x11, 0x6b, 0x67, 0xf1, 0xd3, 0xa5, 0x5d, 0xf9, 0x75, 0x69, 0x57, 0xbc, 0xfd, 0xab,
+	0x1b, 0xcb, 0xb8, 0xbe, 0xb1, 0x8c, 0x9f, 0x37, 0x96, 0xf1, 0xf9, 0xd6, 0xaa, 0x5c, 0xdf, 0x5a,
+	0x95, 0xef, 0xb7, 0x56, 0xe5, 0x5d, 0xb3, 0x7c, 0xf6, 0x48, 0x26, 0x68, 0xf4, 0x81, 0xf5, 0x92,
+	0x50, 0x8e, 0xc9, 0xd5, 0x17, 0xd0, 0xf9, 0xf0, 0x0a, 0x92, 0x47, 0xb1, 0x35, 0x2f, 0x6f, 0x9e,
+	0x67, 0xbf, 0x07, 0x00, 0x79, 0x92, 0x90, 0x69, 0x14, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +143,65 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Inquiries) > 0 {
+		for iNdEx := len(m.Inquiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inquiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextTaskId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTaskId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Deviations) > 0 {
+		for iNdEx := len(m.Deviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SlashingParams != nil {
+		{
+			size, err := m.SlashingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Tasks) > 0 {
 		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -261,6 +334,31 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.SlashingParams != nil {
+		l = m.SlashingParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Deviations) > 0 {
+		for _, e := range m.Deviations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTaskId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTaskId))
+	}
+	if len(m.Inquiries) > 0 {
+		for _, e := range m.Inquiries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -507,6 +605,163 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashingParams == nil {
+				m.SlashingParams = &SlashingParams{}
+			}
+			if err := m.SlashingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deviations = append(m.Deviations, OperatorDeviations{})
+			if err := m.Deviations[len(m.Deviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, Slash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTaskId", wireType)
+			}
+			m.NextTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inquiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inquiries = append(m.Inquiries, Inquiry{})
+			if err := m.Inquiries[len(m.Inquiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -26,6 +26,12 @@ var (
 	TotalCollateralKeyPrefix  = []byte{0x03}
 	TaskStoreKeyPrefix        = []byte{0x04}
 	ClosingTaskStoreKeyPrefix = []byte{0x05}
+	DeviationStoreKeyPrefix   = []byte{0x06}
+	SlashStoreKeyPrefix       = []byte{0x07}
+	NextTaskIDKey             = []byte{0x08}
+	TargetTaskStoreKeyPrefix  = []byte{0x09}
+	CreatorTaskStoreKeyPrefix = []byte{0x0A}
+	InquiryStoreKeyPrefix     = []byte{0x0B}
 )
 
 func OperatorStoreKey(operator sdk.AccAddress) []byte {
@@ -42,12 +48,70 @@ func TotalCollateralKey() []byte {
 	return TotalCollateralKeyPrefix
 }
 
-func TaskStoreKey(contract, function string) []byte {
-	return append(append(TaskStoreKeyPrefix, []byte(contract)...), []byte(function)...)
+// TaskIDBytes encodes a task ID in big endian, so that iterations over
+// keys ending with task IDs return tasks in the order of creation.
+func TaskIDBytes(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return b
+}
+
+func TaskStoreKey(id uint64) []byte {
+	return append(TaskStoreKeyPrefix, TaskIDBytes(id)...)
+}
+
+func NextTaskIDStoreKey() []byte {
+	return NextTaskIDKey
+}
+
+// lengthPrefix prefixes a string with its length as a fixed-width big
+// endian integer, so that keys of strings of any length do not collide.
+func lengthPrefix(s string) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(len(s)))
+	return append(b, []byte(s)...)
+}
+
+// TargetTasksStoreKey returns the prefix of the task index for a contract function.
+func TargetTasksStoreKey(contract, function string) []byte {
+	key := append(TargetTaskStoreKeyPrefix, lengthPrefix(contract)...)
+	return append(key, lengthPrefix(function)...)
 }
 
-func ClosingTaskIDsStoreKey(blockHeight int64) []byte {
+func TargetTaskStoreKey(contract, function string, id uint64) []byte {
+	return append(TargetTasksStoreKey(contract, function), TaskIDBytes(id)...)
+}
+
+// CreatorTasksStoreKey returns the prefix of the task index for a creator.
+func CreatorTasksStoreKey(creator sdk.AccAddress) []byte {
+	return append(append(CreatorTaskStoreKeyPrefix, byte(len(creator))), creator.Bytes()...)
+}
+
+func CreatorTaskStoreKey(creator sdk.AccAddress, id uint64) []byte {
+	return append(CreatorTasksStoreKey(creator), TaskIDBytes(id)...)
+}
+
+// InquiryStoreKey returns the key of the inquiries of a pending task.
+func InquiryStoreKey(taskID uint64) []byte {
+	return append(InquiryStoreKeyPrefix, TaskIDBytes(taskID)...)
+}
+
+// ClosingTasksStoreKey returns the prefix of the closing block queue for a block height,
+// encoded in big endian so that the queue is iterated in the order of closing blocks.
+func ClosingTasksStoreKey(blockHeight int64) []byte {
 	b := make([]byte, 8)
-	binary.LittleEndian.PutUint64(b, uint64(blockHeight))
+	binary.BigEndian.PutUint64(b, uint64(blockHeight))
 	return append(ClosingTaskStoreKeyPrefix, b...)
 }
+
+func ClosingTaskStoreKey(blockHeight int64, id uint64) []byte {
+	return append(ClosingTasksStoreKey(blockHeight), TaskIDBytes(id)...)
+}
+
+func DeviationStoreKey(operator sdk.AccAddress) []byte {
+	return append(DeviationStoreKeyPrefix, operator.Bytes()...)
+}
+
+func SlashStoreKey(operator sdk.AccAddress) []byte {
+	return append(SlashStoreKeyPrefix, operator.Bytes()...)
+}
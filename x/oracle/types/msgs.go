@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"time"
 
@@ -16,6 +17,8 @@ const (
 	TypeMsgWithdrawReward   = "withdraw_reward"
 	TypeMsgCreateTask       = "create_task"
 	TypeMsgRespondToTask    = "respond_to_task"
+	TypeMsgCommitToTask     = "commit_to_task"
+	TypeMsgRevealToTask     = "reveal_to_task"
 	TypeMsgInquireTask      = "inquire_task"
 	TypeMsgDeleteTask       = "delete_task"
 )
@@ -252,15 +255,18 @@ func (m MsgWithdrawReward) GetSigners() []sdk.AccAddress {
 
 // NewMsgCreateTask returns a new message for creating a task.
 func NewMsgCreateTask(contract, function string, bounty sdk.Coins, description string,
-	creator sdk.AccAddress, wait int64, validDuration time.Duration) *MsgCreateTask {
+	creator sdk.AccAddress, wait int64, validDuration time.Duration, revealBlocks int64,
+	aggregationStrategy AggregationStrategy) *MsgCreateTask {
 	return &MsgCreateTask{
-		Contract:      contract,
-		Function:      function,
-		Bounty:        bounty,
-		Description:   description,
-		Creator:       creator.String(),
-		Wait:          wait,
-		ValidDuration: validDuration,
+		Contract:            contract,
+		Function:            function,
+		Bounty:              bounty,
+		Description:         description,
+		Creator:             creator.String(),
+		Wait:                wait,
+		ValidDuration:       validDuration,
+		RevealBlocks:        revealBlocks,
+		AggregationStrategy: aggregationStrategy,
 	}
 }
 
@@ -272,6 +278,20 @@ func (MsgCreateTask) Type() string { return TypeMsgCreateTask }
 
 // ValidateBasic runs stateless checks on the message.
 func (m MsgCreateTask) ValidateBasic() error {
+	if m.Wait < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative wait: %d", m.Wait)
+	}
+	if m.RevealBlocks < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative reveal blocks: %d", m.RevealBlocks)
+	}
+	if m.Wait > MaxWaitingBlocks || m.RevealBlocks > MaxWaitingBlocks-m.Wait {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wait and reveal blocks exceed %d blocks", MaxWaitingBlocks)
+	}
+	if m.AggregationStrategy != AggregationStrategyNil {
+		if _, err := NewAggregator(m.AggregationStrategy); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -294,12 +314,12 @@ func (m MsgCreateTask) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgTaskResponse returns a new message for responding to a task.
-func NewMsgTaskResponse(contract, function string, score int64, operator sdk.AccAddress) *MsgTaskResponse {
+func NewMsgTaskResponse(taskID uint64, score, confidence int64, operator sdk.AccAddress) *MsgTaskResponse {
 	return &MsgTaskResponse{
-		Contract: contract,
-		Function: function,
-		Score:    score,
-		Operator: operator.String(),
+		TaskId:     taskID,
+		Score:      score,
+		Operator:   operator.String(),
+		Confidence: confidence,
 	}
 }
 
@@ -311,7 +331,7 @@ func (MsgTaskResponse) Type() string { return TypeMsgRespondToTask }
 
 // ValidateBasic runs stateless checks on the message.
 func (m MsgTaskResponse) ValidateBasic() error {
-	return nil
+	return ValidateConfidence(m.Confidence)
 }
 
 // GetSignBytes encodes the message for signing.
@@ -332,13 +352,99 @@ func (m MsgTaskResponse) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// NewMsgCommitTaskResponse returns a new message for committing a response to a task.
+func NewMsgCommitTaskResponse(taskID uint64, hash []byte, operator sdk.AccAddress) *MsgCommitTaskResponse {
+	return &MsgCommitTaskResponse{
+		TaskId:   taskID,
+		Hash:     hash,
+		Operator: operator.String(),
+	}
+}
+
+// Route returns the module name.
+func (MsgCommitTaskResponse) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (MsgCommitTaskResponse) Type() string { return TypeMsgCommitToTask }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCommitTaskResponse) ValidateBasic() error {
+	if len(m.Hash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidCommit, "hash length %d, expected %d", len(m.Hash), sha256.Size)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCommitTaskResponse) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCommitTaskResponse) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRevealTaskResponse returns a new message for revealing a committed response to a task.
+func NewMsgRevealTaskResponse(taskID uint64, score, confidence int64, salt string, operator sdk.AccAddress) *MsgRevealTaskResponse {
+	return &MsgRevealTaskResponse{
+		TaskId:     taskID,
+		Score:      score,
+		Salt:       salt,
+		Operator:   operator.String(),
+		Confidence: confidence,
+	}
+}
+
+// Route returns the module name.
+func (MsgRevealTaskResponse) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (MsgRevealTaskResponse) Type() string { return TypeMsgRevealToTask }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgRevealTaskResponse) ValidateBasic() error {
+	if len(m.Salt) < MinSaltLength || len(m.Salt) > MaxSaltLength {
+		return sdkerrors.Wrapf(ErrInvalidCommit, "salt length %d, expected %d to %d", len(m.Salt), MinSaltLength, MaxSaltLength)
+	}
+	return ValidateConfidence(m.Confidence)
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgRevealTaskResponse) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgRevealTaskResponse) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
 // NewMsgInquiryTask returns a new MsgInquiryTask instance.
-func NewMsgInquiryTask(contract, function, txhash string, inquirer sdk.AccAddress) *MsgInquiryTask {
+// The callback contract, if not empty, is called with the result of a pending task when it closes.
+func NewMsgInquiryTask(contract, function, txhash string, inquirer sdk.AccAddress, callback string) *MsgInquiryTask {
 	return &MsgInquiryTask{
 		Contract: contract,
 		Function: function,
 		TxHash:   txhash,
 		Inquirer: inquirer.String(),
+		Callback: callback,
 	}
 }
 
@@ -350,6 +456,11 @@ func (MsgInquiryTask) Type() string { return TypeMsgInquireTask }
 
 // ValidateBasic runs stateless checks on the message.
 func (m MsgInquiryTask) ValidateBasic() error {
+	if m.Callback != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Callback); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInquiry, "invalid callback contract address")
+		}
+	}
 	return nil
 }
 
@@ -372,12 +483,11 @@ func (m MsgInquiryTask) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgDeleteTask returns a new MsgDeleteTask instance.
-func NewMsgDeleteTask(contract, function string, force bool, deleter sdk.AccAddress) *MsgDeleteTask {
+func NewMsgDeleteTask(taskID uint64, force bool, deleter sdk.AccAddress) *MsgDeleteTask {
 	return &MsgDeleteTask{
-		Contract: contract,
-		Function: function,
-		Force:    force,
-		Deleter:  deleter.String(),
+		TaskId:  taskID,
+		Force:   force,
+		Deleter: deleter.String(),
 	}
 }
 
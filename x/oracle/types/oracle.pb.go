@@ -62,6 +62,41 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8a60831f9c2fed90, []int{0}
 }
 
+// AggregationStrategy defines how the responses to a task are aggregated into its result.
+type AggregationStrategy int32
+
+const (
+	AggregationStrategyNil            AggregationStrategy = 0
+	AggregationStrategyWeightedMean   AggregationStrategy = 1
+	AggregationStrategyWeightedMedian AggregationStrategy = 2
+	AggregationStrategyTrimmedMean    AggregationStrategy = 3
+	AggregationStrategyQuorumMajority AggregationStrategy = 4
+)
+
+var AggregationStrategy_name = map[int32]string{
+	0: "AGGREGATION_STRATEGY_UNSPECIFIED",
+	1: "AGGREGATION_STRATEGY_WEIGHTED_MEAN",
+	2: "AGGREGATION_STRATEGY_WEIGHTED_MEDIAN",
+	3: "AGGREGATION_STRATEGY_TRIMMED_MEAN",
+	4: "AGGREGATION_STRATEGY_QUORUM_MAJORITY",
+}
+
+var AggregationStrategy_value = map[string]int32{
+	"AGGREGATION_STRATEGY_UNSPECIFIED":     0,
+	"AGGREGATION_STRATEGY_WEIGHTED_MEAN":   1,
+	"AGGREGATION_STRATEGY_WEIGHTED_MEDIAN": 2,
+	"AGGREGATION_STRATEGY_TRIMMED_MEAN":    3,
+	"AGGREGATION_STRATEGY_QUORUM_MAJORITY": 4,
+}
+
+func (x AggregationStrategy) String() string {
+	return proto.EnumName(AggregationStrategy_name, int32(x))
+}
+
+func (AggregationStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{1}
+}
+
 // Withdraw stores a withdraw of "Amount" scheduled for a given "DueBlock."
 type Withdraw struct {
 	Address  string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -103,18 +138,22 @@ func (m *Withdraw) XXX_DiscardUnknown() {
 var xxx_messageInfo_Withdraw proto.InternalMessageInfo
 
 type Task struct {
-	Contract      string                                   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
-	Function      string                                   `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
-	BeginBlock    int64                                    `protobuf:"varint,3,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty" yaml:"begin_block"`
-	Bounty        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty" yaml:"bounty"`
-	Description   string                                   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	Expiration    time.Time                                `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration" yaml:"expiration"`
-	Creator       string                                   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
-	Responses     Responses                                `protobuf:"bytes,8,rep,name=responses,proto3,castrepeated=Responses" json:"responses" yaml:"responses"`
-	Result        github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,9,opt,name=result,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"result" yaml:"result"`
-	ClosingBlock  int64                                    `protobuf:"varint,10,opt,name=closing_block,json=closingBlock,proto3" json:"closing_block,omitempty" yaml:"closing_block"`
-	WaitingBlocks int64                                    `protobuf:"varint,11,opt,name=waiting_blocks,json=waitingBlocks,proto3" json:"waiting_blocks,omitempty" yaml:"waiting_blocks"`
-	Status        TaskStatus                               `protobuf:"varint,12,opt,name=status,proto3,enum=shentu.oracle.v1alpha1.TaskStatus" json:"status,omitempty" yaml:"status"`
+	Id                  uint64                                   `protobuf:"varint,15,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Contract            string                                   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	Function            string                                   `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
+	BeginBlock          int64                                    `protobuf:"varint,3,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty" yaml:"begin_block"`
+	Bounty              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty" yaml:"bounty"`
+	Description         string                                   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Expiration          time.Time                                `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration" yaml:"expiration"`
+	Creator             string                                   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Responses           Responses                                `protobuf:"bytes,8,rep,name=responses,proto3,castrepeated=Responses" json:"responses" yaml:"responses"`
+	Result              github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,9,opt,name=result,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"result" yaml:"result"`
+	ClosingBlock        int64                                    `protobuf:"varint,10,opt,name=closing_block,json=closingBlock,proto3" json:"closing_block,omitempty" yaml:"closing_block"`
+	WaitingBlocks       int64                                    `protobuf:"varint,11,opt,name=waiting_blocks,json=waitingBlocks,proto3" json:"waiting_blocks,omitempty" yaml:"waiting_blocks"`
+	Status              TaskStatus                               `protobuf:"varint,12,opt,name=status,proto3,enum=shentu.oracle.v1alpha1.TaskStatus" json:"status,omitempty" yaml:"status"`
+	RevealBlocks        int64                                    `protobuf:"varint,13,opt,name=reveal_blocks,json=revealBlocks,proto3" json:"reveal_blocks,omitempty" yaml:"reveal_blocks"`
+	Commits             []ResponseCommit                         `protobuf:"bytes,14,rep,name=commits,proto3" json:"commits" yaml:"commits"`
+	AggregationStrategy AggregationStrategy                      `protobuf:"varint,16,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=shentu.oracle.v1alpha1.AggregationStrategy" json:"aggregation_strategy,omitempty" yaml:"aggregation_strategy"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -151,10 +190,11 @@ func (m *Task) XXX_DiscardUnknown() {
 var xxx_messageInfo_Task proto.InternalMessageInfo
 
 type Response struct {
-	Operator string                                   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
-	Score    github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"score" yaml:"score"`
-	Weight   github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
-	Reward   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward" yaml:"reward"`
+	Operator   string                                   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Score      github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"score" yaml:"score"`
+	Weight     github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
+	Reward     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward" yaml:"reward"`
+	Confidence int64                                    `protobuf:"varint,5,opt,name=confidence,proto3" json:"confidence,omitempty" yaml:"confidence"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
@@ -190,6 +230,135 @@ func (m *Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Response proto.InternalMessageInfo
 
+// ResponseCommit stores the hash of an operator's score and salt committed
+// to a task, to be revealed after the commit phase of the task.
+type ResponseCommit struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Hash     []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Revealed bool   `protobuf:"varint,3,opt,name=revealed,proto3" json:"revealed,omitempty" yaml:"revealed"`
+}
+
+func (m *ResponseCommit) Reset()         { *m = ResponseCommit{} }
+func (m *ResponseCommit) String() string { return proto.CompactTextString(m) }
+func (*ResponseCommit) ProtoMessage()    {}
+func (*ResponseCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{3}
+}
+func (m *ResponseCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseCommit.Merge(m, src)
+}
+func (m *ResponseCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseCommit proto.InternalMessageInfo
+
+// Inquiry stores a paid inquiry of a pending task, whose fee is split among
+// the operators who answered the task and whose callback contract is called
+// with the result when the task closes.
+type Inquiry struct {
+	TaskId   uint64                                   `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+	Inquirer string                                   `protobuf:"bytes,2,opt,name=inquirer,proto3" json:"inquirer,omitempty" yaml:"inquirer"`
+	Fee      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee" yaml:"fee"`
+	Callback string                                   `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback,omitempty" yaml:"callback"`
+	TxHash   string                                   `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+}
+
+func (m *Inquiry) Reset()         { *m = Inquiry{} }
+func (m *Inquiry) String() string { return proto.CompactTextString(m) }
+func (*Inquiry) ProtoMessage()    {}
+func (*Inquiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{4}
+}
+func (m *Inquiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Inquiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Inquiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Inquiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Inquiry.Merge(m, src)
+}
+func (m *Inquiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Inquiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Inquiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Inquiry proto.InternalMessageInfo
+
+type Inquiries struct {
+	Inquiries []Inquiry `protobuf:"bytes,1,rep,name=inquiries,proto3" json:"inquiries"`
+}
+
+func (m *Inquiries) Reset()         { *m = Inquiries{} }
+func (m *Inquiries) String() string { return proto.CompactTextString(m) }
+func (*Inquiries) ProtoMessage()    {}
+func (*Inquiries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{5}
+}
+func (m *Inquiries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Inquiries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Inquiries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Inquiries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Inquiries.Merge(m, src)
+}
+func (m *Inquiries) XXX_Size() int {
+	return m.Size()
+}
+func (m *Inquiries) XXX_DiscardUnknown() {
+	xxx_messageInfo_Inquiries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Inquiries proto.InternalMessageInfo
+
+func (m *Inquiries) GetInquiries() []Inquiry {
+	if m != nil {
+		return m.Inquiries
+	}
+	return nil
+}
+
 type Operator struct {
 	Address            string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	Proposer           string                                   `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty" yaml:"proposer"`
@@ -202,7 +371,7 @@ func (m *Operator) Reset()         { *m = Operator{} }
 func (m *Operator) String() string { return proto.CompactTextString(m) }
 func (*Operator) ProtoMessage()    {}
 func (*Operator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a60831f9c2fed90, []int{3}
+	return fileDescriptor_8a60831f9c2fed90, []int{6}
 }
 func (m *Operator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,19 +401,25 @@ func (m *Operator) XXX_DiscardUnknown() {
 var xxx_messageInfo_Operator proto.InternalMessageInfo
 
 type TaskParams struct {
-	ExpirationDuration time.Duration                          `protobuf:"bytes,1,opt,name=expiration_duration,json=expirationDuration,proto3,stdduration" json:"expiration_duration" yaml:"task_expiration_duration"`
-	AggregationWindow  int64                                  `protobuf:"varint,2,opt,name=aggregation_window,json=aggregationWindow,proto3" json:"aggregation_window,omitempty" yaml:"task_aggregation_window"`
-	AggregationResult  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=aggregation_result,json=aggregationResult,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"aggregation_result" yaml:"task_aggregation_result"`
-	ThresholdScore     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=threshold_score,json=thresholdScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold_score" yaml:"task_threshold_score"`
-	Epsilon1           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=epsilon1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epsilon1" yaml:"task_epsilon1"`
-	Epsilon2           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=epsilon2,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epsilon2" yaml:"task_epsilon2"`
+	ExpirationDuration           time.Duration                          `protobuf:"bytes,1,opt,name=expiration_duration,json=expirationDuration,proto3,stdduration" json:"expiration_duration" yaml:"task_expiration_duration"`
+	AggregationWindow            int64                                  `protobuf:"varint,2,opt,name=aggregation_window,json=aggregationWindow,proto3" json:"aggregation_window,omitempty" yaml:"task_aggregation_window"`
+	AggregationResult            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=aggregation_result,json=aggregationResult,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"aggregation_result" yaml:"task_aggregation_result"`
+	ThresholdScore               github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=threshold_score,json=thresholdScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold_score" yaml:"task_threshold_score"`
+	Epsilon1                     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=epsilon1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epsilon1" yaml:"task_epsilon1"`
+	Epsilon2                     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=epsilon2,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epsilon2" yaml:"task_epsilon2"`
+	AllowedAggregationStrategies []AggregationStrategy                  `protobuf:"varint,7,rep,packed,name=allowed_aggregation_strategies,json=allowedAggregationStrategies,proto3,enum=shentu.oracle.v1alpha1.AggregationStrategy" json:"allowed_aggregation_strategies,omitempty" yaml:"task_allowed_aggregation_strategies"`
+	TrimFraction                 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=trim_fraction,json=trimFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_fraction" yaml:"task_trim_fraction"`
+	MajorityQuorum               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=majority_quorum,json=majorityQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"majority_quorum" yaml:"task_majority_quorum"`
+	MinResponses                 int64                                  `protobuf:"varint,10,opt,name=min_responses,json=minResponses,proto3" json:"min_responses,omitempty" yaml:"task_min_responses"`
+	MinResponseCollateral        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_response_collateral,json=minResponseCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_response_collateral" yaml:"task_min_response_collateral"`
+	InquiryFee                   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=inquiry_fee,json=inquiryFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inquiry_fee" yaml:"task_inquiry_fee"`
 }
 
 func (m *TaskParams) Reset()         { *m = TaskParams{} }
 func (m *TaskParams) String() string { return proto.CompactTextString(m) }
 func (*TaskParams) ProtoMessage()    {}
 func (*TaskParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a60831f9c2fed90, []int{4}
+	return fileDescriptor_8a60831f9c2fed90, []int{7}
 }
 func (m *TaskParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +457,7 @@ func (m *LockedPoolParams) Reset()         { *m = LockedPoolParams{} }
 func (m *LockedPoolParams) String() string { return proto.CompactTextString(m) }
 func (*LockedPoolParams) ProtoMessage()    {}
 func (*LockedPoolParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a60831f9c2fed90, []int{5}
+	return fileDescriptor_8a60831f9c2fed90, []int{8}
 }
 func (m *LockedPoolParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,16 +486,188 @@ func (m *LockedPoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LockedPoolParams proto.InternalMessageInfo
 
+type SlashingParams struct {
+	DeviationThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=deviation_threshold,json=deviationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deviation_threshold" yaml:"deviation_threshold"`
+	DeviationWindow    int64                                  `protobuf:"varint,2,opt,name=deviation_window,json=deviationWindow,proto3" json:"deviation_window,omitempty" yaml:"deviation_window"`
+	MaxDeviations      int64                                  `protobuf:"varint,3,opt,name=max_deviations,json=maxDeviations,proto3" json:"max_deviations,omitempty" yaml:"max_deviations"`
+	SlashFraction      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+}
+
+func (m *SlashingParams) Reset()         { *m = SlashingParams{} }
+func (m *SlashingParams) String() string { return proto.CompactTextString(m) }
+func (*SlashingParams) ProtoMessage()    {}
+func (*SlashingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{9}
+}
+func (m *SlashingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingParams.Merge(m, src)
+}
+func (m *SlashingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingParams proto.InternalMessageInfo
+
+// OperatorDeviations stores the blocks at which an operator's responses
+// deviated from the task results within the deviation window.
+type OperatorDeviations struct {
+	Operator string  `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Heights  []int64 `protobuf:"varint,2,rep,packed,name=heights,proto3" json:"heights,omitempty" yaml:"heights"`
+}
+
+func (m *OperatorDeviations) Reset()         { *m = OperatorDeviations{} }
+func (m *OperatorDeviations) String() string { return proto.CompactTextString(m) }
+func (*OperatorDeviations) ProtoMessage()    {}
+func (*OperatorDeviations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{10}
+}
+func (m *OperatorDeviations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorDeviations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorDeviations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorDeviations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorDeviations.Merge(m, src)
+}
+func (m *OperatorDeviations) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorDeviations) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorDeviations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorDeviations proto.InternalMessageInfo
+
+// Slash records a slashing of an operator's collateral and the response
+// that triggered it.
+type Slash struct {
+	Operator   string                                   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	Height     int64                                    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Contract   string                                   `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	Function   string                                   `protobuf:"bytes,5,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
+	Score      github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,6,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"score" yaml:"score"`
+	Result     github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,7,opt,name=result,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"result" yaml:"result"`
+	Unrevealed bool                                     `protobuf:"varint,8,opt,name=unrevealed,proto3" json:"unrevealed,omitempty" yaml:"unrevealed"`
+	TaskId     uint64                                   `protobuf:"varint,9,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+}
+
+func (m *Slash) Reset()         { *m = Slash{} }
+func (m *Slash) String() string { return proto.CompactTextString(m) }
+func (*Slash) ProtoMessage()    {}
+func (*Slash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{11}
+}
+func (m *Slash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Slash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Slash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Slash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slash.Merge(m, src)
+}
+func (m *Slash) XXX_Size() int {
+	return m.Size()
+}
+func (m *Slash) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slash proto.InternalMessageInfo
+
+type Slashes struct {
+	Slashes []Slash `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+}
+
+func (m *Slashes) Reset()         { *m = Slashes{} }
+func (m *Slashes) String() string { return proto.CompactTextString(m) }
+func (*Slashes) ProtoMessage()    {}
+func (*Slashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a60831f9c2fed90, []int{12}
+}
+func (m *Slashes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Slashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Slashes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Slashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slashes.Merge(m, src)
+}
+func (m *Slashes) XXX_Size() int {
+	return m.Size()
+}
+func (m *Slashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slashes proto.InternalMessageInfo
+
+func (m *Slashes) GetSlashes() []Slash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
 type TaskID struct {
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
 	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
+	Id       uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
 }
 
 func (m *TaskID) Reset()         { *m = TaskID{} }
 func (m *TaskID) String() string { return proto.CompactTextString(m) }
 func (*TaskID) ProtoMessage()    {}
 func (*TaskID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a60831f9c2fed90, []int{6}
+	return fileDescriptor_8a60831f9c2fed90, []int{13}
 }
 func (m *TaskID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +704,7 @@ func (m *TaskIDs) Reset()         { *m = TaskIDs{} }
 func (m *TaskIDs) String() string { return proto.CompactTextString(m) }
 func (*TaskIDs) ProtoMessage()    {}
 func (*TaskIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a60831f9c2fed90, []int{7}
+	return fileDescriptor_8a60831f9c2fed90, []int{14}
 }
 func (m *TaskIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +748,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a60831f9c2fed90, []int{8}
+	return fileDescriptor_8a60831f9c2fed90, []int{15}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,12 +786,20 @@ func (m *CoinsProto) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 
 func init() {
 	proto.RegisterEnum("shentu.oracle.v1alpha1.TaskStatus", TaskStatus_name, TaskStatus_value)
+	proto.RegisterEnum("shentu.oracle.v1alpha1.AggregationStrategy", AggregationStrategy_name, AggregationStrategy_value)
 	proto.RegisterType((*Withdraw)(nil), "shentu.oracle.v1alpha1.Withdraw")
 	proto.RegisterType((*Task)(nil), "shentu.oracle.v1alpha1.Task")
 	proto.RegisterType((*Response)(nil), "shentu.oracle.v1alpha1.Response")
+	proto.RegisterType((*ResponseCommit)(nil), "shentu.oracle.v1alpha1.ResponseCommit")
+	proto.RegisterType((*Inquiry)(nil), "shentu.oracle.v1alpha1.Inquiry")
+	proto.RegisterType((*Inquiries)(nil), "shentu.oracle.v1alpha1.Inquiries")
 	proto.RegisterType((*Operator)(nil), "shentu.oracle.v1alpha1.Operator")
 	proto.RegisterType((*TaskParams)(nil), "shentu.oracle.v1alpha1.TaskParams")
 	proto.RegisterType((*LockedPoolParams)(nil), "shentu.oracle.v1alpha1.LockedPoolParams")
+	proto.RegisterType((*SlashingParams)(nil), "shentu.oracle.v1alpha1.SlashingParams")
+	proto.RegisterType((*OperatorDeviations)(nil), "shentu.oracle.v1alpha1.OperatorDeviations")
+	proto.RegisterType((*Slash)(nil), "shentu.oracle.v1alpha1.Slash")
+	proto.RegisterType((*Slashes)(nil), "shentu.oracle.v1alpha1.Slashes")
 	proto.RegisterType((*TaskID)(nil), "shentu.oracle.v1alpha1.TaskID")
 	proto.RegisterType((*TaskIDs)(nil), "shentu.oracle.v1alpha1.TaskIDs")
 	proto.RegisterType((*CoinsProto)(nil), "shentu.oracle.v1alpha1.CoinsProto")
@@ -455,92 +810,147 @@ func init() {
 }
 
 var fileDescriptor_8a60831f9c2fed90 = []byte{
-	// 1350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xc6, 0x89, 0xe3, 0x4c, 0x9a, 0xd4, 0x99, 0xa4, 0xad, 0xeb, 0xaa, 0x5e, 0x6b, 0xaa,
-	0xef, 0x57, 0x11, 0x94, 0xb5, 0x62, 0x0e, 0xa0, 0x4a, 0x50, 0xe2, 0xd8, 0x41, 0xa6, 0x6d, 0x30,
-	0xe3, 0x54, 0x15, 0x5c, 0xac, 0xf1, 0xee, 0xd4, 0x5e, 0x65, 0xbd, 0x63, 0xed, 0xec, 0xd6, 0xed,
-	0x09, 0x8e, 0x55, 0x4e, 0x3d, 0x22, 0xa4, 0x48, 0x95, 0x7a, 0xe3, 0xc6, 0x8d, 0x3f, 0xa1, 0xc7,
-	0x1e, 0x38, 0x20, 0x0e, 0x2e, 0x6a, 0x2f, 0x08, 0x6e, 0x16, 0x7f, 0x00, 0x9a, 0x1f, 0x6b, 0x6f,
-	0x92, 0x96, 0x62, 0x41, 0x4f, 0xf6, 0xbe, 0xf7, 0x79, 0x9f, 0xf7, 0x63, 0xde, 0x7b, 0xb3, 0x0b,
-	0xae, 0xf0, 0x1e, 0xf5, 0xc3, 0xa8, 0xcc, 0x02, 0x62, 0x7b, 0xb4, 0x7c, 0x6f, 0x8b, 0x78, 0x83,
-	0x1e, 0xd9, 0xd2, 0xcf, 0xd6, 0x20, 0x60, 0x21, 0x83, 0xe7, 0x15, 0xc8, 0xd2, 0xc2, 0x18, 0x54,
-	0xd8, 0xe8, 0xb2, 0x2e, 0x93, 0x90, 0xb2, 0xf8, 0xa7, 0xd0, 0x85, 0xa2, 0xcd, 0x78, 0x9f, 0xf1,
-	0x72, 0x87, 0x70, 0x41, 0xd8, 0xa1, 0x21, 0xd9, 0x2a, 0xdb, 0xcc, 0xf5, 0xb5, 0xde, 0xec, 0x32,
-	0xd6, 0xf5, 0x68, 0x59, 0x3e, 0x75, 0xa2, 0xbb, 0xe5, 0xd0, 0xed, 0x53, 0x1e, 0x92, 0xfe, 0x20,
-	0x26, 0x38, 0x09, 0x70, 0xa2, 0x80, 0x84, 0x2e, 0xd3, 0x04, 0xe8, 0x0f, 0x03, 0x64, 0xef, 0xb8,
-	0x61, 0xcf, 0x09, 0xc8, 0x10, 0x5e, 0x05, 0x8b, 0xc4, 0x71, 0x02, 0xca, 0x79, 0xde, 0x28, 0x19,
-	0x9b, 0x4b, 0x55, 0x38, 0x1e, 0x99, 0xab, 0x0f, 0x48, 0xdf, 0xbb, 0x86, 0xb4, 0x02, 0xe1, 0x18,
-	0x02, 0x43, 0x90, 0x21, 0x7d, 0x16, 0xf9, 0x61, 0x7e, 0xae, 0x94, 0xde, 0x5c, 0xae, 0x5c, 0xb4,
-	0x54, 0xb0, 0x96, 0x08, 0xd6, 0xd2, 0xc1, 0x5a, 0x3b, 0xcc, 0xf5, 0xab, 0xdb, 0x4f, 0x47, 0x66,
-	0x6a, 0x3c, 0x32, 0x57, 0x34, 0x97, 0x34, 0x43, 0xdf, 0x3f, 0x37, 0x37, 0xbb, 0x6e, 0xd8, 0x8b,
-	0x3a, 0x96, 0xcd, 0xfa, 0x65, 0x9d, 0xaa, 0xfa, 0x79, 0x8f, 0x3b, 0x07, 0xe5, 0xf0, 0xc1, 0x80,
-	0x72, 0xc9, 0xc0, 0xb1, 0xf6, 0x05, 0xb7, 0xc0, 0x92, 0x13, 0xd1, 0x76, 0xc7, 0x63, 0xf6, 0x41,
-	0x3e, 0x5d, 0x32, 0x36, 0xd3, 0xd5, 0x8d, 0xf1, 0xc8, 0xcc, 0x29, 0xe6, 0x89, 0x0a, 0xe1, 0xac,
-	0x13, 0xd1, 0xaa, 0xf8, 0x7b, 0x2d, 0xfb, 0xf0, 0xb1, 0x99, 0xfa, 0xed, 0xb1, 0x99, 0x42, 0x7f,
-	0x66, 0xc0, 0xfc, 0x3e, 0xe1, 0x07, 0xb0, 0x0c, 0xb2, 0x36, 0xf3, 0xc3, 0x80, 0xd8, 0xa1, 0x4e,
-	0x75, 0x7d, 0x3c, 0x32, 0xcf, 0x2a, 0x92, 0x58, 0x83, 0xf0, 0x04, 0x24, 0x0c, 0xee, 0x46, 0xbe,
-	0x2d, 0x2a, 0x97, 0x9f, 0x3b, 0x69, 0x10, 0x6b, 0x10, 0x9e, 0x80, 0xe0, 0x07, 0x60, 0xb9, 0x43,
-	0xbb, 0xae, 0x7f, 0x2c, 0xd2, 0xf3, 0xe3, 0x91, 0x09, 0x95, 0x4d, 0x42, 0x89, 0x30, 0x90, 0x4f,
-	0x32, 0x5a, 0x51, 0xd6, 0x8e, 0xc8, 0xf4, 0x41, 0x7e, 0x7e, 0xc6, 0xb2, 0x2a, 0xb3, 0x19, 0xcb,
-	0xaa, 0x8c, 0xe0, 0x87, 0x60, 0xd9, 0xa1, 0xdc, 0x0e, 0xdc, 0x81, 0x4c, 0x71, 0x41, 0xa6, 0x98,
-	0x08, 0x37, 0xa1, 0x44, 0x38, 0x09, 0x85, 0x5f, 0x02, 0x40, 0xef, 0x0f, 0x5c, 0xd5, 0x55, 0xf9,
-	0x4c, 0xc9, 0xd8, 0x5c, 0xae, 0x14, 0x2c, 0xd5, 0x76, 0x56, 0xdc, 0x76, 0xd6, 0x7e, 0xdc, 0x97,
-	0xd5, 0xcb, 0x3a, 0xe8, 0x35, 0x45, 0x3c, 0xb5, 0x45, 0x8f, 0x9e, 0x9b, 0x06, 0x4e, 0x90, 0x89,
-	0x7e, 0xb4, 0x03, 0x4a, 0x42, 0x16, 0xe4, 0x17, 0x4f, 0xf6, 0xa3, 0x56, 0x20, 0x1c, 0x43, 0x20,
-	0x05, 0x4b, 0x01, 0xe5, 0x03, 0xe6, 0x73, 0xca, 0xf3, 0x59, 0x59, 0xbb, 0x92, 0xf5, 0xea, 0x69,
-	0xb3, 0xb0, 0x06, 0x56, 0xff, 0xa7, 0xa3, 0xd1, 0xfd, 0x33, 0x21, 0x10, 0x55, 0x5c, 0x8a, 0x51,
-	0x1c, 0x4f, 0x99, 0xe1, 0x1d, 0x90, 0x09, 0x28, 0x8f, 0xbc, 0x30, 0xbf, 0x24, 0x63, 0xba, 0x2e,
-	0x18, 0x7e, 0x19, 0x99, 0xff, 0xff, 0x07, 0x35, 0x6f, 0xf8, 0xe1, 0xf4, 0xb8, 0x14, 0x0b, 0xc2,
-	0x9a, 0x0e, 0x7e, 0x04, 0x56, 0x6c, 0x8f, 0x71, 0xd7, 0xef, 0xea, 0x9e, 0x01, 0xb2, 0x67, 0xf2,
-	0xe3, 0x91, 0xb9, 0xa1, 0x73, 0x4e, 0xaa, 0x11, 0x3e, 0xa3, 0x9f, 0x55, 0xdf, 0x7c, 0x02, 0x56,
-	0x87, 0xc4, 0x0d, 0x27, 0x7a, 0x9e, 0x5f, 0x96, 0xf6, 0x17, 0xc7, 0x23, 0xf3, 0x9c, 0xb2, 0x3f,
-	0xae, 0x47, 0x78, 0x45, 0x0b, 0x24, 0x01, 0x87, 0xb7, 0x40, 0x86, 0x87, 0x24, 0x8c, 0x78, 0xfe,
-	0x4c, 0xc9, 0xd8, 0x5c, 0xad, 0xa0, 0xd7, 0x55, 0x4f, 0x8c, 0x50, 0x4b, 0x22, 0xab, 0x6b, 0xd3,
-	0x7c, 0x94, 0x2d, 0xc2, 0x9a, 0x24, 0x31, 0x76, 0xbf, 0xcf, 0x81, 0x6c, 0x5c, 0x4b, 0x31, 0x49,
-	0x6c, 0x40, 0x03, 0x79, 0xaa, 0xa7, 0x46, 0x2f, 0xd6, 0x20, 0x3c, 0x01, 0xc1, 0x7d, 0xb0, 0xc0,
-	0x6d, 0x16, 0x50, 0x3d, 0x77, 0x1f, 0xcf, 0x5c, 0xef, 0x33, 0x3a, 0x3e, 0x41, 0x82, 0xb0, 0x22,
-	0x13, 0xc7, 0x38, 0xa4, 0x6e, 0xb7, 0x17, 0xca, 0xd1, 0xfc, 0x17, 0xc7, 0xa8, 0x58, 0x10, 0xd6,
-	0x74, 0x62, 0x7e, 0x03, 0x3a, 0x24, 0x81, 0x33, 0xf3, 0xfc, 0x2a, 0xb3, 0x19, 0xe7, 0x57, 0x19,
-	0x25, 0x8a, 0xfd, 0x63, 0x1a, 0x64, 0x3f, 0x8f, 0x6b, 0x37, 0xdb, 0x46, 0x2f, 0x83, 0xec, 0x20,
-	0x60, 0x03, 0xc6, 0x69, 0x70, 0x7a, 0xc9, 0xc5, 0x1a, 0x84, 0x27, 0x20, 0xf8, 0x8d, 0x01, 0x80,
-	0xcd, 0x3c, 0x8f, 0x84, 0x34, 0x20, 0x5e, 0x3e, 0xfd, 0xa6, 0x84, 0xeb, 0xc7, 0x67, 0x7f, 0x6a,
-	0x3a, 0x5b, 0xd2, 0x09, 0x9f, 0xf0, 0x3b, 0x03, 0xac, 0x13, 0xdb, 0x8e, 0xfa, 0x91, 0x90, 0x38,
-	0x6d, 0x55, 0x0f, 0xfe, 0xe6, 0xe2, 0xef, 0xe9, 0x58, 0x0a, 0xba, 0x1a, 0xa7, 0x39, 0x66, 0x0b,
-	0x0a, 0x26, 0x18, 0xb0, 0x22, 0x80, 0x57, 0xc0, 0xbc, 0x4f, 0xfa, 0x54, 0xaf, 0xd3, 0xb3, 0xe3,
-	0x91, 0xb9, 0xac, 0xbc, 0x09, 0x29, 0xc2, 0x52, 0x99, 0x38, 0xba, 0x27, 0x0b, 0x00, 0x88, 0xd9,
-	0x6a, 0x92, 0x80, 0xf4, 0x39, 0x1c, 0x82, 0xf5, 0xe9, 0x32, 0x6c, 0xc7, 0x17, 0xb7, 0x3c, 0x48,
-	0x91, 0xd9, 0xc9, 0x15, 0x5b, 0xd3, 0x80, 0xea, 0xbb, 0x3a, 0x33, 0x53, 0xf9, 0x0a, 0x09, 0x3f,
-	0x68, 0xbf, 0x82, 0x08, 0x7d, 0x2b, 0xf6, 0x2d, 0x9c, 0x6a, 0x62, 0x02, 0xf8, 0x05, 0x80, 0xa4,
-	0xdb, 0x0d, 0x68, 0x57, 0x19, 0x0c, 0x5d, 0xdf, 0x61, 0x43, 0xd9, 0x11, 0xe9, 0x2a, 0x1a, 0x8f,
-	0xcc, 0x62, 0x82, 0xf8, 0x34, 0x10, 0xe1, 0xb5, 0x84, 0xf0, 0x8e, 0x94, 0xc1, 0xaf, 0x8f, 0x53,
-	0xea, 0x0d, 0xaa, 0x46, 0xaf, 0x39, 0xf3, 0xe8, 0xbd, 0x2e, 0x80, 0x78, 0xa5, 0x26, 0x03, 0xc0,
-	0x6a, 0xbb, 0xde, 0x03, 0x67, 0xc3, 0x5e, 0x40, 0x79, 0x8f, 0x79, 0x4e, 0x5b, 0xed, 0x93, 0x79,
-	0xe9, 0xfd, 0xd6, 0xcc, 0xde, 0x2f, 0x25, 0xbc, 0x9f, 0xe0, 0x44, 0x78, 0x75, 0x22, 0x69, 0xc9,
-	0x3d, 0xd3, 0x01, 0x59, 0x3a, 0xe0, 0xae, 0xc7, 0xfc, 0x2d, 0xdd, 0x06, 0xbb, 0x33, 0x3b, 0xdc,
-	0x48, 0x1e, 0xa4, 0x26, 0x43, 0x78, 0xc2, 0x9b, 0xf0, 0x51, 0x91, 0x17, 0xf0, 0x7f, 0xe4, 0xa3,
-	0x32, 0xf5, 0x51, 0x49, 0x74, 0xe9, 0x0f, 0x06, 0xc8, 0xdd, 0x64, 0xf6, 0x01, 0x75, 0x9a, 0x8c,
-	0x79, 0xba, 0x57, 0xeb, 0x20, 0xe7, 0x49, 0x59, 0x3b, 0x7e, 0xab, 0x51, 0x1b, 0x27, 0x5d, 0xbd,
-	0x34, 0x1e, 0x99, 0x17, 0x14, 0xf9, 0x49, 0x04, 0xc2, 0xab, 0x4a, 0xd4, 0xf0, 0xf5, 0x15, 0x74,
-	0x13, 0xc0, 0xbe, 0xeb, 0xbb, 0xfd, 0xa8, 0xdf, 0x4e, 0xec, 0x15, 0xd5, 0x79, 0x97, 0xc7, 0x23,
-	0xf3, 0xa2, 0x22, 0x3a, 0x8d, 0x41, 0x78, 0x4d, 0x0b, 0x77, 0x26, 0xb2, 0x44, 0xcc, 0x21, 0xc8,
-	0x88, 0xc1, 0x6a, 0xd4, 0xde, 0xfe, 0x9b, 0x5f, 0xc2, 0xeb, 0x67, 0x60, 0x51, 0x79, 0xe5, 0xf0,
-	0x3a, 0xc8, 0xca, 0xd2, 0xba, 0x8e, 0xa8, 0x8b, 0x58, 0x4d, 0xc5, 0xbf, 0xbb, 0x5d, 0x1b, 0xb5,
-	0xea, 0xbc, 0x38, 0x42, 0xbc, 0x28, 0xac, 0x1a, 0x0e, 0x47, 0x62, 0xd5, 0xca, 0x45, 0xd3, 0x94,
-	0x9f, 0x11, 0x01, 0x58, 0x10, 0x9f, 0x01, 0x31, 0xd9, 0xdb, 0x7d, 0xf7, 0x56, 0xae, 0xde, 0xf9,
-	0xc9, 0x50, 0xeb, 0x49, 0x5d, 0xfd, 0xd0, 0x02, 0x17, 0xf6, 0xb7, 0x5b, 0x37, 0xda, 0xad, 0xfd,
-	0xed, 0xfd, 0xdb, 0xad, 0xf6, 0xed, 0xbd, 0x56, 0xb3, 0xbe, 0xd3, 0xd8, 0x6d, 0xd4, 0x6b, 0xb9,
-	0x54, 0x61, 0xed, 0xf0, 0xa8, 0xb4, 0x32, 0x05, 0xef, 0xb9, 0x1e, 0xb4, 0xc0, 0x7a, 0x12, 0xdf,
-	0xac, 0xef, 0xd5, 0x1a, 0x7b, 0x9f, 0xe6, 0x8c, 0xc2, 0xb9, 0xc3, 0xa3, 0xd2, 0xda, 0x14, 0xdb,
-	0xa4, 0xbe, 0xe3, 0xfa, 0x5d, 0x58, 0x01, 0xe7, 0x92, 0xf8, 0xd6, 0xed, 0x9d, 0x9d, 0x7a, 0xbd,
-	0x56, 0xaf, 0xe5, 0xe6, 0x0a, 0x17, 0x0e, 0x8f, 0x4a, 0xeb, 0x53, 0x8b, 0x56, 0x64, 0xdb, 0x94,
-	0x3a, 0xd4, 0x81, 0x57, 0x01, 0x4c, 0xda, 0xec, 0x6e, 0x37, 0x6e, 0xd6, 0x6b, 0xb9, 0x74, 0x61,
-	0xe3, 0xf0, 0xa8, 0x94, 0x9b, 0x1a, 0xec, 0x12, 0xd7, 0xa3, 0x4e, 0x61, 0xfe, 0xe1, 0x93, 0x62,
-	0xaa, 0x7a, 0xe3, 0xe9, 0x8b, 0xa2, 0xf1, 0xec, 0x45, 0xd1, 0xf8, 0xf5, 0x45, 0xd1, 0x78, 0xf4,
-	0xb2, 0x98, 0x7a, 0xf6, 0xb2, 0x98, 0xfa, 0xf9, 0x65, 0x31, 0xf5, 0xd5, 0x56, 0xb2, 0x42, 0x34,
-	0x08, 0xdd, 0x83, 0xbb, 0x2c, 0xf2, 0x1d, 0xb9, 0x56, 0xca, 0xfa, 0x63, 0xef, 0x7e, 0xfc, 0xb9,
-	0x27, 0x0b, 0xd6, 0xc9, 0xc8, 0x75, 0xfc, 0xfe, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x9d,
-	0x0f, 0xdf, 0x0c, 0x0e, 0x00, 0x00,
+	// 2237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xd7, 0x8a, 0x7a, 0x50, 0xa3, 0x87, 0xa9, 0x91, 0x6c, 0x6f, 0x98, 0x9a, 0xcb, 0x8c, 0x9b,
+	0x40, 0xb5, 0x5d, 0x12, 0x52, 0x11, 0xb4, 0x08, 0xd0, 0xc4, 0xa4, 0x48, 0xc9, 0x8c, 0x2d, 0x59,
+	0x1e, 0x52, 0x70, 0xdd, 0x0b, 0xb1, 0xda, 0x1d, 0x91, 0x1b, 0xed, 0x83, 0xd9, 0x5d, 0x5a, 0xd2,
+	0x21, 0x48, 0x8f, 0x81, 0x80, 0x02, 0x01, 0x72, 0x68, 0x51, 0x40, 0x40, 0x82, 0xde, 0x0a, 0xf4,
+	0xd0, 0x5b, 0x0f, 0xfd, 0x03, 0x72, 0xcc, 0xa1, 0x87, 0xa0, 0x07, 0xa6, 0xb0, 0x2f, 0x05, 0x7a,
+	0x2a, 0xff, 0x82, 0x62, 0x1e, 0xcb, 0x1d, 0x52, 0x94, 0x95, 0x45, 0xdd, 0xf6, 0x24, 0xee, 0xf7,
+	0xf8, 0xcd, 0xf7, 0x9a, 0x6f, 0xe6, 0x1b, 0x81, 0xdb, 0x41, 0x9b, 0xb8, 0x61, 0xb7, 0xe8, 0xf9,
+	0xba, 0x61, 0x93, 0xe2, 0xf3, 0x75, 0xdd, 0xee, 0xb4, 0xf5, 0x75, 0xf1, 0x5d, 0xe8, 0xf8, 0x5e,
+	0xe8, 0xc1, 0x1b, 0x5c, 0xa8, 0x20, 0x88, 0x91, 0x50, 0x76, 0xb5, 0xe5, 0xb5, 0x3c, 0x26, 0x52,
+	0xa4, 0xbf, 0xb8, 0x74, 0x36, 0x67, 0x78, 0x81, 0xe3, 0x05, 0xc5, 0x03, 0x3d, 0xa0, 0x80, 0x07,
+	0x24, 0xd4, 0xd7, 0x8b, 0x86, 0x67, 0xb9, 0x82, 0xaf, 0xb5, 0x3c, 0xaf, 0x65, 0x93, 0x22, 0xfb,
+	0x3a, 0xe8, 0x1e, 0x16, 0x43, 0xcb, 0x21, 0x41, 0xa8, 0x3b, 0x9d, 0x08, 0x60, 0x54, 0xc0, 0xec,
+	0xfa, 0x7a, 0x68, 0x79, 0x02, 0x00, 0xfd, 0x53, 0x01, 0xe9, 0xa7, 0x56, 0xd8, 0x36, 0x7d, 0xfd,
+	0x18, 0xde, 0x03, 0xb3, 0xba, 0x69, 0xfa, 0x24, 0x08, 0x54, 0x25, 0xaf, 0xac, 0xcd, 0x95, 0x61,
+	0xbf, 0xa7, 0x2d, 0x9d, 0xea, 0x8e, 0xfd, 0x1e, 0x12, 0x0c, 0x84, 0x23, 0x11, 0x18, 0x82, 0x19,
+	0xdd, 0xf1, 0xba, 0x6e, 0xa8, 0x4e, 0xe6, 0x53, 0x6b, 0xf3, 0x1b, 0x6f, 0x14, 0xb8, 0xb1, 0x05,
+	0x6a, 0x6c, 0x41, 0x18, 0x5b, 0xd8, 0xf4, 0x2c, 0xb7, 0x5c, 0xfa, 0xba, 0xa7, 0x4d, 0xf4, 0x7b,
+	0xda, 0xa2, 0xc0, 0x62, 0x6a, 0xe8, 0x0f, 0xdf, 0x69, 0x6b, 0x2d, 0x2b, 0x6c, 0x77, 0x0f, 0x0a,
+	0x86, 0xe7, 0x14, 0x85, 0xab, 0xfc, 0xcf, 0x8f, 0x03, 0xf3, 0xa8, 0x18, 0x9e, 0x76, 0x48, 0xc0,
+	0x10, 0x02, 0x2c, 0xd6, 0x82, 0xeb, 0x60, 0xce, 0xec, 0x92, 0xe6, 0x81, 0xed, 0x19, 0x47, 0x6a,
+	0x2a, 0xaf, 0xac, 0xa5, 0xca, 0xab, 0xfd, 0x9e, 0x96, 0xe1, 0xc8, 0x03, 0x16, 0xc2, 0x69, 0xb3,
+	0x4b, 0xca, 0xf4, 0xe7, 0x7b, 0xe9, 0xcf, 0xbe, 0xd4, 0x26, 0xfe, 0xf1, 0xa5, 0x36, 0x81, 0xfe,
+	0x38, 0x07, 0xa6, 0x1a, 0x7a, 0x70, 0x04, 0x6f, 0x81, 0x49, 0xcb, 0x54, 0xaf, 0xe5, 0x95, 0xb5,
+	0xa9, 0xf2, 0x62, 0xbf, 0xa7, 0xcd, 0x71, 0x75, 0xcb, 0x44, 0x78, 0xd2, 0x32, 0x61, 0x11, 0xa4,
+	0x0d, 0xcf, 0x0d, 0x7d, 0xdd, 0x08, 0x45, 0x24, 0x56, 0xfa, 0x3d, 0xed, 0x1a, 0x17, 0x8a, 0x38,
+	0x08, 0x0f, 0x84, 0xa8, 0xc2, 0x61, 0xd7, 0x35, 0x68, 0x60, 0xd5, 0xc9, 0x51, 0x85, 0x88, 0x83,
+	0xf0, 0x40, 0x08, 0xfe, 0x14, 0xcc, 0x1f, 0x90, 0x96, 0xe5, 0x0e, 0x39, 0x72, 0xa3, 0xdf, 0xd3,
+	0x20, 0xd7, 0x91, 0x98, 0x08, 0x03, 0xf6, 0xc5, 0x9c, 0xa1, 0x51, 0x3f, 0xa0, 0x81, 0x38, 0x55,
+	0xa7, 0x12, 0x46, 0x9d, 0xab, 0x25, 0x8c, 0x3a, 0x57, 0x82, 0x3f, 0x03, 0xf3, 0x26, 0x09, 0x0c,
+	0xdf, 0xea, 0x30, 0x17, 0xa7, 0x99, 0x8b, 0x92, 0xb9, 0x12, 0x13, 0x61, 0x59, 0x14, 0x3e, 0x03,
+	0x80, 0x9c, 0x74, 0x2c, 0x5e, 0x74, 0xea, 0x4c, 0x5e, 0x59, 0x9b, 0xdf, 0xc8, 0x16, 0x78, 0x55,
+	0x16, 0xa2, 0xaa, 0x2c, 0x34, 0xa2, 0xb2, 0x2d, 0xdf, 0x12, 0x46, 0x2f, 0x73, 0xe0, 0x58, 0x17,
+	0x7d, 0xfe, 0x9d, 0xa6, 0x60, 0x09, 0x8c, 0x96, 0xab, 0xe1, 0x13, 0x3d, 0xf4, 0x7c, 0x75, 0x76,
+	0xb4, 0x5c, 0x05, 0x03, 0xe1, 0x48, 0x04, 0x12, 0x30, 0xe7, 0x93, 0xa0, 0xe3, 0xb9, 0x01, 0x09,
+	0xd4, 0x34, 0x8b, 0x5d, 0xbe, 0x30, 0x7e, 0x33, 0x16, 0xb0, 0x10, 0x2c, 0xbf, 0x2d, 0xac, 0x11,
+	0xe5, 0x35, 0x00, 0xa0, 0x51, 0x9c, 0x8b, 0xa4, 0x02, 0x1c, 0x23, 0xc3, 0xa7, 0x60, 0xc6, 0x27,
+	0x41, 0xd7, 0x0e, 0xd5, 0x39, 0x66, 0xd3, 0x07, 0x14, 0xe1, 0x6f, 0x3d, 0xed, 0x9d, 0xef, 0x11,
+	0xf3, 0x9a, 0x1b, 0xc6, 0xe9, 0xe2, 0x28, 0x08, 0x0b, 0x38, 0xf8, 0x73, 0xb0, 0x68, 0xd8, 0x5e,
+	0x60, 0xb9, 0x2d, 0x51, 0x33, 0x80, 0xd5, 0x8c, 0xda, 0xef, 0x69, 0xab, 0xc2, 0x67, 0x99, 0x8d,
+	0xf0, 0x82, 0xf8, 0xe6, 0x75, 0x73, 0x1f, 0x2c, 0x1d, 0xeb, 0x56, 0x38, 0xe0, 0x07, 0xea, 0x3c,
+	0xd3, 0x7f, 0xa3, 0xdf, 0xd3, 0xae, 0x73, 0xfd, 0x61, 0x3e, 0xc2, 0x8b, 0x82, 0xc0, 0x00, 0x02,
+	0xb8, 0x03, 0x66, 0x82, 0x50, 0x0f, 0xbb, 0x81, 0xba, 0x90, 0x57, 0xd6, 0x96, 0x36, 0xd0, 0x65,
+	0xd1, 0xa3, 0x3b, 0xac, 0xce, 0x24, 0xcb, 0xcb, 0xb1, 0x3f, 0x5c, 0x17, 0x61, 0x01, 0x42, 0xfd,
+	0xf1, 0xc9, 0x73, 0xa2, 0xdb, 0x91, 0x3d, 0x8b, 0xa3, 0xfe, 0x0c, 0xb1, 0x11, 0x5e, 0xe0, 0xdf,
+	0xc2, 0x9a, 0x5f, 0x80, 0x59, 0xc3, 0x73, 0x1c, 0x2b, 0x0c, 0xd4, 0x25, 0x96, 0xcc, 0x77, 0xae,
+	0x4a, 0xe6, 0x26, 0x13, 0x2f, 0xdf, 0x10, 0x29, 0x8d, 0x0a, 0x85, 0x83, 0xd0, 0x42, 0xe1, 0xbf,
+	0xe0, 0xa7, 0x60, 0x55, 0x6f, 0xb5, 0x7c, 0xd2, 0x62, 0x55, 0xd6, 0x0c, 0x42, 0x5f, 0x0f, 0x49,
+	0xeb, 0x54, 0xcd, 0x30, 0xaf, 0xef, 0x5e, 0xb6, 0x4c, 0x29, 0xd6, 0xa9, 0x0b, 0x95, 0xb2, 0xd6,
+	0xef, 0x69, 0x6f, 0xf2, 0x75, 0xc6, 0x41, 0x22, 0xbc, 0xa2, 0x5f, 0xd4, 0x92, 0xfa, 0xd5, 0x57,
+	0x29, 0x90, 0x8e, 0xcc, 0xa7, 0x3d, 0xc6, 0xeb, 0x10, 0x9f, 0xd5, 0xfb, 0x85, 0xa6, 0x14, 0x71,
+	0x10, 0x1e, 0x08, 0xc1, 0x06, 0x98, 0x0e, 0x0c, 0xcf, 0x27, 0xa2, 0x23, 0xbd, 0x9f, 0xb8, 0x12,
+	0x17, 0x44, 0xe6, 0x28, 0x08, 0xc2, 0x1c, 0x8c, 0x16, 0xf8, 0x31, 0xb1, 0x5a, 0xed, 0x50, 0x4d,
+	0xfd, 0x67, 0x05, 0xce, 0x51, 0x10, 0x16, 0x70, 0xb4, 0xb3, 0xf9, 0xe4, 0x58, 0xf7, 0xcd, 0xc4,
+	0x9d, 0x8d, 0xab, 0x25, 0xec, 0x6c, 0x5c, 0x09, 0xbe, 0x0b, 0x80, 0xe1, 0xb9, 0x87, 0x96, 0x49,
+	0x5c, 0x83, 0xb0, 0xc6, 0x96, 0x2a, 0x5f, 0x8f, 0xfb, 0x4f, 0xcc, 0x43, 0x58, 0x12, 0x94, 0x73,
+	0xa4, 0x80, 0xa5, 0xe1, 0x12, 0x4b, 0x9e, 0xa9, 0xdb, 0x60, 0xaa, 0xad, 0x07, 0x6d, 0x96, 0xa8,
+	0x85, 0xf2, 0xb5, 0x7e, 0x4f, 0x9b, 0xe7, 0xc2, 0x94, 0x8a, 0x30, 0x63, 0x52, 0x54, 0xbe, 0x03,
+	0x88, 0xc9, 0x42, 0x9f, 0x96, 0x51, 0x23, 0x0e, 0xc2, 0x03, 0x21, 0xc9, 0xc6, 0xbf, 0x4c, 0x82,
+	0xd9, 0x9a, 0xfb, 0x71, 0xd7, 0xf2, 0x4f, 0xe1, 0x5d, 0x30, 0x1b, 0xea, 0xc1, 0x51, 0xd3, 0x32,
+	0x99, 0x6d, 0x53, 0x72, 0xd7, 0x14, 0x0c, 0x84, 0x67, 0xe8, 0xaf, 0x1a, 0x3b, 0x08, 0x2d, 0xa6,
+	0x47, 0xfc, 0x8b, 0xe7, 0x5a, 0xc4, 0x41, 0x78, 0x20, 0x04, 0x8f, 0x40, 0xea, 0x90, 0x10, 0x35,
+	0x75, 0x55, 0x06, 0xdf, 0x17, 0x19, 0x04, 0xe2, 0x88, 0x24, 0x24, 0x59, 0xfa, 0xe8, 0x2a, 0xec,
+	0x98, 0xd6, 0x6d, 0xfb, 0x40, 0x37, 0x8e, 0xd4, 0xa9, 0x0b, 0xc7, 0xb4, 0xe0, 0xd0, 0x63, 0x5a,
+	0xfc, 0x64, 0xbe, 0x9f, 0x34, 0x59, 0xa8, 0xa7, 0x47, 0x4f, 0x0c, 0xc1, 0xa0, 0xbe, 0x9f, 0x3c,
+	0xd0, 0x83, 0xb6, 0x14, 0xbe, 0x3d, 0x30, 0xc7, 0xa3, 0x67, 0x91, 0x00, 0x6e, 0x82, 0x39, 0x2b,
+	0xfa, 0x50, 0x15, 0xe6, 0xa7, 0x76, 0x59, 0x4f, 0x10, 0x31, 0x2f, 0x4f, 0x51, 0x6f, 0x71, 0xac,
+	0x87, 0xfe, 0x9c, 0x02, 0xe9, 0xc7, 0x51, 0xf6, 0x93, 0x5d, 0xbb, 0x8a, 0x20, 0xdd, 0xf1, 0xbd,
+	0x8e, 0x17, 0x8c, 0x4b, 0x49, 0xc4, 0x41, 0x78, 0x20, 0x04, 0x7f, 0xa5, 0xd0, 0x12, 0xb7, 0x6d,
+	0x3d, 0x24, 0xbe, 0x6e, 0x5f, 0x9d, 0x9a, 0xea, 0xf0, 0x09, 0x1c, 0xab, 0x26, 0xcb, 0x90, 0xb4,
+	0x26, 0xfc, 0x9d, 0x02, 0x56, 0x74, 0xc3, 0xe8, 0x3a, 0x5d, 0x4a, 0x31, 0x9b, 0x7c, 0xef, 0x05,
+	0x57, 0x6f, 0xf4, 0x5d, 0x61, 0x4b, 0x56, 0x44, 0xe3, 0x22, 0x46, 0x32, 0xa3, 0xa0, 0x84, 0x80,
+	0x39, 0x00, 0xdd, 0x7c, 0xae, 0xee, 0x10, 0x51, 0x11, 0xd2, 0xe6, 0xa3, 0x54, 0x84, 0x19, 0x53,
+	0x2a, 0x86, 0x7f, 0x01, 0x00, 0xe8, 0x09, 0xb7, 0xa7, 0xfb, 0xba, 0x13, 0xc0, 0x63, 0xb0, 0x12,
+	0x5f, 0x49, 0x9a, 0xd1, 0xed, 0x9a, 0x25, 0x92, 0x7a, 0x36, 0x7a, 0xd1, 0xa9, 0x08, 0x81, 0xf2,
+	0x5d, 0xe1, 0x99, 0x26, 0xed, 0xbc, 0x31, 0x40, 0xe8, 0xb7, 0xf4, 0xd6, 0x03, 0x63, 0x4e, 0x04,
+	0x00, 0x9f, 0x00, 0x28, 0x9f, 0x29, 0xc7, 0x96, 0x6b, 0x7a, 0xc7, 0xac, 0x22, 0x52, 0x65, 0xd4,
+	0xef, 0x69, 0x39, 0x09, 0xf8, 0xa2, 0x20, 0xc2, 0xcb, 0x12, 0xf1, 0x29, 0xa3, 0xc1, 0x4f, 0x87,
+	0x21, 0xc5, 0x3d, 0x86, 0xb7, 0xf9, 0xbd, 0xc4, 0x6d, 0xfe, 0x32, 0x03, 0xa2, 0x8b, 0x8d, 0x6c,
+	0x00, 0x66, 0x34, 0xf8, 0x1c, 0x5c, 0x0b, 0xdb, 0x3e, 0x09, 0xda, 0x9e, 0x6d, 0x36, 0xf9, 0xd9,
+	0xc5, 0xf7, 0xf5, 0x4e, 0xe2, 0xd5, 0xdf, 0x94, 0x56, 0x1f, 0xc1, 0x44, 0x78, 0x69, 0x40, 0xa9,
+	0x53, 0x02, 0x3c, 0x00, 0x69, 0xd2, 0x09, 0x2c, 0xdb, 0x73, 0xd7, 0x45, 0x19, 0x6c, 0x25, 0x5e,
+	0x70, 0x55, 0x4e, 0xa4, 0x00, 0x43, 0x78, 0x80, 0x2b, 0xad, 0xb1, 0xa1, 0xce, 0xbc, 0xbe, 0x35,
+	0x36, 0xe2, 0x35, 0x36, 0xe0, 0x57, 0x0a, 0xc8, 0xe9, 0xb6, 0xed, 0x1d, 0x13, 0xb3, 0x39, 0xe6,
+	0xc2, 0x41, 0x3b, 0xd6, 0x6c, 0x3e, 0x95, 0xf4, 0x16, 0x53, 0xe8, 0xf7, 0xb4, 0x3b, 0x72, 0x32,
+	0x5f, 0xb9, 0x02, 0xc2, 0x3f, 0x10, 0x02, 0x17, 0xb1, 0x68, 0xff, 0xec, 0x80, 0xc5, 0xd0, 0xb7,
+	0x9c, 0xe6, 0x21, 0x1d, 0x9c, 0xe8, 0x56, 0x49, 0xb3, 0x60, 0x3c, 0x4c, 0x10, 0x8c, 0x0a, 0x31,
+	0xfa, 0x3d, 0xed, 0x0d, 0x39, 0xc3, 0x32, 0x22, 0xc2, 0x0b, 0xf4, 0x7b, 0x4b, 0x7c, 0xd2, 0xaa,
+	0x72, 0xf4, 0x8f, 0x3c, 0xdf, 0x0a, 0x4f, 0x9b, 0x1f, 0x77, 0x3d, 0xbf, 0xeb, 0xa8, 0x73, 0x89,
+	0xab, 0x8a, 0xaf, 0x29, 0x57, 0xd5, 0x08, 0x26, 0xc2, 0x4b, 0x11, 0xe5, 0x09, 0x23, 0xc0, 0x32,
+	0x58, 0x74, 0x2c, 0x56, 0xef, 0x62, 0xea, 0xe0, 0x37, 0xf6, 0x5b, 0x23, 0xb6, 0x0f, 0xc9, 0x20,
+	0xbc, 0xe0, 0x58, 0xee, 0x60, 0xb2, 0x80, 0xbf, 0x56, 0xc0, 0x4d, 0x59, 0xa0, 0x29, 0x75, 0xf2,
+	0x79, 0xe6, 0xc4, 0x7e, 0xe2, 0x2a, 0xba, 0x7d, 0xc9, 0xe2, 0x12, 0x36, 0xc2, 0xd7, 0x25, 0x33,
+	0x36, 0x07, 0x74, 0xf8, 0x11, 0x98, 0xe7, 0xa7, 0xd8, 0x69, 0x93, 0x9e, 0xf3, 0x0b, 0xcc, 0x84,
+	0x5a, 0x62, 0x13, 0x6e, 0xca, 0xf7, 0x8d, 0x18, 0x0f, 0x61, 0x20, 0xbe, 0xb6, 0x88, 0xdc, 0x73,
+	0xff, 0xa4, 0x80, 0xcc, 0x23, 0xcf, 0x38, 0x22, 0xe6, 0x9e, 0xe7, 0xd9, 0xa2, 0xf3, 0x56, 0x41,
+	0xc6, 0x66, 0xb4, 0x66, 0x34, 0x29, 0xf3, 0xf3, 0x33, 0x55, 0x7e, 0x33, 0x5e, 0x61, 0x54, 0x02,
+	0xe1, 0x25, 0x4e, 0xaa, 0xb9, 0x62, 0x90, 0x78, 0x04, 0xa0, 0x63, 0xb9, 0x96, 0xd3, 0x75, 0xe4,
+	0xd8, 0x4e, 0x8e, 0xa6, 0xea, 0xa2, 0x0c, 0xc2, 0xcb, 0x82, 0x18, 0xc7, 0x47, 0xb2, 0xf9, 0x8b,
+	0x14, 0x58, 0xaa, 0xdb, 0x7a, 0xd0, 0xb6, 0xdc, 0x96, 0xb0, 0xf8, 0x13, 0xb0, 0x62, 0x92, 0xe7,
+	0x16, 0xdf, 0x31, 0x83, 0x16, 0x24, 0x0e, 0xfd, 0x47, 0x89, 0x83, 0x98, 0x8d, 0x66, 0xef, 0x0b,
+	0x90, 0x08, 0xc3, 0x01, 0xb5, 0x11, 0x11, 0xe1, 0x16, 0xc8, 0xc4, 0xb2, 0x43, 0xe7, 0x85, 0x14,
+	0xb0, 0x51, 0x09, 0x84, 0xaf, 0x0d, 0x48, 0xe2, 0x98, 0xb8, 0x0f, 0x96, 0x1c, 0xfd, 0xa4, 0x39,
+	0x20, 0x07, 0x6a, 0x6a, 0x74, 0x94, 0x1c, 0xe6, 0x23, 0xbc, 0xe8, 0xe8, 0x27, 0x95, 0xc1, 0x37,
+	0x74, 0xc1, 0x52, 0x40, 0x43, 0x13, 0x37, 0x01, 0xde, 0xe6, 0xb7, 0x13, 0x6f, 0x48, 0xb1, 0xde,
+	0x30, 0x1a, 0xc2, 0x8b, 0x8c, 0x10, 0x75, 0x00, 0x29, 0x2b, 0x9f, 0x00, 0x18, 0xdd, 0xbb, 0x24,
+	0x7b, 0x12, 0x5f, 0xd8, 0xef, 0x81, 0xd9, 0x36, 0x9b, 0x5a, 0x02, 0xf6, 0xf8, 0x95, 0x92, 0xaf,
+	0x6c, 0x82, 0x81, 0x70, 0x24, 0x22, 0x2d, 0xff, 0xed, 0x14, 0x98, 0x66, 0x45, 0x91, 0x7c, 0xc9,
+	0xff, 0xcf, 0x73, 0xdb, 0x8f, 0xc0, 0x4c, 0x3b, 0x9e, 0xf6, 0x52, 0xf2, 0x40, 0xdf, 0x8e, 0xe6,
+	0x37, 0xfe, 0x63, 0xe8, 0xd1, 0x6c, 0x2a, 0xe9, 0xa3, 0xd9, 0xf4, 0xf7, 0x79, 0x34, 0x1b, 0x0c,
+	0xb4, 0x33, 0xaf, 0x79, 0xa0, 0x15, 0x37, 0x9d, 0xd9, 0xd7, 0xfb, 0x62, 0xf3, 0x2e, 0x00, 0x5d,
+	0x77, 0x30, 0xb2, 0xa5, 0xd9, 0xc8, 0x26, 0x8d, 0x96, 0x31, 0x0f, 0x61, 0x49, 0x50, 0x1e, 0xd0,
+	0xe6, 0xae, 0x1a, 0xd0, 0xa4, 0xd2, 0x7a, 0x00, 0x66, 0x59, 0x65, 0x11, 0xfa, 0xb4, 0x32, 0x1b,
+	0xf0, 0x9f, 0x62, 0x40, 0xb9, 0x75, 0xd9, 0x71, 0xcf, 0x34, 0xc4, 0x78, 0x12, 0xe9, 0xa0, 0x2f,
+	0x14, 0x30, 0x43, 0x6f, 0xb8, 0xb5, 0xca, 0xff, 0xe0, 0x21, 0x94, 0xbf, 0xc4, 0xa6, 0x2e, 0x79,
+	0x89, 0x95, 0xfc, 0xfb, 0x10, 0xcc, 0x72, 0xa3, 0x02, 0xf8, 0x01, 0x48, 0x8b, 0x40, 0x44, 0x0e,
+	0xe6, 0x5e, 0xf5, 0x16, 0x55, 0xab, 0x44, 0x1e, 0xf2, 0xa0, 0x05, 0x88, 0x8e, 0x44, 0xac, 0xce,
+	0xf7, 0xd8, 0x9b, 0xbc, 0x0f, 0xa6, 0xe9, 0x9b, 0x7a, 0x04, 0xf6, 0xdf, 0xdd, 0x59, 0x7c, 0xa9,
+	0x3b, 0x7f, 0x55, 0x00, 0x88, 0x1f, 0xca, 0x60, 0x01, 0xdc, 0x6c, 0x94, 0xea, 0x0f, 0x9b, 0xf5,
+	0x46, 0xa9, 0xb1, 0x5f, 0x6f, 0xee, 0xef, 0xd6, 0xf7, 0xaa, 0x9b, 0xb5, 0xad, 0x5a, 0xb5, 0x92,
+	0x99, 0xc8, 0x2e, 0x9f, 0x9d, 0xe7, 0x17, 0x63, 0xe1, 0x5d, 0xcb, 0x86, 0x05, 0xb0, 0x22, 0xcb,
+	0xef, 0x55, 0x77, 0x2b, 0xb5, 0xdd, 0xed, 0x8c, 0x92, 0xbd, 0x7e, 0x76, 0x9e, 0x5f, 0x8e, 0x65,
+	0xf7, 0x88, 0x6b, 0x5a, 0x6e, 0x0b, 0x6e, 0x80, 0xeb, 0xb2, 0x7c, 0x7d, 0x7f, 0x73, 0xb3, 0x5a,
+	0xad, 0x54, 0x2b, 0x99, 0xc9, 0xec, 0xcd, 0xb3, 0xf3, 0xfc, 0x4a, 0xac, 0x51, 0xef, 0x1a, 0x06,
+	0x21, 0x26, 0x31, 0xe1, 0x3d, 0x00, 0x65, 0x9d, 0xad, 0x52, 0xed, 0x51, 0xb5, 0x92, 0x49, 0x65,
+	0x57, 0xcf, 0xce, 0xf3, 0x99, 0x58, 0x61, 0x4b, 0xb7, 0x6c, 0x62, 0x66, 0xa7, 0x3e, 0xfb, 0x7d,
+	0x6e, 0xe2, 0xce, 0x6f, 0x52, 0x60, 0x65, 0xcc, 0x1d, 0x12, 0xde, 0x07, 0xf9, 0xd2, 0xf6, 0x36,
+	0xae, 0x6e, 0x97, 0x1a, 0xb5, 0xc7, 0xbb, 0xcd, 0x7a, 0x03, 0x97, 0x1a, 0xd5, 0xed, 0x67, 0x23,
+	0x8e, 0x66, 0xcf, 0xce, 0xf3, 0x37, 0xc6, 0xa8, 0x53, 0x8f, 0x1f, 0x02, 0x34, 0x16, 0xe1, 0x69,
+	0xb5, 0xb6, 0xfd, 0xa0, 0x51, 0xad, 0x34, 0x77, 0xaa, 0xa5, 0xdd, 0x8c, 0x92, 0xbd, 0x7d, 0x76,
+	0x9e, 0xd7, 0xc6, 0x60, 0x3c, 0x65, 0x5d, 0x8a, 0x98, 0x3b, 0x44, 0x77, 0xe1, 0x63, 0xf0, 0xc3,
+	0xab, 0xc0, 0x2a, 0xb5, 0xd2, 0x6e, 0x66, 0x32, 0xfb, 0xf6, 0xd9, 0x79, 0xfe, 0xad, 0x57, 0xc2,
+	0x99, 0x96, 0xee, 0xc2, 0x1a, 0x78, 0x6b, 0x2c, 0x60, 0x03, 0xd7, 0x76, 0x76, 0x22, 0xe3, 0x52,
+	0x59, 0x74, 0x76, 0x9e, 0xcf, 0x8d, 0x41, 0x6b, 0xf8, 0x96, 0xe3, 0x5c, 0x61, 0xdb, 0x93, 0xfd,
+	0xc7, 0x78, 0x7f, 0xa7, 0xb9, 0x53, 0xfa, 0xf0, 0x31, 0xae, 0x35, 0x9e, 0x65, 0xa6, 0x2e, 0xb5,
+	0x8d, 0xdf, 0x3f, 0x77, 0xc4, 0x6d, 0x94, 0x67, 0xa6, 0xfc, 0xf0, 0xeb, 0x17, 0x39, 0xe5, 0x9b,
+	0x17, 0x39, 0xe5, 0xef, 0x2f, 0x72, 0xca, 0xe7, 0x2f, 0x73, 0x13, 0xdf, 0xbc, 0xcc, 0x4d, 0x7c,
+	0xfb, 0x32, 0x37, 0xf1, 0xcb, 0x75, 0xb9, 0x76, 0x89, 0x1f, 0x5a, 0x47, 0x87, 0x5e, 0xd7, 0x35,
+	0x19, 0x64, 0x51, 0xfc, 0x4f, 0xeb, 0x24, 0xfa, 0xaf, 0x16, 0x2b, 0xe5, 0x83, 0x19, 0x36, 0xd0,
+	0xfe, 0xe4, 0xdf, 0x03, 0x00, 0x45, 0xb0, 0x76, 0x88, 0xf3, 0x1a, 0x00, 0x00,
 }
 
 func (m *Withdraw) Marshal() (dAtA []byte, err error) {
@@ -612,6 +1022,37 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggregationStrategy != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AggregationStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Id != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.RevealBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RevealBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Status != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.Status))
 		i--
@@ -729,6 +1170,11 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Confidence != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Confidence))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Reward) > 0 {
 		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -773,7 +1219,7 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operator) Marshal() (dAtA []byte, err error) {
+func (m *ResponseCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -783,69 +1229,44 @@ func (m *Operator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operator) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseCommit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResponseCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+	if m.Revealed {
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.AccumulatedRewards) > 0 {
-		for iNdEx := len(m.AccumulatedRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccumulatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Collateral) > 0 {
-		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Proposer)))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskParams) Marshal() (dAtA []byte, err error) {
+func (m *Inquiry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -855,19 +1276,254 @@ func (m *TaskParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Inquiry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Inquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Epsilon2.Size()
-		i -= size
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Inquirer) > 0 {
+		i -= len(m.Inquirer)
+		copy(dAtA[i:], m.Inquirer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Inquirer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Inquiries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Inquiries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Inquiries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Inquiries) > 0 {
+		for iNdEx := len(m.Inquiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inquiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AccumulatedRewards) > 0 {
+		for iNdEx := len(m.AccumulatedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InquiryFee.Size()
+		i -= size
+		if _, err := m.InquiryFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinResponseCollateral.Size()
+		i -= size
+		if _, err := m.MinResponseCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MinResponses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinResponses))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MajorityQuorum.Size()
+		i -= size
+		if _, err := m.MajorityQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TrimFraction.Size()
+		i -= size
+		if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.AllowedAggregationStrategies) > 0 {
+		dAtA3 := make([]byte, len(m.AllowedAggregationStrategies)*10)
+		var j2 int
+		for _, num := range m.AllowedAggregationStrategies {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintOracle(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Epsilon2.Size()
+		i -= size
 		if _, err := m.Epsilon2.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
@@ -910,12 +1566,12 @@ func (m *TaskParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpirationDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpirationDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpirationDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpirationDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -954,7 +1610,7 @@ func (m *LockedPoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskID) Marshal() (dAtA []byte, err error) {
+func (m *SlashingParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -964,34 +1620,50 @@ func (m *TaskID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskID) MarshalTo(dAtA []byte) (int, error) {
+func (m *SlashingParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SlashingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Function)))
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxDeviations != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxDeviations))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Contract)))
+	if m.DeviationWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationWindow))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.DeviationThreshold.Size()
+		i -= size
+		if _, err := m.DeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskIDs) Marshal() (dAtA []byte, err error) {
+func (m *OperatorDeviations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1001,34 +1673,46 @@ func (m *TaskIDs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskIDs) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperatorDeviations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperatorDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TaskIds) > 0 {
-		for iNdEx := len(m.TaskIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(m.Heights) > 0 {
+		dAtA6 := make([]byte, len(m.Heights)*10)
+		var j5 int
+		for _, num1 := range m.Heights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintOracle(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+func (m *Slash) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1038,27 +1722,241 @@ func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+func (m *Slash) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Slash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
+	if m.TaskId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Unrevealed {
+		i--
+		if m.Unrevealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Result.Size()
+		i -= size
+		if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Slashes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slashes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Slashes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskIds) > 0 {
+		for iNdEx := len(m.TaskIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
@@ -1148,6 +2046,21 @@ func (m *Task) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovOracle(uint64(m.Status))
 	}
+	if m.RevealBlocks != 0 {
+		n += 1 + sovOracle(uint64(m.RevealBlocks))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Id != 0 {
+		n += 1 + sovOracle(uint64(m.Id))
+	}
+	if m.AggregationStrategy != 0 {
+		n += 2 + sovOracle(uint64(m.AggregationStrategy))
+	}
 	return n
 }
 
@@ -1171,65 +2084,151 @@ func (m *Response) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if m.Confidence != 0 {
+		n += 1 + sovOracle(uint64(m.Confidence))
+	}
 	return n
 }
 
-func (m *Operator) Size() (n int) {
+func (m *ResponseCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.Proposer)
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	if len(m.Collateral) > 0 {
-		for _, e := range m.Collateral {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.Revealed {
+		n += 2
 	}
-	if len(m.AccumulatedRewards) > 0 {
-		for _, e := range m.AccumulatedRewards {
+	return n
+}
+
+func (m *Inquiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovOracle(uint64(m.TaskId))
+	}
+	l = len(m.Inquirer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
 			l = e.Size()
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
-	l = len(m.Name)
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.TxHash)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func (m *TaskParams) Size() (n int) {
+func (m *Inquiries) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpirationDuration)
-	n += 1 + l + sovOracle(uint64(l))
-	if m.AggregationWindow != 0 {
-		n += 1 + sovOracle(uint64(m.AggregationWindow))
+	if len(m.Inquiries) > 0 {
+		for _, e := range m.Inquiries {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
 	}
-	l = m.AggregationResult.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.ThresholdScore.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Epsilon1.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Epsilon2.Size()
-	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
-func (m *LockedPoolParams) Size() (n int) {
+func (m *Operator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.AccumulatedRewards) > 0 {
+		for _, e := range m.AccumulatedRewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpirationDuration)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.AggregationWindow != 0 {
+		n += 1 + sovOracle(uint64(m.AggregationWindow))
+	}
+	l = m.AggregationResult.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.ThresholdScore.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Epsilon1.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Epsilon2.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.AllowedAggregationStrategies) > 0 {
+		l = 0
+		for _, e := range m.AllowedAggregationStrategies {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	l = m.TrimFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MajorityQuorum.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MinResponses != 0 {
+		n += 1 + sovOracle(uint64(m.MinResponses))
+	}
+	l = m.MinResponseCollateral.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.InquiryFee.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *LockedPoolParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1244,6 +2243,100 @@ func (m *LockedPoolParams) Size() (n int) {
 	return n
 }
 
+func (m *SlashingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeviationThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.DeviationWindow != 0 {
+		n += 1 + sovOracle(uint64(m.DeviationWindow))
+	}
+	if m.MaxDeviations != 0 {
+		n += 1 + sovOracle(uint64(m.MaxDeviations))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *OperatorDeviations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Slash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Function)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Result.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Unrevealed {
+		n += 2
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovOracle(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *Slashes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TaskID) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1258,6 +2351,9 @@ func (m *TaskID) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.Id != 0 {
+		n += 1 + sovOracle(uint64(m.Id))
+	}
 	return n
 }
 
@@ -1288,16 +2384,1271 @@ func (m *CoinsProto) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Withdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Withdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Withdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueBlock", wireType)
+			}
+			m.DueBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DueBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			m.BeginBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, Response{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingBlock", wireType)
+			}
+			m.ClosingBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosingBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingBlocks", wireType)
+			}
+			m.WaitingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealBlocks", wireType)
+			}
+			m.RevealBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, ResponseCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
+			}
+			m.AggregationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationStrategy |= AggregationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			m.Confidence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confidence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Inquiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inquiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inquirer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inquirer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *Inquiries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inquiries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inquiries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inquiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inquiries = append(m.Inquiries, Inquiry{})
+			if err := m.Inquiries[len(m.Inquiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Withdraw) Unmarshal(dAtA []byte) error {
+func (m *Operator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,10 +3671,10 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Withdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Withdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1360,7 +3711,39 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1387,16 +3770,16 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DueBlock", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedRewards", wireType)
 			}
-			m.DueBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1406,11 +3789,58 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DueBlock |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedRewards = append(m.AccumulatedRewards, types.Coin{})
+			if err := m.AccumulatedRewards[len(m.AccumulatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1435,7 +3865,7 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Task) Unmarshal(dAtA []byte) error {
+func (m *TaskParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,17 +3888,17 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1478,61 +3908,30 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpirationDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Function = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationWindow", wireType)
 			}
-			m.BeginBlock = 0
+			m.AggregationWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1542,16 +3941,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeginBlock |= int64(b&0x7F) << shift
+				m.AggregationWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationResult", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1561,29 +3960,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bounty = append(m.Bounty, types.Coin{})
-			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregationResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1611,13 +4010,15 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.ThresholdScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon1", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1627,28 +4028,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epsilon1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon2", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1676,13 +4078,84 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.Epsilon2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v AggregationStrategy
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AggregationStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedAggregationStrategies = append(m.AllowedAggregationStrategies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedAggregationStrategies) == 0 {
+					m.AllowedAggregationStrategies = make([]AggregationStrategy, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AggregationStrategy
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AggregationStrategy(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedAggregationStrategies = append(m.AllowedAggregationStrategies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAggregationStrategies", wireType)
+			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1692,29 +4165,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, Response{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MajorityQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1742,15 +4215,15 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MajorityQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosingBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinResponses", wireType)
 			}
-			m.ClosingBlock = 0
+			m.MinResponses = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1760,16 +4233,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClosingBlock |= int64(b&0x7F) << shift
+				m.MinResponses |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitingBlocks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinResponseCollateral", wireType)
 			}
-			m.WaitingBlocks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1779,16 +4252,31 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WaitingBlocks |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinResponseCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryFee", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1798,11 +4286,26 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= TaskStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InquiryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1827,7 +4330,7 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *LockedPoolParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,17 +4353,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockedPoolParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockedPoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedInBlocks", wireType)
 			}
-			var stringLen uint64
+			m.LockedInBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1870,97 +4373,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LockedInBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumCollateral", wireType)
 			}
-			var msglen int
+			m.MinimumCollateral = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1970,26 +4392,11 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinimumCollateral |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reward = append(m.Reward, types.Coin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2014,7 +4421,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operator) Unmarshal(dAtA []byte) error {
+func (m *SlashingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2037,15 +4444,15 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2073,13 +4480,15 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.DeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationWindow", wireType)
 			}
-			var stringLen uint64
+			m.DeviationWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2089,29 +4498,16 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DeviationWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviations", wireType)
 			}
-			var msglen int
+			m.MaxDeviations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2121,31 +4517,16 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDeviations |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Collateral = append(m.Collateral, types.Coin{})
-			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2155,29 +4536,82 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccumulatedRewards = append(m.AccumulatedRewards, types.Coin{})
-			if err := m.AccumulatedRewards[len(m.AccumulatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorDeviations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorDeviations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2205,8 +4639,84 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2231,7 +4741,7 @@ func (m *Operator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskParams) Unmarshal(dAtA []byte) error {
+func (m *Slash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2254,15 +4764,47 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2289,15 +4831,16 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpirationDuration, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregationWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.AggregationWindow = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2307,14 +4850,14 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AggregationWindow |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregationResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2342,13 +4885,11 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregationResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2376,13 +4917,11 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ThresholdScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Function = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2410,13 +4949,13 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2444,10 +4983,49 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrevealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unrevealed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2472,7 +5050,7 @@ func (m *TaskParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedPoolParams) Unmarshal(dAtA []byte) error {
+func (m *Slashes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2495,17 +5073,17 @@ func (m *LockedPoolParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedPoolParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Slashes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedPoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Slashes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedInBlocks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
-			m.LockedInBlocks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2515,30 +5093,26 @@ func (m *LockedPoolParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockedInBlocks |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumCollateral", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.MinimumCollateral = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinimumCollateral |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, Slash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2656,6 +5230,25 @@ func (m *TaskID) Unmarshal(dAtA []byte) error {
 			}
 			m.Function = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
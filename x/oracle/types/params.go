@@ -11,12 +11,15 @@ import (
 var (
 	ParamsStoreKeyTaskParams = []byte("taskparams")
 	ParamsStoreKeyPoolParams = []byte("poolparams")
+
+	ParamsStoreKeySlashingParams = []byte("slashingparams")
 )
 
 // Default parameters
 var (
 	MinScore                 = sdk.NewInt(0)
 	MaxScore                 = sdk.NewInt(100)
+	MaxConfidence            = int64(100)
 	DefaultThresholdScore    = sdk.NewInt(50)
 	DefaultAggregationResult = sdk.NewInt(50)
 
@@ -24,9 +27,25 @@ var (
 	DefaultAggregationWindow  = int64(20)
 	DefaultEpsilon1           = sdk.NewInt(1)
 	DefaultEpsilon2           = sdk.NewInt(100)
+	DefaultTrimFraction       = sdk.NewDecWithPrec(2, 1)
+	DefaultMajorityQuorum     = sdk.NewDec(2).QuoInt64(3)
+
+	DefaultMinResponses          = int64(1)
+	DefaultMinResponseCollateral = sdk.NewInt(0)
+	DefaultInquiryFee            = sdk.NewInt(1000)
+
+	// InquiryCallbackGasLimit is the gas limit of a callback contract called with the result of a task.
+	InquiryCallbackGasLimit = uint64(200000)
+	// MaxInquiryCallbacks is the maximum number of callback contracts called with the result of a task.
+	MaxInquiryCallbacks = 10
 
 	DefaultLockedInBlocks    = int64(30)
 	DefaultMinimumCollateral = int64(50000)
+
+	DefaultDeviationThreshold = sdk.NewInt(30)
+	DefaultDeviationWindow    = int64(10000)
+	DefaultMaxDeviations      = int64(3)
+	DefaultSlashFraction      = sdk.NewDecWithPrec(1, 2)
 )
 
 // ParamKeyTable is the key declaration for parameters.
@@ -34,26 +53,64 @@ func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		params.NewParamSetPair(ParamsStoreKeyTaskParams, TaskParams{}, validateTaskParams),
 		params.NewParamSetPair(ParamsStoreKeyPoolParams, LockedPoolParams{}, validatePoolParams),
+		params.NewParamSetPair(ParamsStoreKeySlashingParams, SlashingParams{}, validateSlashingParams),
 	)
 }
 
 // NewTaskParams returns a TaskParams object.
 func NewTaskParams(expirationDuration time.Duration, aggregationWindow int64, aggregationResult,
-	thresholdScore, epsilon1, epsilon2 sdk.Int) TaskParams {
+	thresholdScore, epsilon1, epsilon2 sdk.Int, allowedAggregationStrategies []AggregationStrategy,
+	trimFraction, majorityQuorum sdk.Dec, minResponses int64, minResponseCollateral, inquiryFee sdk.Int) TaskParams {
 	return TaskParams{
-		ExpirationDuration: expirationDuration,
-		AggregationWindow:  aggregationWindow,
-		AggregationResult:  aggregationResult,
-		ThresholdScore:     thresholdScore,
-		Epsilon1:           epsilon1,
-		Epsilon2:           epsilon2,
+		ExpirationDuration:           expirationDuration,
+		AggregationWindow:            aggregationWindow,
+		AggregationResult:            aggregationResult,
+		ThresholdScore:               thresholdScore,
+		Epsilon1:                     epsilon1,
+		Epsilon2:                     epsilon2,
+		AllowedAggregationStrategies: allowedAggregationStrategies,
+		TrimFraction:                 trimFraction,
+		MajorityQuorum:               majorityQuorum,
+		MinResponses:                 minResponses,
+		MinResponseCollateral:        minResponseCollateral,
+		InquiryFee:                   inquiryFee,
 	}
 }
 
 // DefaultTaskParams generates default set for TaskParams.
 func DefaultTaskParams() TaskParams {
 	return NewTaskParams(DefaultExpirationDuration, DefaultAggregationWindow,
-		DefaultAggregationResult, DefaultThresholdScore, DefaultEpsilon1, DefaultEpsilon2)
+		DefaultAggregationResult, DefaultThresholdScore, DefaultEpsilon1, DefaultEpsilon2,
+		AggregationStrategies, DefaultTrimFraction, DefaultMajorityQuorum, DefaultMinResponses, DefaultMinResponseCollateral,
+		DefaultInquiryFee)
+}
+
+// IsAggregationStrategyAllowed returns true if tasks can be created with an aggregation strategy.
+// The default aggregation strategy is always allowed.
+func (p TaskParams) IsAggregationStrategyAllowed(strategy AggregationStrategy) bool {
+	if strategy == DefaultAggregationStrategy {
+		return true
+	}
+	for _, allowed := range p.AllowedAggregationStrategies {
+		if allowed == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+// IsQuorumReached returns true if enough responses from operators with enough total collateral,
+// regardless of the confidences of the responses, are received for a task to produce a result.
+func (p TaskParams) IsQuorumReached(collaterals []sdk.Int) bool {
+	count := int64(0)
+	total := sdk.NewInt(0)
+	for _, collateral := range collaterals {
+		if collateral.IsPositive() {
+			count++
+			total = total.Add(collateral)
+		}
+	}
+	return count >= p.MinResponses && total.GTE(p.MinResponseCollateral)
 }
 
 func validateTaskParams(i interface{}) error {
@@ -68,6 +125,26 @@ func validateTaskParams(i interface{}) error {
 		taskParams.Epsilon2.LT(sdk.NewInt(0)) {
 		return ErrInvalidTaskParams
 	}
+	for _, strategy := range taskParams.AllowedAggregationStrategies {
+		if _, err := NewAggregator(strategy); err != nil {
+			return err
+		}
+	}
+	if taskParams.TrimFraction.IsNil() || taskParams.TrimFraction.IsNegative() ||
+		taskParams.TrimFraction.GTE(sdk.NewDecWithPrec(5, 1)) {
+		return ErrInvalidTaskParams
+	}
+	if taskParams.MajorityQuorum.IsNil() || taskParams.MajorityQuorum.LTE(sdk.NewDecWithPrec(5, 1)) ||
+		taskParams.MajorityQuorum.GT(sdk.OneDec()) {
+		return ErrInvalidTaskParams
+	}
+	if taskParams.MinResponses < 0 ||
+		taskParams.MinResponseCollateral.IsNil() || taskParams.MinResponseCollateral.IsNegative() {
+		return ErrInvalidTaskParams
+	}
+	if taskParams.InquiryFee.IsNil() || taskParams.InquiryFee.IsNegative() {
+		return ErrInvalidTaskParams
+	}
 	return nil
 }
 
@@ -97,3 +174,40 @@ func validatePoolParams(i interface{}) error {
 	}
 	return nil
 }
+
+// NewSlashingParams returns a SlashingParams object.
+func NewSlashingParams(deviationThreshold sdk.Int, deviationWindow, maxDeviations int64, slashFraction sdk.Dec) SlashingParams {
+	return SlashingParams{
+		DeviationThreshold: deviationThreshold,
+		DeviationWindow:    deviationWindow,
+		MaxDeviations:      maxDeviations,
+		SlashFraction:      slashFraction,
+	}
+}
+
+// DefaultSlashingParams generates default set for SlashingParams.
+func DefaultSlashingParams() SlashingParams {
+	return NewSlashingParams(DefaultDeviationThreshold, DefaultDeviationWindow, DefaultMaxDeviations, DefaultSlashFraction)
+}
+
+func validateSlashingParams(i interface{}) error {
+	slashingParams, ok := i.(SlashingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return slashingParams.Validate()
+}
+
+// Validate checks that the slashing params have valid values.
+func (p SlashingParams) Validate() error {
+	if p.DeviationThreshold.IsNil() || p.DeviationThreshold.IsNegative() || p.DeviationThreshold.GT(MaxScore) {
+		return ErrInvalidSlashingParams
+	}
+	if p.DeviationWindow < 0 || p.MaxDeviations < 0 {
+		return ErrInvalidSlashingParams
+	}
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(sdk.OneDec()) {
+		return ErrInvalidSlashingParams
+	}
+	return nil
+}
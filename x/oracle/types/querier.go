@@ -4,37 +4,105 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 
 // Querier routes for the oracle module
 const (
-	QueryOperator    = "operator"
-	QueryOperators   = "operators"
-	QueryWithdrawals = "withdrawals"
-	QueryTask        = "task"
-	QueryResponse    = "response"
+	QueryOperator     = "operator"
+	QueryOperators    = "operators"
+	QueryWithdrawals  = "withdrawals"
+	QueryTask         = "task"
+	QueryTasks        = "tasks"
+	QueryPendingTasks = "pending_tasks"
+	QueryTaskHistory  = "task_history"
+	QueryResponse     = "response"
+	QuerySlashes      = "slashes"
 )
 
 type QueryTaskParams struct {
-	Contract string
-	Function string
+	TaskID uint64
 }
 
 // NewQueryTaskParams returns a QueryTaskParams object.
-func NewQueryTaskParams(contract string, function string) QueryTaskParams {
+func NewQueryTaskParams(taskID uint64) QueryTaskParams {
 	return QueryTaskParams{
+		TaskID: taskID,
+	}
+}
+
+// QueryTasksParams is the type for parameters of querying tasks.
+type QueryTasksParams struct {
+	Page            int
+	Limit           int
+	Status          TaskStatus
+	Creator         sdk.AccAddress
+	MinClosingBlock int64
+	MaxClosingBlock int64
+	MinBounty       sdk.Coins
+}
+
+// NewQueryTasksParams returns a QueryTasksParams object.
+func NewQueryTasksParams(page, limit int, status TaskStatus, creator sdk.AccAddress, minClosingBlock, maxClosingBlock int64,
+	minBounty sdk.Coins) QueryTasksParams {
+	return QueryTasksParams{
+		Page:            page,
+		Limit:           limit,
+		Status:          status,
+		Creator:         creator,
+		MinClosingBlock: minClosingBlock,
+		MaxClosingBlock: maxClosingBlock,
+		MinBounty:       minBounty,
+	}
+}
+
+// Matches returns true if a task matches the status, closing block range and minimum bounty filters.
+func (p QueryTasksParams) Matches(task Task) bool {
+	if p.Status != TaskStatusNil && task.Status != p.Status {
+		return false
+	}
+	if task.ClosingBlock < p.MinClosingBlock {
+		return false
+	}
+	if p.MaxClosingBlock > 0 && task.ClosingBlock > p.MaxClosingBlock {
+		return false
+	}
+	return task.Bounty.IsAllGTE(p.MinBounty)
+}
+
+// QueryPendingTasksParams is the type for parameters of querying tasks pending for an operator.
+type QueryPendingTasksParams struct {
+	Page     int
+	Limit    int
+	Operator sdk.AccAddress
+}
+
+// NewQueryPendingTasksParams returns a QueryPendingTasksParams object.
+func NewQueryPendingTasksParams(page, limit int, operator sdk.AccAddress) QueryPendingTasksParams {
+	return QueryPendingTasksParams{
+		Page:     page,
+		Limit:    limit,
+		Operator: operator,
+	}
+}
+
+type QueryTaskHistoryParams struct {
+	Contract string
+	Function string
+}
+
+// NewQueryTaskHistoryParams returns a QueryTaskHistoryParams object.
+func NewQueryTaskHistoryParams(contract string, function string) QueryTaskHistoryParams {
+	return QueryTaskHistoryParams{
 		Contract: contract,
 		Function: function,
 	}
 }
 
 type QueryResponseParams struct {
-	Contract string
-	Function string
+	TaskID   uint64
 	Operator sdk.AccAddress
 }
 
 // NewQueryResponseParams returns a QueryResponseParams.
-func NewQueryResponseParams(contract string, function string, operator sdk.AccAddress) QueryResponseParams {
+func NewQueryResponseParams(taskID uint64, operator sdk.AccAddress) QueryResponseParams {
 	return QueryResponseParams{
-		Contract: contract,
-		Function: function,
+		TaskID:   taskID,
 		Operator: operator,
 	}
 }
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -279,8 +279,7 @@ func (m *QueryWithdrawsResponse) GetWithdraws() []Withdraw {
 }
 
 type QueryTaskRequest struct {
-	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
 func (m *QueryTaskRequest) Reset()         { *m = QueryTaskRequest{} }
@@ -316,18 +315,11 @@ func (m *QueryTaskRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTaskRequest proto.InternalMessageInfo
 
-func (m *QueryTaskRequest) GetContract() string {
-	if m != nil {
-		return m.Contract
-	}
-	return ""
-}
-
-func (m *QueryTaskRequest) GetFunction() string {
+func (m *QueryTaskRequest) GetTaskId() uint64 {
 	if m != nil {
-		return m.Function
+		return m.TaskId
 	}
-	return ""
+	return 0
 }
 
 type QueryTaskResponse struct {
@@ -374,24 +366,28 @@ func (m *QueryTaskResponse) GetTask() Task {
 	return Task{}
 }
 
-type QueryResponseRequest struct {
-	Contract        string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	Function        string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
-	OperatorAddress string `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+type QueryTasksRequest struct {
+	Status          TaskStatus `protobuf:"varint,1,opt,name=status,proto3,enum=shentu.oracle.v1alpha1.TaskStatus" json:"status,omitempty"`
+	Creator         string     `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	MinClosingBlock int64      `protobuf:"varint,3,opt,name=min_closing_block,json=minClosingBlock,proto3" json:"min_closing_block,omitempty"`
+	MaxClosingBlock int64      `protobuf:"varint,4,opt,name=max_closing_block,json=maxClosingBlock,proto3" json:"max_closing_block,omitempty"`
+	MinBounty       string     `protobuf:"bytes,5,opt,name=min_bounty,json=minBounty,proto3" json:"min_bounty,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryResponseRequest) Reset()         { *m = QueryResponseRequest{} }
-func (m *QueryResponseRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryResponseRequest) ProtoMessage()    {}
-func (*QueryResponseRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTasksRequest) Reset()         { *m = QueryTasksRequest{} }
+func (m *QueryTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksRequest) ProtoMessage()    {}
+func (*QueryTasksRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb973146e7d7bfc4, []int{8}
 }
-func (m *QueryResponseRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryResponseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryResponseRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTasksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -401,55 +397,78 @@ func (m *QueryResponseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryResponseRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResponseRequest.Merge(m, src)
+func (m *QueryTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksRequest.Merge(m, src)
 }
-func (m *QueryResponseRequest) XXX_Size() int {
+func (m *QueryTasksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryResponseRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResponseRequest.DiscardUnknown(m)
+func (m *QueryTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResponseRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTasksRequest proto.InternalMessageInfo
 
-func (m *QueryResponseRequest) GetContract() string {
+func (m *QueryTasksRequest) GetStatus() TaskStatus {
 	if m != nil {
-		return m.Contract
+		return m.Status
 	}
-	return ""
+	return TaskStatusNil
 }
 
-func (m *QueryResponseRequest) GetFunction() string {
+func (m *QueryTasksRequest) GetCreator() string {
 	if m != nil {
-		return m.Function
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *QueryResponseRequest) GetOperatorAddress() string {
+func (m *QueryTasksRequest) GetMinClosingBlock() int64 {
 	if m != nil {
-		return m.OperatorAddress
+		return m.MinClosingBlock
+	}
+	return 0
+}
+
+func (m *QueryTasksRequest) GetMaxClosingBlock() int64 {
+	if m != nil {
+		return m.MaxClosingBlock
+	}
+	return 0
+}
+
+func (m *QueryTasksRequest) GetMinBounty() string {
+	if m != nil {
+		return m.MinBounty
 	}
 	return ""
 }
 
-type QueryResponseResponse struct {
-	Response Response `protobuf:"bytes,1,opt,name=response,proto3" json:"response"`
+func (m *QueryTasksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryResponseResponse) Reset()         { *m = QueryResponseResponse{} }
-func (m *QueryResponseResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryResponseResponse) ProtoMessage()    {}
-func (*QueryResponseResponse) Descriptor() ([]byte, []int) {
+type QueryTasksResponse struct {
+	Tasks []Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksResponse) Reset()         { *m = QueryTasksResponse{} }
+func (m *QueryTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksResponse) ProtoMessage()    {}
+func (*QueryTasksResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb973146e7d7bfc4, []int{9}
 }
-func (m *QueryResponseResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryResponseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryResponseResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTasksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -459,790 +478,2432 @@ func (m *QueryResponseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryResponseResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResponseResponse.Merge(m, src)
+func (m *QueryTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksResponse.Merge(m, src)
 }
-func (m *QueryResponseResponse) XXX_Size() int {
+func (m *QueryTasksResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryResponseResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResponseResponse.DiscardUnknown(m)
+func (m *QueryTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResponseResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTasksResponse proto.InternalMessageInfo
 
-func (m *QueryResponseResponse) GetResponse() Response {
+func (m *QueryTasksResponse) GetTasks() []Task {
 	if m != nil {
-		return m.Response
+		return m.Tasks
 	}
-	return Response{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryOperatorRequest)(nil), "shentu.oracle.v1alpha1.QueryOperatorRequest")
-	proto.RegisterType((*QueryOperatorResponse)(nil), "shentu.oracle.v1alpha1.QueryOperatorResponse")
-	proto.RegisterType((*QueryOperatorsRequest)(nil), "shentu.oracle.v1alpha1.QueryOperatorsRequest")
-	proto.RegisterType((*QueryOperatorsResponse)(nil), "shentu.oracle.v1alpha1.QueryOperatorsResponse")
-	proto.RegisterType((*QueryWithdrawsRequest)(nil), "shentu.oracle.v1alpha1.QueryWithdrawsRequest")
-	proto.RegisterType((*QueryWithdrawsResponse)(nil), "shentu.oracle.v1alpha1.QueryWithdrawsResponse")
-	proto.RegisterType((*QueryTaskRequest)(nil), "shentu.oracle.v1alpha1.QueryTaskRequest")
-	proto.RegisterType((*QueryTaskResponse)(nil), "shentu.oracle.v1alpha1.QueryTaskResponse")
-	proto.RegisterType((*QueryResponseRequest)(nil), "shentu.oracle.v1alpha1.QueryResponseRequest")
-	proto.RegisterType((*QueryResponseResponse)(nil), "shentu.oracle.v1alpha1.QueryResponseResponse")
+func (m *QueryTasksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("shentu/oracle/v1alpha1/query.proto", fileDescriptor_cb973146e7d7bfc4)
+type QueryPendingTasksForOperatorRequest struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var fileDescriptor_cb973146e7d7bfc4 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0xb3, 0x6d, 0x7f, 0xfd, 0x25, 0xe3, 0xc1, 0x3a, 0xd4, 0x1a, 0x96, 0xb2, 0xd6, 0xed,
-	0xa5, 0x29, 0x75, 0xc7, 0x44, 0xf0, 0x6e, 0x29, 0x1e, 0xac, 0x20, 0x06, 0x41, 0x50, 0x50, 0x26,
-	0x9b, 0xc9, 0x66, 0x49, 0xba, 0xb3, 0xdd, 0x99, 0x6d, 0x0d, 0x21, 0x17, 0x5f, 0x81, 0x20, 0x9e,
-	0x7c, 0x05, 0xbe, 0x93, 0x1e, 0x0b, 0x22, 0xe8, 0x45, 0x24, 0xf1, 0x85, 0xc8, 0xce, 0xce, 0xb3,
-	0x09, 0xdb, 0xa6, 0x1b, 0xe9, 0x6d, 0xfe, 0x3c, 0xdf, 0xe7, 0xf9, 0x64, 0xf2, 0xfd, 0xb2, 0xc8,
-	0x16, 0x5d, 0x16, 0xc8, 0x98, 0xf0, 0x88, 0xba, 0x7d, 0x46, 0x4e, 0xea, 0xb4, 0x1f, 0x76, 0x69,
-	0x9d, 0x1c, 0xc7, 0x2c, 0x1a, 0x38, 0x61, 0xc4, 0x25, 0xc7, 0x1b, 0x69, 0x8d, 0x93, 0xd6, 0x38,
-	0x50, 0x63, 0xee, 0xba, 0x5c, 0x1c, 0x71, 0x41, 0x5a, 0x54, 0xb0, 0x54, 0x40, 0x4e, 0xea, 0x2d,
-	0x26, 0x69, 0x9d, 0x84, 0xd4, 0xf3, 0x03, 0x2a, 0x7d, 0x1e, 0xa4, 0x3d, 0xcc, 0x75, 0x8f, 0x7b,
-	0x5c, 0x2d, 0x49, 0xb2, 0xd2, 0xa7, 0x9b, 0x1e, 0xe7, 0x5e, 0x9f, 0x11, 0x1a, 0xfa, 0x84, 0x06,
-	0x01, 0x97, 0x4a, 0x22, 0xf4, 0xed, 0xf6, 0x1c, 0x36, 0xcd, 0xa1, 0x8a, 0xec, 0x07, 0x68, 0xfd,
-	0x45, 0x32, 0xfa, 0x79, 0xc8, 0x22, 0x2a, 0x79, 0xd4, 0x64, 0xc7, 0x31, 0x13, 0x12, 0x57, 0xd1,
-	0xff, 0xb4, 0xdd, 0x8e, 0x98, 0x10, 0x55, 0x63, 0xcb, 0xd8, 0xa9, 0x34, 0x61, 0x6b, 0xbf, 0x41,
-	0xb7, 0x73, 0x0a, 0x11, 0xf2, 0x40, 0x30, 0xbc, 0x8f, 0xca, 0x5c, 0x9f, 0x29, 0xcd, 0x8d, 0xc6,
-	0x96, 0x73, 0xf9, 0x4f, 0x77, 0x40, 0xbb, 0xbf, 0x72, 0xf6, 0xeb, 0x6e, 0xa9, 0x99, 0xe9, 0xec,
-	0x3b, 0xb9, 0xe6, 0x42, 0xf3, 0xd8, 0x6f, 0xd1, 0x46, 0xfe, 0x42, 0x8f, 0x3d, 0x40, 0x15, 0x90,
-	0x27, 0xac, 0xcb, 0xff, 0x30, 0x77, 0x2a, 0xcc, 0x06, 0xbf, 0xf2, 0x65, 0xb7, 0x1d, 0xd1, 0xd3,
-	0x0b, 0x83, 0x67, 0x2e, 0xa6, 0x83, 0x4f, 0xe1, 0xb0, 0x68, 0x30, 0xa8, 0x61, 0x70, 0x26, 0xb4,
-	0x9f, 0xa2, 0x35, 0xd5, 0xff, 0x25, 0x15, 0x3d, 0x78, 0x7c, 0x13, 0x95, 0x5d, 0x1e, 0xc8, 0x88,
-	0xba, 0x52, 0xbf, 0x7e, 0xb6, 0x4f, 0xee, 0x3a, 0x71, 0xe0, 0x26, 0x7f, 0x74, 0x75, 0x29, 0xbd,
-	0x83, 0xbd, 0x7d, 0x88, 0x6e, 0xcd, 0xf4, 0xd2, 0x98, 0x8f, 0xd0, 0x8a, 0xa4, 0xa2, 0xa7, 0xff,
-	0x92, 0xcd, 0x79, 0x84, 0x89, 0x46, 0xd3, 0xa9, 0x7a, 0x7b, 0xa0, 0x9d, 0x01, 0x8d, 0xae, 0x09,
-	0x87, 0x6b, 0x68, 0x0d, 0x9e, 0xfb, 0x1d, 0x58, 0x6b, 0x59, 0xd5, 0xdc, 0x84, 0xf3, 0xc7, 0x39,
-	0x8b, 0x4d, 0x47, 0x4f, 0x2d, 0x16, 0xe9, 0x75, 0x91, 0xc5, 0x40, 0x03, 0x16, 0x03, 0x5d, 0xe3,
-	0xe7, 0x2a, 0xfa, 0x4f, 0x75, 0xc7, 0x5f, 0x0c, 0x54, 0x06, 0x47, 0xe0, 0xbd, 0x79, 0x8d, 0x2e,
-	0x8b, 0x87, 0x79, 0x7f, 0xc1, 0xea, 0x74, 0xa6, 0xdd, 0xf8, 0xf0, 0xed, 0xcf, 0xa7, 0xa5, 0x3d,
-	0xbc, 0x4b, 0xe6, 0x65, 0x52, 0x2b, 0xc8, 0x50, 0x3f, 0xcd, 0x08, 0x7f, 0x36, 0x50, 0x25, 0x73,
-	0x3b, 0x5e, 0x6c, 0x20, 0xb8, 0xd6, 0x74, 0x16, 0x2d, 0xd7, 0x80, 0x35, 0x05, 0xb8, 0x8d, 0xef,
-	0x15, 0x01, 0x0a, 0xc5, 0x95, 0x85, 0xa1, 0x80, 0x2b, 0x9f, 0xa6, 0x02, 0xae, 0x0b, 0x19, 0x2b,
-	0xe6, 0xca, 0x82, 0x84, 0xbf, 0x1a, 0x68, 0x25, 0x31, 0x31, 0xde, 0xb9, 0x72, 0xc6, 0x4c, 0xce,
-	0xcc, 0xda, 0x02, 0x95, 0x1a, 0xe4, 0x99, 0x02, 0x79, 0x82, 0x0f, 0xe6, 0x81, 0x40, 0x06, 0xc8,
-	0x10, 0x56, 0x23, 0x02, 0xde, 0x27, 0x43, 0x58, 0x8d, 0x48, 0x92, 0x2d, 0xfc, 0xdd, 0x40, 0xe5,
-	0xcc, 0xd4, 0x57, 0x3b, 0x2f, 0x17, 0xbf, 0x02, 0xe7, 0xe5, 0x13, 0x63, 0xf7, 0x15, 0x77, 0x07,
-	0xb7, 0xaf, 0xcb, 0x3d, 0x75, 0x68, 0x3e, 0xc5, 0x23, 0x92, 0x65, 0xed, 0xf0, 0x6c, 0x6c, 0x19,
-	0xe7, 0x63, 0xcb, 0xf8, 0x3d, 0xb6, 0x8c, 0x8f, 0x13, 0xab, 0x74, 0x3e, 0xb1, 0x4a, 0x3f, 0x26,
-	0x56, 0xe9, 0x75, 0xdd, 0xf3, 0x65, 0x37, 0x6e, 0x39, 0x2e, 0x3f, 0x22, 0x2e, 0x8b, 0xa4, 0xdf,
-	0xeb, 0xf0, 0x38, 0x68, 0xab, 0x0f, 0x16, 0xa0, 0xbd, 0x07, 0x38, 0x39, 0x08, 0x99, 0x68, 0xad,
-	0xaa, 0x2f, 0xd4, 0xc3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x77, 0xf4, 0xcd, 0x10, 0x64, 0x07,
-	0x00, 0x00,
+func (m *QueryPendingTasksForOperatorRequest) Reset()         { *m = QueryPendingTasksForOperatorRequest{} }
+func (m *QueryPendingTasksForOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTasksForOperatorRequest) ProtoMessage()    {}
+func (*QueryPendingTasksForOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{10}
+}
+func (m *QueryPendingTasksForOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTasksForOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTasksForOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTasksForOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTasksForOperatorRequest.Merge(m, src)
+}
+func (m *QueryPendingTasksForOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTasksForOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTasksForOperatorRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingTasksForOperatorRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Operator(ctx context.Context, in *QueryOperatorRequest, opts ...grpc.CallOption) (*QueryOperatorResponse, error)
-	Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error)
-	Withdraws(ctx context.Context, in *QueryWithdrawsRequest, opts ...grpc.CallOption) (*QueryWithdrawsResponse, error)
-	Task(ctx context.Context, in *QueryTaskRequest, opts ...grpc.CallOption) (*QueryTaskResponse, error)
-	Response(ctx context.Context, in *QueryResponseRequest, opts ...grpc.CallOption) (*QueryResponseResponse, error)
+func (m *QueryPendingTasksForOperatorRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingTasksForOperatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryPendingTasksForOperatorResponse struct {
+	Tasks []Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Operator(ctx context.Context, in *QueryOperatorRequest, opts ...grpc.CallOption) (*QueryOperatorResponse, error) {
-	out := new(QueryOperatorResponse)
-	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Operator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingTasksForOperatorResponse) Reset()         { *m = QueryPendingTasksForOperatorResponse{} }
+func (m *QueryPendingTasksForOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTasksForOperatorResponse) ProtoMessage()    {}
+func (*QueryPendingTasksForOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{11}
+}
+func (m *QueryPendingTasksForOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTasksForOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTasksForOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingTasksForOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTasksForOperatorResponse.Merge(m, src)
+}
+func (m *QueryPendingTasksForOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTasksForOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTasksForOperatorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error) {
-	out := new(QueryOperatorsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Operators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingTasksForOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTasksForOperatorResponse) GetTasks() []Task {
+	if m != nil {
+		return m.Tasks
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Withdraws(ctx context.Context, in *QueryWithdrawsRequest, opts ...grpc.CallOption) (*QueryWithdrawsResponse, error) {
-	out := new(QueryWithdrawsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Withdraws", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingTasksForOperatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Task(ctx context.Context, in *QueryTaskRequest, opts ...grpc.CallOption) (*QueryTaskResponse, error) {
-	out := new(QueryTaskResponse)
-	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Task", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryTaskHistoryRequest struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+}
+
+func (m *QueryTaskHistoryRequest) Reset()         { *m = QueryTaskHistoryRequest{} }
+func (m *QueryTaskHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskHistoryRequest) ProtoMessage()    {}
+func (*QueryTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{12}
+}
+func (m *QueryTaskHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTaskHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskHistoryRequest.Merge(m, src)
+}
+func (m *QueryTaskHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Response(ctx context.Context, in *QueryResponseRequest, opts ...grpc.CallOption) (*QueryResponseResponse, error) {
-	out := new(QueryResponseResponse)
-	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Response", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaskHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryTaskHistoryRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Operator(context.Context, *QueryOperatorRequest) (*QueryOperatorResponse, error)
-	Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error)
-	Withdraws(context.Context, *QueryWithdrawsRequest) (*QueryWithdrawsResponse, error)
-	Task(context.Context, *QueryTaskRequest) (*QueryTaskResponse, error)
-	Response(context.Context, *QueryResponseRequest) (*QueryResponseResponse, error)
+func (m *QueryTaskHistoryRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryTaskHistoryResponse struct {
+	Tasks []Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
 }
 
-func (*UnimplementedQueryServer) Operator(ctx context.Context, req *QueryOperatorRequest) (*QueryOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Operator not implemented")
+func (m *QueryTaskHistoryResponse) Reset()         { *m = QueryTaskHistoryResponse{} }
+func (m *QueryTaskHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskHistoryResponse) ProtoMessage()    {}
+func (*QueryTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{13}
 }
-func (*UnimplementedQueryServer) Operators(ctx context.Context, req *QueryOperatorsRequest) (*QueryOperatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Operators not implemented")
+func (m *QueryTaskHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Withdraws(ctx context.Context, req *QueryWithdrawsRequest) (*QueryWithdrawsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraws not implemented")
+func (m *QueryTaskHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Task(ctx context.Context, req *QueryTaskRequest) (*QueryTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Task not implemented")
+func (m *QueryTaskHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskHistoryResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Response(ctx context.Context, req *QueryResponseRequest) (*QueryResponseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Response not implemented")
+func (m *QueryTaskHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTaskHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_Operator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Operator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.oracle.v1alpha1.Query/Operator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Operator(ctx, req.(*QueryOperatorRequest))
+var xxx_messageInfo_QueryTaskHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTaskHistoryResponse) GetTasks() []Task {
+	if m != nil {
+		return m.Tasks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Operators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Operators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.oracle.v1alpha1.Query/Operators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Operators(ctx, req.(*QueryOperatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryResponseRequest struct {
+	TaskId          uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
 }
 
-func _Query_Withdraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Withdraws(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.oracle.v1alpha1.Query/Withdraws",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Withdraws(ctx, req.(*QueryWithdrawsRequest))
+func (m *QueryResponseRequest) Reset()         { *m = QueryResponseRequest{} }
+func (m *QueryResponseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResponseRequest) ProtoMessage()    {}
+func (*QueryResponseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{14}
+}
+func (m *QueryResponseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResponseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResponseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryResponseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResponseRequest.Merge(m, src)
+}
+func (m *QueryResponseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResponseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResponseRequest.DiscardUnknown(m)
 }
 
-func _Query_Task_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Task(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.oracle.v1alpha1.Query/Task",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Task(ctx, req.(*QueryTaskRequest))
+var xxx_messageInfo_QueryResponseRequest proto.InternalMessageInfo
+
+func (m *QueryResponseRequest) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Response_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryResponseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Response(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.oracle.v1alpha1.Query/Response",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Response(ctx, req.(*QueryResponseRequest))
+func (m *QueryResponseRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "shentu.oracle.v1alpha1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Operator",
-			Handler:    _Query_Operator_Handler,
-		},
-		{
-			MethodName: "Operators",
-			Handler:    _Query_Operators_Handler,
-		},
-		{
-			MethodName: "Withdraws",
-			Handler:    _Query_Withdraws_Handler,
-		},
-		{
-			MethodName: "Task",
-			Handler:    _Query_Task_Handler,
-		},
-		{
-			MethodName: "Response",
-			Handler:    _Query_Response_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "shentu/oracle/v1alpha1/query.proto",
+type QueryResponseResponse struct {
+	Response Response `protobuf:"bytes,1,opt,name=response,proto3" json:"response"`
 }
 
-func (m *QueryOperatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryResponseResponse) Reset()         { *m = QueryResponseResponse{} }
+func (m *QueryResponseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResponseResponse) ProtoMessage()    {}
+func (*QueryResponseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{15}
 }
-
-func (m *QueryOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResponseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryResponseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResponseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryResponseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResponseResponse.Merge(m, src)
+}
+func (m *QueryResponseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResponseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResponseResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResponseResponse proto.InternalMessageInfo
+
+func (m *QueryResponseResponse) GetResponse() Response {
+	if m != nil {
+		return m.Response
 	}
-	return dAtA[:n], nil
+	return Response{}
 }
 
-func (m *QueryOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySlashesRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Operator.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySlashesRequest) Reset()         { *m = QuerySlashesRequest{} }
+func (m *QuerySlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesRequest) ProtoMessage()    {}
+func (*QuerySlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{16}
+}
+func (m *QuerySlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesRequest.Merge(m, src)
+}
+func (m *QuerySlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOperatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashesRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySlashesResponse struct {
+	Slashes []Slash `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
 }
 
-func (m *QueryOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySlashesResponse) Reset()         { *m = QuerySlashesResponse{} }
+func (m *QuerySlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesResponse) ProtoMessage()    {}
+func (*QuerySlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb973146e7d7bfc4, []int{17}
 }
-
-func (m *QueryOperatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesResponse.Merge(m, src)
+}
+func (m *QuerySlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operators) > 0 {
-		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QuerySlashesResponse proto.InternalMessageInfo
+
+func (m *QuerySlashesResponse) GetSlashes() []Slash {
+	if m != nil {
+		return m.Slashes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWithdrawsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryOperatorRequest)(nil), "shentu.oracle.v1alpha1.QueryOperatorRequest")
+	proto.RegisterType((*QueryOperatorResponse)(nil), "shentu.oracle.v1alpha1.QueryOperatorResponse")
+	proto.RegisterType((*QueryOperatorsRequest)(nil), "shentu.oracle.v1alpha1.QueryOperatorsRequest")
+	proto.RegisterType((*QueryOperatorsResponse)(nil), "shentu.oracle.v1alpha1.QueryOperatorsResponse")
+	proto.RegisterType((*QueryWithdrawsRequest)(nil), "shentu.oracle.v1alpha1.QueryWithdrawsRequest")
+	proto.RegisterType((*QueryWithdrawsResponse)(nil), "shentu.oracle.v1alpha1.QueryWithdrawsResponse")
+	proto.RegisterType((*QueryTaskRequest)(nil), "shentu.oracle.v1alpha1.QueryTaskRequest")
+	proto.RegisterType((*QueryTaskResponse)(nil), "shentu.oracle.v1alpha1.QueryTaskResponse")
+	proto.RegisterType((*QueryTasksRequest)(nil), "shentu.oracle.v1alpha1.QueryTasksRequest")
+	proto.RegisterType((*QueryTasksResponse)(nil), "shentu.oracle.v1alpha1.QueryTasksResponse")
+	proto.RegisterType((*QueryPendingTasksForOperatorRequest)(nil), "shentu.oracle.v1alpha1.QueryPendingTasksForOperatorRequest")
+	proto.RegisterType((*QueryPendingTasksForOperatorResponse)(nil), "shentu.oracle.v1alpha1.QueryPendingTasksForOperatorResponse")
+	proto.RegisterType((*QueryTaskHistoryRequest)(nil), "shentu.oracle.v1alpha1.QueryTaskHistoryRequest")
+	proto.RegisterType((*QueryTaskHistoryResponse)(nil), "shentu.oracle.v1alpha1.QueryTaskHistoryResponse")
+	proto.RegisterType((*QueryResponseRequest)(nil), "shentu.oracle.v1alpha1.QueryResponseRequest")
+	proto.RegisterType((*QueryResponseResponse)(nil), "shentu.oracle.v1alpha1.QueryResponseResponse")
+	proto.RegisterType((*QuerySlashesRequest)(nil), "shentu.oracle.v1alpha1.QuerySlashesRequest")
+	proto.RegisterType((*QuerySlashesResponse)(nil), "shentu.oracle.v1alpha1.QuerySlashesResponse")
 }
 
-func (m *QueryWithdrawsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("shentu/oracle/v1alpha1/query.proto", fileDescriptor_cb973146e7d7bfc4)
 }
 
-func (m *QueryWithdrawsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_cb973146e7d7bfc4 = []byte{
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x8e, 0xf3, 0xc3, 0x2f, 0x12, 0x6d, 0x87, 0xd0, 0x58, 0xab, 0xc6, 0x0d, 0x1b,
+	0x28, 0x49, 0x9a, 0xee, 0xd4, 0x06, 0x21, 0x54, 0xe0, 0x50, 0x17, 0x52, 0xaa, 0xa8, 0xa2, 0x75,
+	0x82, 0x90, 0x8a, 0x84, 0x35, 0x5e, 0x6f, 0xed, 0x55, 0xec, 0x1d, 0x77, 0x67, 0xdc, 0x26, 0x8a,
+	0x7c, 0x41, 0xe2, 0xc4, 0x05, 0x09, 0x21, 0x90, 0xb8, 0x70, 0x84, 0xff, 0x01, 0x89, 0x6b, 0x8f,
+	0x95, 0xb8, 0x70, 0x02, 0x94, 0xf0, 0x0f, 0xf0, 0x1f, 0xa0, 0x9d, 0x7d, 0xb3, 0xfe, 0x51, 0xdb,
+	0xbb, 0x84, 0x4b, 0x6f, 0xbb, 0xb3, 0xef, 0xfb, 0xde, 0x67, 0xde, 0xcc, 0x7b, 0xcf, 0x06, 0x4b,
+	0x34, 0x5d, 0x5f, 0x76, 0x29, 0x0f, 0x98, 0xd3, 0x72, 0xe9, 0xe3, 0x22, 0x6b, 0x75, 0x9a, 0xac,
+	0x48, 0x1f, 0x75, 0xdd, 0xe0, 0xc8, 0xee, 0x04, 0x5c, 0x72, 0x72, 0x31, 0xb2, 0xb1, 0x23, 0x1b,
+	0x5b, 0xdb, 0x98, 0x5b, 0x0e, 0x17, 0x6d, 0x2e, 0x68, 0x8d, 0x09, 0x37, 0x12, 0xd0, 0xc7, 0xc5,
+	0x9a, 0x2b, 0x59, 0x91, 0x76, 0x58, 0xc3, 0xf3, 0x99, 0xf4, 0xb8, 0x1f, 0xf9, 0x30, 0x97, 0x1b,
+	0xbc, 0xc1, 0xd5, 0x23, 0x0d, 0x9f, 0x70, 0xf5, 0x52, 0x83, 0xf3, 0x46, 0xcb, 0xa5, 0xac, 0xe3,
+	0x51, 0xe6, 0xfb, 0x5c, 0x2a, 0x89, 0xc0, 0xaf, 0xeb, 0x13, 0xd8, 0x90, 0x43, 0x19, 0x59, 0xd7,
+	0x61, 0xf9, 0x7e, 0x18, 0xfa, 0xe3, 0x8e, 0x1b, 0x30, 0xc9, 0x83, 0x8a, 0xfb, 0xa8, 0xeb, 0x0a,
+	0x49, 0xf2, 0xb0, 0xc0, 0xea, 0xf5, 0xc0, 0x15, 0x22, 0x6f, 0xac, 0x19, 0x1b, 0xb9, 0x8a, 0x7e,
+	0xb5, 0x3e, 0x83, 0x57, 0x46, 0x14, 0xa2, 0xc3, 0x7d, 0xe1, 0x92, 0x32, 0x2c, 0x72, 0x5c, 0x53,
+	0x9a, 0xa5, 0xd2, 0x9a, 0x3d, 0x7e, 0xeb, 0xb6, 0xd6, 0x96, 0xb3, 0x4f, 0xff, 0xb8, 0x3c, 0x53,
+	0x89, 0x75, 0xd6, 0xca, 0x88, 0x73, 0x81, 0x3c, 0xd6, 0xe7, 0x70, 0x71, 0xf4, 0x03, 0x86, 0xfd,
+	0x00, 0x72, 0x5a, 0x1e, 0xb2, 0xce, 0xfe, 0x87, 0xb8, 0x7d, 0x61, 0x1c, 0xf8, 0x53, 0x4f, 0x36,
+	0xeb, 0x01, 0x7b, 0xf2, 0x5c, 0xe0, 0x81, 0x0f, 0xfd, 0xc0, 0x4f, 0xf4, 0x62, 0x52, 0x60, 0xad,
+	0xd6, 0x81, 0x63, 0xa1, 0x75, 0x15, 0xce, 0x2b, 0xff, 0xfb, 0x4c, 0x1c, 0xe8, 0xe4, 0xaf, 0xc0,
+	0x82, 0x64, 0xe2, 0xa0, 0xea, 0xd5, 0x55, 0x22, 0xb3, 0x95, 0xf9, 0xf0, 0xf5, 0x4e, 0xdd, 0xda,
+	0x85, 0x0b, 0x03, 0xc6, 0xc8, 0xf1, 0x36, 0x64, 0xc3, 0xcf, 0x98, 0xf3, 0x4b, 0x93, 0x10, 0x42,
+	0x0d, 0x86, 0x57, 0xf6, 0xd6, 0x4f, 0x99, 0x01, 0x6f, 0x7a, 0xbf, 0xe4, 0x06, 0xcc, 0x0b, 0xc9,
+	0x64, 0x37, 0x3a, 0xf7, 0x97, 0x4a, 0xd6, 0x34, 0x7f, 0x7b, 0xca, 0xb2, 0x82, 0x8a, 0xf0, 0xd2,
+	0x38, 0x81, 0xab, 0x2e, 0x40, 0x26, 0xba, 0x34, 0xf8, 0x4a, 0xb6, 0xe0, 0x42, 0xdb, 0xf3, 0xab,
+	0x4e, 0x8b, 0x0b, 0xcf, 0x6f, 0x54, 0x6b, 0x2d, 0xee, 0x1c, 0xe4, 0x67, 0xd7, 0x8c, 0x8d, 0xd9,
+	0xca, 0xb9, 0xb6, 0xe7, 0xdf, 0x8a, 0xd6, 0xcb, 0xe1, 0xb2, 0xb2, 0x65, 0x87, 0x23, 0xb6, 0x59,
+	0xb4, 0x65, 0x87, 0x43, 0xb6, 0xab, 0x00, 0xa1, 0xdf, 0x1a, 0xef, 0xfa, 0xf2, 0x28, 0x3f, 0xa7,
+	0x82, 0xe6, 0xda, 0x9e, 0x5f, 0x56, 0x0b, 0x64, 0x07, 0xa0, 0x5f, 0x4a, 0xf9, 0x79, 0x95, 0xa0,
+	0x2b, 0x76, 0x54, 0x77, 0x76, 0x58, 0x77, 0x76, 0x54, 0xa8, 0x58, 0x77, 0xf6, 0x3d, 0xd6, 0x70,
+	0x31, 0x11, 0x95, 0x01, 0xa5, 0xf5, 0x9d, 0x01, 0x64, 0x30, 0x55, 0x98, 0xf9, 0x77, 0x60, 0x2e,
+	0xcc, 0xa4, 0x3e, 0xfd, 0x34, 0xa9, 0x8f, 0x04, 0xe4, 0xf6, 0x10, 0x58, 0x46, 0x81, 0xbd, 0x91,
+	0x08, 0x16, 0x85, 0x1d, 0x22, 0xfb, 0xde, 0x80, 0x75, 0x45, 0x76, 0xcf, 0xf5, 0xeb, 0x9e, 0xdf,
+	0x50, 0x80, 0x3b, 0x3c, 0x18, 0xad, 0xe7, 0x4d, 0x38, 0xaf, 0x2f, 0x7b, 0x75, 0xb8, 0xb0, 0xcf,
+	0xe9, 0xf5, 0x9b, 0xd1, 0x32, 0xd9, 0x19, 0xc3, 0x76, 0x96, 0xa4, 0xfd, 0x6c, 0xc0, 0x6b, 0xd3,
+	0xd1, 0x5e, 0x9c, 0x34, 0xde, 0x87, 0x95, 0xf8, 0x7c, 0x3f, 0xf2, 0x84, 0xe4, 0xc1, 0x91, 0xce,
+	0x9c, 0x09, 0x8b, 0x0e, 0xf7, 0x65, 0xc0, 0x1c, 0x89, 0x19, 0x8b, 0xdf, 0xc3, 0x6f, 0x0f, 0xbb,
+	0xbe, 0x13, 0x47, 0xcf, 0x55, 0xe2, 0x77, 0x6b, 0x1f, 0xf2, 0xcf, 0xbb, 0xfc, 0xbf, 0x3b, 0xb6,
+	0x1e, 0x60, 0xbf, 0x8e, 0x77, 0x91, 0xd0, 0x32, 0xc6, 0x1e, 0x7c, 0x66, 0xec, 0xc1, 0xc7, 0x9d,
+	0xbd, 0xef, 0xbb, 0xdf, 0xd9, 0x03, 0x7c, 0x4e, 0xea, 0xec, 0x5a, 0xa3, 0x3b, 0xbb, 0xd6, 0x59,
+	0x14, 0x5e, 0x56, 0xce, 0xf7, 0x5a, 0x4c, 0x34, 0x5d, 0x91, 0x3c, 0x67, 0x3e, 0x81, 0xe5, 0x61,
+	0x01, 0xc2, 0xbc, 0x0f, 0x0b, 0x22, 0x5a, 0xc2, 0xec, 0xad, 0x4e, 0x62, 0x51, 0x4a, 0x04, 0xd1,
+	0x9a, 0xd2, 0x3f, 0x4b, 0x30, 0xa7, 0xfc, 0x92, 0x1f, 0x0c, 0x58, 0xd4, 0x77, 0x91, 0x6c, 0x4f,
+	0x72, 0x32, 0x6e, 0x3a, 0x9a, 0xd7, 0x52, 0x5a, 0xe3, 0xde, 0x4b, 0x5f, 0xfc, 0xf6, 0xf7, 0x37,
+	0x99, 0x6d, 0xb2, 0x45, 0x27, 0x8d, 0x64, 0x54, 0xd0, 0x63, 0xdc, 0x7d, 0x8f, 0x7c, 0x6b, 0x40,
+	0x4e, 0x3b, 0x12, 0x24, 0x5d, 0x40, 0x9d, 0x55, 0xd3, 0x4e, 0x6b, 0x8e, 0x80, 0x9b, 0x0a, 0x70,
+	0x9d, 0xbc, 0x9a, 0x04, 0x28, 0x14, 0x57, 0x3c, 0x0b, 0x13, 0xb8, 0x46, 0x87, 0xa9, 0x69, 0xa7,
+	0x35, 0x4f, 0xcb, 0x15, 0xcf, 0x51, 0xf2, 0x95, 0x01, 0xd9, 0xb0, 0x5c, 0xc8, 0xc6, 0xd4, 0x18,
+	0x03, 0x63, 0xd6, 0xdc, 0x4c, 0x61, 0x89, 0x20, 0xb6, 0x02, 0xd9, 0x20, 0x57, 0x26, 0x81, 0x84,
+	0xd5, 0x46, 0x8f, 0xb1, 0x04, 0x7b, 0xe4, 0x4b, 0x03, 0xe6, 0xf6, 0x55, 0x8b, 0x4a, 0x0e, 0x12,
+	0x67, 0x67, 0x2b, 0x8d, 0x29, 0x02, 0xbd, 0xae, 0x80, 0x2e, 0x93, 0xd5, 0x69, 0x40, 0x82, 0xfc,
+	0x69, 0xc0, 0xca, 0x84, 0xf6, 0x4b, 0xde, 0x9d, 0x1a, 0x6e, 0xfa, 0x3c, 0x31, 0xdf, 0x3b, 0x9b,
+	0x18, 0xe9, 0xef, 0x28, 0xfa, 0x5b, 0xe4, 0x66, 0x72, 0x41, 0x8c, 0x36, 0xaf, 0x1e, 0xed, 0x44,
+	0xce, 0xab, 0xd1, 0x0e, 0x7f, 0x35, 0x60, 0x69, 0xa0, 0xc5, 0x12, 0x9a, 0x98, 0xc4, 0xe1, 0xfe,
+	0x6e, 0x5e, 0x4f, 0x2f, 0x40, 0xfa, 0xbb, 0x8a, 0xfe, 0x36, 0xf9, 0x70, 0x12, 0xbd, 0x9e, 0x0f,
+	0xf4, 0x58, 0x3f, 0xf5, 0xa8, 0x9e, 0x0b, 0xf4, 0x58, 0x3f, 0xf5, 0xf0, 0x8c, 0x7e, 0x31, 0x60,
+	0x31, 0xee, 0x6e, 0xd3, 0xfb, 0xd0, 0x48, 0xd7, 0x37, 0xaf, 0xa5, 0xb4, 0x46, 0xf0, 0x3d, 0x05,
+	0x7e, 0x97, 0xec, 0xa6, 0xbb, 0xc5, 0x53, 0x4f, 0x41, 0x37, 0x76, 0xf2, 0xa3, 0x01, 0x0b, 0xd8,
+	0xa3, 0xc9, 0xd5, 0xa9, 0x3c, 0xc3, 0xad, 0xdf, 0xdc, 0x4e, 0x67, 0x8c, 0xec, 0x37, 0x14, 0xfb,
+	0x5b, 0xa4, 0x94, 0xbe, 0x87, 0x52, 0xec, 0xf9, 0xe5, 0xdd, 0xa7, 0x27, 0x05, 0xe3, 0xd9, 0x49,
+	0xc1, 0xf8, 0xeb, 0xa4, 0x60, 0x7c, 0x7d, 0x5a, 0x98, 0x79, 0x76, 0x5a, 0x98, 0xf9, 0xfd, 0xb4,
+	0x30, 0xf3, 0xa0, 0xd8, 0xf0, 0x64, 0xb3, 0x5b, 0xb3, 0x1d, 0xde, 0xa6, 0x8e, 0x1b, 0x48, 0xef,
+	0xe0, 0x21, 0xef, 0xfa, 0x75, 0xf5, 0xa3, 0x40, 0x07, 0x3a, 0xd4, 0xa1, 0xe4, 0x51, 0xc7, 0x15,
+	0xb5, 0x79, 0xf5, 0xc7, 0xe9, 0xcd, 0x7f, 0x07, 0x00, 0xf1, 0x9d, 0x66, 0xde, 0xfb, 0x0d, 0x00,
+	0x00,
 }
 
-func (m *QueryWithdrawsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Operator(ctx context.Context, in *QueryOperatorRequest, opts ...grpc.CallOption) (*QueryOperatorResponse, error)
+	Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error)
+	Withdraws(ctx context.Context, in *QueryWithdrawsRequest, opts ...grpc.CallOption) (*QueryWithdrawsResponse, error)
+	Task(ctx context.Context, in *QueryTaskRequest, opts ...grpc.CallOption) (*QueryTaskResponse, error)
+	Tasks(ctx context.Context, in *QueryTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	PendingTasksForOperator(ctx context.Context, in *QueryPendingTasksForOperatorRequest, opts ...grpc.CallOption) (*QueryPendingTasksForOperatorResponse, error)
+	TaskHistory(ctx context.Context, in *QueryTaskHistoryRequest, opts ...grpc.CallOption) (*QueryTaskHistoryResponse, error)
+	Response(ctx context.Context, in *QueryResponseRequest, opts ...grpc.CallOption) (*QueryResponseResponse, error)
+	Slashes(ctx context.Context, in *QuerySlashesRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error)
 }
 
-func (m *QueryWithdrawsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryWithdrawsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Withdraws) > 0 {
-		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Operator(ctx context.Context, in *QueryOperatorRequest, opts ...grpc.CallOption) (*QueryOperatorResponse, error) {
+	out := new(QueryOperatorResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Operator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Function)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error) {
+	out := new(QueryOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Operators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Withdraws(ctx context.Context, in *QueryWithdrawsRequest, opts ...grpc.CallOption) (*QueryWithdrawsResponse, error) {
+	out := new(QueryWithdrawsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Withdraws", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Task(ctx context.Context, in *QueryTaskRequest, opts ...grpc.CallOption) (*QueryTaskResponse, error) {
+	out := new(QueryTaskResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Task", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Tasks(ctx context.Context, in *QueryTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryResponseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingTasksForOperator(ctx context.Context, in *QueryPendingTasksForOperatorRequest, opts ...grpc.CallOption) (*QueryPendingTasksForOperatorResponse, error) {
+	out := new(QueryPendingTasksForOperatorResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/PendingTasksForOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryResponseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TaskHistory(ctx context.Context, in *QueryTaskHistoryRequest, opts ...grpc.CallOption) (*QueryTaskHistoryResponse, error) {
+	out := new(QueryTaskHistoryResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/TaskHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryResponseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Function)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Response(ctx context.Context, in *QueryResponseRequest, opts ...grpc.CallOption) (*QueryResponseResponse, error) {
+	out := new(QueryResponseResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Response", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryResponseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Slashes(ctx context.Context, in *QuerySlashesRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error) {
+	out := new(QuerySlashesResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Query/Slashes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryResponseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Operator(context.Context, *QueryOperatorRequest) (*QueryOperatorResponse, error)
+	Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error)
+	Withdraws(context.Context, *QueryWithdrawsRequest) (*QueryWithdrawsResponse, error)
+	Task(context.Context, *QueryTaskRequest) (*QueryTaskResponse, error)
+	Tasks(context.Context, *QueryTasksRequest) (*QueryTasksResponse, error)
+	PendingTasksForOperator(context.Context, *QueryPendingTasksForOperatorRequest) (*QueryPendingTasksForOperatorResponse, error)
+	TaskHistory(context.Context, *QueryTaskHistoryRequest) (*QueryTaskHistoryResponse, error)
+	Response(context.Context, *QueryResponseRequest) (*QueryResponseResponse, error)
+	Slashes(context.Context, *QuerySlashesRequest) (*QuerySlashesResponse, error)
 }
 
-func (m *QueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) Operator(ctx context.Context, req *QueryOperatorRequest) (*QueryOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operator not implemented")
 }
-func (m *QueryOperatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) Operators(ctx context.Context, req *QueryOperatorsRequest) (*QueryOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operators not implemented")
+}
+func (*UnimplementedQueryServer) Withdraws(ctx context.Context, req *QueryWithdrawsRequest) (*QueryWithdrawsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraws not implemented")
+}
+func (*UnimplementedQueryServer) Task(ctx context.Context, req *QueryTaskRequest) (*QueryTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Task not implemented")
+}
+func (*UnimplementedQueryServer) Tasks(ctx context.Context, req *QueryTasksRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tasks not implemented")
+}
+func (*UnimplementedQueryServer) PendingTasksForOperator(ctx context.Context, req *QueryPendingTasksForOperatorRequest) (*QueryPendingTasksForOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTasksForOperator not implemented")
+}
+func (*UnimplementedQueryServer) TaskHistory(ctx context.Context, req *QueryTaskHistoryRequest) (*QueryTaskHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskHistory not implemented")
+}
+func (*UnimplementedQueryServer) Response(ctx context.Context, req *QueryResponseRequest) (*QueryResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Response not implemented")
+}
+func (*UnimplementedQueryServer) Slashes(ctx context.Context, req *QuerySlashesRequest) (*QuerySlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Slashes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Operator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Operator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Operator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Operator(ctx, req.(*QueryOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Operators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Operator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Operators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Operators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Operators(ctx, req.(*QueryOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Withdraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Withdraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Withdraws",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Withdraws(ctx, req.(*QueryWithdrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Task_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Operators) > 0 {
-		for _, e := range m.Operators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Task(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Task(ctx, req.(*QueryTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWithdrawsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Tasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Tasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Tasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tasks(ctx, req.(*QueryTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWithdrawsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingTasksForOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTasksForOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Withdraws) > 0 {
-		for _, e := range m.Withdraws {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTasksForOperator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/PendingTasksForOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTasksForOperator(ctx, req.(*QueryPendingTasksForOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TaskHistory(ctx, in)
 	}
-	l = len(m.Function)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/TaskHistory",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskHistory(ctx, req.(*QueryTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Response_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Task.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Response(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Response",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Response(ctx, req.(*QueryResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResponseRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Slashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Slashes(ctx, in)
 	}
-	l = len(m.Function)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Query/Slashes",
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Slashes(ctx, req.(*QuerySlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shentu.oracle.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Operator",
+			Handler:    _Query_Operator_Handler,
+		},
+		{
+			MethodName: "Operators",
+			Handler:    _Query_Operators_Handler,
+		},
+		{
+			MethodName: "Withdraws",
+			Handler:    _Query_Withdraws_Handler,
+		},
+		{
+			MethodName: "Task",
+			Handler:    _Query_Task_Handler,
+		},
+		{
+			MethodName: "Tasks",
+			Handler:    _Query_Tasks_Handler,
+		},
+		{
+			MethodName: "PendingTasksForOperator",
+			Handler:    _Query_PendingTasksForOperator_Handler,
+		},
+		{
+			MethodName: "TaskHistory",
+			Handler:    _Query_TaskHistory_Handler,
+		},
+		{
+			MethodName: "Response",
+			Handler:    _Query_Response_Handler,
+		},
+		{
+			MethodName: "Slashes",
+			Handler:    _Query_Slashes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shentu/oracle/v1alpha1/query.proto",
+}
+
+func (m *QueryOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Operator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdraws) > 0 {
+		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinBounty) > 0 {
+		i -= len(m.MinBounty)
+		copy(dAtA[i:], m.MinBounty)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinBounty)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxClosingBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxClosingBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinClosingBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinClosingBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTasksForOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTasksForOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTasksForOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTasksForOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTasksForOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTasksForOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResponseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResponseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResponseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResponseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResponseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWithdrawsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWithdrawsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdraws) > 0 {
+		for _, e := range m.Withdraws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovQuery(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *QueryTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Task.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinClosingBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MinClosingBlock))
+	}
+	if m.MaxClosingBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxClosingBlock))
+	}
+	l = len(m.MinBounty)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTasksForOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTasksForOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaskHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Function)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaskHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResponseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovQuery(uint64(m.TaskId))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Response.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOperatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, Operator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdraws = append(m.Withdraws, Withdraw{})
+			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryResponseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Response.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryOperatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1265,15 +2926,34 @@ func (m *QueryOperatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1301,7 +2981,113 @@ func (m *QueryOperatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinClosingBlock", wireType)
+			}
+			m.MinClosingBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinClosingBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClosingBlock", wireType)
+			}
+			m.MaxClosingBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClosingBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBounty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBounty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1327,7 +3113,7 @@ func (m *QueryOperatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1350,15 +3136,15 @@ func (m *QueryOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1385,7 +3171,44 @@ func (m *QueryOperatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1413,7 +3236,7 @@ func (m *QueryOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTasksForOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1423,25 +3246,93 @@ func (m *QueryOperatorsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTasksForOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTasksForOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1466,7 +3357,7 @@ func (m *QueryOperatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTasksForOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,15 +3380,15 @@ func (m *QueryOperatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTasksForOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTasksForOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1524,8 +3415,44 @@ func (m *QueryOperatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operators = append(m.Operators, Operator{})
-			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1553,7 +3480,7 @@ func (m *QueryOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1576,12 +3503,76 @@ func (m *QueryWithdrawsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1606,7 +3597,7 @@ func (m *QueryWithdrawsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1629,15 +3620,15 @@ func (m *QueryWithdrawsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1664,8 +3655,8 @@ func (m *QueryWithdrawsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdraws = append(m.Withdraws, Withdraw{})
-			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1693,7 +3684,7 @@ func (m *QueryWithdrawsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResponseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1716,17 +3707,17 @@ func (m *QueryTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResponseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResponseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1736,27 +3727,14 @@ func (m *QueryTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1784,7 +3762,7 @@ func (m *QueryTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Function = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1810,7 +3788,7 @@ func (m *QueryTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResponseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1833,15 +3811,15 @@ func (m *QueryTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResponseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1868,7 +3846,7 @@ func (m *QueryTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1896,7 +3874,7 @@ func (m *QueryTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResponseRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1919,79 +3897,15 @@ func (m *QueryResponseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResponseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResponseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Function = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2019,7 +3933,7 @@ func (m *QueryResponseRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2045,7 +3959,7 @@ func (m *QueryResponseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResponseResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2068,15 +3982,15 @@ func (m *QueryResponseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResponseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2103,7 +4017,8 @@ func (m *QueryResponseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, Slash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
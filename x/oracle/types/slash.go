@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSlash returns a Slash object.
+func NewSlash(operator sdk.AccAddress, amount sdk.Coins, height int64, task Task, score sdk.Int) Slash {
+	return Slash{
+		Operator: operator.String(),
+		Amount:   amount,
+		Height:   height,
+		Contract: task.Contract,
+		Function: task.Function,
+		Score:    score,
+		Result:   task.Result,
+		TaskId:   task.Id,
+	}
+}
+
+// NewUnrevealedSlash returns a Slash object for a commit never revealed to a task.
+func NewUnrevealedSlash(operator sdk.AccAddress, amount sdk.Coins, height int64, task Task) Slash {
+	slash := NewSlash(operator, amount, height, task, sdk.ZeroInt())
+	slash.Unrevealed = true
+	return slash
+}
+
+// IsDeviated returns true if a score deviates from the result beyond the threshold.
+func (p SlashingParams) IsDeviated(score, result sdk.Int) bool {
+	deviation := score.Sub(result)
+	if deviation.IsNegative() {
+		deviation = deviation.Neg()
+	}
+	return deviation.GT(p.DeviationThreshold)
+}
+
+// IsEnabled returns true if operators can be slashed for their deviations and unrevealed commits.
+func (p SlashingParams) IsEnabled() bool {
+	return p.MaxDeviations > 0 && p.SlashFraction.IsPositive()
+}
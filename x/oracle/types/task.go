@@ -1,14 +1,28 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/json"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinSaltLength is the minimum length of the salt hiding a committed score.
+	MinSaltLength = 8
+	// MaxSaltLength is the maximum length of the salt hiding a committed score.
+	MaxSaltLength = 128
+	// MaxWaitingBlocks is the maximum number of blocks a task waits for responses, including its reveal phase.
+	MaxWaitingBlocks = int64(1000000)
 )
 
 // NewTask returns a new task.
 func NewTask(
+	id uint64,
 	contract string,
 	function string,
 	beginBlock int64,
@@ -18,8 +32,11 @@ func NewTask(
 	creator sdk.AccAddress,
 	closingBlock int64,
 	waitingBlocks int64,
+	revealBlocks int64,
+	aggregationStrategy AggregationStrategy,
 ) Task {
 	return Task{
+		Id:            id,
 		Contract:      contract,
 		Function:      function,
 		BeginBlock:    beginBlock,
@@ -30,15 +47,81 @@ func NewTask(
 		ClosingBlock:  closingBlock,
 		WaitingBlocks: waitingBlocks,
 		Status:        TaskStatusPending,
+		RevealBlocks:  revealBlocks,
+
+		AggregationStrategy: aggregationStrategy,
 	}
 }
 
+// TaskStatusFromString returns the task status given its name, one of pending, succeeded and failed.
+func TaskStatusFromString(str string) (TaskStatus, error) {
+	switch strings.ToLower(str) {
+	case "pending":
+		return TaskStatusPending, nil
+	case "succeeded":
+		return TaskStatusSucceeded, nil
+	case "failed":
+		return TaskStatusFailed, nil
+	default:
+		return TaskStatusNil, sdkerrors.Wrapf(ErrInvalidTaskStatus, "%s", str)
+	}
+}
+
+// IsCommitReveal returns true if the task takes responses in a commit phase and a reveal phase.
+func (t Task) IsCommitReveal() bool {
+	return t.RevealBlocks > 0
+}
+
+// CommitClosingBlock returns the last block of the commit phase of the task.
+func (t Task) CommitClosingBlock() int64 {
+	return t.ClosingBlock - t.RevealBlocks
+}
+
+// NewResponseCommit returns a new response commit.
+func NewResponseCommit(hash []byte, operator sdk.AccAddress) ResponseCommit {
+	return ResponseCommit{
+		Operator: operator.String(),
+		Hash:     hash,
+	}
+}
+
+// ResponseCommitHash returns the hash an operator commits to a task for a score, a confidence and a salt.
+func ResponseCommitHash(operator sdk.AccAddress, score, confidence int64, salt string) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(score))
+	binary.BigEndian.PutUint64(bz[8:], uint64(confidence))
+	hash := sha256.Sum256(append(append(operator.Bytes(), bz...), []byte(salt)...))
+	return hash[:]
+}
+
 // NewResponse returns a new response.
-func NewResponse(score sdk.Int, operator sdk.AccAddress) Response {
+func NewResponse(score sdk.Int, confidence int64, operator sdk.AccAddress) Response {
 	return Response{
-		Operator: operator.String(),
-		Score:    score,
+		Operator:   operator.String(),
+		Score:      score,
+		Confidence: confidence,
+	}
+}
+
+// ValidateConfidence returns error if a confidence is out of range. Zero leaves the confidence unspecified.
+func ValidateConfidence(confidence int64) error {
+	if confidence < 0 || confidence > MaxConfidence {
+		return sdkerrors.Wrapf(ErrInvalidConfidence, "%d is out of range [0, %d]", confidence, MaxConfidence)
+	}
+	return nil
+}
+
+// EffectiveConfidence returns the confidence of the response, which is the maximum confidence if unspecified.
+func (r Response) EffectiveConfidence() int64 {
+	if r.Confidence == 0 {
+		return MaxConfidence
 	}
+	return r.Confidence
+}
+
+// ConfidenceWeighted returns an amount scaled by the confidence of the response.
+func (r Response) ConfidenceWeighted(amount sdk.Int) sdk.Int {
+	return amount.MulRaw(r.EffectiveConfidence()).QuoRaw(MaxConfidence)
 }
 
 type Responses []Response
@@ -406,13 +406,15 @@ func (m *MsgWithdrawRewardResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgWithdrawRewardResponse proto.InternalMessageInfo
 
 type MsgCreateTask struct {
-	Contract      string                                   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
-	Function      string                                   `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
-	Bounty        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty" yaml:"bounty"`
-	Description   string                                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	Creator       string                                   `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
-	Wait          int64                                    `protobuf:"varint,6,opt,name=wait,proto3" json:"wait,omitempty" yaml:"wait"`
-	ValidDuration time.Duration                            `protobuf:"bytes,7,opt,name=valid_duration,json=validDuration,proto3,stdduration" json:"valid_duration" yaml:"valid_duration"`
+	Contract            string                                   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	Function            string                                   `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
+	Bounty              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty" yaml:"bounty"`
+	Description         string                                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Creator             string                                   `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Wait                int64                                    `protobuf:"varint,6,opt,name=wait,proto3" json:"wait,omitempty" yaml:"wait"`
+	ValidDuration       time.Duration                            `protobuf:"bytes,7,opt,name=valid_duration,json=validDuration,proto3,stdduration" json:"valid_duration" yaml:"valid_duration"`
+	RevealBlocks        int64                                    `protobuf:"varint,8,opt,name=reveal_blocks,json=revealBlocks,proto3" json:"reveal_blocks,omitempty" yaml:"reveal_blocks"`
+	AggregationStrategy AggregationStrategy                      `protobuf:"varint,9,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=shentu.oracle.v1alpha1.AggregationStrategy" json:"aggregation_strategy,omitempty" yaml:"aggregation_strategy"`
 }
 
 func (m *MsgCreateTask) Reset()         { *m = MsgCreateTask{} }
@@ -449,6 +451,7 @@ func (m *MsgCreateTask) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgCreateTask proto.InternalMessageInfo
 
 type MsgCreateTaskResponse struct {
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
 }
 
 func (m *MsgCreateTaskResponse) Reset()         { *m = MsgCreateTaskResponse{} }
@@ -484,11 +487,18 @@ func (m *MsgCreateTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateTaskResponse proto.InternalMessageInfo
 
+func (m *MsgCreateTaskResponse) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
 type MsgTaskResponse struct {
-	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
-	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
-	Score    int64  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty" yaml:"score"`
-	Operator string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	TaskId     uint64 `protobuf:"varint,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+	Score      int64  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty" yaml:"score"`
+	Operator   string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Confidence int64  `protobuf:"varint,6,opt,name=confidence,proto3" json:"confidence,omitempty" yaml:"confidence"`
 }
 
 func (m *MsgTaskResponse) Reset()         { *m = MsgTaskResponse{} }
@@ -560,18 +570,171 @@ func (m *MsgTaskResponseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTaskResponseResponse proto.InternalMessageInfo
 
+type MsgCommitTaskResponse struct {
+	TaskId   uint64 `protobuf:"varint,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+	Hash     []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Operator string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+}
+
+func (m *MsgCommitTaskResponse) Reset()         { *m = MsgCommitTaskResponse{} }
+func (m *MsgCommitTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitTaskResponse) ProtoMessage()    {}
+func (*MsgCommitTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997621a7e064be40, []int{14}
+}
+func (m *MsgCommitTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitTaskResponse.Merge(m, src)
+}
+func (m *MsgCommitTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitTaskResponse proto.InternalMessageInfo
+
+type MsgCommitTaskResponseResponse struct {
+}
+
+func (m *MsgCommitTaskResponseResponse) Reset()         { *m = MsgCommitTaskResponseResponse{} }
+func (m *MsgCommitTaskResponseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitTaskResponseResponse) ProtoMessage()    {}
+func (*MsgCommitTaskResponseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997621a7e064be40, []int{15}
+}
+func (m *MsgCommitTaskResponseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitTaskResponseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitTaskResponseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitTaskResponseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitTaskResponseResponse.Merge(m, src)
+}
+func (m *MsgCommitTaskResponseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitTaskResponseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitTaskResponseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitTaskResponseResponse proto.InternalMessageInfo
+
+type MsgRevealTaskResponse struct {
+	TaskId     uint64 `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+	Score      int64  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty" yaml:"score"`
+	Salt       string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	Operator   string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	Confidence int64  `protobuf:"varint,7,opt,name=confidence,proto3" json:"confidence,omitempty" yaml:"confidence"`
+}
+
+func (m *MsgRevealTaskResponse) Reset()         { *m = MsgRevealTaskResponse{} }
+func (m *MsgRevealTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealTaskResponse) ProtoMessage()    {}
+func (*MsgRevealTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997621a7e064be40, []int{16}
+}
+func (m *MsgRevealTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealTaskResponse.Merge(m, src)
+}
+func (m *MsgRevealTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealTaskResponse proto.InternalMessageInfo
+
+type MsgRevealTaskResponseResponse struct {
+}
+
+func (m *MsgRevealTaskResponseResponse) Reset()         { *m = MsgRevealTaskResponseResponse{} }
+func (m *MsgRevealTaskResponseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealTaskResponseResponse) ProtoMessage()    {}
+func (*MsgRevealTaskResponseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997621a7e064be40, []int{17}
+}
+func (m *MsgRevealTaskResponseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealTaskResponseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealTaskResponseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealTaskResponseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealTaskResponseResponse.Merge(m, src)
+}
+func (m *MsgRevealTaskResponseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealTaskResponseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealTaskResponseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealTaskResponseResponse proto.InternalMessageInfo
+
 type MsgInquiryTask struct {
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
 	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
 	TxHash   string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
 	Inquirer string `protobuf:"bytes,4,opt,name=inquirer,proto3" json:"inquirer,omitempty" yaml:"inquirer"`
+	Callback string `protobuf:"bytes,5,opt,name=callback,proto3" json:"callback,omitempty" yaml:"callback"`
 }
 
 func (m *MsgInquiryTask) Reset()         { *m = MsgInquiryTask{} }
 func (m *MsgInquiryTask) String() string { return proto.CompactTextString(m) }
 func (*MsgInquiryTask) ProtoMessage()    {}
 func (*MsgInquiryTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_997621a7e064be40, []int{14}
+	return fileDescriptor_997621a7e064be40, []int{18}
 }
 func (m *MsgInquiryTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,13 +764,18 @@ func (m *MsgInquiryTask) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgInquiryTask proto.InternalMessageInfo
 
 type MsgInquiryTaskResponse struct {
+	TaskId       uint64                                   `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+	Result       github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=result,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"result" yaml:"result"`
+	Status       TaskStatus                               `protobuf:"varint,3,opt,name=status,proto3,enum=shentu.oracle.v1alpha1.TaskStatus" json:"status,omitempty" yaml:"status"`
+	ClosingBlock int64                                    `protobuf:"varint,4,opt,name=closing_block,json=closingBlock,proto3" json:"closing_block,omitempty" yaml:"closing_block"`
+	Fee          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee" yaml:"fee"`
 }
 
 func (m *MsgInquiryTaskResponse) Reset()         { *m = MsgInquiryTaskResponse{} }
 func (m *MsgInquiryTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInquiryTaskResponse) ProtoMessage()    {}
 func (*MsgInquiryTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_997621a7e064be40, []int{15}
+	return fileDescriptor_997621a7e064be40, []int{19}
 }
 func (m *MsgInquiryTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,18 +804,45 @@ func (m *MsgInquiryTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInquiryTaskResponse proto.InternalMessageInfo
 
+func (m *MsgInquiryTaskResponse) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *MsgInquiryTaskResponse) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusNil
+}
+
+func (m *MsgInquiryTaskResponse) GetClosingBlock() int64 {
+	if m != nil {
+		return m.ClosingBlock
+	}
+	return 0
+}
+
+func (m *MsgInquiryTaskResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 type MsgDeleteTask struct {
-	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
-	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty" yaml:"function"`
-	Force    bool   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty" yaml:"force"`
-	Deleter  string `protobuf:"bytes,4,opt,name=deleter,proto3" json:"deleter,omitempty" yaml:"deleter"`
+	TaskId  uint64 `protobuf:"varint,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" yaml:"task_id"`
+	Force   bool   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty" yaml:"force"`
+	Deleter string `protobuf:"bytes,4,opt,name=deleter,proto3" json:"deleter,omitempty" yaml:"deleter"`
 }
 
 func (m *MsgDeleteTask) Reset()         { *m = MsgDeleteTask{} }
 func (m *MsgDeleteTask) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteTask) ProtoMessage()    {}
 func (*MsgDeleteTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_997621a7e064be40, []int{16}
+	return fileDescriptor_997621a7e064be40, []int{20}
 }
 func (m *MsgDeleteTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +878,7 @@ func (m *MsgDeleteTaskResponse) Reset()         { *m = MsgDeleteTaskResponse{} }
 func (m *MsgDeleteTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteTaskResponse) ProtoMessage()    {}
 func (*MsgDeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_997621a7e064be40, []int{17}
+	return fileDescriptor_997621a7e064be40, []int{21}
 }
 func (m *MsgDeleteTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,6 +922,10 @@ func init() {
 	proto.RegisterType((*MsgCreateTaskResponse)(nil), "shentu.oracle.v1alpha1.MsgCreateTaskResponse")
 	proto.RegisterType((*MsgTaskResponse)(nil), "shentu.oracle.v1alpha1.MsgTaskResponse")
 	proto.RegisterType((*MsgTaskResponseResponse)(nil), "shentu.oracle.v1alpha1.MsgTaskResponseResponse")
+	proto.RegisterType((*MsgCommitTaskResponse)(nil), "shentu.oracle.v1alpha1.MsgCommitTaskResponse")
+	proto.RegisterType((*MsgCommitTaskResponseResponse)(nil), "shentu.oracle.v1alpha1.MsgCommitTaskResponseResponse")
+	proto.RegisterType((*MsgRevealTaskResponse)(nil), "shentu.oracle.v1alpha1.MsgRevealTaskResponse")
+	proto.RegisterType((*MsgRevealTaskResponseResponse)(nil), "shentu.oracle.v1alpha1.MsgRevealTaskResponseResponse")
 	proto.RegisterType((*MsgInquiryTask)(nil), "shentu.oracle.v1alpha1.MsgInquiryTask")
 	proto.RegisterType((*MsgInquiryTaskResponse)(nil), "shentu.oracle.v1alpha1.MsgInquiryTaskResponse")
 	proto.RegisterType((*MsgDeleteTask)(nil), "shentu.oracle.v1alpha1.MsgDeleteTask")
@@ -736,73 +935,96 @@ func init() {
 func init() { proto.RegisterFile("shentu/oracle/v1alpha1/tx.proto", fileDescriptor_997621a7e064be40) }
 
 var fileDescriptor_997621a7e064be40 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0xdb, 0x36,
-	0x14, 0xb7, 0xe2, 0xe6, 0x63, 0x4c, 0xf3, 0x51, 0xa5, 0x4d, 0x15, 0x07, 0xb3, 0x32, 0x0e, 0xcb,
-	0x3c, 0x74, 0x95, 0xe6, 0xf6, 0x32, 0xf4, 0x56, 0x27, 0x03, 0x56, 0x14, 0x46, 0x01, 0x62, 0xc0,
-	0x80, 0x5d, 0x02, 0x5a, 0x62, 0x64, 0x21, 0xb6, 0xe8, 0x91, 0x74, 0x3e, 0x80, 0x1d, 0x76, 0xdc,
-	0x71, 0xc7, 0x5d, 0x06, 0xf4, 0xb0, 0xd3, 0xfe, 0x92, 0x1e, 0xdb, 0xcb, 0x30, 0xec, 0xe0, 0x0e,
-	0xc9, 0x65, 0x18, 0xb0, 0x8b, 0xff, 0x82, 0x41, 0x94, 0x44, 0x53, 0xb6, 0xa1, 0xd8, 0x45, 0x91,
-	0x53, 0x62, 0xbe, 0x1f, 0x7f, 0xef, 0xbd, 0xdf, 0x7b, 0xe4, 0xa3, 0x80, 0xcd, 0xdb, 0x24, 0x12,
-	0x7d, 0x97, 0x32, 0xec, 0x75, 0x88, 0x7b, 0x5a, 0xc7, 0x9d, 0x5e, 0x1b, 0xd7, 0x5d, 0x71, 0xee,
-	0xf4, 0x18, 0x15, 0xd4, 0xdc, 0x4e, 0x00, 0x4e, 0x02, 0x70, 0x32, 0x40, 0xe5, 0x6e, 0x40, 0x03,
-	0x2a, 0x21, 0x6e, 0xfc, 0x5f, 0x82, 0xae, 0x54, 0x3d, 0xca, 0xbb, 0x94, 0xbb, 0x2d, 0xcc, 0x63,
-	0xb2, 0x16, 0x11, 0xb8, 0xee, 0x7a, 0x34, 0x8c, 0x32, 0x7b, 0x40, 0x69, 0xd0, 0x21, 0xae, 0xfc,
-	0xd5, 0xea, 0x1f, 0xbb, 0x7e, 0x9f, 0x61, 0x11, 0xd2, 0xd4, 0x0e, 0x7f, 0x5b, 0x00, 0x77, 0x9a,
-	0x3c, 0x38, 0x60, 0x04, 0x0b, 0xf2, 0xa2, 0x47, 0x18, 0x16, 0x94, 0x99, 0x9f, 0x83, 0x65, 0xec,
-	0xfb, 0x8c, 0x70, 0x6e, 0x19, 0x7b, 0x46, 0xed, 0x83, 0x86, 0x39, 0x1c, 0xd8, 0xeb, 0x17, 0xb8,
-	0xdb, 0x79, 0x02, 0x53, 0x03, 0x44, 0x19, 0xc4, 0xfc, 0xd1, 0x00, 0xc0, 0xa3, 0x9d, 0x0e, 0x16,
-	0x84, 0xe1, 0x8e, 0xb5, 0xb0, 0x57, 0xae, 0xad, 0x3e, 0xda, 0x71, 0x92, 0xc8, 0x9c, 0x38, 0x32,
-	0x27, 0x8d, 0xcc, 0x39, 0xa0, 0x61, 0xd4, 0xf8, 0xea, 0xd5, 0xc0, 0x2e, 0x0d, 0x07, 0xf6, 0x9d,
-	0x84, 0x70, 0xb4, 0x15, 0xfe, 0xfe, 0xd6, 0xae, 0x05, 0xa1, 0x68, 0xf7, 0x5b, 0x8e, 0x47, 0xbb,
-	0x6e, 0x9a, 0x5b, 0xf2, 0xe7, 0x21, 0xf7, 0x4f, 0x5c, 0x71, 0xd1, 0x23, 0x5c, 0xb2, 0x70, 0xa4,
-	0xf9, 0x34, 0x5d, 0xb0, 0xd2, 0x63, 0xb4, 0x47, 0x39, 0x61, 0x56, 0x59, 0x46, 0xbc, 0x35, 0x1c,
-	0xd8, 0x1b, 0x89, 0x83, 0xcc, 0x02, 0x91, 0x02, 0x99, 0x1f, 0x83, 0x5b, 0x11, 0xee, 0x12, 0xeb,
-	0x96, 0x04, 0x6f, 0x0c, 0x07, 0xf6, 0x6a, 0x02, 0x8e, 0x57, 0x21, 0x92, 0xc6, 0x27, 0x2b, 0x3f,
-	0xbd, 0xb4, 0x4b, 0xff, 0xbc, 0xb4, 0x4b, 0x70, 0x17, 0xec, 0x4c, 0xa8, 0x84, 0x08, 0xef, 0xd1,
-	0x88, 0x13, 0xf8, 0x83, 0x94, 0x10, 0x91, 0x2e, 0x3d, 0x7d, 0x57, 0x09, 0xf5, 0xf8, 0x17, 0x66,
-	0x88, 0x7f, 0x22, 0xb4, 0xbc, 0x77, 0x15, 0xda, 0xbf, 0x06, 0xd8, 0x6c, 0xf2, 0xe0, 0xa9, 0xef,
-	0x1f, 0x8c, 0xc4, 0x9a, 0x2f, 0xb4, 0x5f, 0x0d, 0x70, 0x77, 0xa4, 0xf4, 0x51, 0x18, 0x79, 0x8c,
-	0x74, 0x49, 0x24, 0xae, 0xaf, 0xf3, 0x8b, 0xb4, 0xce, 0xbb, 0xe3, 0x75, 0x1e, 0x91, 0xcc, 0x57,
-	0xf1, 0xad, 0x11, 0xc5, 0xb3, 0x8c, 0x41, 0x53, 0xa2, 0x02, 0xac, 0xf1, 0x5c, 0x95, 0x10, 0xff,
-	0x19, 0x60, 0x4b, 0xca, 0xe4, 0xf7, 0x3d, 0xf2, 0xbe, 0xb4, 0xf0, 0xc9, 0x7b, 0xd0, 0x42, 0x91,
-	0xbc, 0xb3, 0x16, 0x87, 0x64, 0x52, 0x8b, 0x0f, 0xc1, 0xee, 0x94, 0x74, 0x95, 0x1c, 0xcf, 0x65,
-	0xcb, 0x7e, 0x1b, 0x8a, 0xb6, 0xcf, 0xf0, 0x19, 0x22, 0x67, 0x98, 0xf9, 0xf3, 0x69, 0x31, 0xd1,
-	0x81, 0x79, 0x32, 0xe5, 0xe9, 0xaa, 0x0c, 0xd6, 0xd4, 0xd1, 0xf9, 0x06, 0xf3, 0x93, 0xb8, 0xd7,
-	0x3d, 0x1a, 0x09, 0x86, 0x3d, 0x91, 0xfa, 0xd1, 0x7a, 0x3d, 0xb3, 0x40, 0xa4, 0x40, 0xf1, 0x86,
-	0xe3, 0x7e, 0xe4, 0xc5, 0xb7, 0xd6, 0xe4, 0xe1, 0xc8, 0x2c, 0x10, 0x29, 0x90, 0x29, 0xc0, 0x52,
-	0x8b, 0xf6, 0x23, 0x71, 0x61, 0x95, 0xaf, 0xab, 0xcb, 0xd3, 0xb4, 0x2e, 0x6b, 0x09, 0x5b, 0xb2,
-	0x6d, 0xbe, 0x4a, 0xa4, 0xbe, 0xcc, 0x2f, 0xc1, 0xaa, 0x4f, 0xb8, 0xc7, 0xc2, 0x9e, 0x8c, 0x34,
-	0xb9, 0x59, 0xb6, 0x87, 0x03, 0xdb, 0x4c, 0xb8, 0x35, 0x23, 0x44, 0x3a, 0x34, 0x16, 0xde, 0x8b,
-	0xf5, 0xa1, 0xcc, 0x5a, 0x1c, 0x17, 0x3e, 0x35, 0x40, 0x94, 0x41, 0xe2, 0xab, 0xeb, 0x0c, 0x87,
-	0xc2, 0x5a, 0xda, 0x33, 0x6a, 0x65, 0xfd, 0xea, 0x8a, 0x57, 0x21, 0x92, 0x46, 0xd3, 0x03, 0xeb,
-	0xa7, 0xb8, 0x13, 0xfa, 0x47, 0xd9, 0x7d, 0x6f, 0x2d, 0xef, 0x19, 0x52, 0x8a, 0x64, 0x20, 0x38,
-	0xd9, 0x40, 0x70, 0x0e, 0x53, 0x40, 0xe3, 0xa3, 0x54, 0x8a, 0x7b, 0x09, 0x5b, 0x7e, 0x3b, 0xfc,
-	0xe5, 0xad, 0x6d, 0xa0, 0x35, 0xb9, 0x98, 0xed, 0xd0, 0x5a, 0xe0, 0x3e, 0xb8, 0x97, 0x2b, 0xb2,
-	0x2a, 0xff, 0x1f, 0x06, 0xd8, 0x68, 0xf2, 0x40, 0x5f, 0xbb, 0x81, 0x06, 0xd8, 0x07, 0x8b, 0xdc,
-	0xa3, 0x8c, 0xc8, 0x59, 0x50, 0x6e, 0x6c, 0x0e, 0x07, 0xf6, 0xed, 0x04, 0x2d, 0x97, 0x21, 0x4a,
-	0xcc, 0x31, 0x31, 0x4d, 0xaf, 0xcc, 0xb4, 0x5e, 0x1a, 0x71, 0x66, 0x81, 0x48, 0x81, 0xb4, 0x8c,
-	0x77, 0xc0, 0xfd, 0xb1, 0xbc, 0x54, 0xce, 0x7f, 0x19, 0x60, 0xbd, 0xc9, 0x83, 0x67, 0xd1, 0xf7,
-	0xfd, 0x90, 0x5d, 0xdc, 0x50, 0xcf, 0x3f, 0x00, 0xcb, 0xe2, 0xfc, 0xa8, 0x8d, 0x79, 0x3b, 0x1d,
-	0x80, 0x5a, 0x0f, 0xa5, 0x06, 0x88, 0x96, 0xc4, 0xf9, 0xd7, 0x98, 0xb7, 0x63, 0xf6, 0x50, 0x46,
-	0x47, 0xa6, 0xe4, 0x9d, 0x59, 0x20, 0x52, 0x20, 0x2d, 0x6f, 0x0b, 0x6c, 0xe7, 0x73, 0x53, 0x69,
-	0xbf, 0x31, 0xe4, 0x49, 0x3f, 0x24, 0x1d, 0x72, 0x63, 0x27, 0x7d, 0x1f, 0x2c, 0x1e, 0x53, 0xe6,
-	0x25, 0x85, 0x5e, 0xd1, 0x0b, 0x2d, 0x97, 0x21, 0x4a, 0xcc, 0xf1, 0x09, 0xf3, 0x65, 0x5c, 0x59,
-	0xbe, 0x9a, 0x3a, 0xa9, 0x01, 0xa2, 0x0c, 0x32, 0xd1, 0xd7, 0xa3, 0x94, 0xb2, 0x64, 0x1f, 0xbd,
-	0x59, 0x06, 0xe5, 0x26, 0x0f, 0xcc, 0x08, 0xac, 0x8f, 0xbd, 0x9d, 0x3e, 0x73, 0xa6, 0x3f, 0xe0,
-	0x9c, 0x89, 0x07, 0x44, 0xa5, 0x3e, 0x33, 0x54, 0x9d, 0x9d, 0x08, 0xac, 0x8f, 0x3d, 0x34, 0x8a,
-	0xfc, 0xe5, 0xa1, 0x85, 0xfe, 0xa6, 0x3f, 0x20, 0xcc, 0x13, 0xb0, 0x96, 0x7f, 0x3c, 0xd4, 0x0a,
-	0x38, 0x72, 0xc8, 0xca, 0x17, 0xb3, 0x22, 0x95, 0x33, 0x01, 0x36, 0x27, 0x06, 0xf4, 0x83, 0xc2,
-	0x98, 0xf3, 0xe0, 0xca, 0xe3, 0x39, 0xc0, 0xba, 0xa4, 0x63, 0x83, 0xb0, 0x48, 0xd2, 0x3c, 0xb4,
-	0x50, 0xd2, 0xe9, 0x13, 0xd1, 0x6c, 0x01, 0xa0, 0x4d, 0xc3, 0x4f, 0xae, 0xed, 0x81, 0x18, 0x56,
-	0x79, 0x38, 0x13, 0x4c, 0xf9, 0x68, 0x83, 0xdb, 0xb9, 0xdf, 0x9f, 0x16, 0x6c, 0xd7, 0x81, 0x15,
-	0x77, 0x46, 0xa0, 0x62, 0x26, 0x60, 0x55, 0xbf, 0xe8, 0xf6, 0x0b, 0xf6, 0x6b, 0xb8, 0x8a, 0x33,
-	0x1b, 0x4e, 0x17, 0x4d, 0xbb, 0x58, 0x8a, 0x44, 0x1b, 0xc1, 0x0a, 0x45, 0x9b, 0x3c, 0xd3, 0x8d,
-	0xe7, 0xaf, 0x2e, 0xab, 0xc6, 0xeb, 0xcb, 0xaa, 0xf1, 0xf7, 0x65, 0xd5, 0xf8, 0xf9, 0xaa, 0x5a,
-	0x7a, 0x7d, 0x55, 0x2d, 0xfd, 0x79, 0x55, 0x2d, 0x7d, 0x57, 0xd7, 0x1f, 0x03, 0x84, 0x89, 0xf0,
-	0xe4, 0x98, 0xf6, 0x23, 0x5f, 0x4e, 0x41, 0x37, 0xfd, 0xa0, 0x3b, 0xcf, 0x3e, 0xe9, 0xe4, 0xdb,
-	0xa0, 0xb5, 0x24, 0x07, 0xec, 0xe3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x83, 0x4a, 0x23, 0x8a,
-	0xf0, 0x0d, 0x00, 0x00,
+	// 1423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x8e, 0x93, 0x4e, 0x12, 0x37, 0xdd, 0x24, 0xed, 0x76, 0xab, 0x7a, 0xf3, 0x9d,
+	0xe8, 0x5b, 0x82, 0x4a, 0xbc, 0x38, 0x55, 0x25, 0x54, 0x09, 0x50, 0xdd, 0x20, 0x91, 0x56, 0x51,
+	0xa5, 0x29, 0x52, 0x25, 0x2e, 0xd1, 0x78, 0x77, 0xb2, 0x5e, 0x79, 0xbd, 0x63, 0x76, 0xc6, 0x69,
+	0x82, 0x90, 0xe0, 0x88, 0xc4, 0x01, 0x8e, 0x70, 0x40, 0xaa, 0x04, 0x07, 0xc4, 0x89, 0x3f, 0xa3,
+	0xdc, 0x7a, 0x44, 0x1c, 0x5c, 0xd4, 0x72, 0x40, 0x48, 0x5c, 0xfc, 0x17, 0xa0, 0x9d, 0x9d, 0x5d,
+	0xef, 0xfa, 0x57, 0xec, 0xb6, 0xe2, 0xe4, 0xdd, 0x79, 0x9f, 0x79, 0xef, 0xcd, 0x67, 0x3e, 0xf3,
+	0xde, 0xac, 0x81, 0xc1, 0x1a, 0xc4, 0xe7, 0x1d, 0x93, 0x06, 0xd8, 0xf2, 0x88, 0x79, 0x5c, 0xc5,
+	0x5e, 0xbb, 0x81, 0xab, 0x26, 0x3f, 0xa9, 0xb4, 0x03, 0xca, 0xa9, 0x7a, 0x31, 0x02, 0x54, 0x22,
+	0x40, 0x25, 0x06, 0xe8, 0xeb, 0x0e, 0x75, 0xa8, 0x80, 0x98, 0xe1, 0x53, 0x84, 0xd6, 0xcb, 0x16,
+	0x65, 0x2d, 0xca, 0xcc, 0x3a, 0x66, 0xa1, 0xb3, 0x3a, 0xe1, 0xb8, 0x6a, 0x5a, 0xd4, 0xf5, 0x63,
+	0xbb, 0x43, 0xa9, 0xe3, 0x11, 0x53, 0xbc, 0xd5, 0x3b, 0x47, 0xa6, 0xdd, 0x09, 0x30, 0x77, 0x69,
+	0x6c, 0xdf, 0x1a, 0x93, 0x8e, 0x8c, 0x2e, 0x40, 0xf0, 0xc7, 0x1c, 0xb8, 0x70, 0xc0, 0x9c, 0x3b,
+	0x01, 0xc1, 0x9c, 0xdc, 0x6f, 0x93, 0x00, 0x73, 0x1a, 0xa8, 0x6f, 0x81, 0x05, 0x6c, 0xdb, 0x01,
+	0x61, 0x4c, 0x53, 0x36, 0x95, 0xed, 0x73, 0x35, 0xb5, 0xd7, 0x35, 0x4a, 0xa7, 0xb8, 0xe5, 0xdd,
+	0x82, 0xd2, 0x00, 0x51, 0x0c, 0x51, 0xbf, 0x50, 0x00, 0xb0, 0xa8, 0xe7, 0x61, 0x4e, 0x02, 0xec,
+	0x69, 0xb9, 0xcd, 0xfc, 0xf6, 0xd2, 0xee, 0xe5, 0x4a, 0x94, 0x7e, 0x25, 0x4c, 0xbf, 0x22, 0xd3,
+	0xaf, 0xdc, 0xa1, 0xae, 0x5f, 0xfb, 0xe0, 0x49, 0xd7, 0x98, 0xeb, 0x75, 0x8d, 0x0b, 0x91, 0xc3,
+	0xfe, 0x54, 0xf8, 0xf3, 0x33, 0x63, 0xdb, 0x71, 0x79, 0xa3, 0x53, 0xaf, 0x58, 0xb4, 0x65, 0x4a,
+	0x02, 0xa2, 0x9f, 0x1d, 0x66, 0x37, 0x4d, 0x7e, 0xda, 0x26, 0x4c, 0x78, 0x61, 0x28, 0x15, 0x53,
+	0x35, 0xc1, 0x62, 0x3b, 0xa0, 0x6d, 0xca, 0x48, 0xa0, 0xe5, 0x45, 0xc6, 0x6b, 0xbd, 0xae, 0x71,
+	0x3e, 0x0a, 0x10, 0x5b, 0x20, 0x4a, 0x40, 0xea, 0x16, 0x28, 0xf8, 0xb8, 0x45, 0xb4, 0x82, 0x00,
+	0x9f, 0xef, 0x75, 0x8d, 0xa5, 0x08, 0x1c, 0x8e, 0x42, 0x24, 0x8c, 0xb7, 0x16, 0xbf, 0x7c, 0x6c,
+	0xcc, 0xfd, 0xf5, 0xd8, 0x98, 0x83, 0x57, 0xc0, 0xe5, 0x21, 0x96, 0x10, 0x61, 0x6d, 0xea, 0x33,
+	0x02, 0x3f, 0x13, 0x14, 0x22, 0xd2, 0xa2, 0xc7, 0x2f, 0x4b, 0x61, 0x3a, 0xff, 0xdc, 0x14, 0xf9,
+	0x0f, 0xa5, 0x96, 0x8d, 0x9e, 0xa4, 0xf6, 0xb7, 0x02, 0x56, 0x0f, 0x98, 0x73, 0xdb, 0xb6, 0xef,
+	0xf4, 0xc9, 0x9a, 0x2d, 0xb5, 0xef, 0x15, 0xb0, 0xde, 0x67, 0xfa, 0xd0, 0xf5, 0xad, 0x80, 0xb4,
+	0x88, 0xcf, 0xcf, 0xde, 0xe7, 0xfb, 0x72, 0x9f, 0xaf, 0x0c, 0xee, 0x73, 0xdf, 0xc9, 0x6c, 0x3b,
+	0xbe, 0xd6, 0x77, 0xb1, 0x1f, 0x7b, 0x48, 0x31, 0xa1, 0x03, 0x6d, 0x70, 0xad, 0x09, 0x11, 0xff,
+	0x28, 0x60, 0x4d, 0xd0, 0x64, 0x77, 0x2c, 0xf2, 0xba, 0xb8, 0xb0, 0xc9, 0x6b, 0xe0, 0xc2, 0x26,
+	0xaf, 0xca, 0xc5, 0x1e, 0x19, 0xe6, 0xe2, 0x2a, 0xb8, 0x32, 0x62, 0xb9, 0x09, 0x1d, 0xf7, 0x84,
+	0x64, 0x1f, 0xba, 0xbc, 0x61, 0x07, 0xf8, 0x11, 0x22, 0x8f, 0x70, 0x60, 0xcf, 0xc6, 0xc5, 0x90,
+	0x02, 0xb3, 0xce, 0x92, 0x48, 0x3f, 0xcc, 0x83, 0x95, 0xe4, 0xe8, 0x7c, 0x84, 0x59, 0x33, 0xd4,
+	0xba, 0x45, 0x7d, 0x1e, 0x60, 0x8b, 0x6b, 0xca, 0xa0, 0xd6, 0x63, 0x0b, 0x44, 0x09, 0x28, 0x9c,
+	0x70, 0xd4, 0xf1, 0xad, 0xb0, 0xb4, 0x0d, 0x1f, 0x8e, 0xd8, 0x02, 0x51, 0x02, 0x52, 0x39, 0x28,
+	0xd6, 0x69, 0xc7, 0xe7, 0xa7, 0x5a, 0xfe, 0xac, 0x7d, 0xb9, 0x2d, 0xf7, 0x65, 0x25, 0xf2, 0x16,
+	0x4d, 0x9b, 0x6d, 0x27, 0x64, 0x2c, 0xf5, 0x1d, 0xb0, 0x64, 0x13, 0x66, 0x05, 0x6e, 0x5b, 0x64,
+	0x1a, 0x55, 0x96, 0x8b, 0xbd, 0xae, 0xa1, 0x46, 0xbe, 0x53, 0x46, 0x88, 0xd2, 0xd0, 0x90, 0x78,
+	0x2b, 0xe4, 0x87, 0x06, 0xda, 0xfc, 0x20, 0xf1, 0xd2, 0x00, 0x51, 0x0c, 0x09, 0x4b, 0xd7, 0x23,
+	0xec, 0x72, 0xad, 0xb8, 0xa9, 0x6c, 0xe7, 0xd3, 0xa5, 0x2b, 0x1c, 0x85, 0x48, 0x18, 0x55, 0x0b,
+	0x94, 0x8e, 0xb1, 0xe7, 0xda, 0x87, 0x71, 0x53, 0xd0, 0x16, 0x36, 0x15, 0x41, 0x45, 0xd4, 0x35,
+	0x2a, 0x71, 0xd7, 0xa8, 0xec, 0x49, 0x40, 0xed, 0x7f, 0x92, 0x8a, 0x8d, 0xc8, 0x5b, 0x76, 0x3a,
+	0xfc, 0xf6, 0x99, 0xa1, 0xa0, 0x15, 0x31, 0x18, 0xcf, 0x50, 0xdf, 0x05, 0x2b, 0x01, 0x39, 0x26,
+	0xd8, 0x3b, 0xac, 0x7b, 0xd4, 0x6a, 0x32, 0x6d, 0x51, 0xa4, 0xa4, 0xf5, 0xba, 0xc6, 0x7a, 0xe4,
+	0x24, 0x63, 0x86, 0x68, 0x39, 0x7a, 0xaf, 0x89, 0x57, 0xf5, 0x73, 0xb0, 0x8e, 0x1d, 0x27, 0x20,
+	0x8e, 0xf0, 0x76, 0xc8, 0x78, 0x80, 0x39, 0x71, 0x4e, 0xb5, 0x73, 0x9b, 0xca, 0x76, 0x69, 0xf7,
+	0x7a, 0x65, 0x74, 0xb7, 0xac, 0xdc, 0xee, 0xcf, 0x79, 0x20, 0xa7, 0xd4, 0x8c, 0xfe, 0xd1, 0x1a,
+	0xe5, 0x12, 0xa2, 0x35, 0x3c, 0x3c, 0x2b, 0x25, 0xe1, 0x3d, 0xb0, 0x91, 0x11, 0x69, 0x2c, 0x5f,
+	0xf5, 0x3a, 0x58, 0xe0, 0x98, 0x35, 0x0f, 0x5d, 0x5b, 0x68, 0xb5, 0x90, 0xde, 0x1a, 0x69, 0x80,
+	0xa8, 0x18, 0x3e, 0xed, 0xdb, 0xf0, 0x4f, 0x05, 0x9c, 0x3f, 0x60, 0xce, 0x38, 0x07, 0xf3, 0x67,
+	0x39, 0x50, 0xaf, 0x81, 0x79, 0x66, 0xd1, 0x80, 0x88, 0x1e, 0x96, 0xaf, 0xad, 0xf6, 0xba, 0xc6,
+	0x72, 0x04, 0x15, 0xc3, 0x10, 0x45, 0xe6, 0xf0, 0x44, 0x50, 0x59, 0xea, 0xb5, 0xc2, 0xe0, 0x89,
+	0x88, 0x2d, 0x10, 0x25, 0x20, 0xf5, 0x66, 0xd8, 0xa1, 0xfd, 0x23, 0xd7, 0x26, 0xbe, 0x45, 0xa4,
+	0x72, 0x36, 0xd2, 0x2d, 0x38, 0xb6, 0x41, 0x94, 0x02, 0xf6, 0x09, 0xba, 0x5b, 0x58, 0x54, 0x56,
+	0x73, 0x77, 0x0b, 0x8b, 0xb9, 0xd5, 0x3c, 0xbc, 0x0c, 0x2e, 0x0d, 0xac, 0x32, 0xfe, 0x85, 0xbf,
+	0x28, 0x11, 0x91, 0xb4, 0xd5, 0x72, 0xf9, 0xcb, 0xf3, 0xb0, 0x05, 0x0a, 0x0d, 0xcc, 0x1a, 0x82,
+	0x86, 0xe5, 0xb4, 0xc4, 0xc3, 0x51, 0x88, 0x84, 0x71, 0x66, 0x12, 0xc6, 0xac, 0xc6, 0x00, 0x57,
+	0x47, 0x66, 0x9c, 0xac, 0xe9, 0xeb, 0x9c, 0x58, 0x13, 0x12, 0xd2, 0x1d, 0xb7, 0xa6, 0xe2, 0x6b,
+	0xdb, 0xdb, 0x2d, 0x50, 0x60, 0xd8, 0xe3, 0xc3, 0x37, 0x93, 0x70, 0x14, 0x22, 0x61, 0xcc, 0xac,
+	0x7d, 0x7e, 0x76, 0x01, 0x2c, 0xbc, 0x9a, 0x00, 0x22, 0xca, 0x86, 0x09, 0x89, 0x7f, 0xe1, 0x57,
+	0x39, 0x50, 0x3a, 0x60, 0xce, 0xbe, 0xff, 0x49, 0xc7, 0x0d, 0x4e, 0xff, 0xa3, 0xaa, 0x1f, 0xee,
+	0xc6, 0xc9, 0x61, 0xa2, 0x9b, 0x4c, 0x15, 0x95, 0x86, 0x70, 0x37, 0x4e, 0x3e, 0x94, 0xe2, 0x71,
+	0x45, 0x76, 0x64, 0x84, 0x78, 0x62, 0x0b, 0x44, 0x09, 0x48, 0xe4, 0x8f, 0x3d, 0xaf, 0x8e, 0xad,
+	0xe6, 0x30, 0xe3, 0xb1, 0x25, 0xcc, 0x5f, 0x3e, 0xa6, 0x8a, 0xcb, 0x77, 0x79, 0x70, 0x31, 0xcb,
+	0xc6, 0x4b, 0x95, 0x17, 0xf5, 0x21, 0x28, 0x06, 0x84, 0x75, 0x3c, 0x2e, 0xf9, 0x78, 0x3f, 0x2c,
+	0xd8, 0xbf, 0x77, 0x8d, 0x6b, 0x53, 0xb4, 0xaa, 0x7d, 0x9f, 0xf7, 0xbb, 0x5c, 0xe4, 0x05, 0x22,
+	0xe9, 0x4e, 0x3d, 0x00, 0x45, 0xc6, 0x31, 0xef, 0x30, 0x41, 0x5c, 0x69, 0x17, 0x8e, 0x2b, 0xbd,
+	0x61, 0xee, 0x0f, 0x04, 0xb2, 0x76, 0xa1, 0xef, 0x2e, 0x9a, 0x0b, 0x91, 0x74, 0x12, 0xb6, 0x05,
+	0xcb, 0xa3, 0xcc, 0xf5, 0x9d, 0xa8, 0xf0, 0x6b, 0x85, 0xc1, 0xb6, 0x90, 0x31, 0x43, 0xb4, 0x2c,
+	0xdf, 0x45, 0x5f, 0x50, 0x9b, 0x20, 0x7f, 0x44, 0x88, 0x36, 0x7f, 0x56, 0xeb, 0x7e, 0x4f, 0xf6,
+	0x2b, 0x20, 0x25, 0x41, 0xc8, 0x6c, 0x7d, 0x3b, 0x8c, 0x02, 0x7f, 0x52, 0xc4, 0xf5, 0x64, 0x8f,
+	0x78, 0x44, 0x5e, 0x4f, 0x66, 0x2d, 0xd8, 0x47, 0x34, 0xb0, 0xa2, 0x43, 0xbd, 0x98, 0x3e, 0xd4,
+	0x62, 0x18, 0xa2, 0xc8, 0x1c, 0x76, 0x78, 0x5b, 0x84, 0x88, 0xd5, 0x96, 0x72, 0x2a, 0x0d, 0x10,
+	0xc5, 0x90, 0x31, 0xa7, 0xee, 0x12, 0xd8, 0xc8, 0x64, 0x1a, 0x8b, 0x68, 0xf7, 0xd7, 0x73, 0x20,
+	0x7f, 0xc0, 0x1c, 0xd5, 0x07, 0xa5, 0x81, 0xef, 0xb8, 0x37, 0xc7, 0x6d, 0xe4, 0xd0, 0xc7, 0x8c,
+	0x5e, 0x9d, 0x1a, 0x9a, 0x88, 0xd7, 0x07, 0xa5, 0x81, 0x8f, 0x9e, 0x49, 0xf1, 0xb2, 0x50, 0xbd,
+	0x3a, 0x35, 0x34, 0x89, 0xd7, 0x04, 0x2b, 0xd9, 0x0f, 0x99, 0xed, 0x09, 0x3e, 0x32, 0x48, 0xfd,
+	0xed, 0x69, 0x91, 0x49, 0x30, 0x0e, 0x56, 0x87, 0x3e, 0x16, 0xae, 0x4f, 0xcc, 0x39, 0x0b, 0xd6,
+	0x6f, 0xcc, 0x00, 0x4e, 0x53, 0x3a, 0x70, 0x29, 0x9f, 0x44, 0x69, 0x16, 0xaa, 0x57, 0xa7, 0x86,
+	0x26, 0xf1, 0xea, 0x00, 0xa4, 0x6e, 0xe6, 0xff, 0x3f, 0x53, 0x03, 0x21, 0x4c, 0xdf, 0x99, 0x0a,
+	0x96, 0xc4, 0x68, 0x80, 0xe5, 0xcc, 0xfb, 0x1b, 0x13, 0xa6, 0xa7, 0x81, 0xba, 0x39, 0x25, 0x30,
+	0xf1, 0xfc, 0x29, 0x50, 0x47, 0xdc, 0x3c, 0x26, 0xa6, 0x3b, 0x04, 0xd7, 0x6f, 0xce, 0x04, 0x4f,
+	0xc7, 0x1e, 0x71, 0x43, 0xd8, 0x99, 0x28, 0x82, 0x41, 0xb8, 0x7e, 0x73, 0x26, 0x78, 0x12, 0x85,
+	0x80, 0xa5, 0x74, 0xab, 0xbd, 0x36, 0xc1, 0x4b, 0x0a, 0xa7, 0x57, 0xa6, 0xc3, 0xa5, 0xc5, 0x92,
+	0xaa, 0x93, 0x93, 0xc4, 0xd2, 0x87, 0xe9, 0x3b, 0x53, 0xc1, 0xe2, 0x18, 0xb5, 0x7b, 0x4f, 0x9e,
+	0x97, 0x95, 0xa7, 0xcf, 0xcb, 0xca, 0x1f, 0xcf, 0xcb, 0xca, 0x37, 0x2f, 0xca, 0x73, 0x4f, 0x5f,
+	0x94, 0xe7, 0x7e, 0x7b, 0x51, 0x9e, 0xfb, 0xb8, 0x9a, 0x2e, 0xec, 0x24, 0xe0, 0x6e, 0xf3, 0x88,
+	0x76, 0x7c, 0x5b, 0xdc, 0xe8, 0x4d, 0xf9, 0x57, 0xd7, 0x49, 0xfc, 0x67, 0x97, 0xa8, 0xf3, 0xf5,
+	0xa2, 0xf8, 0xc8, 0xb9, 0xf1, 0xef, 0x00, 0x6c, 0x1a, 0xa5, 0x88, 0x99, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -824,6 +1046,8 @@ type MsgClient interface {
 	WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error)
 	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
 	TaskResponse(ctx context.Context, in *MsgTaskResponse, opts ...grpc.CallOption) (*MsgTaskResponseResponse, error)
+	CommitTaskResponse(ctx context.Context, in *MsgCommitTaskResponse, opts ...grpc.CallOption) (*MsgCommitTaskResponseResponse, error)
+	RevealTaskResponse(ctx context.Context, in *MsgRevealTaskResponse, opts ...grpc.CallOption) (*MsgRevealTaskResponseResponse, error)
 	InquiryTask(ctx context.Context, in *MsgInquiryTask, opts ...grpc.CallOption) (*MsgInquiryTaskResponse, error)
 	DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error)
 }
@@ -899,6 +1123,24 @@ func (c *msgClient) TaskResponse(ctx context.Context, in *MsgTaskResponse, opts
 	return out, nil
 }
 
+func (c *msgClient) CommitTaskResponse(ctx context.Context, in *MsgCommitTaskResponse, opts ...grpc.CallOption) (*MsgCommitTaskResponseResponse, error) {
+	out := new(MsgCommitTaskResponseResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Msg/CommitTaskResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealTaskResponse(ctx context.Context, in *MsgRevealTaskResponse, opts ...grpc.CallOption) (*MsgRevealTaskResponseResponse, error) {
+	out := new(MsgRevealTaskResponseResponse)
+	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Msg/RevealTaskResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InquiryTask(ctx context.Context, in *MsgInquiryTask, opts ...grpc.CallOption) (*MsgInquiryTaskResponse, error) {
 	out := new(MsgInquiryTaskResponse)
 	err := c.cc.Invoke(ctx, "/shentu.oracle.v1alpha1.Msg/InquiryTask", in, out, opts...)
@@ -926,6 +1168,8 @@ type MsgServer interface {
 	WithdrawReward(context.Context, *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error)
 	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
 	TaskResponse(context.Context, *MsgTaskResponse) (*MsgTaskResponseResponse, error)
+	CommitTaskResponse(context.Context, *MsgCommitTaskResponse) (*MsgCommitTaskResponseResponse, error)
+	RevealTaskResponse(context.Context, *MsgRevealTaskResponse) (*MsgRevealTaskResponseResponse, error)
 	InquiryTask(context.Context, *MsgInquiryTask) (*MsgInquiryTaskResponse, error)
 	DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error)
 }
@@ -955,6 +1199,12 @@ func (*UnimplementedMsgServer) CreateTask(ctx context.Context, req *MsgCreateTas
 func (*UnimplementedMsgServer) TaskResponse(ctx context.Context, req *MsgTaskResponse) (*MsgTaskResponseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskResponse not implemented")
 }
+func (*UnimplementedMsgServer) CommitTaskResponse(ctx context.Context, req *MsgCommitTaskResponse) (*MsgCommitTaskResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTaskResponse not implemented")
+}
+func (*UnimplementedMsgServer) RevealTaskResponse(ctx context.Context, req *MsgRevealTaskResponse) (*MsgRevealTaskResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealTaskResponse not implemented")
+}
 func (*UnimplementedMsgServer) InquiryTask(ctx context.Context, req *MsgInquiryTask) (*MsgInquiryTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InquiryTask not implemented")
 }
@@ -1092,6 +1342,42 @@ func _Msg_TaskResponse_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitTaskResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitTaskResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitTaskResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Msg/CommitTaskResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitTaskResponse(ctx, req.(*MsgCommitTaskResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealTaskResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealTaskResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealTaskResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.oracle.v1alpha1.Msg/RevealTaskResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealTaskResponse(ctx, req.(*MsgRevealTaskResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InquiryTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInquiryTask)
 	if err := dec(in); err != nil {
@@ -1160,6 +1446,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TaskResponse",
 			Handler:    _Msg_TaskResponse_Handler,
 		},
+		{
+			MethodName: "CommitTaskResponse",
+			Handler:    _Msg_CommitTaskResponse_Handler,
+		},
+		{
+			MethodName: "RevealTaskResponse",
+			Handler:    _Msg_RevealTaskResponse_Handler,
+		},
 		{
 			MethodName: "InquiryTask",
 			Handler:    _Msg_InquiryTask_Handler,
@@ -1521,6 +1815,16 @@ func (m *MsgCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggregationStrategy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AggregationStrategy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RevealBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ValidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidDuration):])
 	if err1 != nil {
 		return 0, err1
@@ -1599,6 +1903,11 @@ func (m *MsgCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1622,6 +1931,16 @@ func (m *MsgTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Confidence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Confidence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -1634,20 +1953,6 @@ func (m *MsgTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Function)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
@@ -1674,7 +1979,7 @@ func (m *MsgTaskResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgInquiryTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgCommitTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1684,48 +1989,39 @@ func (m *MsgInquiryTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgInquiryTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCommitTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInquiryTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCommitTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Inquirer) > 0 {
-		i -= len(m.Inquirer)
-		copy(dAtA[i:], m.Inquirer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Inquirer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Function)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgInquiryTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCommitTaskResponseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1735,12 +2031,12 @@ func (m *MsgInquiryTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgInquiryTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCommitTaskResponseResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInquiryTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCommitTaskResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1748,7 +2044,7 @@ func (m *MsgInquiryTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevealTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1758,51 +2054,49 @@ func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevealTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevealTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deleter) > 0 {
-		i -= len(m.Deleter)
-		copy(dAtA[i:], m.Deleter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Deleter)))
+	if m.Confidence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Confidence))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x38
 	}
-	if m.Force {
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x30
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x2a
 	}
-	if len(m.Function) > 0 {
-		i -= len(m.Function)
-		copy(dAtA[i:], m.Function)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Function)))
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+	if m.Score != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Score))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevealTaskResponseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1812,12 +2106,200 @@ func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevealTaskResponseResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevealTaskResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInquiryTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInquiryTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInquiryTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Inquirer) > 0 {
+		i -= len(m.Inquirer)
+		copy(dAtA[i:], m.Inquirer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Inquirer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInquiryTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInquiryTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInquiryTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ClosingBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClosingBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Result.Size()
+		i -= size
+		if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Deleter) > 0 {
+		i -= len(m.Deleter)
+		copy(dAtA[i:], m.Deleter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Deleter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2009,6 +2491,12 @@ func (m *MsgCreateTask) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidDuration)
 	n += 1 + l + sovTx(uint64(l))
+	if m.RevealBlocks != 0 {
+		n += 1 + sovTx(uint64(m.RevealBlocks))
+	}
+	if m.AggregationStrategy != 0 {
+		n += 1 + sovTx(uint64(m.AggregationStrategy))
+	}
 	return n
 }
 
@@ -2018,6 +2506,9 @@ func (m *MsgCreateTaskResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
 	return n
 }
 
@@ -2027,25 +2518,87 @@ func (m *MsgTaskResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.Contract)
+	if m.Score != 0 {
+		n += 1 + sovTx(uint64(m.Score))
+	}
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Function)
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	if m.Confidence != 0 {
+		n += 1 + sovTx(uint64(m.Confidence))
+	}
+	return n
+}
+
+func (m *MsgTaskResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgCommitTaskResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Score != 0 {
 		n += 1 + sovTx(uint64(m.Score))
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	if m.Confidence != 0 {
+		n += 1 + sovTx(uint64(m.Confidence))
+	}
 	return n
 }
 
-func (m *MsgTaskResponseResponse) Size() (n int) {
+func (m *MsgRevealTaskResponseResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2076,6 +2629,10 @@ func (m *MsgInquiryTask) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2085,6 +2642,23 @@ func (m *MsgInquiryTaskResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.ClosingBlock != 0 {
+		n += 1 + sovTx(uint64(m.ClosingBlock))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2094,14 +2668,6 @@ func (m *MsgDeleteTask) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Function)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Force {
 		n += 2
 	}
@@ -2109,6 +2675,9 @@ func (m *MsgDeleteTask) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
 	return n
 }
 
@@ -3258,25 +3827,63 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealBlocks", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			m.RevealBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
+			}
+			m.AggregationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationStrategy |= AggregationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -3311,6 +3918,25 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3364,11 +3990,11 @@ func (m *MsgTaskResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var stringLen uint64
+			m.Score = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3378,27 +4004,14 @@ func (m *MsgTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Score |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3426,13 +4039,13 @@ func (m *MsgTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Function = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.Score = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3442,16 +4055,16 @@ func (m *MsgTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= int64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
 			}
-			var stringLen uint64
+			m.Confidence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3461,24 +4074,11 @@ func (m *MsgTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Confidence |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3556,7 +4156,7 @@ func (m *MsgTaskResponseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3579,17 +4179,17 @@ func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInquiryTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInquiryTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3599,59 +4199,29 @@ func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Function = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3679,13 +4249,13 @@ func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inquirer", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3695,24 +4265,11 @@ func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Inquirer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3737,7 +4294,7 @@ func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInquiryTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitTaskResponseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,10 +4317,10 @@ func (m *MsgInquiryTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInquiryTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitTaskResponseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInquiryTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitTaskResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3790,7 +4347,7 @@ func (m *MsgInquiryTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3813,15 +4370,34 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3849,11 +4425,11 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3881,13 +4457,13 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Function = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var v int
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3897,17 +4473,16 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deleter", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
 			}
-			var stringLen uint64
+			m.Confidence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3917,24 +4492,579 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Confidence |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deleter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealTaskResponseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealTaskResponseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealTaskResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInquiryTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInquiryTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInquiryTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inquirer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inquirer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInquiryTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInquiryTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInquiryTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingBlock", wireType)
+			}
+			m.ClosingBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosingBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
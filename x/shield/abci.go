@@ -15,6 +15,25 @@ func BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	if ctx.BlockHeight() == common.Update1Height {
 		k.SetShieldStakingRate(ctx, types.DefaultStakingShieldRate)
 	}
+	if ctx.BlockHeight() == common.Update2Height {
+		// Only state stored before the upgrade is migrated, which chains
+		// started from new-format genesis states do not have.
+		if k.HasLegacyPools(ctx) {
+			k.MigrateRewardIndexes(ctx)
+			k.MigrateCollateralAllocations(ctx)
+		}
+		k.MigrateShieldAdmin(ctx)
+		if !k.HasUnstakeCooldownPeriod(ctx) {
+			k.SetUnstakeCooldownPeriod(ctx, types.DefaultUnstakeCooldownPeriod)
+		}
+
+		poolParams := k.GetPoolParams(ctx)
+		if poolParams.PoolCreatorShieldLimit.IsNil() || poolParams.PoolCreatorMinFeesRate.IsNil() {
+			poolParams.PoolCreatorShieldLimit = types.DefaultPoolCreatorShieldLimit
+			poolParams.PoolCreatorMinFeesRate = types.DefaultPoolCreatorMinFeesRate
+			k.SetPoolParams(ctx, poolParams)
+		}
+	}
 }
 
 // EndBlocker processes premium payment at every block.
@@ -25,6 +44,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Process completed withdraws.
 	k.DequeueCompletedWithdrawQueue(ctx)
 
+	// Return unstaked amounts whose cooldown period has ended.
+	k.DequeueCompletedUnstakingQueue(ctx)
+
 	// Close pools who do not have any shield and shield limits are set to zero.
 	k.ClosePools(ctx)
+
+	// Compound rewards and record pool and global statistics at every epoch boundary.
+	if height := uint64(ctx.BlockHeight()); height%common.BlocksPerEpoch == 0 {
+		k.CompoundProviderRewards(ctx)
+		k.SnapshotEpoch(ctx, height/common.BlocksPerEpoch)
+	}
 }
@@ -35,13 +35,27 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdPurchases(),
 		GetCmdProvider(),
 		GetCmdProviders(),
+		GetCmdPoolAllocations(),
+		GetCmdProviderAllocations(),
 		GetCmdPoolParams(),
 		GetCmdClaimParams(),
 		GetCmdStatus(),
 		GetCmdStaking(),
 		GetCmdShieldStakingRate(),
+		GetCmdStakingRewards(),
+		GetCmdUnstakings(),
 		GetCmdReimbursement(),
 		GetCmdReimbursements(),
+		GetCmdReimbursementVesting(),
+		GetCmdEpochSnapshots(),
+		GetCmdProviderYield(),
+		GetCmdPoolUtilization(),
+		GetCmdAvailableShield(),
+		GetCmdShieldRoles(),
+		GetCmdClaim(),
+		GetCmdPoolClaims(),
+		GetCmdPurchaserClaims(),
+		GetCmdClaims(),
 	)
 
 	return shieldQueryCmd
@@ -345,6 +359,76 @@ $ %[1]s query shield providers
 	return cmd
 }
 
+// GetCmdPoolAllocations returns the command for querying
+// collateral allocations to a given pool.
+func GetCmdPoolAllocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-allocations [pool_ID]",
+		Short: "query collateral allocations to a given pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id %s is invalid", args[0])
+			}
+
+			res, err := queryClient.Allocations(
+				cmd.Context(),
+				&types.QueryAllocationsRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProviderAllocations returns the command for querying
+// collateral allocations made by a given provider.
+func GetCmdProviderAllocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocations-by [provider_address]",
+		Short: "query collateral allocations of a given provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			provider, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allocations(
+				cmd.Context(),
+				&types.QueryAllocationsRequest{Provider: provider.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdPoolParams returns the command for querying pool parameters.
 func GetCmdPoolParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -488,6 +572,76 @@ func GetCmdShieldStakingRate() *cobra.Command {
 	return cmd
 }
 
+// GetCmdStakingRewards returns the command for querying the staked-for-shield
+// amounts of a purchaser and their accrued rewards.
+func GetCmdStakingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-rewards [purchaser_address]",
+		Short: "get staked CTK for shield and accrued rewards of a purchaser",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			purchaser, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StakingRewards(
+				cmd.Context(),
+				&types.QueryStakingRewardsRequest{Purchaser: purchaser.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnstakings returns the command for querying the ongoing
+// unstakings of a purchaser.
+func GetCmdUnstakings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstakings [purchaser_address]",
+		Short: "get ongoing unstakings of a purchaser",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			purchaser, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Unstakings(
+				cmd.Context(),
+				&types.QueryUnstakingsRequest{Purchaser: purchaser.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdReimbursement returns the command for querying a reimbursement.
 func GetCmdReimbursement() *cobra.Command {
 	cmd := &cobra.Command{
@@ -547,3 +701,341 @@ func GetCmdReimbursements() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdReimbursementVesting returns the command for querying
+// vested and unvested amounts of a reimbursement.
+func GetCmdReimbursementVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reimbursement-vesting [proposal ID]",
+		Short: "query vested and unvested amounts of a reimbursement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s is invalid", args[0])
+			}
+
+			res, err := queryClient.ReimbursementVesting(
+				cmd.Context(),
+				&types.QueryReimbursementVestingRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdEpochSnapshots returns the command for querying epoch
+// snapshots of pools and global totals.
+func GetCmdEpochSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-snapshots [[start-epoch] [end-epoch]]",
+		Short: "query epoch snapshots of pools and global totals, optionally within an epoch range",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			var epochs [2]uint64
+			for i, arg := range args {
+				epochs[i], err = strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("epoch %s is invalid", arg)
+				}
+			}
+
+			res, err := queryClient.EpochSnapshots(
+				cmd.Context(),
+				&types.QueryEpochSnapshotsRequest{StartEpoch: epochs[0], EndEpoch: epochs[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProviderYield returns the command for querying the estimated
+// annualized yield of a provider.
+func GetCmdProviderYield() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-yield [provider_address]",
+		Short: "query the annualized yield of a provider estimated over trailing epochs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			windowEpochs, err := cmd.Flags().GetUint64(flagWindowEpochs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProviderYield(
+				cmd.Context(),
+				&types.QueryProviderYieldRequest{Address: address.String(), WindowEpochs: windowEpochs},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagWindowEpochs, types.DefaultYieldWindowEpochs, "number of trailing epochs to estimate the yield over")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPoolUtilization returns the command for querying the
+// utilization of a pool.
+func GetCmdPoolUtilization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-utilization [pool_ID]",
+		Short: "query the shield of a pool against its shield limit and available collaterals",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id %s is invalid", args[0])
+			}
+
+			res, err := queryClient.PoolUtilization(
+				cmd.Context(),
+				&types.QueryPoolUtilizationRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAvailableShield returns the command for querying the maximum
+// amount of shield that can be purchased from a pool.
+func GetCmdAvailableShield() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "available-shield [pool_ID]",
+		Short: "query the maximum amount of shield that can be purchased from a pool now",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id %s is invalid", args[0])
+			}
+
+			res, err := queryClient.AvailableShield(
+				cmd.Context(),
+				&types.QueryAvailableShieldRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdShieldRoles returns the command for querying the addresses
+// holding the Shield admin roles.
+func GetCmdShieldRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "query the pool operators, pausers and claim managers of Shield",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			res, err := queryClient.ShieldRoles(cmd.Context(), &types.QueryShieldRolesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaim returns the command for querying the claim of a claim proposal.
+func GetCmdClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [proposal ID]",
+		Short: "query the claim of a claim proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s is invalid", args[0])
+			}
+
+			res, err := queryClient.Claim(
+				cmd.Context(),
+				&types.QueryClaimRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPoolClaims returns the command for querying claims against a given pool.
+func GetCmdPoolClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-claims [pool_ID]",
+		Short: "query claims against a given pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id %s is invalid", args[0])
+			}
+
+			res, err := queryClient.Claims(
+				cmd.Context(),
+				&types.QueryClaimsRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPurchaserClaims returns the command for querying claims submitted by a given purchaser.
+func GetCmdPurchaserClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims-by [purchaser_address]",
+		Short: "query claims submitted by a given purchaser",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			purchaser, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Claims(
+				cmd.Context(),
+				&types.QueryClaimsRequest{Purchaser: purchaser.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaims returns the command for querying all claims.
+func GetCmdClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims",
+		Short: "query all claims",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			res, err := queryClient.Claims(cmd.Context(), &types.QueryClaimsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
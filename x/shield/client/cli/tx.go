@@ -24,6 +24,12 @@ var (
 	flagSponsor       = "sponsor"
 	flagDescription   = "description"
 	flagShieldLimit   = "shield-limit"
+	flagWindowEpochs  = "window-epochs"
+
+	flagDeductibleAmount = "deductible-amount"
+	flagDeductibleRate   = "deductible-rate"
+	flagMaxClaim         = "max-claim"
+	flagWaitingPeriod    = "waiting-period"
 )
 
 // NewTxCmd returns the transaction commands for this module.
@@ -41,9 +47,14 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdatePool(),
 		GetCmdPausePool(),
 		GetCmdResumePool(),
+		GetCmdSetCoverageTerms(),
+		GetCmdSetCoveredAssets(),
 		GetCmdDepositCollateral(),
 		GetCmdWithdrawCollateral(),
+		GetCmdAllocateCollateral(),
+		GetCmdDeallocateCollateral(),
 		GetCmdWithdrawRewards(),
+		GetCmdSetAutoCompound(),
 		GetCmdWithdrawForeignRewards(),
 		GetCmdClearPayouts(),
 		GetCmdPurchaseShield(),
@@ -120,6 +131,67 @@ Where proposal.json contains:
 	return cmd
 }
 
+// GetCmdSubmitAdminUpdateProposal implements the command for submitting a Shield admin update proposal.
+func GetCmdSubmitAdminUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shield-admin-update [proposal file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to replace the Shield pool operators, pausers and claim managers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a Shield admin update proposal along with an initial deposit.
+The proposal replaces all holders of the Shield admin roles. Pool operators can
+manage any pool, pausers can pause any pool and claim managers can assess claims.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal shield-admin-update <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Delegate emergency pausing",
+  "description": "Add an emergency pauser",
+  "pool_operators": ["certik1..."],
+  "pausers": ["certik1...", "certik1..."],
+  "claim_managers": ["certik1..."],
+  "deposit": [
+    {
+      "denom": "ctk",
+      "amount": "100"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			proposal, err := ParseShieldAdminUpdateProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+			roles := types.NewShieldRoles(proposal.PoolOperators, proposal.Pausers, proposal.ClaimManagers)
+			content := types.NewShieldAdminUpdateProposal(proposal.Title, proposal.Description, roles, from)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdCreatePool implements the command for creating a Shield pool.
 func GetCmdCreatePool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -127,7 +199,8 @@ func GetCmdCreatePool() *cobra.Command {
 		Args:  cobra.ExactArgs(3),
 		Short: "create new Shield pool initialized with an validator address",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Create a Shield pool. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Create a Shield pool. Can only be executed from a Shield pool operator address
+or a certified pool creator of the sponsor.
 
 Example:
 $ %s tx shield create-pool <shield amount> <sponsor> <sponsor-address> --native-deposit <ctk deposit> --shield-limit <shield limit>
@@ -192,7 +265,8 @@ func GetCmdUpdatePool() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "update an existing Shield pool by adding more deposit or updating Shield amount.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update a Shield pool. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Update a Shield pool. Can only be executed from a Shield pool operator address
+or a certified pool creator of the sponsor.
 
 Example:
 $ %s tx shield update-pool <id> --native-deposit <ctk deposit> --shield <shield amount> --shield-limit <shield limit>
@@ -256,7 +330,8 @@ func GetCmdPausePool() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "pause a Shield pool to disallow further Shield purchase.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Pause a Shield pool to prevent new Shield purchases. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Pause a Shield pool to prevent new Shield purchases. Can only be executed from a Shield
+pauser or pool operator address, or a certified pool creator of the sponsor.
 
 Example:
 $ %s tx shield pause-pool <pool id>
@@ -278,7 +353,8 @@ func GetCmdResumePool() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "resume a Shield pool to allow Shield purchase.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Resume a Shield pool to reactivate Shield purchase. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Resume a Shield pool to reactivate Shield purchase. Can only be executed from a Shield
+pool operator address or a certified pool creator of the sponsor.
 
 Example:
 $ %s tx shield resume-pool <pool id>
@@ -322,6 +398,136 @@ func pauseOrResume(active bool) func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// GetCmdSetCoverageTerms implements the command for setting the coverage terms of a pool.
+func GetCmdSetCoverageTerms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-coverage-terms [pool id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the deductible, maximum claim and waiting period of a Shield pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the coverage terms applied to claims against a Shield pool. The larger of the
+deductible amount and the deductible rate of a loss is not reimbursed, and the
+reimbursement of an incident is capped at the maximum claim, if it is positive.
+Claims are only allowed once the waiting period after the purchase has passed.
+Can only be executed from a Shield pool operator address or a certified pool creator of the sponsor.
+
+Example:
+$ %s tx shield set-coverage-terms <pool id> --deductible-amount 1000000 --deductible-rate 0.05 --max-claim 500000000 --waiting-period 72h
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			deductibleAmountStr, err := cmd.Flags().GetString(flagDeductibleAmount)
+			if err != nil {
+				return err
+			}
+			deductibleAmount, ok := sdk.NewIntFromString(deductibleAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid deductible amount %s", deductibleAmountStr)
+			}
+			deductibleRateStr, err := cmd.Flags().GetString(flagDeductibleRate)
+			if err != nil {
+				return err
+			}
+			deductibleRate, err := sdk.NewDecFromStr(deductibleRateStr)
+			if err != nil {
+				return err
+			}
+			maxClaimStr, err := cmd.Flags().GetString(flagMaxClaim)
+			if err != nil {
+				return err
+			}
+			maxClaim, ok := sdk.NewIntFromString(maxClaimStr)
+			if !ok {
+				return fmt.Errorf("invalid max claim %s", maxClaimStr)
+			}
+			waitingPeriod, err := cmd.Flags().GetDuration(flagWaitingPeriod)
+			if err != nil {
+				return err
+			}
+
+			terms := types.NewCoverageTerms(deductibleAmount, deductibleRate, maxClaim, waitingPeriod)
+			msg := types.NewMsgSetCoverageTerms(fromAddr, id, terms)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeductibleAmount, "0", "fixed amount of a loss not reimbursed")
+	cmd.Flags().String(flagDeductibleRate, "0", "ratio of a loss not reimbursed")
+	cmd.Flags().String(flagMaxClaim, "0", "maximum reimbursement per incident (0 for no maximum)")
+	cmd.Flags().Duration(flagWaitingPeriod, 0, "time after a purchase before claims against it are allowed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetCoveredAssets implements the command for setting the covered assets of a pool.
+func GetCmdSetCoveredAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-covered-assets [pool id] [assets]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "set the contract addresses and identifiers covered by a Shield pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the comma-separated list of assets covered by a Shield pool, replacing the
+current list. Assets are CVM contract addresses in bech32 form or identifiers of
+contracts on external chains. Omit the list to clear it.
+Can only be executed from a Shield pool operator address or a certified pool creator of the sponsor.
+
+Example:
+$ %s tx shield set-covered-assets <pool id> certik1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq,ethereum:0xdAC17F958D2ee523a2206206994597C13D831ec7
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var assets []string
+			if len(args) > 1 {
+				assets = strings.Split(args[1], ",")
+			}
+
+			msg := types.NewMsgSetCoveredAssets(fromAddr, id, assets)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdDepositCollateral implements command for community member to
 // join a pool by depositing collateral.
 func GetCmdDepositCollateral() *cobra.Command {
@@ -390,6 +596,82 @@ func GetCmdWithdrawCollateral() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAllocateCollateral implements command for provider to
+// allocate collateral to a Shield pool.
+func GetCmdAllocateCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocate-collateral [pool id] [collateral]",
+		Short: "allocate deposited collateral to a Shield pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			collateral, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAllocateCollateral(fromAddr, poolID, collateral)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeallocateCollateral implements command for provider to
+// deallocate collateral from a Shield pool.
+func GetCmdDeallocateCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deallocate-collateral [pool id] [collateral]",
+		Short: "deallocate deposited collateral from a Shield pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			collateral, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeallocateCollateral(fromAddr, poolID, collateral)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdWithdrawRewards implements command for requesting to withdraw native tokens rewards.
 func GetCmdWithdrawRewards() *cobra.Command {
 	cmd := &cobra.Command{
@@ -415,6 +697,37 @@ func GetCmdWithdrawRewards() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSetAutoCompound implements command for turning on or off
+// auto-compounding of CTK rewards into collateral.
+func GetCmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Short: "turn on or off compounding CTK rewards into collateral at every epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithTxConfig(cliCtx.TxConfig).WithAccountRetriever(cliCtx.AccountRetriever)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(fromAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdWithdrawForeignRewards implements command for requesting to withdraw foreign tokens rewards.
 func GetCmdWithdrawForeignRewards() *cobra.Command {
 	cmd := &cobra.Command{
@@ -662,7 +975,7 @@ func GetCmdUpdateSponsor() *cobra.Command {
 		Args:  cobra.ExactArgs(3),
 		Short: "update the sponsor of an existing pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update a pool's sponsor. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Update a pool's sponsor. Can only be executed from a Shield pool operator address.
 Example:
 $ %s tx shield update-sponsor <id> <new_sponsor_name> <new_sponsor_address> --from=<key_or_address>
 `,
@@ -32,3 +32,29 @@ func ParseShieldClaimProposalJSON(proposalFile string) (ShieldClaimProposalJSON,
 
 	return proposal, nil
 }
+
+// ShieldAdminUpdateProposalJSON defines a shield admin update proposal.
+type ShieldAdminUpdateProposalJSON struct {
+	Title         string    `json:"title" yaml:"title"`
+	Description   string    `json:"description" yaml:"description"`
+	PoolOperators []string  `json:"pool_operators" yaml:"pool_operators"`
+	Pausers       []string  `json:"pausers" yaml:"pausers"`
+	ClaimManagers []string  `json:"claim_managers" yaml:"claim_managers"`
+	Deposit       sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseShieldAdminUpdateProposalJSON reads and parses a ShieldAdminUpdateProposalJSON from a file.
+func ParseShieldAdminUpdateProposalJSON(proposalFile string) (ShieldAdminUpdateProposalJSON, error) {
+	proposal := ShieldAdminUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -10,4 +10,7 @@ import (
 var (
 	// shield claim proposal handler
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+	// shield admin update proposal handler
+	AdminUpdateProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitAdminUpdateProposal, rest.AdminUpdateProposalRESTHandler)
 )
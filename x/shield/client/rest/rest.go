@@ -24,6 +24,14 @@ func ProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
 	}
 }
 
+// AdminUpdateProposalRESTHandler returns a ProposalRESTHandler that exposes the shield admin update REST handler with a given sub-route.
+func AdminUpdateProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "shield_admin_update",
+		Handler:  postAdminUpdateProposalHandlerFn(cliCtx),
+	}
+}
+
 type depositCollateralReq struct {
 	BaseReq resttypes.BaseReq `json:"base_req" yaml:"base_req"`
 	Amount  sdk.Coins         `json:"amount" yaml:"amount"`
@@ -72,3 +80,14 @@ type ShieldClaimProposalReq struct {
 	Description string            `json:"description" yaml:"description"`
 	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
 }
+
+// ShieldAdminUpdateProposalReq defines a shield admin update proposal request body.
+type ShieldAdminUpdateProposalReq struct {
+	BaseReq       resttypes.BaseReq `json:"base_req" yaml:"base_req"`
+	Title         string            `json:"title" yaml:"title"`
+	Description   string            `json:"description" yaml:"description"`
+	PoolOperators []string          `json:"pool_operators" yaml:"pool_operators"`
+	Pausers       []string          `json:"pausers" yaml:"pausers"`
+	ClaimManagers []string          `json:"claim_managers" yaml:"claim_managers"`
+	Deposit       sdk.Coins         `json:"deposit" yaml:"deposit"`
+}
@@ -16,24 +16,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 	k.SetPoolParams(ctx, data.PoolParams)
 	k.SetClaimProposalParams(ctx, data.ClaimProposalParams)
 
-	adminAddr := sdk.AccAddress{}
-	var err error
+	// The legacy single shield admin is granted all roles.
+	roles := data.ShieldRoles
 	if len(strings.TrimSpace(data.ShieldAdmin)) != 0 {
-		adminAddr, err = sdk.AccAddressFromBech32(data.ShieldAdmin)
+		adminAddr, err := sdk.AccAddressFromBech32(data.ShieldAdmin)
 		if err != nil {
 			panic(err)
 		}
+		roles = roles.WithAdmin(adminAddr)
 	}
 
-	k.SetAdmin(ctx, adminAddr)
+	k.SetShieldRoles(ctx, roles)
 	k.SetTotalCollateral(ctx, data.TotalCollateral)
 	k.SetTotalWithdrawing(ctx, data.TotalWithdrawing)
 	k.SetTotalShield(ctx, data.TotalShield)
 	k.SetTotalClaimed(ctx, data.TotalClaimed)
 	k.SetServiceFees(ctx, data.ServiceFees)
 	k.SetRemainingServiceFees(ctx, data.RemainingServiceFees)
+	k.SetRewardIndex(ctx, data.RewardIndex)
+	k.SetOutstandingRewards(ctx, data.OutstandingRewards)
 	k.SetGlobalShieldStakingPool(ctx, data.GlobalStakingPool)
 	k.SetShieldStakingRate(ctx, data.ShieldStakingRate)
+	k.SetUnstakeCooldownPeriod(ctx, data.UnstakeCooldownPeriod)
+	k.SetStakingRewardRate(ctx, data.StakingRewardRate)
+	k.SetStakingRewardIndex(ctx, data.StakingRewardIndex)
 	for _, pool := range data.Pools {
 		k.SetPool(ctx, pool)
 	}
@@ -69,6 +75,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 	for _, pRPair := range data.ProposalIDReimbursementPairs {
 		k.SetReimbursement(ctx, pRPair.ProposalId, pRPair.Reimbursement)
 	}
+	for _, allocation := range data.Allocations {
+		providerAddr, err := sdk.AccAddressFromBech32(allocation.Provider)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAllocation(ctx, allocation.PoolId, providerAddr, allocation)
+	}
+	for _, snapshot := range data.EpochSnapshots {
+		k.SetEpochSnapshot(ctx, snapshot)
+	}
+	for _, claim := range data.Claims {
+		k.SetClaim(ctx, claim)
+	}
+	for _, unstaking := range data.Unstakings {
+		k.InsertUnstakingQueue(ctx, unstaking)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -77,7 +99,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	poolParams := k.GetPoolParams(ctx)
 	claimProposalParams := k.GetClaimProposalParams(ctx)
-	shieldAdmin := k.GetAdmin(ctx)
 	totalCollateral := k.GetTotalCollateral(ctx)
 	totalWithdrawing := k.GetTotalWithdrawing(ctx)
 	totalShield := k.GetTotalShield(ctx)
@@ -96,8 +117,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	stakingPurchases := k.GetAllStakeForShields(ctx)
 	originalStaking := k.GetAllOriginalStakings(ctx)
 	reimbursements := k.GetAllProposalIDReimbursementPairs(ctx)
+	allocations := k.GetAllAllocations(ctx)
+	rewardIndex := k.GetRewardIndex(ctx)
+	outstandingRewards := k.GetOutstandingRewards(ctx)
+	epochSnapshots := k.GetEpochSnapshots(ctx, 0, 0)
+	shieldRoles := k.GetShieldRoles(ctx)
+	claims := k.GetAllClaims(ctx)
+	unstakeCooldownPeriod := k.GetUnstakeCooldownPeriod(ctx)
+	stakingRewardIndex := k.GetStakingRewardIndex(ctx)
+	unstakings := k.GetAllUnstakings(ctx)
+	stakingRewardRate := k.GetStakingRewardRate(ctx)
 
-	return types.NewGenesisState(shieldAdmin, nextPoolID, nextPurchaseID, poolParams, claimProposalParams,
+	return types.NewGenesisState(nextPoolID, nextPurchaseID, poolParams, claimProposalParams,
 		totalCollateral, totalWithdrawing, totalShield, totalClaimed, serviceFees, remainingServiceFees, pools,
-		providers, purchaseLists, withdraws, lastUpdateTime, stakingPurchaseRate, globalStakingPool, stakingPurchases, originalStaking, reimbursements)
+		providers, purchaseLists, withdraws, lastUpdateTime, stakingPurchaseRate, globalStakingPool, stakingPurchases, originalStaking, reimbursements, allocations,
+		rewardIndex, outstandingRewards, epochSnapshots, shieldRoles, claims,
+		unstakeCooldownPeriod, stakingRewardIndex, unstakings, stakingRewardRate)
 }
@@ -2,6 +2,7 @@ package shield
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -35,10 +36,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.ResumePool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetCoverageTerms:
+			res, err := msgServer.SetCoverageTerms(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetCoveredAssets:
+			res, err := msgServer.SetCoveredAssets(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgWithdrawRewards:
 			res, err := msgServer.WithdrawRewards(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetAutoCompound:
+			res, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgDepositCollateral:
 			res, err := msgServer.DepositCollateral(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -47,6 +60,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.WithdrawCollateral(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgAllocateCollateral:
+			res, err := msgServer.AllocateCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDeallocateCollateral:
+			res, err := msgServer.DeallocateCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgPurchaseShield:
 			res, err := msgServer.PurchaseShield(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -78,6 +99,8 @@ func NewShieldClaimProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.ShieldClaimProposal:
 			return handleShieldClaimProposal(ctx, k, c)
+		case *types.ShieldAdminUpdateProposal:
+			return handleShieldAdminUpdateProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized shield proposal content type: %T", c)
 		}
@@ -89,17 +112,36 @@ func handleShieldClaimProposal(ctx sdk.Context, k keeper.Keeper, p *types.Shield
 	if err != nil {
 		panic(err)
 	}
-	if err := k.CreateReimbursement(ctx, p.ProposalId, p.Loss, proposerAddr); err != nil {
+	payout := k.GetClaimPayout(ctx, p.PoolId, p.Loss)
+	if err := k.CreateReimbursement(ctx, p.ProposalId, p.PoolId, payout, proposerAddr); err != nil {
 		return err
 	}
+	// Release the secured collaterals not paid out under the coverage terms.
+	k.ClaimEnd(ctx, p.ProposalId, p.PoolId, p.Loss.Sub(payout))
+	k.UpdateClaimStatus(ctx, p.ProposalId, types.ClaimStatusApproved)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateReimbursement,
 			sdk.NewAttribute(types.AttributeKeyPurchaseID, strconv.FormatUint(p.PurchaseId, 10)),
-			sdk.NewAttribute(types.AttributeKeyCompensationAmount, p.Loss.String()),
+			sdk.NewAttribute(types.AttributeKeyLoss, p.Loss.String()),
+			sdk.NewAttribute(types.AttributeKeyCompensationAmount, payout.String()),
 			sdk.NewAttribute(types.AttributeKeyBeneficiary, p.Proposer),
 		),
 	})
 	return nil
 }
+
+func handleShieldAdminUpdateProposal(ctx sdk.Context, k keeper.Keeper, p *types.ShieldAdminUpdateProposal) error {
+	k.SetShieldRoles(ctx, p.Roles)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateShieldRoles,
+			sdk.NewAttribute(types.AttributeKeyPoolOperators, strings.Join(p.Roles.PoolOperators, ",")),
+			sdk.NewAttribute(types.AttributeKeyPausers, strings.Join(p.Roles.Pausers, ",")),
+			sdk.NewAttribute(types.AttributeKeyClaimManagers, strings.Join(p.Roles.ClaimManagers, ",")),
+		),
+	})
+	return nil
+}
@@ -6,15 +6,56 @@ import (
 	"github.com/certikfoundation/shentu/x/shield/types"
 )
 
-// SetAdmin sets the Shield admin account address.
-func (k Keeper) SetAdmin(ctx sdk.Context, admin sdk.AccAddress) {
+// SetShieldRoles sets the addresses holding the Shield admin roles.
+func (k Keeper) SetShieldRoles(ctx sdk.Context, roles types.ShieldRoles) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&roles)
+	store.Set(types.GetShieldRolesKey(), bz)
+}
+
+// GetShieldRoles gets the addresses holding the Shield admin roles.
+func (k Keeper) GetShieldRoles(ctx sdk.Context) types.ShieldRoles {
 	store := ctx.KVStore(k.storeKey)
-	bz := admin
-	store.Set(types.GetShieldAdminKey(), bz)
+	bz := store.Get(types.GetShieldRolesKey())
+	if bz == nil {
+		return types.ShieldRoles{}
+	}
+	var roles types.ShieldRoles
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &roles)
+	return roles
+}
+
+// SetAdmin grants all Shield admin roles to a single account address,
+// replacing the existing role holders.
+func (k Keeper) SetAdmin(ctx sdk.Context, admin sdk.AccAddress) {
+	k.SetShieldRoles(ctx, types.ShieldRoles{}.WithAdmin(admin))
+}
+
+// IsPoolOperator returns true if the address holds the pool operator role.
+func (k Keeper) IsPoolOperator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.GetShieldRoles(ctx).IsPoolOperator(addr)
+}
+
+// IsPauser returns true if the address holds the pauser role.
+func (k Keeper) IsPauser(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.GetShieldRoles(ctx).IsPauser(addr)
+}
+
+// IsClaimManager returns true if the address holds the claim manager role.
+func (k Keeper) IsClaimManager(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.GetShieldRoles(ctx).IsClaimManager(addr)
 }
 
-// GetAdmin gets the Shield admin account address.
-func (k Keeper) GetAdmin(ctx sdk.Context) sdk.AccAddress {
+// MigrateShieldAdmin grants all Shield admin roles to the legacy
+// single Shield admin and removes the legacy admin from the store.
+func (k Keeper) MigrateShieldAdmin(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	return store.Get(types.GetShieldAdminKey())
+	if !store.Has(types.GetShieldAdminKey()) {
+		return
+	}
+	admin := sdk.AccAddress(store.Get(types.GetShieldAdminKey()))
+	if !admin.Empty() {
+		k.SetShieldRoles(ctx, k.GetShieldRoles(ctx).WithAdmin(admin))
+	}
+	store.Delete(types.GetShieldAdminKey())
 }
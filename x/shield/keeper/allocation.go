@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetAllocation sets a provider's collateral allocation to a pool.
+func (k Keeper) SetAllocation(ctx sdk.Context, poolID uint64, provider sdk.AccAddress, allocation types.Allocation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&allocation)
+	store.Set(types.GetAllocationKey(poolID, provider), bz)
+	store.Set(types.GetProviderAllocationKey(provider, poolID), []byte{})
+}
+
+// GetAllocation gets a provider's collateral allocation to a pool.
+func (k Keeper) GetAllocation(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) (types.Allocation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAllocationKey(poolID, provider))
+	if bz == nil {
+		return types.Allocation{}, false
+	}
+	var allocation types.Allocation
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &allocation)
+	return allocation, true
+}
+
+// DeleteAllocation deletes a provider's collateral allocation to a pool.
+func (k Keeper) DeleteAllocation(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAllocationKey(poolID, provider))
+	store.Delete(types.GetProviderAllocationKey(provider, poolID))
+}
+
+// IteratePoolAllocations iterates through allocations to a pool.
+func (k Keeper) IteratePoolAllocations(ctx sdk.Context, poolID uint64, callback func(allocation types.Allocation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolAllocationsKey(poolID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var allocation types.Allocation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &allocation)
+
+		if callback(allocation) {
+			break
+		}
+	}
+}
+
+// IterateAllocations iterates through all allocations.
+func (k Keeper) IterateAllocations(ctx sdk.Context, callback func(allocation types.Allocation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AllocationKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var allocation types.Allocation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &allocation)
+
+		if callback(allocation) {
+			break
+		}
+	}
+}
+
+// GetPoolAllocations retrieves all allocations to a pool.
+func (k Keeper) GetPoolAllocations(ctx sdk.Context, poolID uint64) (allocations []types.Allocation) {
+	k.IteratePoolAllocations(ctx, poolID, func(allocation types.Allocation) bool {
+		allocations = append(allocations, allocation)
+		return false
+	})
+	return
+}
+
+// GetProviderAllocations retrieves all allocations made by a provider
+// through the provider's allocation index.
+func (k Keeper) GetProviderAllocations(ctx sdk.Context, provider sdk.AccAddress) (allocations []types.Allocation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProviderAllocationsKey(provider))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poolID := sdk.BigEndianToUint64(iterator.Key()[len(iterator.Key())-8:])
+		if allocation, found := k.GetAllocation(ctx, poolID, provider); found {
+			allocations = append(allocations, allocation)
+		}
+	}
+	return
+}
+
+// GetAllAllocations retrieves all allocations.
+func (k Keeper) GetAllAllocations(ctx sdk.Context) (allocations []types.Allocation) {
+	k.IterateAllocations(ctx, func(allocation types.Allocation) bool {
+		allocations = append(allocations, allocation)
+		return false
+	})
+	return
+}
+
+// GetPoolAvailableCollateral returns the amount of collaterals
+// allocated to the pool that are not being withdrawn.
+func (k Keeper) GetPoolAvailableCollateral(ctx sdk.Context, poolID uint64) sdk.Int {
+	available := sdk.ZeroInt()
+	k.IteratePoolAllocations(ctx, poolID, func(allocation types.Allocation) bool {
+		providerAddr, err := sdk.AccAddressFromBech32(allocation.Provider)
+		if err != nil {
+			panic(err)
+		}
+		provider, found := k.GetProvider(ctx, providerAddr)
+		if !found {
+			panic("provider not found but its collaterals are allocated")
+		}
+		available = available.Add(sdk.MinInt(allocation.Amount, provider.Collateral.Sub(provider.Withdrawing)))
+		return false
+	})
+	return available
+}
+
+// AllocateCollateral allocates the given amount of a provider's
+// collaterals to a pool. A provider may allocate up to its
+// non-withdrawing collaterals to each pool.
+func (k Keeper) AllocateCollateral(ctx sdk.Context, from sdk.AccAddress, poolID uint64, amount sdk.Int) error {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrNoPoolFound
+	}
+	if _, found := k.GetProvider(ctx, from); !found {
+		return types.ErrProviderNotFound
+	}
+	k.UpdateProviderRewards(ctx, from)
+	provider, _ := k.GetProvider(ctx, from)
+
+	allocation, found := k.GetAllocation(ctx, poolID, from)
+	if !found {
+		allocation = types.NewAllocation(poolID, from, sdk.ZeroInt(), pool.RewardIndex)
+	}
+	if allocation.Amount.Add(amount).GT(provider.Collateral.Sub(provider.Withdrawing)) {
+		return types.ErrOverAllocate
+	}
+
+	allocation.Amount = allocation.Amount.Add(amount)
+	k.SetAllocation(ctx, poolID, from, allocation)
+
+	pool.Allocation = pool.Allocation.Add(amount)
+	k.SetPool(ctx, pool)
+
+	return nil
+}
+
+// DeallocateCollateral deallocates the given amount of a provider's
+// collaterals from a pool. The remaining allocation to the pool
+// must be able to cover the pool's shield.
+func (k Keeper) DeallocateCollateral(ctx sdk.Context, from sdk.AccAddress, poolID uint64, amount sdk.Int) error {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrNoPoolFound
+	}
+	allocation, found := k.GetAllocation(ctx, poolID, from)
+	if !found {
+		return types.ErrNoAllocationFound
+	}
+	if amount.GT(allocation.Amount) {
+		return types.ErrOverDeallocate
+	}
+	if pool.Allocation.Sub(amount).LT(pool.Shield) {
+		return types.ErrAllocationInUse
+	}
+
+	k.UpdateProviderRewards(ctx, from)
+	k.reduceAllocation(ctx, poolID, from, amount)
+	return nil
+}
+
+// reduceAllocation reduces a provider's allocation to a pool and
+// the pool's total allocation. The provider's rewards must have been
+// settled beforehand.
+func (k Keeper) reduceAllocation(ctx sdk.Context, poolID uint64, provider sdk.AccAddress, amount sdk.Int) {
+	allocation, found := k.GetAllocation(ctx, poolID, provider)
+	if !found || !amount.IsPositive() {
+		return
+	}
+	amount = sdk.MinInt(amount, allocation.Amount)
+
+	allocation.Amount = allocation.Amount.Sub(amount)
+	if allocation.Amount.IsZero() {
+		k.DeleteAllocation(ctx, poolID, provider)
+	} else {
+		k.SetAllocation(ctx, poolID, provider, allocation)
+	}
+
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		panic("cannot find the pool for an allocation")
+	}
+	pool.Allocation = pool.Allocation.Sub(amount)
+	k.SetPool(ctx, pool)
+}
+
+// capAllocations reduces allocations of a provider exceeding its
+// collateral after the collateral has decreased. The provider's
+// rewards must have been settled beforehand.
+func (k Keeper) capAllocations(ctx sdk.Context, providerAddr sdk.AccAddress) {
+	provider, found := k.GetProvider(ctx, providerAddr)
+	if !found {
+		return
+	}
+	for _, allocation := range k.GetProviderAllocations(ctx, providerAddr) {
+		if allocation.Amount.GT(provider.Collateral) {
+			k.reduceAllocation(ctx, allocation.PoolId, providerAddr, allocation.Amount.Sub(provider.Collateral))
+		}
+	}
+}
+
+// HasLegacyPools returns true if any pool was stored before the
+// introduction of allocations, which leaves its allocation unset.
+func (k Keeper) HasLegacyPools(ctx sdk.Context) bool {
+	for _, pool := range k.GetAllPools(ctx) {
+		if pool.Allocation.IsNil() {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateCollateralAllocations allocates all existing collaterals
+// of every provider to every pool, which is equivalent to the
+// global collateral pool before the introduction of allocations,
+// and sets service fees of pools from their unexpired purchases.
+func (k Keeper) MigrateCollateralAllocations(ctx sdk.Context) {
+	for _, provider := range k.GetAllProviders(ctx) {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.UpdateProviderRewards(ctx, providerAddr)
+	}
+
+	providers := k.GetAllProviders(ctx)
+	for _, pool := range k.GetAllPools(ctx) {
+		pool.Allocation = sdk.ZeroInt()
+		for _, provider := range providers {
+			providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+			if err != nil {
+				panic(err)
+			}
+			if !provider.Collateral.IsPositive() {
+				k.DeleteAllocation(ctx, pool.Id, providerAddr)
+				continue
+			}
+			k.SetAllocation(ctx, pool.Id, providerAddr, types.NewAllocation(pool.Id, providerAddr, provider.Collateral, pool.RewardIndex))
+			pool.Allocation = pool.Allocation.Add(provider.Collateral)
+		}
+
+		// Track service fees of unexpired purchases in the pool.
+		pool.ServiceFees = types.InitMixedDecCoins()
+		k.IteratePoolPurchaseLists(ctx, pool.Id, func(purchaseList types.PurchaseList) bool {
+			for _, entry := range purchaseList.Entries {
+				pool.ServiceFees = pool.ServiceFees.Add(entry.ServiceFees)
+			}
+			return false
+		})
+		k.SetPool(ctx, pool)
+	}
+}
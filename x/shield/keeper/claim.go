@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetClaim sets a claim in store and indexes it by pool and purchaser.
+func (k Keeper) SetClaim(ctx sdk.Context, claim types.Claim) {
+	purchaser, err := sdk.AccAddressFromBech32(claim.Purchaser)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&claim)
+	store.Set(types.GetClaimKey(claim.ProposalId), bz)
+	store.Set(types.GetPoolClaimKey(claim.PoolId, claim.ProposalId), []byte{})
+	store.Set(types.GetPurchaserClaimKey(purchaser, claim.ProposalId), []byte{})
+}
+
+// GetClaim gets the claim of a claim proposal.
+func (k Keeper) GetClaim(ctx sdk.Context, proposalID uint64) (types.Claim, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClaimKey(proposalID))
+	if bz == nil {
+		return types.Claim{}, false
+	}
+	var claim types.Claim
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &claim)
+	return claim, true
+}
+
+// IterateClaims iterates through all claims in proposal ID order.
+func (k Keeper) IterateClaims(ctx sdk.Context, callback func(claim types.Claim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.Claim
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &claim)
+		if callback(claim) {
+			break
+		}
+	}
+}
+
+// GetAllClaims gets all claims.
+func (k Keeper) GetAllClaims(ctx sdk.Context) (claims []types.Claim) {
+	k.IterateClaims(ctx, func(claim types.Claim) bool {
+		claims = append(claims, claim)
+		return false
+	})
+	return
+}
+
+// getIndexedClaims gets the claims whose proposal IDs are indexed
+// under the given key prefix.
+func (k Keeper) getIndexedClaims(ctx sdk.Context, prefix []byte) (claims []types.Claim) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		claim, found := k.GetClaim(ctx, proposalID)
+		if !found {
+			panic("indexed claim not found")
+		}
+		claims = append(claims, claim)
+	}
+	return
+}
+
+// GetPoolClaims gets all claims against a pool.
+func (k Keeper) GetPoolClaims(ctx sdk.Context, poolID uint64) []types.Claim {
+	return k.getIndexedClaims(ctx, types.GetPoolClaimsKey(poolID))
+}
+
+// GetPurchaserClaims gets all claims submitted by a purchaser.
+func (k Keeper) GetPurchaserClaims(ctx sdk.Context, purchaser sdk.AccAddress) []types.Claim {
+	return k.getIndexedClaims(ctx, types.GetPurchaserClaimsKey(purchaser))
+}
+
+// OpenClaim records a claim whose loss has been secured
+// upon the submission of a claim proposal.
+func (k Keeper) OpenClaim(ctx sdk.Context, proposalID, poolID, purchaseID uint64, purchaser sdk.AccAddress, loss sdk.Coins) {
+	k.SetClaim(ctx, types.NewClaim(proposalID, poolID, purchaseID, purchaser, loss, ctx.BlockTime()))
+}
+
+// UpdateClaimStatus updates the status of a claim. Claims of proposals
+// submitted before claims were recorded are ignored.
+func (k Keeper) UpdateClaimStatus(ctx sdk.Context, proposalID uint64, status types.ClaimStatus) {
+	claim, found := k.GetClaim(ctx, proposalID)
+	if !found {
+		return
+	}
+	claim.Status = status
+	claim.UpdateTime = ctx.BlockTime()
+	k.SetClaim(ctx, claim)
+}
+
+// updateClaimAmounts applies an update to the amounts of a claim,
+// if the claim is recorded.
+func (k Keeper) updateClaimAmounts(ctx sdk.Context, proposalID uint64, update func(claim *types.Claim)) {
+	claim, found := k.GetClaim(ctx, proposalID)
+	if !found {
+		return
+	}
+	update(&claim)
+	k.SetClaim(ctx, claim)
+}
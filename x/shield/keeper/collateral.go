@@ -11,6 +11,9 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, from sdk.AccAddress, amount s
 	provider, found := k.GetProvider(ctx, from)
 	if !found {
 		provider = k.addProvider(ctx, from)
+	} else {
+		k.UpdateProviderRewards(ctx, from)
+		provider, _ = k.GetProvider(ctx, from)
 	}
 	// Check if there are enough delegations backing collaterals.
 	if provider.DelegationBonded.LT(provider.Collateral.Add(amount).Sub(provider.Withdrawing)) {
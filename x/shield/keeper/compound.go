@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetAutoCompound turns on or off auto-compounding of a provider's
+// native rewards into collateral.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, addr sdk.AccAddress, enabled bool) error {
+	provider, found := k.GetProvider(ctx, addr)
+	if !found {
+		return types.ErrProviderNotFound
+	}
+	provider.AutoCompound = enabled
+	k.SetProvider(ctx, addr, provider)
+	return nil
+}
+
+// CompoundProviderRewards compounds native rewards of all providers
+// with auto-compounding turned on.
+func (k Keeper) CompoundProviderRewards(ctx sdk.Context) {
+	var providers []sdk.AccAddress
+	k.IterateProviders(ctx, func(provider types.Provider) bool {
+		if provider.AutoCompound {
+			addr, err := sdk.AccAddressFromBech32(provider.Address)
+			if err != nil {
+				panic(err)
+			}
+			providers = append(providers, addr)
+		}
+		return false
+	})
+	for _, addr := range providers {
+		k.compoundRewards(ctx, addr)
+	}
+}
+
+// compoundRewards pays out a provider's native rewards, delegates them
+// and deposits them as collateral. If the provider does not have a
+// bonded delegation to add the rewards to, or the delegation cannot
+// back the additional collateral, the rewards stay in the provider's
+// liquid balance.
+func (k Keeper) compoundRewards(ctx sdk.Context, addr sdk.AccAddress) {
+	payoutCtx, writePayout := ctx.CacheContext()
+	rewards, err := k.PayoutNativeRewards(payoutCtx, addr)
+	if err != nil || rewards.IsZero() {
+		return
+	}
+	writePayout()
+
+	amount := rewards.AmountOf(k.BondDenom(ctx))
+	compoundCtx, writeCompound := ctx.CacheContext()
+	compounded := k.delegateAndDeposit(compoundCtx, addr, amount) == nil
+	if compounded {
+		writeCompound()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompoundRewards,
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCompounded, strconv.FormatBool(compounded)),
+		),
+	)
+}
+
+// delegateAndDeposit delegates the given amount from a provider to
+// the bonded validator holding the provider's largest delegation and
+// deposits the delegated amount as collateral. The deposit is allocated
+// to the provider's pools in proportion to the share of its available
+// collateral already allocated to each of them.
+func (k Keeper) delegateAndDeposit(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Int) error {
+	var validator stakingtypes.Validator
+	largest := sdk.ZeroDec()
+	for _, delegation := range k.sk.GetAllDelegatorDelegations(ctx, addr) {
+		val, found := k.sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found || !val.IsBonded() || delegation.Shares.LTE(largest) {
+			continue
+		}
+		validator, largest = val, delegation.Shares
+	}
+	if !largest.IsPositive() {
+		return types.ErrInsufficientStaking
+	}
+
+	if _, err := k.sk.Delegate(ctx, addr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	// Share conversion may truncate the delegated tokens, so deposit no
+	// more than the delegations can back.
+	provider, found := k.GetProvider(ctx, addr)
+	if !found {
+		return types.ErrProviderNotFound
+	}
+	deposit := sdk.MinInt(amount, provider.DelegationBonded.Sub(provider.Collateral).Add(provider.Withdrawing))
+	if !deposit.IsPositive() {
+		return types.ErrInsufficientStaking
+	}
+	available := provider.Collateral.Sub(provider.Withdrawing)
+	allocations := k.GetProviderAllocations(ctx, addr)
+	if err := k.DepositCollateral(ctx, addr, deposit); err != nil {
+		return err
+	}
+	if !available.IsPositive() {
+		return nil
+	}
+	for _, allocation := range allocations {
+		increase := allocation.Amount.Mul(deposit).Quo(available)
+		if !increase.IsPositive() {
+			continue
+		}
+		if err := k.AllocateCollateral(ctx, addr, allocation.PoolId, increase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetCoverageTerms sets the coverage terms of a pool, which apply to
+// claims submitted afterwards.
+func (k Keeper) SetCoverageTerms(ctx sdk.Context, updater sdk.AccAddress, id uint64, terms types.CoverageTerms) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, id)
+	if !found {
+		return types.Pool{}, types.ErrNoPoolFound
+	}
+	if _, err := k.authorizePoolManager(ctx, updater, pool.SponsorAddr); err != nil {
+		return types.Pool{}, err
+	}
+	pool.CoverageTerms = &terms
+	k.SetPool(ctx, pool)
+	return pool, nil
+}
+
+// CheckCoverageTerms checks whether a claim of the loss against the
+// purchase is allowed by the coverage terms of the pool.
+func (k Keeper) CheckCoverageTerms(ctx sdk.Context, poolID uint64, purchase types.Purchase, loss sdk.Coins) error {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrNoPoolFound
+	}
+	terms := pool.CoverageTerms
+	if terms == nil {
+		return nil
+	}
+	if waitingEndTime := purchase.PurchaseTime.Add(terms.WaitingPeriod); ctx.BlockTime().Before(waitingEndTime) {
+		return sdkerrors.Wrapf(types.ErrClaimInWaitingPeriod, "claims are allowed after %s", waitingEndTime)
+	}
+	lossAmt := loss.AmountOf(k.BondDenom(ctx))
+	if !terms.Payout(lossAmt).IsPositive() {
+		return sdkerrors.Wrapf(types.ErrLossWithinDeductible, "loss %s, deductible %s", lossAmt, terms.Deductible(lossAmt))
+	}
+	return nil
+}
+
+// GetClaimPayout returns the reimbursement of a claim of the loss
+// under the coverage terms of the pool.
+func (k Keeper) GetClaimPayout(ctx sdk.Context, poolID uint64, loss sdk.Coins) sdk.Coins {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found || pool.CoverageTerms == nil {
+		return loss
+	}
+	bondDenom := k.BondDenom(ctx)
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, pool.CoverageTerms.Payout(loss.AmountOf(bondDenom))))
+}
+
+// SetCoveredAssets sets the assets protected by a pool.
+func (k Keeper) SetCoveredAssets(ctx sdk.Context, updater sdk.AccAddress, id uint64, assets []string) (types.Pool, error) {
+	if err := types.ValidateCoveredAssets(assets); err != nil {
+		return types.Pool{}, sdkerrors.Wrap(types.ErrInvalidCoveredAssets, err.Error())
+	}
+	pool, found := k.GetPool(ctx, id)
+	if !found {
+		return types.Pool{}, types.ErrNoPoolFound
+	}
+	if _, err := k.authorizePoolManager(ctx, updater, pool.SponsorAddr); err != nil {
+		return types.Pool{}, err
+	}
+	pool.CoveredAssets = assets
+	k.SetPool(ctx, pool)
+	return pool, nil
+}
+
+// GetAssetCoverage returns the IDs and total shield of active pools
+// currently covering the asset.
+func (k Keeper) GetAssetCoverage(ctx sdk.Context, asset string) (poolIDs []uint64, shield sdk.Int) {
+	shield = sdk.ZeroInt()
+	k.IterateAllPools(ctx, func(pool types.Pool) bool {
+		if !pool.Active || !pool.Shield.IsPositive() {
+			return false
+		}
+		for _, covered := range pool.CoveredAssets {
+			if covered == asset {
+				poolIDs = append(poolIDs, pool.Id)
+				shield = shield.Add(pool.Shield)
+				break
+			}
+		}
+		return false
+	})
+	return poolIDs, shield
+}
@@ -119,7 +119,7 @@ func (q Keeper) Provider(c context.Context, req *types.QueryProviderRequest) (*t
 		return nil, err
 	}
 
-	provider, found := q.GetProvider(ctx, address)
+	provider, found := q.GetSettledProvider(ctx, address)
 	if !found {
 		return nil, types.ErrProviderNotFound
 	}
@@ -134,7 +134,17 @@ func (q Keeper) Providers(c context.Context, req *types.QueryProvidersRequest) (
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryProvidersResponse{Providers: q.GetAllProviders(ctx)}, nil
+	var providers []types.Provider
+	for _, provider := range q.GetAllProviders(ctx) {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			return nil, err
+		}
+		provider, _ = q.GetSettledProvider(ctx, providerAddr)
+		providers = append(providers, provider)
+	}
+
+	return &types.QueryProvidersResponse{Providers: providers}, nil
 }
 
 // PoolParams queries pool parameters.
@@ -185,7 +195,7 @@ func (q Keeper) ShieldStaking(c context.Context, req *types.QueryShieldStakingRe
 	if err != nil {
 		return nil, err
 	}
-	shieldStaking, found := q.GetStakeForShield(ctx, req.PoolId, purchaser)
+	shieldStaking, found := q.GetSettledStakeForShield(ctx, req.PoolId, purchaser)
 	if !found {
 		return nil, types.ErrPurchaseNotFound
 	}
@@ -200,7 +210,46 @@ func (q Keeper) ShieldStakingRate(c context.Context, req *types.QueryShieldStaki
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryShieldStakingRateResponse{Rate: q.GetShieldStakingRate(ctx)}, nil
+	return &types.QueryShieldStakingRateResponse{
+		Rate:                  q.GetShieldStakingRate(ctx),
+		UnstakeCooldownPeriod: q.GetUnstakeCooldownPeriod(ctx),
+	}, nil
+}
+
+// StakingRewards queries the staking purchases of a purchaser
+// and their accrued block rewards.
+func (q Keeper) StakingRewards(c context.Context, req *types.QueryStakingRewardsRequest) (*types.QueryStakingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	purchaser, err := sdk.AccAddressFromBech32(req.Purchaser)
+	if err != nil {
+		return nil, err
+	}
+	stakings := q.GetPurchaserStakeForShields(ctx, purchaser)
+	total := types.InitMixedDecCoins()
+	for _, staking := range stakings {
+		total = total.Add(staking.Rewards)
+	}
+
+	return &types.QueryStakingRewardsResponse{ShieldStakings: stakings, Total: total}, nil
+}
+
+// Unstakings queries the ongoing unstakings of a purchaser.
+func (q Keeper) Unstakings(c context.Context, req *types.QueryUnstakingsRequest) (*types.QueryUnstakingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	purchaser, err := sdk.AccAddressFromBech32(req.Purchaser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnstakingsResponse{Unstakings: q.GetPurchaserUnstakings(ctx, purchaser)}, nil
 }
 
 // Reimbursement queries a reimbursement by proposal ID.
@@ -227,3 +276,185 @@ func (q Keeper) Reimbursements(c context.Context, req *types.QueryReimbursements
 
 	return &types.QueryReimbursementsResponse{Pairs: q.GetAllProposalIDReimbursementPairs(ctx)}, nil
 }
+
+// ReimbursementVesting queries vested and unvested amounts of a
+// reimbursement by proposal ID.
+func (q Keeper) ReimbursementVesting(c context.Context, req *types.QueryReimbursementVestingRequest) (*types.QueryReimbursementVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reimbursement, err := q.GetReimbursement(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	vested := reimbursement.VestedAmount(ctx.BlockTime())
+
+	return &types.QueryReimbursementVestingResponse{
+		Vested:    vested,
+		Unvested:  reimbursement.Amount.Sub(vested),
+		Withdrawn: reimbursement.Withdrawn,
+	}, nil
+}
+
+// Allocations queries collateral allocations given pool or
+// provider parameters.
+func (q Keeper) Allocations(c context.Context, req *types.QueryAllocationsRequest) (*types.QueryAllocationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var allocations []types.Allocation
+	if req.Provider != "" {
+		provider, err := sdk.AccAddressFromBech32(req.Provider)
+		if err != nil {
+			return nil, err
+		}
+		allocations = q.GetProviderAllocations(ctx, provider)
+	} else {
+		allocations = q.GetPoolAllocations(ctx, req.PoolId)
+	}
+
+	return &types.QueryAllocationsResponse{Allocations: allocations}, nil
+}
+
+// EpochSnapshots queries recorded epoch snapshots within an epoch range.
+func (q Keeper) EpochSnapshots(c context.Context, req *types.QueryEpochSnapshotsRequest) (*types.QueryEpochSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, status.Error(codes.InvalidArgument, "end epoch must not be less than start epoch")
+	}
+
+	return &types.QueryEpochSnapshotsResponse{Snapshots: q.GetEpochSnapshots(ctx, req.StartEpoch, req.EndEpoch)}, nil
+}
+
+// ProviderYield queries the annualized yield of a provider estimated
+// over a trailing window of epochs.
+func (q Keeper) ProviderYield(c context.Context, req *types.QueryProviderYieldRequest) (*types.QueryProviderYieldResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	yield, rewards, windowStart, collateral, err := q.GetProviderYield(ctx, address, req.WindowEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProviderYieldResponse{
+		AnnualizedYield: yield,
+		WindowRewards:   rewards,
+		WindowStart:     windowStart,
+		Collateral:      collateral,
+	}, nil
+}
+
+// PoolUtilization queries the shield of a pool against its shield
+// limit and available collaterals.
+func (q Keeper) PoolUtilization(c context.Context, req *types.QueryPoolUtilizationRequest) (*types.QueryPoolUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := q.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool under ID %d doesn't exist", req.PoolId)
+	}
+
+	maxShield := q.GetPoolMaxShield(ctx, pool)
+	utilization := sdk.ZeroDec()
+	if maxShield.IsPositive() {
+		utilization = pool.Shield.ToDec().Quo(maxShield.ToDec())
+	}
+
+	return &types.QueryPoolUtilizationResponse{
+		Shield:              pool.Shield,
+		ShieldLimit:         pool.ShieldLimit,
+		AvailableCollateral: q.GetPoolAvailableCollateral(ctx, pool.Id),
+		MaxShield:           maxShield,
+		Utilization:         utilization,
+	}, nil
+}
+
+// AvailableShield queries the maximum amount of shield that can be
+// purchased from a pool at the moment.
+func (q Keeper) AvailableShield(c context.Context, req *types.QueryAvailableShieldRequest) (*types.QueryAvailableShieldResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := q.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool under ID %d doesn't exist", req.PoolId)
+	}
+
+	available := sdk.NewCoins(sdk.NewCoin(q.BondDenom(ctx), q.GetAvailableShield(ctx, pool)))
+	return &types.QueryAvailableShieldResponse{AvailableShield: available}, nil
+}
+
+// ShieldRoles queries the addresses holding the Shield admin roles.
+func (q Keeper) ShieldRoles(c context.Context, req *types.QueryShieldRolesRequest) (*types.QueryShieldRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryShieldRolesResponse{Roles: q.GetShieldRoles(ctx)}, nil
+}
+
+// Claim queries the claim of a claim proposal.
+func (q Keeper) Claim(c context.Context, req *types.QueryClaimRequest) (*types.QueryClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	claim, found := q.GetClaim(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "claim of proposal %d not found", req.ProposalId)
+	}
+
+	return &types.QueryClaimResponse{Claim: claim}, nil
+}
+
+// Claims queries claims given pool or purchaser parameters,
+// or all claims if neither is given.
+func (q Keeper) Claims(c context.Context, req *types.QueryClaimsRequest) (*types.QueryClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var claims []types.Claim
+	switch {
+	case req.Purchaser != "":
+		purchaser, err := sdk.AccAddressFromBech32(req.Purchaser)
+		if err != nil {
+			return nil, err
+		}
+		for _, claim := range q.GetPurchaserClaims(ctx, purchaser) {
+			if req.PoolId == 0 || claim.PoolId == req.PoolId {
+				claims = append(claims, claim)
+			}
+		}
+	case req.PoolId != 0:
+		claims = q.GetPoolClaims(ctx, req.PoolId)
+	default:
+		claims = q.GetAllClaims(ctx)
+	}
+
+	return &types.QueryClaimsResponse{Claims: claims}, nil
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 )
 
 // Wrapper struct
@@ -10,7 +12,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ stakingtypes.StakingHooks = Hooks{}
+var (
+	_ stakingtypes.StakingHooks = Hooks{}
+	_ certtypes.CertHooks       = Hooks{}
+)
 
 // Create new distribution hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
@@ -29,6 +34,25 @@ func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress,
 	h.k.RemoveDelegation(ctx, delAddr, valAddr)
 }
 
+// - when a validator is slashed, before its tokens are removed
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	h.k.SlashProviders(ctx, valAddr, fraction)
+}
+
+// - when a certifier revokes a certificate
+func (h Hooks) AfterCertificateRevoked(ctx sdk.Context, certificate certtypes.Certificate) {
+	requestContent := certificate.RequestContent()
+	if certificate.Type() != certtypes.CertificateTypeShieldPoolCreator ||
+		requestContent.RequestContentType != certtypes.RequestContentTypeAddress {
+		return
+	}
+	sponsorAddr, err := sdk.AccAddressFromBech32(requestContent.RequestContent)
+	if err != nil {
+		return
+	}
+	h.k.PauseSponsorPools(ctx, sponsorAddr)
+}
+
 // unused hooks
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                    {}
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {}
@@ -36,7 +60,6 @@ func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 }
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 }
-func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {}
-func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
-func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)          {}
-func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                         {}
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
@@ -14,6 +14,31 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "provider", ProviderInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "shield", ShieldInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "global-staking-pool", GlobalStakingPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "purchase-queue", PurchaseQueueInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "withdraw-queue", WithdrawQueueInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reimbursement", ReimbursementInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "remaining-service-fees", RemainingServiceFeesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the shield module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ModuleAccountInvariant(k),
+			ProviderInvariant(k),
+			ShieldInvariant(k),
+			GlobalStakingPoolInvariant(k),
+			PurchaseQueueInvariant(k),
+			WithdrawQueueInvariant(k),
+			ReimbursementInvariant(k),
+			RemainingServiceFeesInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
@@ -27,24 +52,31 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 		// remaining service fees
 		remainingServiceFees := keeper.GetRemainingServiceFees(ctx)
 
-		// rewards
-		var rewards types.MixedDecCoins
+		// settled and outstanding rewards
+		rewards := keeper.GetOutstandingRewards(ctx)
 		for _, provider := range keeper.GetAllProviders(ctx) {
 			rewards = rewards.Add(provider.Rewards)
 		}
+		stakes := keeper.GetAllStakeForShields(ctx)
+		for _, stake := range stakes {
+			rewards = rewards.Add(stake.Rewards)
+		}
 
 		totalInt, change := remainingServiceFees.Add(rewards).Native.TruncateDecimal()
 
 		// shield stake
 		shieldStake := sdk.ZeroInt()
-		for _, stake := range keeper.GetAllStakeForShields(ctx) {
+		for _, stake := range stakes {
 			shieldStake = shieldStake.Add(stake.Amount)
 		}
+		for _, unstaking := range keeper.GetAllUnstakings(ctx) {
+			shieldStake = shieldStake.Add(unstaking.Amount)
+		}
 
 		// reimbursement
 		reimbursement := sdk.ZeroInt()
 		for _, rmb := range keeper.GetAllReimbursements(ctx) {
-			reimbursement = reimbursement.Add(rmb.Amount.AmountOf(bondDenom))
+			reimbursement = reimbursement.Add(rmb.Amount.AmountOf(bondDenom)).Sub(rmb.Withdrawn.AmountOf(bondDenom))
 		}
 
 		// block service fees
@@ -123,3 +155,129 @@ func GlobalStakingPoolInvariant(keeper Keeper) sdk.Invariant {
 				stakedInt, globalStakingPool.String())), broken
 	}
 }
+
+// PurchaseQueueInvariant checks that the expiring purchase queue holds
+// exactly one pool-purchaser pair for each purchase entry, under the
+// entry's protection end time.
+func PurchaseQueueInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// number of entries per (protection end time, pool, purchaser)
+		counts := make(map[string]int)
+		for _, purchaseList := range keeper.GetAllPurchaseLists(ctx) {
+			for _, entry := range purchaseList.Entries {
+				key := fmt.Sprintf("%X/%d/%s", types.GetPurchaseExpirationTimeKey(entry.ProtectionEndTime), purchaseList.PoolId, purchaseList.Purchaser)
+				counts[key]++
+			}
+		}
+
+		store := ctx.KVStore(keeper.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, types.PurchaseQueueKey)
+		defer iterator.Close()
+
+		var mismatches []string
+		for ; iterator.Valid(); iterator.Next() {
+			var timeslice types.PoolPurchaserPairs
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+			for _, pair := range timeslice.Pairs {
+				key := fmt.Sprintf("%X/%d/%s", iterator.Key(), pair.PoolId, pair.Purchaser)
+				counts[key]--
+			}
+		}
+		for key, count := range counts {
+			if count != 0 {
+				mismatches = append(mismatches, fmt.Sprintf("%s: %d", key, count))
+			}
+		}
+		broken := len(mismatches) != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "purchase-queue",
+			fmt.Sprintf("\n\tpurchase entries not matching the queue (entries - queued): %v\n",
+				mismatches)), broken
+	}
+}
+
+// WithdrawQueueInvariant checks that the withdraws in the queue sum up
+// to the total withdrawing amount and each provider's withdrawing amount.
+func WithdrawQueueInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		withdrawSum := sdk.ZeroInt()
+		providerSums := make(map[string]sdk.Int)
+		broken := false
+		for _, withdraw := range keeper.GetAllWithdraws(ctx) {
+			if !withdraw.Amount.IsPositive() {
+				broken = true
+			}
+			withdrawSum = withdrawSum.Add(withdraw.Amount)
+			if sum, ok := providerSums[withdraw.Address]; ok {
+				providerSums[withdraw.Address] = sum.Add(withdraw.Amount)
+			} else {
+				providerSums[withdraw.Address] = withdraw.Amount
+			}
+		}
+
+		var mismatches []string
+		for _, provider := range keeper.GetAllProviders(ctx) {
+			sum, ok := providerSums[provider.Address]
+			if !ok {
+				sum = sdk.ZeroInt()
+			}
+			if !sum.Equal(provider.Withdrawing) {
+				mismatches = append(mismatches, provider.Address)
+			}
+			delete(providerSums, provider.Address)
+		}
+		for address := range providerSums {
+			mismatches = append(mismatches, address)
+		}
+
+		totalWithdrawing := keeper.GetTotalWithdrawing(ctx)
+		broken = broken || !totalWithdrawing.Equal(withdrawSum) || len(mismatches) != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "withdraw-queue",
+			fmt.Sprintf("\n\ttotal withdrawing amount: %s"+
+				"\n\tsum of queued withdraw amount: %s"+
+				"\n\tproviders not matching their queued withdraws: %v\n",
+				totalWithdrawing, withdrawSum, mismatches)), broken
+	}
+}
+
+// ReimbursementInvariant checks that reimbursements have valid amounts,
+// have not been withdrawn more than their amounts and vest after payout.
+func ReimbursementInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalid []uint64
+		for _, pair := range keeper.GetAllProposalIDReimbursementPairs(ctx) {
+			rmb := pair.Reimbursement
+			_, err := sdk.AccAddressFromBech32(rmb.Beneficiary)
+			if err != nil || !rmb.Amount.IsValid() || !rmb.Withdrawn.IsValid() ||
+				!rmb.Amount.IsAllGTE(rmb.Withdrawn) || rmb.VestingEndTime.Before(rmb.PayoutTime) {
+				invalid = append(invalid, pair.ProposalId)
+			}
+		}
+		broken := len(invalid) != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "reimbursement",
+			fmt.Sprintf("\n\tproposal IDs of invalid reimbursements: %v\n",
+				invalid)), broken
+	}
+}
+
+// RemainingServiceFeesInvariant checks that remaining service fees are
+// not negative and are covered by the module account.
+func RemainingServiceFeesInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleCoins := keeper.bk.GetAllBalances(ctx, keeper.ak.GetModuleAccount(ctx, types.ModuleName).GetAddress())
+		remainingServiceFees := keeper.GetRemainingServiceFees(ctx)
+		serviceFees := keeper.GetServiceFees(ctx)
+
+		remainingInt, _ := remainingServiceFees.Native.TruncateDecimal()
+		broken := remainingServiceFees.Native.IsAnyNegative() || serviceFees.Native.IsAnyNegative() ||
+			!moduleCoins.IsAllGTE(remainingInt)
+
+		return sdk.FormatInvariant(types.ModuleName, "remaining-service-fees",
+			fmt.Sprintf("\n\tremaining service fees: %s"+
+				"\n\tservice fees: %s"+
+				"\n\tshield ModuleAccount coins: %s\n",
+				remainingServiceFees.Native, serviceFees.Native, moduleCoins)), broken
+	}
+}
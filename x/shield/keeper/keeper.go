@@ -20,12 +20,13 @@ type Keeper struct {
 	bk         types.BankKeeper
 	sk         types.StakingKeeper
 	gk         types.GovKeeper
+	ck         types.CertKeeper
 	paramSpace types.ParamSubspace
 }
 
 // NewKeeper creates a shield keeper.
 func NewKeeper(cdc codec.BinaryMarshaler, shieldStoreKey sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
-	sk types.StakingKeeper, gk types.GovKeeper, paramSpace types.ParamSubspace) Keeper {
+	sk types.StakingKeeper, gk types.GovKeeper, ck types.CertKeeper, paramSpace types.ParamSubspace) Keeper {
 	return Keeper{
 		storeKey:   shieldStoreKey,
 		cdc:        cdc,
@@ -33,6 +34,7 @@ func NewKeeper(cdc codec.BinaryMarshaler, shieldStoreKey sdk.StoreKey, ak types.
 		bk:         bk,
 		sk:         sk,
 		gk:         gk,
+		ck:         ck,
 		paramSpace: paramSpace,
 	}
 }
@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"math"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,8 +19,13 @@ import (
 
 	"github.com/certikfoundation/shentu/common"
 	"github.com/certikfoundation/shentu/simapp"
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	"github.com/certikfoundation/shentu/x/gov/testgov"
+	govtypes "github.com/certikfoundation/shentu/x/gov/types"
+	"github.com/certikfoundation/shentu/x/shield"
+	"github.com/certikfoundation/shentu/x/shield/keeper"
 	"github.com/certikfoundation/shentu/x/shield/testshield"
+	"github.com/certikfoundation/shentu/x/shield/types"
 	"github.com/certikfoundation/shentu/x/staking/teststaking"
 )
 
@@ -44,6 +53,11 @@ func skipBlocks(ctx sdk.Context, numBlocks int64, tstaking *teststaking.Helper,
 	return ctx
 }
 
+func encodeUvarint(x uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, x)]
+}
+
 func strAddrEqualsAccAddr(strAddr string, accAddr sdk.AccAddress) bool {
 	convertedAddr, err := sdk.AccAddressFromBech32(strAddr)
 	if err != nil {
@@ -248,11 +262,10 @@ func TestClaimProposal(t *testing.T) {
 	ctx = nextBlock(ctx, tstaking, tshield, tgov)
 	tstaking.CheckValidator(val1addr, stakingtypes.Bonded, false)
 
-	// shield admin deposit and create pool
-	// $BondDenom pool with shield = 100,000 $BondDenom, limit = 500,000 $BondDenom, serviceFees = 200 $BondDenom
+	// shield admin deposit and create pool with limit = 500,000 $BondDenom
 	tstaking.Delegate(shieldAdmin, val1addr, adminDeposit)
 	tshield.DepositCollateral(shieldAdmin, adminDeposit, true)
-	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 100e9, 500e9, "CertiK", "fake_description")
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
 
 	pools := app.ShieldKeeper.GetAllPools(ctx)
 	require.True(t, len(pools) == 1)
@@ -260,11 +273,17 @@ func TestClaimProposal(t *testing.T) {
 
 	poolID := pools[0].Id
 
-	// delegator deposits
+	// shield admin allocates collateral and purchases shield for the pool
+	// shield = 100,000 $BondDenom, serviceFees = 200 $BondDenom
+	tshield.AllocateCollateral(shieldAdmin, poolID, adminDeposit, true)
+	tshield.UpdatePool(shieldAdmin, poolID, 200e6, 100e9, true)
+
+	// delegator deposits and allocates
 	tstaking.CheckDelegator(del1addr, val1addr, false)
 	tstaking.Delegate(del1addr, val1addr, delegatorDeposit)
 	tstaking.CheckDelegator(del1addr, val1addr, true)
 	tshield.DepositCollateral(del1addr, delegatorDeposit, true)
+	tshield.AllocateCollateral(del1addr, poolID, delegatorDeposit, true)
 
 	// purchaser purhcases a shield
 	var shield int64 = 50e9
@@ -318,7 +337,7 @@ func TestClaimProposal(t *testing.T) {
 
 	// create reimbursement
 	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, loss))
-	err := app.ShieldKeeper.CreateReimbursement(ctx, proposalID, lossCoins, purchaser)
+	err := app.ShieldKeeper.CreateReimbursement(ctx, proposalID, poolID, lossCoins, purchaser)
 	require.NoError(t, err)
 	reimbursement, err := app.ShieldKeeper.GetReimbursement(ctx, proposalID)
 	require.NoError(t, err)
@@ -354,3 +373,1080 @@ func TestClaimProposal(t *testing.T) {
 	afterInt := app.BankKeeper.GetBalance(ctx, purchaser, bondDenom).Amount
 	require.True(t, beforeInt.Add(sdk.NewInt(loss)).Equal(afterInt))
 }
+
+// TestAllocation tests pool capacity and payouts based on
+// collateral allocations.
+func TestAllocation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(250e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// both providers deposit collateral
+	tstaking.Delegate(shieldAdmin, val1addr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+
+	// create two pools without shield
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "Shentu", "fake_description")
+	pool1ID, pool2ID := uint64(1), uint64(2)
+
+	// no shield can be purchased without allocations
+	tshield.PurchaseShield(purchaser, 1e9, pool1ID, false)
+
+	// allocations cannot exceed available collaterals
+	tshield.AllocateCollateral(del1addr, pool1ID, 101e9, false)
+	tshield.AllocateCollateral(del1addr, pool1ID, 100e9, true)
+	tshield.AllocateCollateral(del1addr, pool2ID, 100e9, true)
+	tshield.AllocateCollateral(shieldAdmin, pool2ID, 200e9, true)
+
+	pool1, _ := app.ShieldKeeper.GetPool(ctx, pool1ID)
+	require.True(t, pool1.Allocation.Equal(sdk.NewInt(100e9)))
+	require.Len(t, app.ShieldKeeper.GetProviderAllocations(ctx, del1addr), 2)
+
+	// pool capacity is limited by its allocations
+	poolParams := app.ShieldKeeper.GetPoolParams(ctx)
+	maxShield := sdk.NewInt(100e9).ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt()
+	tshield.PurchaseShield(purchaser, maxShield.AddRaw(1).Int64(), pool1ID, false)
+	tshield.PurchaseShield(purchaser, maxShield.Int64(), pool1ID, true)
+
+	// deallocation cannot leave the pool shield uncovered
+	tshield.DeallocateCollateral(del1addr, pool1ID, 100e9, false)
+	tshield.DeallocateCollateral(del1addr, pool2ID, 100e9, true)
+	require.Len(t, app.ShieldKeeper.GetProviderAllocations(ctx, del1addr), 1)
+
+	// allocations are indexed by provider, and deleted allocations leave the index
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.True(t, store.Has(types.GetProviderAllocationKey(del1addr, pool1ID)))
+	require.False(t, store.Has(types.GetProviderAllocationKey(del1addr, pool2ID)))
+	require.Equal(t, pool2ID, app.ShieldKeeper.GetProviderAllocations(ctx, shieldAdmin)[0].PoolId)
+
+	// payouts are made only by providers backing the pool
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	require.NoError(t, app.ShieldKeeper.CreateReimbursement(ctx, 1, pool1ID, lossCoins, purchaser))
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, del1addr)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(99e9)))
+	provider, _ = app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(200e9)))
+	pool1, _ = app.ShieldKeeper.GetPool(ctx, pool1ID)
+	require.True(t, pool1.Allocation.Equal(sdk.NewInt(99e9)))
+
+	// the upgrade leaves allocations of pools created with allocations and
+	// the unstake cooldown period set at genesis unchanged
+	app.ShieldKeeper.SetUnstakeCooldownPeriod(ctx, time.Hour)
+	upgradeCtx := ctx.WithBlockHeight(common.Update2Height)
+	shield.BeginBlock(upgradeCtx, abci.RequestBeginBlock{}, app.ShieldKeeper)
+	pool1, _ = app.ShieldKeeper.GetPool(ctx, pool1ID)
+	require.True(t, pool1.Allocation.Equal(sdk.NewInt(99e9)))
+	require.Len(t, app.ShieldKeeper.GetProviderAllocations(ctx, del1addr), 1)
+	require.Equal(t, time.Hour, app.ShieldKeeper.GetUnstakeCooldownPeriod(ctx))
+
+	// legacy pools, stored without allocations, get all collaterals allocated
+	pool2, _ := app.ShieldKeeper.GetPool(ctx, pool2ID)
+	pool2.Allocation = sdk.ZeroInt()
+	bz := bytes.Replace(app.AppCodec().MustMarshalBinaryBare(&pool2), []byte{0x42, 0x01, '0'}, nil, 1)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.GetPoolKey(pool2ID), append(encodeUvarint(uint64(len(bz))), bz...))
+	require.True(t, app.ShieldKeeper.HasLegacyPools(ctx))
+	shield.BeginBlock(upgradeCtx, abci.RequestBeginBlock{}, app.ShieldKeeper)
+	require.False(t, app.ShieldKeeper.HasLegacyPools(ctx))
+	pool2, _ = app.ShieldKeeper.GetPool(ctx, pool2ID)
+	require.True(t, pool2.Allocation.Equal(sdk.NewInt(299e9)))
+}
+
+func TestRewardIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(250e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(shieldAdmin, val1addr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+
+	// only del1 backs the first pool
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "Shentu", "fake_description")
+	pool1ID, pool2ID := uint64(1), uint64(2)
+	tshield.AllocateCollateral(del1addr, pool1ID, 100e9, true)
+	tshield.AllocateCollateral(shieldAdmin, pool2ID, 200e9, true)
+	tshield.PurchaseShield(purchaser, 1e9, pool1ID, true)
+
+	ctx = skipBlocks(ctx, 100, tstaking, tshield, tgov)
+	tshield.TurnBlock(ctx)
+
+	// rewards are accrued to indexes without touching providers
+	stored, _ := app.ShieldKeeper.GetProvider(ctx, del1addr)
+	require.True(t, stored.Rewards.Native.IsZero())
+	outstanding := app.ShieldKeeper.GetOutstandingRewards(ctx)
+	require.False(t, outstanding.Native.IsZero())
+
+	// settled rewards go to the provider backing the pool
+	settled, _ := app.ShieldKeeper.GetSettledProvider(ctx, del1addr)
+	require.False(t, settled.Rewards.Native.IsZero())
+	require.True(t, settled.Rewards.Native.AmountOf(bondDenom).LTE(outstanding.Native.AmountOf(bondDenom)))
+	adminSettled, _ := app.ShieldKeeper.GetSettledProvider(ctx, shieldAdmin)
+	require.True(t, adminSettled.Rewards.Native.IsZero())
+
+	// settling moves rewards out of outstanding rewards
+	app.ShieldKeeper.UpdateProviderRewards(ctx, del1addr)
+	stored, _ = app.ShieldKeeper.GetProvider(ctx, del1addr)
+	require.Equal(t, settled.Rewards, stored.Rewards)
+	remaining := app.ShieldKeeper.GetOutstandingRewards(ctx)
+	require.Equal(t, outstanding.Native.Sub(stored.Rewards.Native), remaining.Native)
+
+	// settling again without new fees is a no-op
+	app.ShieldKeeper.UpdateProviderRewards(ctx, del1addr)
+	again, _ := app.ShieldKeeper.GetProvider(ctx, del1addr)
+	require.Equal(t, stored.Rewards, again.Rewards)
+}
+
+// BenchmarkRemoveExpiredPurchasesAndDistributeFees shows that the
+// per-block cost of fee distribution does not grow with the number
+// of providers.
+func BenchmarkRemoveExpiredPurchasesAndDistributeFees(b *testing.B) {
+	for _, numProviders := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("providers=%d", numProviders), func(b *testing.B) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+			k := app.ShieldKeeper
+			bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+			collateral := sdk.NewInt(1e9)
+			pool := types.NewPool(1, "benchmark", "sponsor", sdk.AccAddress("sponsor"), sdk.NewInt(1e12), sdk.ZeroInt())
+			for i := 0; i < numProviders; i++ {
+				addr := sdk.AccAddress(fmt.Sprintf("provider%d", i))
+				provider := types.NewProvider(addr)
+				provider.Collateral = collateral
+				k.SetProvider(ctx, addr, provider)
+				k.SetAllocation(ctx, pool.Id, addr, types.NewAllocation(pool.Id, addr, collateral, pool.RewardIndex))
+				pool.Allocation = pool.Allocation.Add(collateral)
+			}
+			pool.ServiceFees = types.MixedDecCoins{Native: sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1e12))}
+			k.SetPool(ctx, pool)
+			k.SetTotalCollateral(ctx, pool.Allocation)
+			k.SetRemainingServiceFees(ctx, types.MixedDecCoins{Native: sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1e15))})
+			k.SetLastUpdateTime(ctx, ctx.BlockTime())
+			// Flush the setup so that iterators do not scan cached writes.
+			ctx.MultiStore().(sdk.CacheMultiStore).Write()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * time.Duration(int64(common.SecondsPerBlock))))
+				k.RemoveExpiredPurchasesAndDistributeFees(ctx)
+				k.SetLastUpdateTime(ctx, ctx.BlockTime())
+			}
+		})
+	}
+}
+
+func TestSlashProviders(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(1e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+	tshield.PurchaseShield(purchaser, 40e9, poolID, true)
+	tshield.WithdrawCollateral(del1addr, 20e9, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// slash 70% of the validator's tokens
+	validator, _ := app.StakingKeeper.GetValidator(ctx, val1addr)
+	app.StakingKeeper.Slash(ctx, sdk.ConsAddress(val1pk.Address()), ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(7, 1))
+
+	// collaterals are reduced to the remaining delegations, withdraws first
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, del1addr)
+	require.True(t, provider.DelegationBonded.Equal(sdk.NewInt(30e9)), provider.DelegationBonded)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(30e9)), provider.Collateral)
+	require.True(t, provider.Withdrawing.IsZero(), provider.Withdrawing)
+	require.Empty(t, app.ShieldKeeper.GetWithdrawsByProvider(ctx, del1addr.String()))
+	require.True(t, app.ShieldKeeper.GetTotalCollateral(ctx).Equal(sdk.NewInt(30e9)))
+	require.True(t, app.ShieldKeeper.GetTotalWithdrawing(ctx).IsZero())
+
+	// the pool can no longer cover its shield and is paused
+	allocation, _ := app.ShieldKeeper.GetAllocation(ctx, poolID, del1addr)
+	require.True(t, allocation.Amount.Equal(sdk.NewInt(30e9)))
+	pool, _ := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, pool.Allocation.Equal(sdk.NewInt(30e9)))
+	require.False(t, pool.Active)
+	tshield.PurchaseShield(purchaser, 1e9, poolID, false)
+}
+
+func TestSlashUnbondingProviders(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(2)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	del1addr := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[1], sdk.ValAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[1].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// the provider's only stake with the validator is unbonding
+	infractionHeight := ctx.BlockHeight()
+	tstaking.Undelegate(del1addr, val1addr, 100e9, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	_, found := app.StakingKeeper.GetDelegation(ctx, del1addr, val1addr)
+	require.False(t, found)
+
+	// slash 70% of the stake at the infraction height, including unbonding entries
+	validator, _ := app.StakingKeeper.GetValidator(ctx, val1addr)
+	app.StakingKeeper.Slash(ctx, sdk.ConsAddress(val1pk.Address()), infractionHeight, validator.GetConsensusPower(), sdk.NewDecWithPrec(7, 1))
+	require.True(t, app.ShieldKeeper.ComputeTotalUnbondingAmount(ctx, del1addr).Equal(sdk.NewInt(30e9)))
+
+	// collaterals are reduced to the remaining unbonding amount
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, del1addr)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(30e9)), provider.Collateral)
+	require.True(t, provider.Withdrawing.LTE(provider.Collateral), provider.Withdrawing)
+	require.True(t, app.ShieldKeeper.GetTotalCollateral(ctx).Equal(sdk.NewInt(30e9)))
+}
+
+func TestReimbursementVesting(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(1e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	beneficiary := sdk.AccAddress(pks[2].Address())
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+
+	// reimbursements of at least 5,000 CTK vest over 10 days
+	claimParams := app.ShieldKeeper.GetClaimProposalParams(ctx)
+	claimParams.VestingPeriod = time.Hour * 24 * 10
+	claimParams.VestingThreshold = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5e9))
+	app.ShieldKeeper.SetClaimProposalParams(ctx, claimParams)
+
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10e9))
+	require.NoError(t, app.ShieldKeeper.CreateReimbursement(ctx, 1, poolID, lossCoins, beneficiary))
+	smallLossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	require.NoError(t, app.ShieldKeeper.CreateReimbursement(ctx, 2, poolID, smallLossCoins, beneficiary))
+
+	// nothing can be withdrawn before the payout time
+	tshield.WithdrawReimbursement(beneficiary, 1, false)
+
+	// half is vested halfway through the vesting period
+	payoutTime := ctx.BlockTime().Add(claimParams.PayoutPeriod)
+	ctx = ctx.WithBlockTime(payoutTime.Add(claimParams.VestingPeriod / 2))
+	tshield.TurnBlock(ctx)
+	res, err := app.ShieldKeeper.ReimbursementVesting(sdk.WrapSDKContext(ctx), &types.QueryReimbursementVestingRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.True(t, res.Vested.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5e9))))
+	require.True(t, res.Unvested.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5e9))))
+
+	tshield.WithdrawReimbursement(beneficiary, 1, true)
+	require.True(t, app.BankKeeper.GetBalance(ctx, beneficiary, bondDenom).Amount.Equal(sdk.NewInt(5e9)))
+	tshield.WithdrawReimbursement(beneficiary, 1, false)
+
+	// small reimbursements are paid in full at the payout time
+	tshield.WithdrawReimbursement(beneficiary, 2, true)
+	require.True(t, app.BankKeeper.GetBalance(ctx, beneficiary, bondDenom).Amount.Equal(sdk.NewInt(6e9)))
+	_, err = app.ShieldKeeper.GetReimbursement(ctx, 2)
+	require.Error(t, err)
+
+	// the rest is withdrawn after the vesting period
+	ctx = ctx.WithBlockTime(payoutTime.Add(claimParams.VestingPeriod))
+	tshield.TurnBlock(ctx)
+	tshield.WithdrawReimbursement(beneficiary, 1, true)
+	require.True(t, app.BankKeeper.GetBalance(ctx, beneficiary, bondDenom).Amount.Equal(sdk.NewInt(11e9)))
+	_, err = app.ShieldKeeper.GetReimbursement(ctx, 1)
+	require.Error(t, err)
+}
+
+func TestPoolCreatorCertificate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(4)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	otherAddr := sdk.AccAddress(pks[2].Address())
+	certifier := sdk.AccAddress(pks[3].Address())
+	app.CertKeeper.SetCertifier(ctx, certtypes.NewCertifier(certifier, "", certifier, ""))
+
+	limit := app.ShieldKeeper.GetPoolParams(ctx).PoolCreatorShieldLimit
+	noFees := types.MixedCoins{}
+
+	// the sponsor cannot create pools without a certificate
+	_, err := app.ShieldKeeper.CreatePool(ctx, sponsorAddr, sdk.Coins{}, noFees, "CertiK", sponsorAddr, "fake_description", limit)
+	require.ErrorIs(t, err, types.ErrNotPoolManager)
+
+	cert, err := certtypes.NewGeneralCertificate("shieldpoolcreator", "address", sponsorAddr.String(), "", certifier)
+	require.NoError(t, err)
+	certID, err := app.CertKeeper.IssueCertificate(ctx, cert)
+	require.NoError(t, err)
+
+	// a certified creator may not create pools for other sponsors or above the limit
+	_, err = app.ShieldKeeper.CreatePool(ctx, sponsorAddr, sdk.Coins{}, noFees, "CertiK", otherAddr, "fake_description", limit)
+	require.ErrorIs(t, err, types.ErrNotPoolManager)
+	_, err = app.ShieldKeeper.CreatePool(ctx, sponsorAddr, sdk.Coins{}, noFees, "CertiK", sponsorAddr, "fake_description", limit.AddRaw(1))
+	require.ErrorIs(t, err, types.ErrPoolCreatorLimitExceeded)
+
+	poolID, err := app.ShieldKeeper.CreatePool(ctx, sponsorAddr, sdk.Coins{}, noFees, "CertiK", sponsorAddr, "fake_description", limit)
+	require.NoError(t, err)
+
+	_, err = app.ShieldKeeper.UpdatePool(ctx, poolID, "", sponsorAddr, sdk.Coins{}, noFees, limit.AddRaw(1))
+	require.ErrorIs(t, err, types.ErrPoolCreatorLimitExceeded)
+	_, err = app.ShieldKeeper.PausePool(ctx, otherAddr, poolID)
+	require.ErrorIs(t, err, types.ErrNotPoolManager)
+	_, err = app.ShieldKeeper.PausePool(ctx, sponsorAddr, poolID)
+	require.NoError(t, err)
+	_, err = app.ShieldKeeper.ResumePool(ctx, sponsorAddr, poolID)
+	require.NoError(t, err)
+
+	// the admin is not bound by the pool creator limits
+	_, err = app.ShieldKeeper.UpdatePool(ctx, poolID, "", shieldAdmin, sdk.Coins{}, noFees, limit.AddRaw(1))
+	require.NoError(t, err)
+
+	// revoking the certificate pauses the sponsor's pools
+	certificate, err := app.CertKeeper.GetCertificateByID(ctx, certID)
+	require.NoError(t, err)
+	require.NoError(t, app.CertKeeper.RevokeCertificate(ctx, certificate, certifier))
+	pool, found := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+	require.False(t, pool.Active)
+
+	_, err = app.ShieldKeeper.ResumePool(ctx, sponsorAddr, poolID)
+	require.ErrorIs(t, err, types.ErrNotPoolManager)
+	_, err = app.ShieldKeeper.ResumePool(ctx, shieldAdmin, poolID)
+	require.NoError(t, err)
+}
+
+func TestEpochSnapshots(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	pks := simapp.CreateTestPubKeys(2)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+
+	poolID, err := app.ShieldKeeper.CreatePool(ctx, shieldAdmin, sdk.Coins{}, types.MixedCoins{}, "CertiK", sponsorAddr, "fake_description", sdk.NewInt(1e9))
+	require.NoError(t, err)
+	pool, _ := app.ShieldKeeper.GetPool(ctx, poolID)
+	pool.Shield = sdk.NewInt(50)
+	pool.Allocation = sdk.NewInt(200)
+	app.ShieldKeeper.SetPool(ctx, pool)
+
+	for epoch := uint64(1); epoch <= types.MaxEpochSnapshots+2; epoch++ {
+		app.ShieldKeeper.SnapshotEpoch(ctx, epoch)
+	}
+
+	// the two oldest snapshots are pruned
+	snapshots := app.ShieldKeeper.GetEpochSnapshots(ctx, 0, 0)
+	require.Len(t, snapshots, types.MaxEpochSnapshots)
+	require.Equal(t, uint64(3), snapshots[0].Epoch)
+	require.Equal(t, uint64(types.MaxEpochSnapshots+2), snapshots[len(snapshots)-1].Epoch)
+
+	snapshots = app.ShieldKeeper.GetEpochSnapshots(ctx, 10, 12)
+	require.Len(t, snapshots, 3)
+	require.Equal(t, uint64(10), snapshots[0].Epoch)
+	require.Len(t, snapshots[0].Pools, 1)
+	require.Equal(t, poolID, snapshots[0].Pools[0].PoolId)
+	require.True(t, snapshots[0].Pools[0].Utilization.Equal(sdk.NewDecWithPrec(25, 2)))
+
+	res, err := app.ShieldKeeper.EpochSnapshots(sdk.WrapSDKContext(ctx), &types.QueryEpochSnapshotsRequest{StartEpoch: types.MaxEpochSnapshots})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 3)
+	_, err = app.ShieldKeeper.EpochSnapshots(sdk.WrapSDKContext(ctx), &types.QueryEpochSnapshotsRequest{StartEpoch: 5, EndEpoch: 4})
+	require.Error(t, err)
+}
+
+func TestProviderYieldAndAvailableShield(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(4)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1e9))
+
+	del1addr := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[3], sdk.ValAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[3].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+
+	// max shield is half of the allocated collaterals
+	wctx := sdk.WrapSDKContext(ctx)
+	available, err := app.ShieldKeeper.AvailableShield(wctx, &types.QueryAvailableShieldRequest{PoolId: poolID})
+	require.NoError(t, err)
+	require.True(t, available.AvailableShield.AmountOf(bondDenom).Equal(sdk.NewInt(50e9)))
+
+	_, err = app.ShieldKeeper.ProviderYield(wctx, &types.QueryProviderYieldRequest{Address: del1addr.String()})
+	require.ErrorIs(t, err, types.ErrNoEpochSnapshot)
+	app.ShieldKeeper.SnapshotEpoch(ctx, 1)
+
+	tshield.PurchaseShield(sponsorAddr, 10e9, poolID, true)
+	ctx = skipBlocks(ctx, int64(common.BlocksPerDay), tstaking, tshield, tgov)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	wctx = sdk.WrapSDKContext(ctx)
+
+	available, err = app.ShieldKeeper.AvailableShield(wctx, &types.QueryAvailableShieldRequest{PoolId: poolID})
+	require.NoError(t, err)
+	require.True(t, available.AvailableShield.AmountOf(bondDenom).Equal(sdk.NewInt(40e9)))
+	_, err = app.ShieldKeeper.PurchaseShield(ctx, poolID, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(40e9+1))), "", sponsorAddr, false)
+	require.ErrorIs(t, err, types.ErrPoolShieldExceedsLimit)
+
+	utilization, err := app.ShieldKeeper.PoolUtilization(wctx, &types.QueryPoolUtilizationRequest{PoolId: poolID})
+	require.NoError(t, err)
+	require.True(t, utilization.MaxShield.Equal(sdk.NewInt(50e9)))
+	require.True(t, utilization.Utilization.Equal(sdk.NewDecWithPrec(2, 1)))
+
+	// the provider has earned service fees since the snapshot
+	yield, err := app.ShieldKeeper.ProviderYield(wctx, &types.QueryProviderYieldRequest{Address: del1addr.String()})
+	require.NoError(t, err)
+	require.True(t, yield.WindowRewards.AmountOf(bondDenom).IsPositive())
+	require.True(t, yield.AnnualizedYield.IsPositive())
+	require.True(t, yield.Collateral.Equal(sdk.NewInt(100e9)))
+}
+
+func TestAutoCompound(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(4)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1e9))
+
+	del1addr := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[3], sdk.ValAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[3].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	require.ErrorIs(t, app.ShieldKeeper.SetAutoCompound(ctx, del1addr, true), types.ErrProviderNotFound)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "Other", "fake_description")
+	tshield.AllocateCollateral(del1addr, poolID+1, 50e9, true)
+	tshield.PurchaseShield(sponsorAddr, 10e9, poolID, true)
+	ctx = skipBlocks(ctx, int64(common.BlocksPerDay), tstaking, tshield, tgov)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// providers without auto-compounding keep their rewards
+	app.ShieldKeeper.CompoundProviderRewards(ctx)
+	provider, found := app.ShieldKeeper.GetSettledProvider(ctx, del1addr)
+	require.True(t, found)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(100e9)))
+	rewards, _ := provider.Rewards.Native.TruncateDecimal()
+	require.True(t, rewards.AmountOf(bondDenom).IsPositive())
+
+	// rewards are delegated and deposited as collateral
+	require.NoError(t, app.ShieldKeeper.SetAutoCompound(ctx, del1addr, true))
+	balance := app.BankKeeper.GetBalance(ctx, del1addr, bondDenom)
+	app.ShieldKeeper.CompoundProviderRewards(ctx)
+	provider, _ = app.ShieldKeeper.GetSettledProvider(ctx, del1addr)
+	compounded := provider.Collateral.Sub(sdk.NewInt(100e9))
+	require.True(t, compounded.IsPositive())
+	require.True(t, compounded.LTE(rewards.AmountOf(bondDenom)))
+	require.True(t, provider.Collateral.LTE(provider.DelegationBonded))
+	require.True(t, app.ShieldKeeper.GetTotalCollateral(ctx).Equal(provider.Collateral))
+	require.True(t, app.BankKeeper.GetBalance(ctx, del1addr, bondDenom).IsEqual(balance))
+
+	// the compounded collateral is allocated in proportion to the existing allocations
+	allocation, found := app.ShieldKeeper.GetAllocation(ctx, poolID, del1addr)
+	require.True(t, found)
+	require.True(t, allocation.Amount.Equal(provider.Collateral))
+	allocation, found = app.ShieldKeeper.GetAllocation(ctx, poolID+1, del1addr)
+	require.True(t, found)
+	require.True(t, allocation.Amount.Equal(sdk.NewInt(50e9).Add(compounded.QuoRaw(2))))
+	pool, found := app.ShieldKeeper.GetPool(ctx, poolID+1)
+	require.True(t, found)
+	require.True(t, pool.Allocation.Equal(allocation.Amount))
+}
+
+func TestShieldRoles(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	pks := simapp.CreateTestPubKeys(3)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+	admin := sdk.AccAddress(pks[0].Address())
+	pauser := sdk.AccAddress(pks[1].Address())
+	operator := sdk.AccAddress(pks[2].Address())
+
+	// the legacy shield admin is migrated to all roles
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.GetShieldAdminKey(), admin)
+	app.ShieldKeeper.MigrateShieldAdmin(ctx)
+	require.Nil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.GetShieldAdminKey()))
+	require.True(t, app.ShieldKeeper.IsPoolOperator(ctx, admin))
+	require.True(t, app.ShieldKeeper.IsPauser(ctx, admin))
+	require.True(t, app.ShieldKeeper.IsClaimManager(ctx, admin))
+
+	limit := app.ShieldKeeper.GetPoolParams(ctx).PoolCreatorShieldLimit
+	poolID, err := app.ShieldKeeper.CreatePool(ctx, admin, sdk.Coins{}, types.MixedCoins{}, "CertiK", admin, "fake_description", limit)
+	require.NoError(t, err)
+
+	// roles are replaced through governance
+	roles := types.NewShieldRoles([]string{operator.String()}, []string{pauser.String()}, []string{admin.String()})
+	proposal := types.NewShieldAdminUpdateProposal("title", "description", roles, admin)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, shield.NewShieldClaimProposalHandler(app.ShieldKeeper)(ctx, proposal))
+	require.False(t, app.ShieldKeeper.IsPoolOperator(ctx, admin))
+
+	// pausers can only pause pools
+	_, err = app.ShieldKeeper.PausePool(ctx, pauser, poolID)
+	require.NoError(t, err)
+	_, err = app.ShieldKeeper.ResumePool(ctx, pauser, poolID)
+	require.ErrorIs(t, err, types.ErrNotPoolManager)
+	_, err = app.ShieldKeeper.UpdateSponsor(ctx, poolID, "CertiK", pauser, pauser)
+	require.ErrorIs(t, err, types.ErrNotShieldAdmin)
+
+	// pool operators manage all pools
+	_, err = app.ShieldKeeper.ResumePool(ctx, admin, poolID)
+	require.ErrorIs(t, err, types.ErrNotPoolManager)
+	_, err = app.ShieldKeeper.ResumePool(ctx, operator, poolID)
+	require.NoError(t, err)
+	_, err = app.ShieldKeeper.UpdateSponsor(ctx, poolID, "CertiK", operator, operator)
+	require.NoError(t, err)
+
+	// duplicate role holders are rejected
+	roles = types.NewShieldRoles([]string{operator.String(), operator.String()}, nil, nil)
+	proposal = types.NewShieldAdminUpdateProposal("title", "description", roles, admin)
+	require.ErrorIs(t, proposal.ValidateBasic(), types.ErrInvalidShieldRoles)
+}
+
+func TestCoverageTerms(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(1e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+
+	// deductible of max(1,000 CTK, 10% of the loss), payouts capped at 20,000 CTK
+	terms := types.NewCoverageTerms(sdk.NewInt(1e9), sdk.NewDecWithPrec(1, 1), sdk.NewInt(20e9), time.Hour)
+	tshield.Handle(types.NewMsgSetCoverageTerms(purchaser, poolID, terms), false)
+	tshield.Handle(types.NewMsgSetCoverageTerms(shieldAdmin, poolID, terms), true)
+
+	tshield.PurchaseShield(purchaser, 50e9, poolID, true)
+	purchaseList, found := app.ShieldKeeper.GetPurchaseList(ctx, poolID, purchaser)
+	require.True(t, found)
+	purchase := purchaseList.Entries[0]
+
+	// claims are not allowed during the waiting period
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5e9))
+	err := app.ShieldKeeper.CheckCoverageTerms(ctx, poolID, purchase, lossCoins)
+	require.ErrorIs(t, err, types.ErrClaimInWaitingPeriod)
+	tgov.ShieldClaimProposal(purchaser, 5e9, poolID, purchase.PurchaseId, false)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	tshield.TurnBlock(ctx)
+	tgov.TurnBlock(ctx)
+	require.NoError(t, app.ShieldKeeper.CheckCoverageTerms(ctx, poolID, purchase, lossCoins))
+	smallLossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	err = app.ShieldKeeper.CheckCoverageTerms(ctx, poolID, purchase, smallLossCoins)
+	require.ErrorIs(t, err, types.ErrLossWithinDeductible)
+	tgov.ShieldClaimProposal(purchaser, 1e9, poolID, purchase.PurchaseId, false)
+
+	// claims are rejected without enough initial deposit, a purchase covering the loss, or an unexpired purchase
+	rejectClaim := func(loss int64, purchaseID uint64, deposit int64) {
+		content := types.NewShieldClaimProposal(poolID, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, loss)), purchaseID,
+			"test_claim_evidence", "test_claim_description", purchaser)
+		msg, err := govtypes.NewMsgSubmitProposal(content, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, deposit)), purchaser)
+		require.NoError(t, err)
+		tgov.Handle(msg, false)
+	}
+	rejectClaim(5e9, purchase.PurchaseId, 100e6)
+	rejectClaim(5e9, purchase.PurchaseId+1, 5e9)
+	rejectClaim(51e9, purchase.PurchaseId, 6e9)
+	tgov.TurnBlock(ctx.WithBlockTime(purchase.ProtectionEndTime.Add(time.Second)))
+	tgov.ShieldClaimProposal(purchaser, 5e9, poolID, purchase.PurchaseId, false)
+	tgov.TurnBlock(ctx)
+	_, found = app.GovKeeper.GetProposal(ctx, 1)
+	require.False(t, found)
+
+	// payouts are reduced by the deductible and capped by the maximum claim
+	require.True(t, app.ShieldKeeper.GetClaimPayout(ctx, poolID, lossCoins).AmountOf(bondDenom).Equal(sdk.NewInt(4e9)))
+	largeLossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30e9))
+	require.True(t, app.ShieldKeeper.GetClaimPayout(ctx, poolID, largeLossCoins).AmountOf(bondDenom).Equal(sdk.NewInt(20e9)))
+
+	// only the payout is reimbursed and the secured collaterals are released
+	claimDuration := app.ShieldKeeper.GetClaimProposalParams(ctx).ClaimPeriod
+	require.NoError(t, app.ShieldKeeper.SecureCollaterals(ctx, poolID, purchaser, purchase.PurchaseId, lossCoins, claimDuration))
+	require.True(t, app.ShieldKeeper.GetTotalClaimed(ctx).Equal(sdk.NewInt(5e9)))
+	proposal := types.NewShieldClaimProposal(poolID, lossCoins, purchase.PurchaseId, "test_claim_evidence", "test_claim_description", purchaser)
+	proposal.ProposalId = 1
+	tshield.HandleProposal(proposal, true)
+	reimbursement, err := app.ShieldKeeper.GetReimbursement(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, reimbursement.Amount.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 4e9))))
+	require.True(t, app.ShieldKeeper.GetTotalClaimed(ctx).Equal(sdk.ZeroInt()))
+}
+
+func TestClaimRecords(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(1e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+	tshield.PurchaseShield(purchaser, 50e9, poolID, true)
+	purchaseList, found := app.ShieldKeeper.GetPurchaseList(ctx, poolID, purchaser)
+	require.True(t, found)
+	purchaseID := purchaseList.Entries[0].PurchaseId
+
+	// two claims are secured and opened
+	claimDuration := app.ShieldKeeper.GetClaimProposalParams(ctx).ClaimPeriod
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	for proposalID := uint64(1); proposalID <= 2; proposalID++ {
+		require.NoError(t, app.ShieldKeeper.SecureCollaterals(ctx, poolID, purchaser, purchaseID, lossCoins, claimDuration))
+		app.ShieldKeeper.OpenClaim(ctx, proposalID, poolID, purchaseID, purchaser, lossCoins)
+	}
+	res, err := app.ShieldKeeper.Claims(sdk.WrapSDKContext(ctx), &types.QueryClaimsRequest{PoolId: poolID})
+	require.NoError(t, err)
+	require.Len(t, res.Claims, 2)
+	res, err = app.ShieldKeeper.Claims(sdk.WrapSDKContext(ctx), &types.QueryClaimsRequest{Purchaser: purchaser.String(), PoolId: poolID + 1})
+	require.NoError(t, err)
+	require.Len(t, res.Claims, 0)
+
+	// the first claim is approved and paid
+	proposal := types.NewShieldClaimProposal(poolID, lossCoins, purchaseID, "test_claim_evidence", "test_claim_description", purchaser)
+	proposal.ProposalId = 1
+	tshield.HandleProposal(proposal, true)
+	claim, found := app.ShieldKeeper.GetClaim(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.ClaimStatusApproved, claim.Status)
+	require.True(t, claim.Payout.IsEqual(lossCoins))
+	require.True(t, claim.Released.IsZero())
+
+	// the second claim is rejected
+	require.NoError(t, app.ShieldKeeper.RestoreShield(ctx, 2, poolID, purchaser, purchaseID, lossCoins))
+	app.ShieldKeeper.ClaimEnd(ctx, 2, poolID, lossCoins)
+	app.ShieldKeeper.UpdateClaimStatus(ctx, 2, types.ClaimStatusRejected)
+	claimRes, err := app.ShieldKeeper.Claim(sdk.WrapSDKContext(ctx), &types.QueryClaimRequest{ProposalId: 2})
+	require.NoError(t, err)
+	require.Equal(t, types.ClaimStatusRejected, claimRes.Claim.Status)
+	require.True(t, claimRes.Claim.Secured.IsEqual(lossCoins))
+	require.True(t, claimRes.Claim.Restored.IsEqual(lossCoins))
+	require.True(t, claimRes.Claim.Released.IsEqual(lossCoins))
+	require.True(t, claimRes.Claim.Payout.IsZero())
+	require.True(t, app.ShieldKeeper.GetTotalClaimed(ctx).IsZero())
+
+	// the first claim is paid after the payout time
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.ShieldKeeper.GetClaimProposalParams(ctx).PayoutPeriod))
+	tshield.TurnBlock(ctx)
+	tshield.WithdrawReimbursement(purchaser, 1, true)
+	claim, _ = app.ShieldKeeper.GetClaim(ctx, 1)
+	require.Equal(t, types.ClaimStatusPaid, claim.Status)
+	require.Equal(t, ctx.BlockTime(), claim.UpdateTime)
+
+	_, err = app.ShieldKeeper.Claim(sdk.WrapSDKContext(ctx), &types.QueryClaimRequest{ProposalId: 3})
+	require.Error(t, err)
+}
+
+func TestStakingRewardsAndUnstaking(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(10e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+
+	// the purchaser stakes for shield
+	shield := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	_, err := app.ShieldKeeper.PurchaseShield(ctx, poolID, shield, "staking", purchaser, true)
+	require.NoError(t, err)
+	staked, found := app.ShieldKeeper.GetStakeForShield(ctx, poolID, purchaser)
+	require.True(t, found)
+	stakedAmt := staked.Amount
+	require.True(t, stakedAmt.IsPositive())
+
+	// block rewards go to providers alone by default
+	blockRewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	require.NoError(t, app.ShieldKeeper.FundShieldBlockRewards(ctx, blockRewards, shieldAdmin))
+	rewardIndex := app.ShieldKeeper.GetRewardIndex(ctx).Native.AmountOf(bondDenom)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	providerShare := blockRewards.AmountOf(bondDenom).ToDec().QuoTruncate(app.ShieldKeeper.GetTotalCollateral(ctx).ToDec())
+	require.True(t, app.ShieldKeeper.GetRewardIndex(ctx).Native.AmountOf(bondDenom).Sub(rewardIndex).Equal(providerShare))
+	require.True(t, app.ShieldKeeper.GetStakingRewardIndex(ctx).Native.IsZero())
+
+	// the staking reward rate of block rewards goes to the staking pool
+	rate := sdk.NewDecWithPrec(25, 2)
+	app.ShieldKeeper.SetStakingRewardRate(ctx, rate)
+	require.NoError(t, app.ShieldKeeper.FundShieldBlockRewards(ctx, blockRewards, shieldAdmin))
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	expected := blockRewards.AmountOf(bondDenom).ToDec().Mul(rate)
+
+	res, err := app.ShieldKeeper.StakingRewards(sdk.WrapSDKContext(ctx), &types.QueryStakingRewardsRequest{Purchaser: purchaser.String()})
+	require.NoError(t, err)
+	require.Len(t, res.ShieldStakings, 1)
+	rewards := res.Total.Native.AmountOf(bondDenom)
+	require.True(t, rewards.IsPositive())
+	require.True(t, rewards.LTE(expected))
+	require.True(t, expected.Sub(rewards).LT(sdk.OneDec()))
+
+	// querying does not settle rewards
+	staked, _ = app.ShieldKeeper.GetStakeForShield(ctx, poolID, purchaser)
+	require.True(t, staked.Rewards.Native.IsZero())
+
+	// the whole stake is unstaked after the purchase expires and the cooldown ends
+	require.NoError(t, app.ShieldKeeper.UnstakeFromShield(ctx, poolID, purchaser, stakedAmt))
+	balance := app.BankKeeper.GetBalance(ctx, purchaser, bondDenom).Amount
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.ShieldKeeper.GetPoolParams(ctx).ProtectionPeriod))
+	tshield.TurnBlock(ctx)
+	_, found = app.ShieldKeeper.GetStakeForShield(ctx, poolID, purchaser)
+	require.False(t, found)
+	unstakings, err := app.ShieldKeeper.Unstakings(sdk.WrapSDKContext(ctx), &types.QueryUnstakingsRequest{Purchaser: purchaser.String()})
+	require.NoError(t, err)
+	require.Len(t, unstakings.Unstakings, 1)
+	require.True(t, unstakings.Unstakings[0].Amount.Equal(stakedAmt))
+	cooldown := app.ShieldKeeper.GetUnstakeCooldownPeriod(ctx)
+	require.Equal(t, ctx.BlockTime().Add(cooldown), unstakings.Unstakings[0].CompletionTime)
+
+	// rewards are paid at expiration
+	balance = balance.Add(rewards.TruncateInt())
+	require.True(t, app.BankKeeper.GetBalance(ctx, purchaser, bondDenom).Amount.Equal(balance))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cooldown))
+	tshield.TurnBlock(ctx)
+	require.Empty(t, app.ShieldKeeper.GetAllUnstakings(ctx))
+	require.True(t, app.BankKeeper.GetBalance(ctx, purchaser, bondDenom).Amount.Equal(balance.Add(stakedAmt)))
+
+	msg, broken := keeper.ModuleAccountInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestCoveredAssets(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	pks := simapp.CreateTestPubKeys(5)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.ZeroInt())
+
+	shieldAdmin := sdk.AccAddress(pks[0].Address())
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewInt(1e9))
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+
+	sponsorAddr := sdk.AccAddress(pks[1].Address())
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, sdk.NewInt(1))
+
+	purchaser := sdk.AccAddress(pks[2].Address())
+	simapp.AddCoinsToAcc(app, ctx, purchaser, sdk.NewInt(10e9))
+
+	del1addr := sdk.AccAddress(pks[3].Address())
+	simapp.AddCoinsToAcc(app, ctx, del1addr, sdk.NewInt(100e9))
+
+	val1pk, val1addr := pks[4], sdk.ValAddress(pks[4].Address())
+	simapp.AddCoinsToAcc(app, ctx, sdk.AccAddress(pks[4].Address()), sdk.NewInt(100e6))
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	tstaking.CreateValidatorWithValPower(val1addr, val1pk, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(del1addr, val1addr, 100e9)
+	tshield.DepositCollateral(del1addr, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 0, 0, 500e9, "CertiK", "fake_description")
+	poolID := uint64(1)
+	tshield.AllocateCollateral(del1addr, poolID, 100e9, true)
+
+	contract := sdk.AccAddress(pks[4].Address()).String()
+	external := "ethereum:0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+	// only pool managers can set the covered assets, which must be unique
+	tshield.Handle(types.NewMsgSetCoveredAssets(purchaser, poolID, []string{contract}), false)
+	tshield.Handle(types.NewMsgSetCoveredAssets(shieldAdmin, poolID, []string{contract, contract}), false)
+	tshield.Handle(types.NewMsgSetCoveredAssets(shieldAdmin, poolID, []string{contract, external}), true)
+	pool, found := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+	require.Equal(t, []string{contract, external}, pool.CoveredAssets)
+
+	// assets are not covered until the pool has shield
+	poolIDs, shield := app.ShieldKeeper.GetAssetCoverage(ctx, contract)
+	require.Empty(t, poolIDs)
+	require.True(t, shield.IsZero())
+
+	tshield.PurchaseShield(purchaser, 50e9, poolID, true)
+	for _, asset := range []string{contract, external} {
+		poolIDs, shield = app.ShieldKeeper.GetAssetCoverage(ctx, asset)
+		require.Equal(t, []uint64{poolID}, poolIDs)
+		require.True(t, shield.Equal(sdk.NewInt(50e9)))
+	}
+	poolIDs, shield = app.ShieldKeeper.GetAssetCoverage(ctx, purchaser.String())
+	require.Empty(t, poolIDs)
+	require.True(t, shield.IsZero())
+
+	// clearing the covered assets ends their coverage
+	tshield.Handle(types.NewMsgSetCoveredAssets(shieldAdmin, poolID, nil), true)
+	poolIDs, _ = app.ShieldKeeper.GetAssetCoverage(ctx, contract)
+	require.Empty(t, poolIDs)
+}
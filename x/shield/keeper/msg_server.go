@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -140,6 +141,67 @@ func (k msgServer) ResumePool(goCtx context.Context, msg *types.MsgResumePool) (
 	return &types.MsgResumePoolResponse{}, nil
 }
 
+func (k msgServer) SetCoverageTerms(goCtx context.Context, msg *types.MsgSetCoverageTerms) (*types.MsgSetCoverageTermsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.Keeper.SetCoverageTerms(ctx, fromAddr, msg.PoolId, msg.Terms)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetCoverageTerms,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDeductibleAmount, msg.Terms.DeductibleAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyDeductibleRate, msg.Terms.DeductibleRate.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxClaim, msg.Terms.MaxClaim.String()),
+			sdk.NewAttribute(types.AttributeKeyWaitingPeriod, msg.Terms.WaitingPeriod.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	})
+
+	return &types.MsgSetCoverageTermsResponse{}, nil
+}
+
+func (k msgServer) SetCoveredAssets(goCtx context.Context, msg *types.MsgSetCoveredAssets) (*types.MsgSetCoveredAssetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.Keeper.SetCoveredAssets(ctx, fromAddr, msg.PoolId, msg.Assets)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetCoveredAssets,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCoveredAssets, strings.Join(msg.Assets, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	})
+
+	return &types.MsgSetCoveredAssetsResponse{}, nil
+}
+
 func (k msgServer) DepositCollateral(goCtx context.Context, msg *types.MsgDepositCollateral) (*types.MsgDepositCollateralResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -201,6 +263,68 @@ func (k msgServer) WithdrawCollateral(goCtx context.Context, msg *types.MsgWithd
 	return &types.MsgWithdrawCollateralResponse{}, nil
 }
 
+func (k msgServer) AllocateCollateral(goCtx context.Context, msg *types.MsgAllocateCollateral) (*types.MsgAllocateCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.Keeper.BondDenom(ctx)
+	for _, coin := range msg.Collateral {
+		if coin.Denom != bondDenom {
+			return nil, types.ErrCollateralBadDenom
+		}
+	}
+	amount := msg.Collateral.AmountOf(bondDenom)
+	if err := k.Keeper.AllocateCollateral(ctx, fromAddr, msg.PoolId, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAllocateCollateral,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCollateral, amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	})
+
+	return &types.MsgAllocateCollateralResponse{}, nil
+}
+
+func (k msgServer) DeallocateCollateral(goCtx context.Context, msg *types.MsgDeallocateCollateral) (*types.MsgDeallocateCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.Keeper.BondDenom(ctx)
+	for _, coin := range msg.Collateral {
+		if coin.Denom != bondDenom {
+			return nil, types.ErrCollateralBadDenom
+		}
+	}
+	amount := msg.Collateral.AmountOf(bondDenom)
+	if err := k.Keeper.DeallocateCollateral(ctx, fromAddr, msg.PoolId, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgDeallocateCollateral,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCollateral, amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	})
+
+	return &types.MsgDeallocateCollateralResponse{}, nil
+}
+
 func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -230,6 +354,33 @@ func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdraw
 	return &types.MsgWithdrawRewardsResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetAutoCompound(ctx, fromAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, msg.From),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	})
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 func (k msgServer) UpdateSponsor(goCtx context.Context, msg *types.MsgUpdateSponsor) (*types.MsgUpdateSponsorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
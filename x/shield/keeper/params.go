@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/x/shield/types"
@@ -40,3 +42,36 @@ func (k Keeper) GetShieldStakingRate(ctx sdk.Context) (rate sdk.Dec) {
 func (k Keeper) SetShieldStakingRate(ctx sdk.Context, rate sdk.Dec) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyStakingShieldRate, &rate)
 }
+
+// GetUnstakeCooldownPeriod returns the cooldown period of unstaking
+// from staking purchases. It is zero until the parameter is set.
+func (k Keeper) GetUnstakeCooldownPeriod(ctx sdk.Context) (period time.Duration) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyUnstakeCooldown, &period)
+	return
+}
+
+// HasUnstakeCooldownPeriod returns whether the cooldown period of
+// unstaking from staking purchases is set.
+func (k Keeper) HasUnstakeCooldownPeriod(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.ParamStoreKeyUnstakeCooldown)
+}
+
+// SetUnstakeCooldownPeriod sets the cooldown period of unstaking
+// from staking purchases.
+func (k Keeper) SetUnstakeCooldownPeriod(ctx sdk.Context, period time.Duration) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyUnstakeCooldown, &period)
+}
+
+// GetStakingRewardRate returns the share of block service fees distributed
+// to staking purchases. It is zero until the parameter is set.
+func (k Keeper) GetStakingRewardRate(ctx sdk.Context) sdk.Dec {
+	rate := sdk.ZeroDec()
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyStakingRewardRate, &rate)
+	return rate
+}
+
+// SetStakingRewardRate sets the share of block service fees distributed
+// to staking purchases.
+func (k Keeper) SetStakingRewardRate(ctx sdk.Context, rate sdk.Dec) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyStakingRewardRate, &rate)
+}
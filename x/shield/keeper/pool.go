@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/certikfoundation/shentu/x/shield/types"
 )
@@ -159,9 +162,14 @@ func (k Keeper) GetPool(ctx sdk.Context, id uint64) (types.Pool, bool) {
 
 // CreatePool creates a pool and sponsor's shield.
 func (k Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, shield sdk.Coins, serviceFees types.MixedCoins, sponsor string, sponsorAddr sdk.AccAddress, description string, shieldLimit sdk.Int) (uint64, error) {
-	admin := k.GetAdmin(ctx)
-	if !creator.Equals(admin) {
-		return 0, types.ErrNotShieldAdmin
+	isAdmin, err := k.authorizePoolManager(ctx, creator, sponsorAddr.String())
+	if err != nil {
+		return 0, err
+	}
+	if !isAdmin {
+		if err := k.checkPoolCreatorLimits(ctx, shieldLimit, shield.AmountOf(k.BondDenom(ctx)), shield, serviceFees.Native); err != nil {
+			return 0, err
+		}
 	}
 	if _, found := k.GetPoolsBySponsor(ctx, sponsor); found {
 		return 0, types.ErrSponsorAlreadyExists
@@ -173,26 +181,112 @@ func (k Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, shield sdk.C
 	k.SetPool(ctx, pool)
 	k.SetNextPoolID(ctx, poolID+1)
 
-	// Purchase shield for the pool.
-	if _, err := k.purchaseShield(ctx, poolID, shield, "shield for sponsor", creator, serviceFees.Native, sdk.NewCoins()); err != nil {
-		return poolID, err
+	// Purchase shield for the pool. Shield can also be purchased after
+	// providers allocate collaterals to the pool.
+	if !shield.IsZero() {
+		if _, err := k.purchaseShield(ctx, poolID, shield, "shield for sponsor", creator, serviceFees.Native, sdk.NewCoins()); err != nil {
+			return poolID, err
+		}
+	} else if !serviceFees.Native.IsZero() {
+		if err := k.addPoolServiceFees(ctx, poolID, creator, serviceFees.Native); err != nil {
+			return poolID, err
+		}
 	}
 
 	return poolID, nil
 }
 
-// UpdatePool updates pool info and shield for B.
-func (k Keeper) UpdatePool(ctx sdk.Context, poolID uint64, description string, updater sdk.AccAddress, shield sdk.Coins, serviceFees types.MixedCoins, shieldLimit sdk.Int) (types.Pool, error) {
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
+// GetFreeCollateral returns the amount of collaterals that are not
+// withdrawing, claimed or backing purchased shields.
+func (k Keeper) GetFreeCollateral(ctx sdk.Context) sdk.Int {
+	free := k.GetTotalCollateral(ctx).Sub(k.GetTotalWithdrawing(ctx)).Sub(k.GetTotalClaimed(ctx)).Sub(k.GetTotalShield(ctx))
+	if free.IsNegative() {
+		return sdk.ZeroInt()
 	}
+	return free
+}
 
-	// Update pool info.
+// GetPoolMaxShield returns the maximum shield of a pool, which is the
+// lesser of its shield limit and the pool shield limit portion of its
+// available collaterals.
+func (k Keeper) GetPoolMaxShield(ctx sdk.Context, pool types.Pool) sdk.Int {
+	poolShieldLimit := k.GetPoolParams(ctx).PoolShieldLimit
+	return sdk.MinInt(pool.ShieldLimit, k.GetPoolAvailableCollateral(ctx, pool.Id).ToDec().Mul(poolShieldLimit).TruncateInt())
+}
+
+// GetAvailableShield returns the maximum amount of shield that can be
+// purchased from a pool at the moment.
+func (k Keeper) GetAvailableShield(ctx sdk.Context, pool types.Pool) sdk.Int {
+	if !pool.Active {
+		return sdk.ZeroInt()
+	}
+	available := sdk.MinInt(k.GetPoolMaxShield(ctx, pool).Sub(pool.Shield), k.GetFreeCollateral(ctx))
+	if available.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return available
+}
+
+// IsCertifiedPoolCreator returns true if the address holds a
+// ShieldPoolCreator certificate.
+func (k Keeper) IsCertifiedPoolCreator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.ck.IsCertified(ctx, "address", addr.String(), "shieldpoolcreator")
+}
+
+// authorizePoolManager checks whether the manager is a pool operator
+// or a certified pool creator managing pools of its own sponsor
+// address. It returns true if the manager is a pool operator, whose
+// operations are not subject to the pool creator limits.
+func (k Keeper) authorizePoolManager(ctx sdk.Context, manager sdk.AccAddress, sponsorAddr string) (bool, error) {
+	if k.IsPoolOperator(ctx, manager) {
+		return true, nil
+	}
+	if manager.String() == sponsorAddr && k.IsCertifiedPoolCreator(ctx, manager) {
+		return false, nil
+	}
+	return false, types.ErrNotPoolManager
+}
+
+// checkPoolCreatorLimits checks the shield limit and shield of a pool
+// managed by a certified pool creator, as well as the service fees
+// paid for the shield purchased by the pool creator.
+func (k Keeper) checkPoolCreatorLimits(ctx sdk.Context, shieldLimit, totalShield sdk.Int, shield, serviceFees sdk.Coins) error {
+	poolParams := k.GetPoolParams(ctx)
+	if shieldLimit.GT(poolParams.PoolCreatorShieldLimit) || totalShield.GT(poolParams.PoolCreatorShieldLimit) {
+		return sdkerrors.Wrapf(types.ErrPoolCreatorLimitExceeded, "shield limit %s, shield %s, maximum %s",
+			shieldLimit, totalShield, poolParams.PoolCreatorShieldLimit)
+	}
+	bondDenom := k.BondDenom(ctx)
+	minServiceFees := shield.AmountOf(bondDenom).ToDec().Mul(poolParams.PoolCreatorMinFeesRate)
+	if serviceFees.AmountOf(bondDenom).ToDec().LT(minServiceFees) {
+		return sdkerrors.Wrapf(types.ErrPoolCreatorLimitExceeded, "service fees %s, minimum %s%s",
+			serviceFees, minServiceFees.Ceil().TruncateInt(), bondDenom)
+	}
+	return nil
+}
+
+// UpdatePool updates pool info and shield for B.
+func (k Keeper) UpdatePool(ctx sdk.Context, poolID uint64, description string, updater sdk.AccAddress, shield sdk.Coins, serviceFees types.MixedCoins, shieldLimit sdk.Int) (types.Pool, error) {
 	pool, found := k.GetPool(ctx, poolID)
 	if !found {
 		return types.Pool{}, types.ErrNoPoolFound
 	}
+	isAdmin, err := k.authorizePoolManager(ctx, updater, pool.SponsorAddr)
+	if err != nil {
+		return types.Pool{}, err
+	}
+	if !isAdmin {
+		newShieldLimit := pool.ShieldLimit
+		if !shieldLimit.IsZero() {
+			newShieldLimit = shieldLimit
+		}
+		newShield := pool.Shield.Add(shield.AmountOf(k.BondDenom(ctx)))
+		if err := k.checkPoolCreatorLimits(ctx, newShieldLimit, newShield, shield, serviceFees.Native); err != nil {
+			return types.Pool{}, err
+		}
+	}
+
+	// Update pool info.
 	if description != "" {
 		pool.Description = description
 	}
@@ -208,27 +302,48 @@ func (k Keeper) UpdatePool(ctx sdk.Context, poolID uint64, description string, u
 		}
 	} else if !serviceFees.Native.IsZero() {
 		// Allow adding service fees without purchasing more shield.
-		totalServiceFees := k.GetServiceFees(ctx)
-		totalServiceFees = totalServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees.Native...)})
-		k.SetServiceFees(ctx, totalServiceFees)
-		totalRemainingServiceFees := k.GetRemainingServiceFees(ctx)
-		totalRemainingServiceFees = totalRemainingServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees.Native...)})
-		k.SetRemainingServiceFees(ctx, totalRemainingServiceFees)
+		if err := k.addPoolServiceFees(ctx, poolID, updater, serviceFees.Native); err != nil {
+			return pool, err
+		}
 	}
 
 	return pool, nil
 }
 
-// PausePool sets an active pool to be inactive.
-func (k Keeper) PausePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (types.Pool, error) {
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
+// addPoolServiceFees adds service fees to a pool without purchasing shield.
+func (k Keeper) addPoolServiceFees(ctx sdk.Context, poolID uint64, from sdk.AccAddress, serviceFees sdk.Coins) error {
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, serviceFees); err != nil {
+		return err
 	}
+	fees := types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)}
+	totalServiceFees := k.GetServiceFees(ctx)
+	totalServiceFees = totalServiceFees.Add(fees)
+	k.SetServiceFees(ctx, totalServiceFees)
+	totalRemainingServiceFees := k.GetRemainingServiceFees(ctx)
+	totalRemainingServiceFees = totalRemainingServiceFees.Add(fees)
+	k.SetRemainingServiceFees(ctx, totalRemainingServiceFees)
+
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrNoPoolFound
+	}
+	pool.ServiceFees = pool.ServiceFees.Add(fees)
+	k.SetPool(ctx, pool)
+	return nil
+}
+
+// PausePool sets an active pool to be inactive. Pausers can pause
+// any pool in addition to its pool managers.
+func (k Keeper) PausePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (types.Pool, error) {
 	pool, found := k.GetPool(ctx, id)
 	if !found {
 		return types.Pool{}, types.ErrNoPoolFound
 	}
+	if !k.IsPauser(ctx, updater) {
+		if _, err := k.authorizePoolManager(ctx, updater, pool.SponsorAddr); err != nil {
+			return types.Pool{}, err
+		}
+	}
 	if !pool.Active {
 		return types.Pool{}, types.ErrPoolAlreadyPaused
 	}
@@ -239,14 +354,13 @@ func (k Keeper) PausePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (t
 
 // ResumePool sets an inactive pool to be active.
 func (k Keeper) ResumePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (types.Pool, error) {
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
-	}
 	pool, found := k.GetPool(ctx, id)
 	if !found {
 		return types.Pool{}, types.ErrNoPoolFound
 	}
+	if _, err := k.authorizePoolManager(ctx, updater, pool.SponsorAddr); err != nil {
+		return types.Pool{}, err
+	}
 	if pool.Active {
 		return types.Pool{}, types.ErrPoolAlreadyActive
 	}
@@ -255,6 +369,30 @@ func (k Keeper) ResumePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (
 	return pool, nil
 }
 
+// PauseSponsorPools pauses active pools of a sponsor address which
+// is no longer a certified pool creator.
+func (k Keeper) PauseSponsorPools(ctx sdk.Context, sponsorAddr sdk.AccAddress) {
+	if k.IsCertifiedPoolCreator(ctx, sponsorAddr) {
+		return
+	}
+	pools, _ := k.GetPoolsBySponsor(ctx, sponsorAddr.String())
+	for _, pool := range pools {
+		if !pool.Active {
+			continue
+		}
+		pool.Active = false
+		k.SetPool(ctx, pool)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePausePool,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySponsorAddress, pool.SponsorAddr),
+			),
+		)
+	}
+}
+
 // GetAllPools retrieves all pools in the store.
 func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
 	k.IterateAllPools(ctx, func(pool types.Pool) bool {
@@ -266,18 +404,25 @@ func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
 
 // ClosePool closes the pool.
 func (k Keeper) ClosePool(ctx sdk.Context, pool types.Pool) {
+	for _, allocation := range k.GetPoolAllocations(ctx, pool.Id) {
+		providerAddr, err := sdk.AccAddressFromBech32(allocation.Provider)
+		if err != nil {
+			panic(err)
+		}
+		k.UpdateProviderRewards(ctx, providerAddr)
+		k.DeleteAllocation(ctx, pool.Id, providerAddr)
+	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPoolKey(pool.Id))
 }
 
 // ClosePools closes pools when both of the pool's shield and shield limit is non-positive.
 func (k Keeper) ClosePools(ctx sdk.Context) {
-	k.IterateAllPools(ctx, func(pool types.Pool) bool {
+	for _, pool := range k.GetAllPools(ctx) {
 		if !pool.Shield.IsPositive() && !pool.ShieldLimit.IsPositive() {
 			k.ClosePool(ctx, pool)
 		}
-		return false
-	})
+	}
 }
 
 // IterateAllPools iterates over the all the stored pools and performs a callback function.
@@ -298,9 +443,8 @@ func (k Keeper) IterateAllPools(ctx sdk.Context, callback func(pool types.Pool)
 
 // UpdateSponsor updates the sponsor information of a given pool.
 func (k Keeper) UpdateSponsor(ctx sdk.Context, poolID uint64, newSponsor string, newSponsorAddr, updater sdk.AccAddress) (types.Pool, error) {
-	// Check admin status of the updater.
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
+	// Check pool operator status of the updater.
+	if !k.IsPoolOperator(ctx, updater) {
 		return types.Pool{}, types.ErrNotShieldAdmin
 	}
 
@@ -29,6 +29,9 @@ func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.
 	if lossAmt.GT(pool.Shield) {
 		return types.ErrNotEnoughShield
 	}
+	if lossAmt.GT(pool.Allocation) {
+		return types.ErrNotEnoughCollateral
+	}
 
 	// Verify collateral availability.
 	totalCollateral := k.GetTotalCollateral(ctx)
@@ -54,20 +57,27 @@ func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.
 		return types.ErrNotEnoughShield
 	}
 
-	// Secure the updated loss ratio from each provider to cover total claimed.
-	providers := k.GetAllProviders(ctx)
-	claimedRatio := totalSecureAmt.ToDec().Quo(totalCollateral.ToDec())
-	remaining := totalSecureAmt
-	for i := range providers {
-		secureAmt := sdk.MinInt(providers[i].Collateral.ToDec().Mul(claimedRatio).TruncateInt(), remaining)
+	// Secure the loss ratio from each provider backing the pool.
+	lossRatio := lossAmt.ToDec().Quo(pool.Allocation.ToDec())
+	remaining := lossAmt
+	for _, allocation := range k.GetPoolAllocations(ctx, poolID) {
+		secureAmt := sdk.MinInt(allocation.Amount.ToDec().Mul(lossRatio).TruncateInt(), remaining)
 
 		// Require each provider to secure one more unit, if possible,
 		// so that the last provider does not have to cover combined
 		// truncated amounts.
-		if secureAmt.LT(remaining) && secureAmt.LT(providers[i].Collateral) {
+		if secureAmt.LT(remaining) && secureAmt.LT(allocation.Amount) {
 			secureAmt = secureAmt.Add(sdk.OneInt())
 		}
-		k.SecureFromProvider(ctx, providers[i], secureAmt, duration)
+		providerAddr, err := sdk.AccAddressFromBech32(allocation.Provider)
+		if err != nil {
+			panic(err)
+		}
+		provider, found := k.GetProvider(ctx, providerAddr)
+		if !found {
+			panic("provider not found but its collaterals are allocated")
+		}
+		k.SecureFromProvider(ctx, provider, secureAmt, duration)
 		remaining = remaining.Sub(secureAmt)
 	}
 
@@ -139,11 +149,15 @@ func (k Keeper) ClaimEnd(ctx sdk.Context, id, poolID uint64, loss sdk.Coins) {
 	lossAmt := loss.AmountOf(k.sk.BondDenom(ctx))
 	totalClaimed := k.GetTotalClaimed(ctx).Sub(lossAmt)
 	k.SetTotalClaimed(ctx, totalClaimed)
+
+	k.updateClaimAmounts(ctx, id, func(claim *types.Claim) {
+		claim.Released = claim.Released.Add(loss...)
+	})
 }
 
 // RestoreShield restores shield-related states as they were prior to
 // the claim proposal submission.
-func (k Keeper) RestoreShield(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error {
+func (k Keeper) RestoreShield(ctx sdk.Context, proposalID, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error {
 	lossAmt := loss.AmountOf(k.sk.BondDenom(ctx))
 
 	// Update the total shield.
@@ -171,6 +185,9 @@ func (k Keeper) RestoreShield(ctx sdk.Context, poolID uint64, purchaser sdk.AccA
 	}
 	k.SetPurchaseList(ctx, purchaseList)
 
+	k.updateClaimAmounts(ctx, proposalID, func(claim *types.Claim) {
+		claim.Restored = claim.Restored.Add(loss...)
+	})
 	return nil
 }
 
@@ -245,39 +262,47 @@ func (k Keeper) GetAllProposalIDReimbursementPairs(ctx sdk.Context) []types.Prop
 	return pRPairs
 }
 
-// CreateReimbursement creates a reimbursement.
-func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID uint64, amount sdk.Coins, beneficiary sdk.AccAddress) error {
+// CreateReimbursement creates a reimbursement paid out by providers
+// backing the pool in proportion to their allocations.
+func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID, poolID uint64, amount sdk.Coins, beneficiary sdk.AccAddress) error {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrNoPoolFound
+	}
 	bondDenom := k.BondDenom(ctx)
 	totalCollateral := k.GetTotalCollateral(ctx)
-	totalPurchased := k.GetTotalShield(ctx)
+	totalPurchased := pool.Shield
 	totalPayout := amount.AmountOf(bondDenom)
-	purchaseRatio := totalPurchased.ToDec().Quo(totalCollateral.ToDec())
-	payoutRatio := totalPayout.ToDec().Quo(totalCollateral.ToDec())
-	for _, provider := range k.GetAllProviders(ctx) {
+	if totalPayout.GT(pool.Allocation) {
+		return types.ErrNotEnoughCollateral
+	}
+	purchaseRatio := totalPurchased.ToDec().Quo(pool.Allocation.ToDec())
+	payoutRatio := totalPayout.ToDec().Quo(pool.Allocation.ToDec())
+	for _, allocation := range k.GetPoolAllocations(ctx, poolID) {
 		if !totalPayout.IsPositive() {
 			break
 		}
 
-		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		providerAddr, err := sdk.AccAddressFromBech32(allocation.Provider)
 		if err != nil {
 			panic(err)
 		}
 
-		purchased := provider.Collateral.ToDec().Mul(purchaseRatio).TruncateInt()
+		purchased := allocation.Amount.ToDec().Mul(purchaseRatio).TruncateInt()
 		if purchased.GT(totalPurchased) {
 			purchased = totalPurchased
 		}
-		payout := provider.Collateral.ToDec().Mul(payoutRatio).TruncateInt()
+		payout := allocation.Amount.ToDec().Mul(payoutRatio).TruncateInt()
 		if payout.GT(totalPayout) {
 			payout = totalPayout
 		}
 
 		// Require providers to cover (purchased + 1) and (payout + 1) if it's possible,
 		// so that the last provider will not be asked to cover all truncated amount.
-		if purchased.LT(totalPurchased) && provider.Collateral.GT(payout.Add(purchased)) {
+		if purchased.LT(totalPurchased) && allocation.Amount.GT(payout.Add(purchased)) {
 			purchased = purchased.Add(sdk.OneInt())
 		}
-		if payout.LT(totalPayout) && provider.Collateral.GT(payout.Add(purchased)) {
+		if payout.LT(totalPayout) && allocation.Amount.GT(payout.Add(purchased)) {
 			payout = payout.Add(sdk.OneInt())
 		}
 
@@ -289,13 +314,25 @@ func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID uint64, amount s
 			panic(err)
 		}
 
+		// Collaterals used for the payout are no longer allocated.
+		k.reduceAllocation(ctx, poolID, providerAddr, payout)
+		k.capAllocations(ctx, providerAddr)
+
 		totalPurchased = totalPurchased.Sub(purchased)
 		totalPayout = totalPayout.Sub(payout)
 	}
 	if totalPayout.IsPositive() {
 		panic("not enough payout made")
 	}
-	reimbursement := types.NewReimbursement(amount, beneficiary, ctx.BlockTime().Add(k.GetClaimProposalParams(ctx).PayoutPeriod))
+	// Reimbursements reaching the vesting threshold are released
+	// linearly over the vesting period after the payout time.
+	claimParams := k.GetClaimProposalParams(ctx)
+	payoutTime := ctx.BlockTime().Add(claimParams.PayoutPeriod)
+	vestingEndTime := payoutTime
+	if claimParams.VestingPeriod > 0 && amount.IsAllGTE(claimParams.VestingThreshold) {
+		vestingEndTime = payoutTime.Add(claimParams.VestingPeriod)
+	}
+	reimbursement := types.NewReimbursement(amount, beneficiary, payoutTime, vestingEndTime)
 	k.SetReimbursement(ctx, proposalID, reimbursement)
 
 	totalCollateral = totalCollateral.Sub(amount.AmountOf(bondDenom))
@@ -304,11 +341,15 @@ func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID uint64, amount s
 	k.SetTotalCollateral(ctx, totalCollateral)
 	k.SetTotalClaimed(ctx, totalClaimed)
 
+	k.updateClaimAmounts(ctx, proposalID, func(claim *types.Claim) {
+		claim.Payout = amount
+	})
 	return nil
 }
 
 // UpdateProviderCollateralForPayout updates a provider's collateral and withdraws according to the payout.
 func (k Keeper) UpdateProviderCollateralForPayout(ctx sdk.Context, providerAddr sdk.AccAddress, purchased, payout sdk.Int) error {
+	k.UpdateProviderRewards(ctx, providerAddr)
 	provider, found := k.GetProvider(ctx, providerAddr)
 	if !found {
 		return types.ErrProviderNotFound
@@ -659,7 +700,9 @@ func (k Keeper) GetSortedUnbondingDelegations(ctx sdk.Context, delAddr sdk.AccAd
 	return unbondingDelegations
 }
 
-// WithdrawReimbursement withdraws a reimbursement made for a beneficiary.
+// WithdrawReimbursement withdraws the vested amount of a reimbursement
+// made for a beneficiary. The reimbursement is deleted once it is fully
+// withdrawn.
 func (k Keeper) WithdrawReimbursement(ctx sdk.Context, proposalID uint64, beneficiary sdk.AccAddress) (sdk.Coins, error) {
 	reimbursement, err := k.GetReimbursement(ctx, proposalID)
 	if err != nil {
@@ -674,11 +717,22 @@ func (k Keeper) WithdrawReimbursement(ctx sdk.Context, proposalID uint64, benefi
 		return sdk.Coins{}, types.ErrNotPayoutTime
 	}
 
-	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, reimbursement.Amount); err != nil {
+	withdrawable := reimbursement.WithdrawableAmount(ctx.BlockTime())
+	if withdrawable.IsZero() {
+		return sdk.Coins{}, types.ErrNoVestedReimbursement
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, withdrawable); err != nil {
 		return sdk.Coins{}, types.ErrNotPayoutTime
 	}
-	if err := k.DeleteReimbursement(ctx, proposalID); err != nil {
-		return sdk.Coins{}, err
+	reimbursement.Withdrawn = reimbursement.Withdrawn.Add(withdrawable...)
+	if reimbursement.Withdrawn.IsAllGTE(reimbursement.Amount) {
+		if err := k.DeleteReimbursement(ctx, proposalID); err != nil {
+			return sdk.Coins{}, err
+		}
+		k.UpdateClaimStatus(ctx, proposalID, types.ClaimStatusPaid)
+	} else {
+		k.SetReimbursement(ctx, proposalID, reimbursement)
 	}
-	return reimbursement.Amount, nil
+	return withdrawable, nil
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/x/shield/types"
@@ -41,6 +43,7 @@ func (k Keeper) addProvider(ctx sdk.Context, addr sdk.AccAddress) types.Provider
 
 	provider := types.NewProvider(addr)
 	provider.DelegationBonded = totalStaked
+	provider.RewardIndex = k.GetRewardIndex(ctx)
 	k.SetProvider(ctx, addr, provider)
 	return provider
 }
@@ -107,6 +110,115 @@ func (k Keeper) updateProviderForDelegationChanges(ctx sdk.Context, delAddr sdk.
 	}
 }
 
+// SlashProviders updates providers delegating or unbonding from a
+// validator that is about to be slashed by the given fraction. Unbonding
+// delegations are already slashed at this point. Collaterals no longer
+// backed by delegations are removed, and pools whose allocations
+// cannot cover their shield are paused.
+func (k Keeper) SlashProviders(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	// Only the delegators of the validator can be providers affected by the slash.
+	var delegators []sdk.AccAddress
+	seen := make(map[string]bool)
+	addDelegator := func(delegator string) {
+		if seen[delegator] {
+			return
+		}
+		seen[delegator] = true
+		delAddr, err := sdk.AccAddressFromBech32(delegator)
+		if err != nil {
+			panic(err)
+		}
+		delegators = append(delegators, delAddr)
+	}
+	for _, delegation := range k.sk.GetValidatorDelegations(ctx, valAddr) {
+		addDelegator(delegation.DelegatorAddress)
+	}
+	for _, ubd := range k.sk.GetUnbondingDelegationsFromValidator(ctx, valAddr) {
+		addDelegator(ubd.DelegatorAddress)
+	}
+
+	affectedPools := make(map[uint64]bool)
+	for _, providerAddr := range delegators {
+		if _, found := k.GetProvider(ctx, providerAddr); !found {
+			continue
+		}
+
+		// Recompute the amount of its total delegations after the slash.
+		totalStakedAmount := sdk.ZeroInt()
+		for _, del := range k.sk.GetAllDelegatorDelegations(ctx, providerAddr) {
+			val, found := k.sk.GetValidator(ctx, del.GetValidatorAddr())
+			if !found {
+				panic("expected validator, not found")
+			}
+			tokens := val.TokensFromShares(del.GetShares())
+			if val.GetOperator().Equals(valAddr) {
+				tokens = tokens.Mul(sdk.OneDec().Sub(fraction))
+			}
+			totalStakedAmount = totalStakedAmount.Add(tokens.TruncateInt())
+		}
+
+		allocations := k.GetProviderAllocations(ctx, providerAddr)
+		if k.slashProvider(ctx, providerAddr, totalStakedAmount) {
+			for _, allocation := range allocations {
+				affectedPools[allocation.PoolId] = true
+			}
+		}
+		k.updateProviderForDelegationChanges(ctx, providerAddr, totalStakedAmount)
+	}
+
+	for _, pool := range k.GetAllPools(ctx) {
+		if !affectedPools[pool.Id] || !pool.Active || pool.Allocation.GTE(pool.Shield) {
+			continue
+		}
+		pool.Active = false
+		k.SetPool(ctx, pool)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePausePool,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+}
+
+// slashProvider reduces a provider's collaterals exceeding its bonded
+// and unbonding delegations, taking from withdrawing collaterals
+// first. It returns true if the collaterals are reduced.
+func (k Keeper) slashProvider(ctx sdk.Context, providerAddr sdk.AccAddress, stakedAmt sdk.Int) bool {
+	k.UpdateProviderRewards(ctx, providerAddr)
+	provider, found := k.GetProvider(ctx, providerAddr)
+	if !found {
+		return false
+	}
+
+	shortfall := provider.Collateral.Sub(stakedAmt.Add(k.ComputeTotalUnbondingAmount(ctx, providerAddr)))
+	if !shortfall.IsPositive() {
+		return false
+	}
+	shortfall = sdk.MinInt(shortfall, provider.Collateral)
+
+	fromWithdrawing := sdk.MinInt(shortfall, provider.Withdrawing)
+	k.reduceWithdraws(ctx, provider.Address, fromWithdrawing)
+	provider.Withdrawing = provider.Withdrawing.Sub(fromWithdrawing)
+	provider.Collateral = provider.Collateral.Sub(shortfall)
+	k.SetProvider(ctx, providerAddr, provider)
+	k.capAllocations(ctx, providerAddr)
+
+	k.SetTotalWithdrawing(ctx, k.GetTotalWithdrawing(ctx).Sub(fromWithdrawing))
+	k.SetTotalCollateral(ctx, k.GetTotalCollateral(ctx).Sub(shortfall))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashCollateral,
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, provider.Address),
+			sdk.NewAttribute(types.AttributeKeyCollateral, shortfall.String()),
+		),
+	)
+	return true
+}
+
 // IterateProviders iterates through all providers.
 func (k Keeper) IterateProviders(ctx sdk.Context, callback func(provider types.Provider) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -107,19 +107,15 @@ func (k Keeper) purchaseShield(ctx sdk.Context, poolID uint64, shield sdk.Coins,
 	// Check available collaterals.
 	bondDenom := k.sk.BondDenom(ctx)
 	shieldAmt := shield.AmountOf(bondDenom)
-	totalCollateral := k.GetTotalCollateral(ctx)
-	totalWithdrawing := k.GetTotalWithdrawing(ctx)
 	totalShield := k.GetTotalShield(ctx)
-	totalClaimed := k.GetTotalClaimed(ctx)
-	if totalShield.Add(shieldAmt).GT(totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed)) {
+	if shieldAmt.GT(k.GetFreeCollateral(ctx)) {
 		return types.Purchase{}, types.ErrNotEnoughCollateral
 	}
 
-	// Check pool shield limit.
+	// Check pool shield limit based on collaterals allocated to the pool.
 	poolParams := k.GetPoolParams(ctx)
 	protectionEndTime := ctx.BlockTime().Add(poolParams.ProtectionPeriod)
-	maxShield := sdk.MinInt(pool.ShieldLimit, totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt())
-	if shieldAmt.Add(pool.Shield).GT(maxShield) {
+	if shieldAmt.Add(pool.Shield).GT(k.GetPoolMaxShield(ctx, pool)) {
 		return types.Purchase{}, types.ErrPoolShieldExceedsLimit
 	}
 
@@ -137,6 +133,7 @@ func (k Keeper) purchaseShield(ctx sdk.Context, poolID uint64, shield sdk.Coins,
 		totalRemainingServiceFees := k.GetRemainingServiceFees(ctx)
 		totalRemainingServiceFees = totalRemainingServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)})
 		k.SetRemainingServiceFees(ctx, totalRemainingServiceFees)
+		pool.ServiceFees = pool.ServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)})
 	} else {
 		if err := k.AddStaking(ctx, poolID, purchaser, purchaseID, stakingCoins.AmountOf(bondDenom)); err != nil {
 			return types.Purchase{}, err
@@ -150,7 +147,7 @@ func (k Keeper) purchaseShield(ctx sdk.Context, poolID uint64, shield sdk.Coins,
 	k.SetPool(ctx, pool)
 
 	// Set a new purchase.
-	purchase := types.NewPurchase(purchaseID, protectionEndTime, protectionEndTime, description, shieldAmt, types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)})
+	purchase := types.NewPurchase(purchaseID, ctx.BlockTime(), protectionEndTime, protectionEndTime, description, shieldAmt, types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)})
 	purchaseList := k.AddPurchase(ctx, poolID, purchaser, purchase)
 	k.InsertExpiringPurchaseQueue(ctx, purchaseList, protectionEndTime)
 
@@ -191,7 +188,8 @@ func (k Keeper) RemoveExpiredPurchasesAndDistributeFees(ctx sdk.Context) {
 
 	totalServiceFees := k.GetServiceFees(ctx)
 	totalShield := k.GetTotalShield(ctx)
-	serviceFees := types.InitMixedDecCoins()
+	poolServiceFees := make(map[uint64]types.MixedDecCoins)
+	expiredPoolFees := make(map[uint64]types.MixedDecCoins)
 	bondDenom := k.BondDenom(ctx)
 	var stakeForShieldUpdateList []pPPTriplet
 
@@ -222,14 +220,19 @@ func (k Keeper) RemoveExpiredPurchasesAndDistributeFees(ctx sdk.Context) {
 				// Otherwise services fees were updated in the last block.
 				if entry.ProtectionEndTime.After(lastUpdateTime) && entry.ServiceFees.Native.IsAllPositive() {
 					// Add purchaseServiceFees * (purchaseProtectionEndTime - previousBlockTime) / protectionPeriod.
-					serviceFees = serviceFees.Add(entry.ServiceFees.MulDec(
+					poolServiceFees[poolPurchaser.PoolId] = poolServiceFees[poolPurchaser.PoolId].Add(entry.ServiceFees.MulDec(
 						sdk.NewDec(entry.ProtectionEndTime.Sub(lastUpdateTime).Nanoseconds()).Quo(
 							sdk.NewDec(k.GetPoolParams(ctx).ProtectionPeriod.Nanoseconds()))))
 					// Remove purchaseServiceFees from total service fees.
 					totalServiceFees = totalServiceFees.Sub(entry.ServiceFees)
+					expiredPoolFees[poolPurchaser.PoolId] = expiredPoolFees[poolPurchaser.PoolId].Add(entry.ServiceFees)
 					// Set purchaseServiceFees to zero because it can be reached again.
 					purchaseList.Entries[i].ServiceFees = types.InitMixedDecCoins()
+				}
 
+				// Staking purchases pay no service fees, so they are
+				// processed once their protection ends regardless of fees.
+				if entry.ProtectionEndTime.After(lastUpdateTime) {
 					originalStaking := k.GetOriginalStaking(ctx, entry.PurchaseId)
 					if !originalStaking.IsZero() {
 						// keep track of the list to be updated to avoid overwriting the purchase list
@@ -275,44 +278,76 @@ func (k Keeper) RemoveExpiredPurchasesAndDistributeFees(ctx sdk.Context) {
 			ppp.purchaser)
 	}
 
-	// Add service fees for this block from unexpired purchases.
-	// totalServiceFees * (currentBlockTime - previousBlockTime) / protectionPeriodTime
-	serviceFees = serviceFees.Add(totalServiceFees.MulDec(
-		sdk.NewDec(ctx.BlockTime().Sub(lastUpdateTime).Nanoseconds())).QuoDec(
-		sdk.NewDec(k.GetPoolParams(ctx).ProtectionPeriod.Nanoseconds())))
-
-	// Limit service fees by remaining service fees.
+	// Distribute service fees of each pool to the pool's reward index,
+	// from which providers backing the pool settle their rewards lazily.
 	remainingServiceFees := k.GetRemainingServiceFees(ctx)
-	if remainingServiceFees.Native.AmountOf(bondDenom).LT(serviceFees.Native.AmountOf(bondDenom)) {
-		serviceFees.Native = remainingServiceFees.Native
-	}
-
-	// Add block service fees that need to be distributed for this block
-	blockServiceFees := k.GetBlockServiceFees(ctx)
-	serviceFees = serviceFees.Add(blockServiceFees)
-	k.DeleteBlockServiceFees(ctx)
+	outstandingRewards := k.GetOutstandingRewards(ctx)
+	protectionPeriod := sdk.NewDec(k.GetPoolParams(ctx).ProtectionPeriod.Nanoseconds())
+	for _, pool := range k.GetAllPools(ctx) {
+		// Remove service fees of purchases whose protection has ended.
+		expired, hasExpired := expiredPoolFees[pool.Id]
+		if hasExpired {
+			native, hasNeg := pool.ServiceFees.Native.SafeSub(expired.Native)
+			if hasNeg {
+				native = sdk.DecCoins{}
+			}
+			pool.ServiceFees.Native = native
+		}
 
-	// Distribute service fees.
-	totalCollateral := k.GetTotalCollateral(ctx)
-	providers := k.GetAllProviders(ctx)
-	for _, provider := range providers {
-		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
-		if err != nil {
-			panic(err)
+		// Add service fees for this block from unexpired purchases.
+		// poolServiceFees * (currentBlockTime - previousBlockTime) / protectionPeriodTime
+		serviceFees := poolServiceFees[pool.Id].Add(pool.ServiceFees.MulDec(
+			sdk.NewDec(ctx.BlockTime().Sub(lastUpdateTime).Nanoseconds())).QuoDec(protectionPeriod))
+		if serviceFees.Native.IsZero() || !pool.Allocation.IsPositive() {
+			if hasExpired {
+				k.SetPool(ctx, pool)
+			}
+			continue
 		}
 
-		// fees * providerCollateral / totalCollateral
-		nativeFees := serviceFees.Native.MulDec(sdk.NewDecFromInt(provider.Collateral).QuoInt(totalCollateral))
+		// Limit service fees by remaining service fees.
+		nativeFees := serviceFees.Native
 		if nativeFees.AmountOf(bondDenom).GT(remainingServiceFees.Native.AmountOf(bondDenom)) {
 			nativeFees = remainingServiceFees.Native
 		}
-		provider.Rewards = provider.Rewards.Add(types.MixedDecCoins{Native: nativeFees})
-		k.SetProvider(ctx, providerAddr, provider)
 
+		// poolIndex += fees / poolAllocation
+		pool.RewardIndex.Native = pool.RewardIndex.Native.Add(nativeFees.QuoDecTruncate(pool.Allocation.ToDec())...)
+		pool.FeesCollected.Native = pool.FeesCollected.Native.Add(nativeFees...)
+		k.SetPool(ctx, pool)
+
+		outstandingRewards.Native = outstandingRewards.Native.Add(nativeFees...)
 		remainingServiceFees.Native = remainingServiceFees.Native.Sub(nativeFees)
 	}
-	// add back block service fees
-	remainingServiceFees.Native = remainingServiceFees.Native.Add(blockServiceFees.Native...)
+
+	// Distribute block service fees to the global reward index, from
+	// which all providers settle their rewards lazily. While there are
+	// staking purchases, the staking reward rate of the fees goes to the
+	// staking reward index instead, from which they settle their rewards.
+	blockServiceFees := k.GetBlockServiceFees(ctx)
+	k.DeleteBlockServiceFees(ctx)
+	totalCollateral := k.GetTotalCollateral(ctx)
+	if !blockServiceFees.Native.IsZero() && totalCollateral.IsPositive() {
+		collateralFees := blockServiceFees.Native
+		if stakingPool := k.GetGlobalShieldStakingPool(ctx); stakingPool.IsPositive() {
+			stakingFees := blockServiceFees.Native.MulDecTruncate(k.GetStakingRewardRate(ctx))
+			collateralFees = collateralFees.Sub(stakingFees)
+
+			// stakingIndex += stakingFees / globalStakingPool
+			stakingRewardIndex := k.GetStakingRewardIndex(ctx)
+			stakingRewardIndex.Native = stakingRewardIndex.Native.Add(stakingFees.QuoDecTruncate(stakingPool.ToDec())...)
+			k.SetStakingRewardIndex(ctx, stakingRewardIndex)
+		}
+
+		// globalIndex += collateralFees / totalCollateral
+		rewardIndex := k.GetRewardIndex(ctx)
+		rewardIndex.Native = rewardIndex.Native.Add(collateralFees.QuoDecTruncate(totalCollateral.ToDec())...)
+		k.SetRewardIndex(ctx, rewardIndex)
+		outstandingRewards.Native = outstandingRewards.Native.Add(blockServiceFees.Native...)
+	} else {
+		remainingServiceFees.Native = remainingServiceFees.Native.Add(blockServiceFees.Native...)
+	}
+	k.SetOutstandingRewards(ctx, outstandingRewards)
 	k.SetRemainingServiceFees(ctx, remainingServiceFees)
 	k.SetLastUpdateTime(ctx, ctx.BlockTime())
 }
@@ -48,6 +48,16 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 			return queryReimbursement(ctx, path[1:], k, legacyQuerierCdc)
 		case types.QueryReimbursements:
 			return queryReimbursements(ctx, path[1:], k, legacyQuerierCdc)
+		case types.QueryClaim:
+			return queryClaim(ctx, path[1:], k, legacyQuerierCdc)
+		case types.QueryPoolClaims:
+			return queryPoolClaims(ctx, path[1:], k, legacyQuerierCdc)
+		case types.QueryPurchaserClaims:
+			return queryPurchaserClaims(ctx, path[1:], k, legacyQuerierCdc)
+		case types.QueryStakingRewards:
+			return queryStakingRewards(ctx, path[1:], k, legacyQuerierCdc)
+		case types.QueryUnstakings:
+			return queryUnstakings(ctx, path[1:], k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -195,7 +205,7 @@ func queryProvider(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *c
 	if err != nil {
 		return nil, err
 	}
-	provider, found := k.GetProvider(ctx, addr)
+	provider, found := k.GetSettledProvider(ctx, addr)
 	if !found {
 		return nil, types.ErrProviderNotFound
 	}
@@ -215,6 +225,13 @@ func queryProviders(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuer
 	}
 
 	providers := k.GetProvidersPaginated(ctx, uint(params.Page), uint(params.Limit))
+	for i, provider := range providers {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			return nil, err
+		}
+		providers[i], _ = k.GetSettledProvider(ctx, providerAddr)
+	}
 
 	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, providers)
 	if err != nil {
@@ -286,7 +303,7 @@ func queryStakeForShield(ctx sdk.Context, path []string, k Keeper, legacyQuerier
 	if err != nil {
 		return nil, err
 	}
-	purchaseList, found := k.GetStakeForShield(ctx, poolID, purchaser)
+	purchaseList, found := k.GetSettledStakeForShield(ctx, poolID, purchaser)
 	if !found {
 		return []byte{}, types.ErrPurchaseNotFound
 	}
@@ -345,3 +362,98 @@ func queryReimbursements(ctx sdk.Context, path []string, k Keeper, legacyQuerier
 	}
 	return res, nil
 }
+
+// queryClaim queries the claim of a claim proposal.
+func queryClaim(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	proposalID, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	claim, found := k.GetClaim(ctx, proposalID)
+	if !found {
+		return nil, types.ErrClaimNotFound
+	}
+
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, claim)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryPoolClaims queries all claims against a pool.
+func queryPoolClaims(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	poolID, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, k.GetPoolClaims(ctx, poolID))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryPurchaserClaims queries all claims submitted by a purchaser.
+func queryPurchaserClaims(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, k.GetPurchaserClaims(ctx, address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryStakingRewards queries the staked-for-shield amounts of a purchaser
+// and their accrued rewards.
+func queryStakingRewards(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, k.GetPurchaserStakeForShields(ctx, address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryUnstakings queries the ongoing unstakings of a purchaser.
+func queryUnstakings(ctx sdk.Context, path []string, k Keeper, legacyQuerierCdc *codec.LegacyAmino) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = codec.MarshalJSONIndent(legacyQuerierCdc, k.GetPurchaserUnstakings(ctx, address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
@@ -39,6 +39,7 @@ func (k Keeper) AddRewards(ctx sdk.Context, provider sdk.AccAddress, earnings ty
 
 // PayoutNativeRewards pays out pending CTK rewards.
 func (k Keeper) PayoutNativeRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	k.UpdateProviderRewards(ctx, addr)
 	rewards := k.GetRewards(ctx, addr)
 	ctkRewards, change := rewards.Native.TruncateDecimal()
 	if ctkRewards.IsZero() {
@@ -57,3 +58,129 @@ func (k Keeper) PayoutNativeRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.C
 	}
 	return ctkRewards, nil
 }
+
+// SetRewardIndex sets the cumulative block service fees distributed
+// per unit of collateral.
+func (k Keeper) SetRewardIndex(ctx sdk.Context, index types.MixedDecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&index)
+	store.Set(types.GetRewardIndexKey(), bz)
+}
+
+// GetRewardIndex returns the cumulative block service fees distributed
+// per unit of collateral.
+func (k Keeper) GetRewardIndex(ctx sdk.Context) types.MixedDecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardIndexKey())
+	if bz == nil {
+		return types.InitMixedDecCoins()
+	}
+	var index types.MixedDecCoins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &index)
+	return index
+}
+
+// SetOutstandingRewards sets rewards distributed to reward indexes
+// but not yet settled to providers.
+func (k Keeper) SetOutstandingRewards(ctx sdk.Context, rewards types.MixedDecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&rewards)
+	store.Set(types.GetOutstandingRewardsKey(), bz)
+}
+
+// GetOutstandingRewards returns rewards distributed to reward indexes
+// but not yet settled to providers.
+func (k Keeper) GetOutstandingRewards(ctx sdk.Context) types.MixedDecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutstandingRewardsKey())
+	if bz == nil {
+		return types.InitMixedDecCoins()
+	}
+	var rewards types.MixedDecCoins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rewards)
+	return rewards
+}
+
+// UpdateProviderRewards settles rewards a provider has accrued from
+// the global reward index and its pool allocations since the last
+// settlement. It must be called before the provider's collateral or
+// allocations change.
+func (k Keeper) UpdateProviderRewards(ctx sdk.Context, providerAddr sdk.AccAddress) {
+	provider, found := k.GetProvider(ctx, providerAddr)
+	if !found {
+		return
+	}
+
+	// block service fees: collateral * (globalIndex - providerIndex)
+	rewardIndex := k.GetRewardIndex(ctx)
+	rewards := rewardIndex.Native.Sub(provider.RewardIndex.Native).MulDecTruncate(provider.Collateral.ToDec())
+	provider.RewardIndex = rewardIndex
+
+	// pool service fees: allocation * (poolIndex - allocationIndex)
+	for _, allocation := range k.GetProviderAllocations(ctx, providerAddr) {
+		pool, found := k.GetPool(ctx, allocation.PoolId)
+		if !found {
+			panic("cannot find the pool for an allocation")
+		}
+		rewards = rewards.Add(pool.RewardIndex.Native.Sub(allocation.RewardIndex.Native).MulDecTruncate(allocation.Amount.ToDec())...)
+		allocation.RewardIndex = pool.RewardIndex
+		k.SetAllocation(ctx, allocation.PoolId, providerAddr, allocation)
+	}
+
+	// Truncated indexes never pay out more than distributed.
+	outstanding := k.GetOutstandingRewards(ctx)
+	rewards = rewards.Intersect(outstanding.Native)
+	outstanding.Native = outstanding.Native.Sub(rewards)
+	k.SetOutstandingRewards(ctx, outstanding)
+
+	provider.Rewards = provider.Rewards.Add(types.MixedDecCoins{Native: rewards})
+	k.SetProvider(ctx, providerAddr, provider)
+}
+
+// GetSettledProvider returns a provider with its accrued rewards
+// settled without writing to the store.
+func (k Keeper) GetSettledProvider(ctx sdk.Context, providerAddr sdk.AccAddress) (types.Provider, bool) {
+	cacheCtx, _ := ctx.CacheContext()
+	k.UpdateProviderRewards(cacheCtx, providerAddr)
+	return k.GetProvider(cacheCtx, providerAddr)
+}
+
+// MigrateRewardIndexes initializes reward indexes of pools, allocations
+// and providers, after which service fees are distributed lazily.
+func (k Keeper) MigrateRewardIndexes(ctx sdk.Context) {
+	// Settle accrued rewards and return truncation leftovers to
+	// remaining service fees before resetting indexes.
+	for _, provider := range k.GetAllProviders(ctx) {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.UpdateProviderRewards(ctx, providerAddr)
+	}
+	remainingServiceFees := k.GetRemainingServiceFees(ctx)
+	remainingServiceFees = remainingServiceFees.Add(k.GetOutstandingRewards(ctx))
+	k.SetRemainingServiceFees(ctx, remainingServiceFees)
+
+	k.SetRewardIndex(ctx, types.InitMixedDecCoins())
+	k.SetOutstandingRewards(ctx, types.InitMixedDecCoins())
+	for _, pool := range k.GetAllPools(ctx) {
+		pool.RewardIndex = types.InitMixedDecCoins()
+		k.SetPool(ctx, pool)
+	}
+	for _, allocation := range k.GetAllAllocations(ctx) {
+		providerAddr, err := sdk.AccAddressFromBech32(allocation.Provider)
+		if err != nil {
+			panic(err)
+		}
+		allocation.RewardIndex = types.InitMixedDecCoins()
+		k.SetAllocation(ctx, allocation.PoolId, providerAddr, allocation)
+	}
+	for _, provider := range k.GetAllProviders(ctx) {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			panic(err)
+		}
+		provider.RewardIndex = types.InitMixedDecCoins()
+		k.SetProvider(ctx, providerAddr, provider)
+	}
+}
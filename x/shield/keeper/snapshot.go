@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetEpochSnapshot sets the snapshot of an epoch.
+func (k Keeper) SetEpochSnapshot(ctx sdk.Context, snapshot types.EpochSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&snapshot)
+	store.Set(types.GetEpochSnapshotKey(snapshot.Epoch), bz)
+}
+
+// GetEpochSnapshot gets the snapshot of an epoch.
+func (k Keeper) GetEpochSnapshot(ctx sdk.Context, epoch uint64) (types.EpochSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochSnapshotKey(epoch))
+	if bz == nil {
+		return types.EpochSnapshot{}, false
+	}
+	var snapshot types.EpochSnapshot
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &snapshot)
+	return snapshot, true
+}
+
+// DeleteEpochSnapshot deletes the snapshot of an epoch.
+func (k Keeper) DeleteEpochSnapshot(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEpochSnapshotKey(epoch))
+}
+
+// IterateEpochSnapshots iterates through epoch snapshots from startEpoch
+// to endEpoch inclusive, in epoch order. Zero endEpoch means no upper bound.
+func (k Keeper) IterateEpochSnapshots(ctx sdk.Context, startEpoch, endEpoch uint64, callback func(snapshot types.EpochSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.EpochSnapshotKey)
+	if endEpoch != 0 && endEpoch+1 != 0 {
+		end = types.GetEpochSnapshotKey(endEpoch + 1)
+	}
+	iterator := store.Iterator(types.GetEpochSnapshotKey(startEpoch), end)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.EpochSnapshot
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &snapshot)
+
+		if callback(snapshot) {
+			break
+		}
+	}
+}
+
+// GetEpochSnapshots gets epoch snapshots from startEpoch to endEpoch
+// inclusive. Zero endEpoch means no upper bound.
+func (k Keeper) GetEpochSnapshots(ctx sdk.Context, startEpoch, endEpoch uint64) (snapshots []types.EpochSnapshot) {
+	k.IterateEpochSnapshots(ctx, startEpoch, endEpoch, func(snapshot types.EpochSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return
+}
+
+// SnapshotEpoch records the state of all pools and global totals for an
+// epoch and prunes snapshots older than the last MaxEpochSnapshots epochs.
+func (k Keeper) SnapshotEpoch(ctx sdk.Context, epoch uint64) {
+	var pools []types.PoolSnapshot
+	k.IterateAllPools(ctx, func(pool types.Pool) bool {
+		pools = append(pools, types.NewPoolSnapshot(pool))
+		return false
+	})
+	snapshot := types.NewEpochSnapshot(epoch, ctx.BlockHeight(), ctx.BlockTime(), pools,
+		k.GetTotalCollateral(ctx), k.GetTotalShield(ctx), k.GetTotalClaimed(ctx), k.GetGlobalShieldStakingPool(ctx), k.GetRewardIndex(ctx))
+	k.SetEpochSnapshot(ctx, snapshot)
+
+	if epoch < types.MaxEpochSnapshots {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetEpochSnapshotKey(0), types.GetEpochSnapshotKey(epoch-types.MaxEpochSnapshots+1))
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
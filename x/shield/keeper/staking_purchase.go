@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -72,9 +73,10 @@ func (k Keeper) AddStaking(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddr
 	pool = pool.Add(stakingAmt)
 	k.SetGlobalShieldStakingPool(ctx, pool)
 
+	k.UpdateStakingRewards(ctx, poolID, purchaser)
 	sFS, found := k.GetStakeForShield(ctx, poolID, purchaser)
 	if !found {
-		sFS = types.NewShieldStaking(poolID, purchaser, stakingAmt)
+		sFS = types.NewShieldStaking(poolID, purchaser, stakingAmt, k.GetStakingRewardIndex(ctx))
 	} else {
 		sFS.Amount = sFS.Amount.Add(stakingAmt)
 	}
@@ -158,6 +160,7 @@ func (k Keeper) IterateOriginalStakings(ctx sdk.Context, callback func(original
 }
 
 func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchaseID uint64, bondDenom string, purchaser sdk.AccAddress) error {
+	k.UpdateStakingRewards(ctx, poolID, purchaser)
 	staked, found := k.GetStakeForShield(ctx, poolID, purchaser)
 	if !found {
 		return nil
@@ -166,8 +169,19 @@ func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchas
 	if amount.IsZero() {
 		return nil
 	}
-	refundCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
-	k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, refundCoins)
+	// Requested withdraws are returned after the unstake cooldown period,
+	// while the rest is refunded to renew the purchase.
+	withdrawAmt := sdk.MinInt(staked.WithdrawRequested, amount)
+	renew := amount.Sub(withdrawAmt)
+	if renew.IsPositive() {
+		refundCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, renew))
+		k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, refundCoins)
+	}
+	if withdrawAmt.IsPositive() {
+		completionTime := ctx.BlockTime().Add(k.GetUnstakeCooldownPeriod(ctx))
+		k.InsertUnstakingQueue(ctx, types.NewUnstaking(poolID, purchaser, withdrawAmt, completionTime))
+	}
+	k.payoutStakingRewards(ctx, purchaser, &staked)
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOriginalStakingKey(purchaseID))
@@ -176,13 +190,7 @@ func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchas
 	pool = pool.Sub(amount)
 	k.SetGlobalShieldStakingPool(ctx, pool)
 
-	renew := amount.Sub(staked.WithdrawRequested)
-	if renew.IsNegative() {
-		renew = sdk.NewInt(0)
-	}
-
 	staked.Amount = staked.Amount.Sub(amount)
-	withdrawAmt := sdk.MinInt(staked.WithdrawRequested, amount)
 	staked.WithdrawRequested = staked.WithdrawRequested.Sub(withdrawAmt)
 	if staked.Amount.IsZero() {
 		store.Delete(types.GetStakeForShieldKey(poolID, purchaser))
@@ -195,14 +203,189 @@ func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchas
 	}
 
 	sPRate := k.GetShieldStakingRate(ctx)
-	renewShieldInt := amount.ToDec().Quo(sPRate).TruncateInt()
+	renewShieldInt := renew.ToDec().Quo(sPRate).TruncateInt()
 	renewShield := sdk.NewCoins(sdk.NewCoin(bondDenom, renewShieldInt))
 	if renewShieldInt.IsZero() {
 		return nil
 	}
+	// Skip the renewal if the refund is not spendable, e.g. locked by vesting.
+	renewStaking := sPRate.MulInt(renewShieldInt).TruncateInt()
+	if k.bk.SpendableCoins(ctx, purchaser).AmountOf(bondDenom).LT(renewStaking) {
+		return nil
+	}
 
 	desc := fmt.Sprintf(`renewed from PurchaseID %s`, strconv.FormatUint(purchaseID, 10))
 	_, _ = k.PurchaseShield(ctx, poolID, renewShield, desc, purchaser, true)
 
 	return nil
 }
+
+// SetStakingRewardIndex sets the cumulative block service fees
+// distributed per unit of staking purchases.
+func (k Keeper) SetStakingRewardIndex(ctx sdk.Context, index types.MixedDecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&index)
+	store.Set(types.GetStakingRewardIndexKey(), bz)
+}
+
+// GetStakingRewardIndex returns the cumulative block service fees
+// distributed per unit of staking purchases.
+func (k Keeper) GetStakingRewardIndex(ctx sdk.Context) types.MixedDecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStakingRewardIndexKey())
+	if bz == nil {
+		return types.InitMixedDecCoins()
+	}
+	var index types.MixedDecCoins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &index)
+	return index
+}
+
+// UpdateStakingRewards settles block rewards a staking purchase has
+// accrued from the staking reward index since the last settlement.
+// It must be called before the staked amount changes.
+func (k Keeper) UpdateStakingRewards(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress) {
+	staked, found := k.GetStakeForShield(ctx, poolID, purchaser)
+	if !found {
+		return
+	}
+
+	// staked * (stakingIndex - purchaseIndex)
+	rewardIndex := k.GetStakingRewardIndex(ctx)
+	rewards := rewardIndex.Native.Sub(staked.RewardIndex.Native).MulDecTruncate(staked.Amount.ToDec())
+	staked.RewardIndex = rewardIndex
+
+	// Truncated indexes never pay out more than distributed.
+	outstanding := k.GetOutstandingRewards(ctx)
+	rewards = rewards.Intersect(outstanding.Native)
+	outstanding.Native = outstanding.Native.Sub(rewards)
+	k.SetOutstandingRewards(ctx, outstanding)
+
+	staked.Rewards = staked.Rewards.Add(types.MixedDecCoins{Native: rewards})
+	k.SetStakeForShield(ctx, poolID, purchaser, staked)
+}
+
+// payoutStakingRewards pays out the settled rewards of a staking purchase
+// and adds truncation leftovers to remaining service fees.
+func (k Keeper) payoutStakingRewards(ctx sdk.Context, purchaser sdk.AccAddress, staked *types.ShieldStaking) {
+	ctkRewards, change := staked.Rewards.Native.TruncateDecimal()
+	staked.Rewards.Native = sdk.DecCoins{}
+
+	remainingServiceFees := k.GetRemainingServiceFees(ctx)
+	remainingServiceFees.Native = remainingServiceFees.Native.Add(change...)
+	k.SetRemainingServiceFees(ctx, remainingServiceFees)
+
+	if ctkRewards.IsZero() {
+		return
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, ctkRewards); err != nil {
+		panic(err)
+	}
+}
+
+// GetSettledStakeForShield returns a staking purchase with its accrued
+// rewards settled without writing to the store.
+func (k Keeper) GetSettledStakeForShield(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress) (types.ShieldStaking, bool) {
+	cacheCtx, _ := ctx.CacheContext()
+	k.UpdateStakingRewards(cacheCtx, poolID, purchaser)
+	return k.GetStakeForShield(cacheCtx, poolID, purchaser)
+}
+
+// GetPurchaserStakeForShields returns the staking purchases of a
+// purchaser in all pools with their accrued rewards settled.
+func (k Keeper) GetPurchaserStakeForShields(ctx sdk.Context, purchaser sdk.AccAddress) (stakings []types.ShieldStaking) {
+	for _, pool := range k.GetAllPools(ctx) {
+		if staked, found := k.GetSettledStakeForShield(ctx, pool.Id, purchaser); found {
+			stakings = append(stakings, staked)
+		}
+	}
+	return
+}
+
+// InsertUnstakingQueue prepares a queue timeslice to insert an unstaking into.
+func (k Keeper) InsertUnstakingQueue(ctx sdk.Context, unstaking types.Unstaking) {
+	timeSlice := k.GetUnstakingQueueTimeSlice(ctx, unstaking.CompletionTime)
+	timeSlice = append(timeSlice, unstaking)
+	k.SetUnstakingQueueTimeSlice(ctx, unstaking.CompletionTime, timeSlice)
+}
+
+// SetUnstakingQueueTimeSlice stores an unstaking queue timeslice
+// using the timestamp as the key.
+func (k Keeper) SetUnstakingQueueTimeSlice(ctx sdk.Context, timestamp time.Time, unstakings []types.Unstaking) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.Unstakings{Unstakings: unstakings})
+	store.Set(types.GetUnstakingCompletionTimeKey(timestamp), bz)
+}
+
+// GetUnstakingQueueTimeSlice gets a specific unstaking queue timeslice.
+func (k Keeper) GetUnstakingQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []types.Unstaking {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnstakingCompletionTimeKey(timestamp))
+	if bz == nil {
+		return []types.Unstaking{}
+	}
+	var unstakings types.Unstakings
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &unstakings)
+	return unstakings.Unstakings
+}
+
+// GetAllUnstakings gets all ongoing unstakings in completion time order.
+func (k Keeper) GetAllUnstakings(ctx sdk.Context) (result []types.Unstaking) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnstakingQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var timeslice types.Unstakings
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+		result = append(result, timeslice.Unstakings...)
+	}
+	return
+}
+
+// GetPurchaserUnstakings gets all ongoing unstakings of a purchaser.
+func (k Keeper) GetPurchaserUnstakings(ctx sdk.Context, purchaser sdk.AccAddress) (result []types.Unstaking) {
+	for _, unstaking := range k.GetAllUnstakings(ctx) {
+		if unstaking.Purchaser == purchaser.String() {
+			result = append(result, unstaking)
+		}
+	}
+	return
+}
+
+// DequeueCompletedUnstakingQueue returns unstaked amounts whose
+// cooldown period has ended to their purchasers.
+func (k Keeper) DequeueCompletedUnstakingQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnstakingQueueKey, sdk.InclusiveEndBytes(types.GetUnstakingCompletionTimeKey(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var unstakings []types.Unstaking
+	for ; iterator.Valid(); iterator.Next() {
+		var timeslice types.Unstakings
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+		unstakings = append(unstakings, timeslice.Unstakings...)
+		store.Delete(iterator.Key())
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	for _, unstaking := range unstakings {
+		purchaser, err := sdk.AccAddressFromBech32(unstaking.Purchaser)
+		if err != nil {
+			panic(err)
+		}
+		unstakedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, unstaking.Amount))
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, unstakedCoins); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnstaking,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(unstaking.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyPurchaser, unstaking.Purchaser),
+				sdk.NewAttribute(types.AttributeKeyAmount, unstaking.Amount.String()),
+			),
+		)
+	}
+}
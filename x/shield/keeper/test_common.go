@@ -81,7 +81,7 @@ func RandomMaturedProposalIDReimbursementPair(r *rand.Rand, k Keeper, ctx sdk.Co
 	}
 	var maturedPRPairs []types.ProposalIDReimbursementPair
 	for _, prPair := range prPairs {
-		if prPair.Reimbursement.PayoutTime.Before(ctx.BlockTime()) {
+		if prPair.Reimbursement.PayoutTime.Before(ctx.BlockTime()) && !prPair.Reimbursement.WithdrawableAmount(ctx.BlockTime()).IsZero() {
 			maturedPRPairs = append(maturedPRPairs, prPair)
 		}
 	}
@@ -96,6 +96,38 @@ func (k Keeper) RemoveTimeSliceFromWithdrawQueue(ctx sdk.Context, timestamp time
 	store.Delete(types.GetWithdrawCompletionTimeKey(timestamp))
 }
 
+// reduceWithdraws reduces a provider's withdraws by the given amount
+// from the latest to the oldest.
+func (k Keeper) reduceWithdraws(ctx sdk.Context, providerAddr string, amount sdk.Int) {
+	withdraws := k.GetWithdrawsByProvider(ctx, providerAddr)
+	for i := len(withdraws) - 1; i >= 0 && amount.IsPositive(); i-- {
+		reduction := sdk.MinInt(amount, withdraws[i].Amount)
+		amount = amount.Sub(reduction)
+
+		timeSlice := k.GetWithdrawQueueTimeSlice(ctx, withdraws[i].CompletionTime)
+		for j := range timeSlice {
+			if timeSlice[j].Address != withdraws[i].Address || !timeSlice[j].Amount.Equal(withdraws[i].Amount) {
+				continue
+			}
+
+			if withdraws[i].Amount.Equal(reduction) {
+				timeSlice = append(timeSlice[:j], timeSlice[j+1:]...)
+			} else {
+				timeSlice[j].Amount = withdraws[i].Amount.Sub(reduction)
+			}
+			break
+		}
+		if len(timeSlice) == 0 {
+			k.RemoveTimeSliceFromWithdrawQueue(ctx, withdraws[i].CompletionTime)
+		} else {
+			k.SetWithdrawQueueTimeSlice(ctx, withdraws[i].CompletionTime, timeSlice)
+		}
+	}
+	if amount.IsPositive() {
+		panic("withdraws were not reduced by the exact amount")
+	}
+}
+
 // DequeueCompletedWithdrawQueue dequeues completed withdraws
 // and processes their completions.
 func (k Keeper) DequeueCompletedWithdrawQueue(ctx sdk.Context) {
@@ -122,6 +154,7 @@ func (k Keeper) DequeueCompletedWithdrawQueue(ctx sdk.Context) {
 			panic(err)
 		}
 
+		k.UpdateProviderRewards(ctx, providerAddr)
 		provider, found := k.GetProvider(ctx, providerAddr)
 		if !found {
 			panic("provider not found but its collaterals are being withdrawn")
@@ -129,6 +162,7 @@ func (k Keeper) DequeueCompletedWithdrawQueue(ctx sdk.Context) {
 		provider.Collateral = provider.Collateral.Sub(withdraw.Amount)
 		provider.Withdrawing = provider.Withdrawing.Sub(withdraw.Amount)
 		k.SetProvider(ctx, providerAddr, provider)
+		k.capAllocations(ctx, providerAddr)
 
 		totalCollateral = totalCollateral.Sub(withdraw.Amount)
 		totalWithdrawing = totalWithdrawing.Sub(withdraw.Amount)
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/common"
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// secondsPerYear is the number of seconds in a 365-day year.
+var secondsPerYear = sdk.NewDec(int64(time.Hour * 24 * 365 / time.Second))
+
+// GetProviderYield estimates the rewards a provider's current collateral
+// and allocations would have earned over the trailing window of epochs,
+// using the reward indexes recorded in the oldest epoch snapshot within
+// the window, and annualizes them relative to the provider's collateral.
+func (k Keeper) GetProviderYield(ctx sdk.Context, providerAddr sdk.AccAddress, windowEpochs uint64) (yield sdk.Dec, rewards sdk.DecCoins, windowStart time.Time, collateral sdk.Int, err error) {
+	provider, found := k.GetProvider(ctx, providerAddr)
+	if !found {
+		return sdk.Dec{}, nil, time.Time{}, sdk.Int{}, types.ErrProviderNotFound
+	}
+	if windowEpochs == 0 {
+		windowEpochs = types.DefaultYieldWindowEpochs
+	}
+
+	var startEpoch uint64
+	if epoch := uint64(ctx.BlockHeight()) / common.BlocksPerEpoch; epoch > windowEpochs {
+		startEpoch = epoch - windowEpochs
+	}
+	var snapshot types.EpochSnapshot
+	found = false
+	k.IterateEpochSnapshots(ctx, startEpoch, 0, func(s types.EpochSnapshot) bool {
+		snapshot, found = s, true
+		return true
+	})
+	if !found || !snapshot.Time.Before(ctx.BlockTime()) {
+		return sdk.Dec{}, nil, time.Time{}, sdk.Int{}, types.ErrNoEpochSnapshot
+	}
+
+	// Rewards from block service fees distributed to all collaterals.
+	globalIndex := k.GetRewardIndex(ctx).Native
+	rewards = indexDelta(globalIndex, snapshot.RewardIndex.Native).MulDecTruncate(provider.Collateral.ToDec())
+
+	// Rewards from service fees of the pools backed by the provider.
+	poolIndexes := make(map[uint64]sdk.DecCoins)
+	for _, pool := range snapshot.Pools {
+		poolIndexes[pool.PoolId] = pool.RewardIndex.Native
+	}
+	for _, allocation := range k.GetProviderAllocations(ctx, providerAddr) {
+		pool, found := k.GetPool(ctx, allocation.PoolId)
+		if !found {
+			continue
+		}
+		delta := indexDelta(pool.RewardIndex.Native, poolIndexes[pool.Id])
+		rewards = rewards.Add(delta.MulDecTruncate(allocation.Amount.ToDec())...)
+	}
+
+	yield = sdk.ZeroDec()
+	if provider.Collateral.IsPositive() {
+		elapsed := sdk.NewDec(int64(ctx.BlockTime().Sub(snapshot.Time) / time.Second))
+		if elapsed.IsPositive() {
+			yield = rewards.AmountOf(k.BondDenom(ctx)).Quo(provider.Collateral.ToDec()).Mul(secondsPerYear).Quo(elapsed)
+		}
+	}
+	return yield, rewards, snapshot.Time, provider.Collateral, nil
+}
+
+// indexDelta returns the growth of a reward index since a previous value.
+func indexDelta(current, previous sdk.DecCoins) sdk.DecCoins {
+	delta, hasNeg := current.SafeSub(previous)
+	if hasNeg {
+		return sdk.DecCoins{}
+	}
+	return delta
+}
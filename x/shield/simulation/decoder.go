@@ -21,6 +21,12 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.ShieldAdminKey):
 			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvA.Value))
 
+		case bytes.Equal(kvA.Key[:1], types.ShieldRolesKey):
+			var rolesA, rolesB types.ShieldRoles
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &rolesA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rolesB)
+			return fmt.Sprintf("%v\n%v", rolesA, rolesB)
+
 		case bytes.Equal(kvA.Key[:1], types.TotalCollateralKey),
 			bytes.Equal(kvA.Key[:1], types.TotalShieldKey),
 			bytes.Equal(kvA.Key[:1], types.TotalClaimedKey):
@@ -30,7 +36,9 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("%v\n%v", totalA, totalB)
 
 		case bytes.Equal(kvA.Key[:1], types.ServiceFeesKey),
-			bytes.Equal(kvA.Key[:1], types.RemainingServiceFeesKey):
+			bytes.Equal(kvA.Key[:1], types.RemainingServiceFeesKey),
+			bytes.Equal(kvA.Key[:1], types.RewardIndexKey),
+			bytes.Equal(kvA.Key[:1], types.OutstandingRewardsKey):
 			var serviceFeesA, serviceFeesB types.MixedDecCoins
 			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &serviceFeesA)
 			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &serviceFeesB)
@@ -84,6 +92,47 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rateB)
 			return fmt.Sprintf("%v\n%v", rateA, rateB)
 
+		case bytes.Equal(kvA.Key[:1], types.AllocationKey):
+			var allocationA, allocationB types.Allocation
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &allocationA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &allocationB)
+			return fmt.Sprintf("%v\n%v", allocationA, allocationB)
+
+		case bytes.Equal(kvA.Key[:1], types.ProviderAllocationKey):
+			poolIDA := sdk.BigEndianToUint64(kvA.Key[len(kvA.Key)-8:])
+			poolIDB := sdk.BigEndianToUint64(kvB.Key[len(kvB.Key)-8:])
+			return fmt.Sprintf("%v\n%v", poolIDA, poolIDB)
+
+		case bytes.Equal(kvA.Key[:1], types.EpochSnapshotKey):
+			var snapshotA, snapshotB types.EpochSnapshot
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &snapshotA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+
+		case bytes.Equal(kvA.Key[:1], types.ClaimKey):
+			var claimA, claimB types.Claim
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &claimA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.StakingRewardIndexKey):
+			var indexA, indexB types.MixedDecCoins
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &indexA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnstakingQueueKey):
+			var unstakingsA, unstakingsB types.Unstakings
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &unstakingsA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &unstakingsB)
+			return fmt.Sprintf("%v\n%v", unstakingsA, unstakingsB)
+
+		case bytes.Equal(kvA.Key[:1], types.PoolClaimKey),
+			bytes.Equal(kvA.Key[:1], types.PurchaserClaimKey):
+			proposalIDA := sdk.BigEndianToUint64(kvA.Key[len(kvA.Key)-8:])
+			proposalIDB := sdk.BigEndianToUint64(kvB.Key[len(kvB.Key)-8:])
+			return fmt.Sprintf("%v\n%v", proposalIDA, proposalIDB)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
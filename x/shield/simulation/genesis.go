@@ -19,8 +19,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	r := simState.Rand
 
 	gs := types.GenesisState{}
-	simAccount, _ := simtypes.RandomAcc(r, simState.Accounts)
-	gs.ShieldAdmin = simAccount.Address.String()
+	gs.ShieldRoles = GenShieldRoles(r, simState.Accounts)
 	gs.NextPoolId = 1
 	gs.PoolParams = GenPoolParams(r)
 	gs.ClaimProposalParams = GenClaimProposalParams(r)
@@ -37,6 +36,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			int(gs.ClaimProposalParams.ClaimPeriod)/10, int(gs.ClaimProposalParams.ClaimPeriod)))
 	}
 	gs.ShieldStakingRate = GenShieldStakingRateParam(r)
+	gs.UnstakeCooldownPeriod = GenUnstakeCooldownPeriod(r)
+	gs.StakingRewardRate = GenStakingRewardRate(r)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gs)
 }
 
@@ -46,8 +47,11 @@ func GenPoolParams(r *rand.Rand) types.PoolParams {
 	withdrawPeriod := time.Duration(simtypes.RandIntBetween(r, 60*1, 60*60*24*3)) * time.Second
 	shieldFeesRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 50)), 3)
 	poolShieldLimit := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 20)), 2)
+	poolCreatorShieldLimit := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1e9, 1e12)))
+	poolCreatorMinFeesRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 50)), 3)
 
-	return types.NewPoolParams(protectionPeriod, withdrawPeriod, shieldFeesRate, poolShieldLimit, sdk.Coins{})
+	return types.NewPoolParams(protectionPeriod, withdrawPeriod, shieldFeesRate, poolShieldLimit, sdk.Coins{},
+		poolCreatorShieldLimit, poolCreatorMinFeesRate)
 }
 
 // GenClaimProposalParams returns a randomized ClaimProposalParams object.
@@ -58,7 +62,9 @@ func GenClaimProposalParams(r *rand.Rand) types.ClaimProposalParams {
 	depositRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 3)
 	feesRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 50)), 3)
 
-	return types.NewClaimProposalParams(claimPeriod, payoutPeriod, minDeposit, depositRate, feesRate)
+	vestingPeriod := time.Duration(simtypes.RandIntBetween(r, 0, 60*60*24)) * time.Second
+
+	return types.NewClaimProposalParams(claimPeriod, payoutPeriod, minDeposit, depositRate, feesRate, vestingPeriod, sdk.Coins{})
 }
 
 // GenShieldStakingRateParam returns a randomized staking-shield rate.
@@ -70,6 +76,46 @@ func GenShieldStakingRateParam(r *rand.Rand) sdk.Dec {
 	return random
 }
 
+// GenUnstakeCooldownPeriod returns a randomized unstake cooldown period.
+func GenUnstakeCooldownPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 0, 60*60*24)) * time.Second
+}
+
+// GenStakingRewardRate returns a randomized staking reward rate.
+func GenStakingRewardRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 50)), 2)
+}
+
+// GenShieldRoles returns randomized shield roles, each held by
+// one to three distinct simulation accounts.
+func GenShieldRoles(r *rand.Rand, accs []simtypes.Account) types.ShieldRoles {
+	genRole := func() []string {
+		n := simtypes.RandIntBetween(r, 1, 4)
+		if n > len(accs) {
+			n = len(accs)
+		}
+		var addrs []string
+		for _, i := range r.Perm(len(accs))[:n] {
+			addrs = append(addrs, accs[i].Address.String())
+		}
+		return addrs
+	}
+	return types.NewShieldRoles(genRole(), genRole(), genRole())
+}
+
+// GenCoverageTerms returns randomized coverage terms.
+func GenCoverageTerms(r *rand.Rand) types.CoverageTerms {
+	deductibleAmount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 1e6)))
+	deductibleRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 20)), 2)
+	maxClaim := sdk.ZeroInt()
+	if r.Intn(2) == 0 {
+		maxClaim = sdk.NewInt(int64(simtypes.RandIntBetween(r, 1e6, 1e10)))
+	}
+	waitingPeriod := time.Duration(simtypes.RandIntBetween(r, 0, 60*60)) * time.Second
+
+	return types.NewCoverageTerms(deductibleAmount, deductibleRate, maxClaim, waitingPeriod)
+}
+
 // GetRandDenom generates a random coin denom.
 func GetRandDenom(r *rand.Rand) string {
 	length := simtypes.RandIntBetween(r, 3, 8)
@@ -19,33 +19,43 @@ import (
 
 const (
 	// C's operations
-	OpWeightMsgCreatePool = "op_weight_msg_create_pool"
-	OpWeightMsgUpdatePool = "op_weight_msg_update_pool"
+	OpWeightMsgCreatePool       = "op_weight_msg_create_pool"
+	OpWeightMsgUpdatePool       = "op_weight_msg_update_pool"
+	OpWeightMsgSetCoverageTerms = "op_weight_msg_set_coverage_terms"
+	OpWeightMsgSetCoveredAssets = "op_weight_msg_set_covered_assets"
 
 	// B and C's operations
 	OpWeightMsgDepositCollateral  = "op_weight_msg_deposit_collateral"
 	OpWeightMsgWithdrawCollateral = "op_weight_msg_withdraw_collateral"
+	OpWeightMsgAllocateCollateral = "op_weight_msg_allocate_collateral"
 	OpWeightMsgWithdrawRewards    = "op_weight_msg_withdraw_rewards"
+	OpWeightMsgSetAutoCompound    = "op_weight_msg_set_auto_compound"
 
 	// P's operations
-	OpWeightMsgPurchaseShield     = "op_weight_msg_purchase_shield"
-	OpWeightShieldClaimProposal   = "op_weight_msg_submit_claim_proposal"
-	OpWeightStakeForShield        = "op_weight_msg_stake_for_shield"
-	OpWeightUnstakeFromShield     = "op_weight_msg_unstake_from_shield"
-	OpWeightWithdrawReimbursement = "op_weight_msg_withdraw_reimbursement"
+	OpWeightMsgPurchaseShield         = "op_weight_msg_purchase_shield"
+	OpWeightShieldClaimProposal       = "op_weight_msg_submit_claim_proposal"
+	OpWeightShieldAdminUpdateProposal = "op_weight_msg_submit_shield_admin_update_proposal"
+	OpWeightStakeForShield            = "op_weight_msg_stake_for_shield"
+	OpWeightUnstakeFromShield         = "op_weight_msg_unstake_from_shield"
+	OpWeightWithdrawReimbursement     = "op_weight_msg_withdraw_reimbursement"
 )
 
 var (
-	DefaultWeightMsgCreatePool            = 10
-	DefaultWeightMsgUpdatePool            = 20
-	DefaultWeightMsgDepositCollateral     = 20
-	DefaultWeightMsgWithdrawCollateral    = 20
-	DefaultWeightMsgWithdrawRewards       = 10
-	DefaultWeightMsgPurchaseShield        = 20
-	DefaultWeightMsgStakeForShield        = 20
-	DefaultWeightMsgUnstakeFromShield     = 15
-	DefaultWeightShieldClaimProposal      = 5
-	DefaultWeightMsgWithdrawReimbursement = 5
+	DefaultWeightMsgCreatePool             = 10
+	DefaultWeightMsgUpdatePool             = 20
+	DefaultWeightMsgSetCoverageTerms       = 5
+	DefaultWeightMsgSetCoveredAssets       = 5
+	DefaultWeightMsgDepositCollateral      = 20
+	DefaultWeightMsgWithdrawCollateral     = 20
+	DefaultWeightMsgAllocateCollateral     = 20
+	DefaultWeightMsgWithdrawRewards        = 10
+	DefaultWeightMsgSetAutoCompound        = 5
+	DefaultWeightMsgPurchaseShield         = 20
+	DefaultWeightMsgStakeForShield         = 20
+	DefaultWeightMsgUnstakeFromShield      = 15
+	DefaultWeightShieldClaimProposal       = 5
+	DefaultWeightShieldAdminUpdateProposal = 2
+	DefaultWeightMsgWithdrawReimbursement  = 5
 
 	DefaultIntMax = 100000000000
 )
@@ -62,6 +72,16 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONMarshaler, k
 		func(_ *rand.Rand) {
 			weightMsgUpdatePool = DefaultWeightMsgUpdatePool
 		})
+	var weightMsgSetCoverageTerms int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetCoverageTerms, &weightMsgSetCoverageTerms, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetCoverageTerms = DefaultWeightMsgSetCoverageTerms
+		})
+	var weightMsgSetCoveredAssets int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetCoveredAssets, &weightMsgSetCoveredAssets, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetCoveredAssets = DefaultWeightMsgSetCoveredAssets
+		})
 	var weightMsgDepositCollateral int
 	appParams.GetOrGenerate(cdc, OpWeightMsgDepositCollateral, &weightMsgDepositCollateral, nil,
 		func(_ *rand.Rand) {
@@ -72,11 +92,21 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONMarshaler, k
 		func(_ *rand.Rand) {
 			weightMsgWithdrawCollateral = DefaultWeightMsgWithdrawCollateral
 		})
+	var weightMsgAllocateCollateral int
+	appParams.GetOrGenerate(cdc, OpWeightMsgAllocateCollateral, &weightMsgAllocateCollateral, nil,
+		func(_ *rand.Rand) {
+			weightMsgAllocateCollateral = DefaultWeightMsgAllocateCollateral
+		})
 	var weightMsgWithdrawRewards int
 	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawRewards, &weightMsgWithdrawRewards, nil,
 		func(_ *rand.Rand) {
 			weightMsgWithdrawRewards = DefaultWeightMsgWithdrawRewards
 		})
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = DefaultWeightMsgSetAutoCompound
+		})
 	var weightMsgPurchaseShield int
 	appParams.GetOrGenerate(cdc, OpWeightMsgPurchaseShield, &weightMsgPurchaseShield, nil,
 		func(_ *rand.Rand) {
@@ -101,9 +131,13 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONMarshaler, k
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(weightMsgCreatePool, SimulateMsgCreatePool(k, ak, bk, sk)),
 		simulation.NewWeightedOperation(weightMsgCreatePool, SimulateMsgUpdatePool(k, ak, bk, sk)),
+		simulation.NewWeightedOperation(weightMsgSetCoverageTerms, SimulateMsgSetCoverageTerms(k, ak)),
+		simulation.NewWeightedOperation(weightMsgSetCoveredAssets, SimulateMsgSetCoveredAssets(k, ak)),
 		simulation.NewWeightedOperation(weightMsgDepositCollateral, SimulateMsgDepositCollateral(k, ak, bk, sk)),
 		simulation.NewWeightedOperation(weightMsgWithdrawCollateral, SimulateMsgWithdrawCollateral(k, ak, bk, sk)),
+		simulation.NewWeightedOperation(weightMsgAllocateCollateral, SimulateMsgAllocateCollateral(k, ak, bk, sk)),
 		simulation.NewWeightedOperation(weightMsgWithdrawRewards, SimulateMsgWithdrawRewards(k, ak)),
+		simulation.NewWeightedOperation(weightMsgSetAutoCompound, SimulateMsgSetAutoCompound(k, ak)),
 		simulation.NewWeightedOperation(weightMsgPurchaseShield, SimulateMsgPurchaseShield(k, ak, bk, sk)),
 		simulation.NewWeightedOperation(weightMsgStakeForShield, SimulateMsgStakeForShield(k, ak, bk, sk)),
 		simulation.NewWeightedOperation(weightMsgUnstakeFromShield, SimulateMsgUnstakeFromShield(k, ak, bk, sk)),
@@ -120,14 +154,10 @@ func SimulateMsgCreatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.Ban
 		if len(pools) > 20 {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "too many pools"), nil, nil
 		}
-		// admin
-		adminAddr := k.GetAdmin(ctx)
-		var simAccount simtypes.Account
-		for _, simAcc := range accs {
-			if simAcc.Address.Equals(adminAddr) {
-				simAccount = simAcc
-				break
-			}
+		// pool operator
+		simAccount, found := randomPoolOperator(r, k, ctx, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "no pool operator"), nil, nil
 		}
 		account := ak.GetAccount(ctx, simAccount.Address)
 		bondDenom := sk.BondDenom(ctx)
@@ -139,11 +169,12 @@ func SimulateMsgCreatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.Ban
 		totalClaimed := k.GetTotalClaimed(ctx)
 		poolParams := k.GetPoolParams(ctx)
 		maxShield := sdk.MinInt(totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt(), totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).Sub(totalShield))
-		shieldAmount, err := simtypes.RandPositiveInt(r, maxShield)
-		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, err.Error()), nil, nil
+		if !maxShield.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "not enough collateral"), nil, nil
 		}
-		shield := sdk.NewCoins(sdk.NewCoin(bondDenom, shieldAmount))
+		// No collateral is allocated to a new pool, so shield is
+		// purchased after providers allocate collaterals.
+		shield := sdk.NewCoins()
 
 		// shield limit
 		// No overflow would happen when converting int64 to int in this case.
@@ -159,7 +190,7 @@ func SimulateMsgCreatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.Ban
 		if !nativeAmount.IsPositive() {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, ""), nil, nil
 		}
-		nativeAmount, err = simtypes.RandPositiveInt(r, nativeAmount)
+		nativeAmount, err := simtypes.RandPositiveInt(r, nativeAmount)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, err.Error()), nil, nil
 		}
@@ -203,13 +234,9 @@ func SimulateMsgCreatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.Ban
 func SimulateMsgUpdatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		adminAddr := k.GetAdmin(ctx)
-		var simAccount simtypes.Account
-		for _, simAcc := range accs {
-			if simAcc.Address.Equals(adminAddr) {
-				simAccount = simAcc
-				break
-			}
+		simAccount, found := randomPoolOperator(r, k, ctx, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdatePool, "no pool operator"), nil, nil
 		}
 		account := ak.GetAccount(ctx, simAccount.Address)
 		bondDenom := sk.BondDenom(ctx)
@@ -230,7 +257,7 @@ func SimulateMsgUpdatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.Ban
 		totalShield := k.GetTotalShield(ctx)
 		totalClaimed := k.GetTotalClaimed(ctx)
 		poolParams := k.GetPoolParams(ctx)
-		maxShield := computeMaxShield(pool, totalCollateral, totalWithdrawing, totalClaimed, totalShield, poolParams)
+		maxShield := computeMaxShield(pool, k.GetPoolAvailableCollateral(ctx, poolID), totalCollateral, totalWithdrawing, totalClaimed, totalShield, poolParams)
 		shieldAmount, err := simtypes.RandPositiveInt(r, maxShield)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdatePool, err.Error()), nil, nil
@@ -281,6 +308,91 @@ func SimulateMsgUpdatePool(k keeper.Keeper, ak types.AccountKeeper, bk types.Ban
 	}
 }
 
+// SimulateMsgSetCoverageTerms generates a MsgSetCoverageTerms object with all of its fields randomized.
+func SimulateMsgSetCoverageTerms(k keeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, found := randomPoolOperator(r, k, ctx, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetCoverageTerms, "no pool operator"), nil, nil
+		}
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		poolID, _, found := keeper.RandomPoolInfo(r, k, ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetCoverageTerms, "random pool info not found"), nil, nil
+		}
+
+		terms := GenCoverageTerms(r)
+		msg := types.NewMsgSetCoverageTerms(simAccount.Address, poolID, terms)
+
+		fees := sdk.Coins{}
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSetCoveredAssets generates a MsgSetCoveredAssets object with all of its fields randomized.
+func SimulateMsgSetCoveredAssets(k keeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, found := randomPoolOperator(r, k, ctx, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetCoveredAssets, "no pool operator"), nil, nil
+		}
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		poolID, _, found := keeper.RandomPoolInfo(r, k, ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetCoveredAssets, "random pool info not found"), nil, nil
+		}
+
+		var assets []string
+		for _, acc := range simtypes.RandomAccounts(r, r.Intn(4)) {
+			assets = append(assets, acc.Address.String())
+		}
+		msg := types.NewMsgSetCoveredAssets(simAccount.Address, poolID, assets)
+
+		fees := sdk.Coins{}
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgDepositCollateral generates a MsgDepositCollateral object with all of its fields randomized.
 func SimulateMsgDepositCollateral(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
@@ -392,6 +504,69 @@ func SimulateMsgWithdrawCollateral(k keeper.Keeper, ak types.AccountKeeper, bk t
 	}
 }
 
+// SimulateMsgAllocateCollateral generates a MsgAllocateCollateral object with all of its fields randomized.
+func SimulateMsgAllocateCollateral(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		provider, found := keeper.RandomProvider(r, k, ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAllocateCollateral, "random provider not found"), nil, nil
+		}
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		var simAccount simtypes.Account
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(providerAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		poolID, _, found := keeper.RandomPoolInfo(r, k, ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAllocateCollateral, "random pool info not found"), nil, nil
+		}
+
+		// allocation coins
+		allocatable := provider.Collateral.Sub(provider.Withdrawing)
+		if allocation, found := k.GetAllocation(ctx, poolID, providerAddr); found {
+			allocatable = allocatable.Sub(allocation.Amount)
+		}
+		allocationAmount, err := simtypes.RandPositiveInt(r, allocatable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAllocateCollateral, err.Error()), nil, nil
+		}
+		collateral := sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), allocationAmount))
+
+		msg := types.NewMsgAllocateCollateral(simAccount.Address, poolID, collateral)
+
+		fees := sdk.Coins{}
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAllocateCollateral, err.Error()), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgWithdrawRewards generates a MsgWithdrawRewards object with all of its fields randomized.
 func SimulateMsgWithdrawRewards(k keeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
@@ -438,6 +613,49 @@ func SimulateMsgWithdrawRewards(k keeper.Keeper, ak types.AccountKeeper) simtype
 	}
 }
 
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound object with all of its fields randomized.
+func SimulateMsgSetAutoCompound(k keeper.Keeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		provider, found := keeper.RandomProvider(r, k, ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "random provider not found"), nil, nil
+		}
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			panic(err)
+		}
+		simAccount, found := simtypes.FindAccount(accs, providerAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "provider account not found"), nil, nil
+		}
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, r.Intn(2) == 0)
+
+		fees := sdk.Coins{}
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+
+		if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgPurchaseShield generates a MsgPurchaseShield object with all of its fields randomized.
 func SimulateMsgPurchaseShield(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
@@ -460,7 +678,7 @@ func SimulateMsgPurchaseShield(k keeper.Keeper, ak types.AccountKeeper, bk types
 		totalShield := k.GetTotalShield(ctx)
 		totalClaimed := k.GetTotalClaimed(ctx)
 		poolParams := k.GetPoolParams(ctx)
-		maxShield := computeMaxShield(pool, totalCollateral, totalWithdrawing, totalClaimed, totalShield, poolParams)
+		maxShield := computeMaxShield(pool, k.GetPoolAvailableCollateral(ctx, poolID), totalCollateral, totalWithdrawing, totalClaimed, totalShield, poolParams)
 		shieldAmount, err := simtypes.RandPositiveInt(r, maxShield)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPurchaseShield, err.Error()), nil, nil
@@ -507,6 +725,11 @@ func ProposalContents(k keeper.Keeper, sk types.StakingKeeper) []simtypes.Weight
 			DefaultWeightShieldClaimProposal,
 			SimulateShieldClaimProposalContent(k, sk),
 		),
+		simulation.NewWeightedProposalContent(
+			OpWeightShieldAdminUpdateProposal,
+			DefaultWeightShieldAdminUpdateProposal,
+			SimulateShieldAdminUpdateProposalContent(),
+		),
 	}
 }
 
@@ -529,13 +752,21 @@ func SimulateShieldClaimProposalContent(k keeper.Keeper, sk types.StakingKeeper)
 		if !found || purchase.ProtectionEndTime.Before(ctx.BlockTime()) {
 			return nil
 		}
-		lossAmount, err := simtypes.RandPositiveInt(r, purchase.Shield)
+		pool, found := k.GetPool(ctx, poolID)
+		if !found {
+			return nil
+		}
+		lossAmount, err := simtypes.RandPositiveInt(r, sdk.MinInt(purchase.Shield, pool.Allocation))
 		if err != nil {
 			return nil
 		}
+		loss := sdk.NewCoins(sdk.NewCoin(bondDenom, lossAmount))
+		if k.CheckCoverageTerms(ctx, poolID, purchase, loss) != nil {
+			return nil
+		}
 		return types.NewShieldClaimProposal(
 			poolID,
-			sdk.NewCoins(sdk.NewCoin(bondDenom, lossAmount)),
+			loss,
 			purchase.PurchaseId,
 			simtypes.RandStringOfLength(r, 500),
 			simtypes.RandStringOfLength(r, 500),
@@ -544,6 +775,33 @@ func SimulateShieldClaimProposalContent(k keeper.Keeper, sk types.StakingKeeper)
 	}
 }
 
+// SimulateShieldAdminUpdateProposalContent generates random shield admin update proposal content.
+func SimulateShieldAdminUpdateProposalContent() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		proposer, _ := simtypes.RandomAcc(r, accs)
+		return types.NewShieldAdminUpdateProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			GenShieldRoles(r, accs),
+			proposer.Address,
+		)
+	}
+}
+
+// randomPoolOperator returns a random simulation account holding the pool operator role.
+func randomPoolOperator(r *rand.Rand, k keeper.Keeper, ctx sdk.Context, accs []simtypes.Account) (simtypes.Account, bool) {
+	var operators []simtypes.Account
+	for _, simAcc := range accs {
+		if k.IsPoolOperator(ctx, simAcc.Address) {
+			operators = append(operators, simAcc)
+		}
+	}
+	if len(operators) == 0 {
+		return simtypes.Account{}, false
+	}
+	return operators[r.Intn(len(operators))], true
+}
+
 // SimulateMsgStakeForShield generates a MsgPurchaseShield object with all of its fields randomized.
 func SimulateMsgStakeForShield(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
@@ -566,7 +824,7 @@ func SimulateMsgStakeForShield(k keeper.Keeper, ak types.AccountKeeper, bk types
 		totalShield := k.GetTotalShield(ctx)
 		totalClaimed := k.GetTotalClaimed(ctx)
 		poolParams := k.GetPoolParams(ctx)
-		maxShield := computeMaxShield(pool, totalCollateral, totalWithdrawing, totalClaimed, totalShield, poolParams)
+		maxShield := computeMaxShield(pool, k.GetPoolAvailableCollateral(ctx, poolID), totalCollateral, totalWithdrawing, totalClaimed, totalShield, poolParams)
 		accountMax := sdk.OneDec().Quo(k.GetShieldStakingRate(ctx)).MulInt(bk.GetAllBalances(ctx, account.GetAddress()).AmountOf(k.BondDenom(ctx))).TruncateInt()
 		max := sdk.MinInt(accountMax, maxShield)
 		shieldAmount, err := simtypes.RandPositiveInt(r, max)
@@ -712,8 +970,8 @@ func SimulateMsgWithdrawReimbursement(k keeper.Keeper, ak types.AccountKeeper, b
 	}
 }
 
-func computeMaxShield(pool types.Pool, totalCollateral, totalWithdrawing, totalClaimed, totalShield sdk.Int, poolParams types.PoolParams) sdk.Int {
-	poolLimit := pool.ShieldLimit.Sub(pool.Shield)
+func computeMaxShield(pool types.Pool, poolAvailable, totalCollateral, totalWithdrawing, totalClaimed, totalShield sdk.Int, poolParams types.PoolParams) sdk.Int {
+	poolLimit := sdk.MinInt(pool.ShieldLimit, poolAvailable.ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt()).Sub(pool.Shield)
 	globalLimit := sdk.MinInt(totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt().Sub(pool.Shield),
 		totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).Sub(totalShield))
 	return sdk.MinInt(poolLimit, globalLimit)
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
 
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -33,5 +34,18 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return string(bz)
 			},
 		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyUnstakeCooldown),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf(`"%d"`, GenUnstakeCooldownPeriod(r))
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyStakingRewardRate),
+			func(r *rand.Rand) string {
+				bz, _ := json.Marshal(GenStakingRewardRate(r))
+				return string(bz)
+			},
+		),
 	}
 }
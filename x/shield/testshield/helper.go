@@ -42,6 +42,18 @@ func (sh *Helper) WithdrawCollateral(addr sdk.AccAddress, amount int64, ok bool)
 	sh.Handle(msg, ok)
 }
 
+func (sh *Helper) AllocateCollateral(addr sdk.AccAddress, poolID uint64, amount int64, ok bool) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, amount))
+	msg := types.NewMsgAllocateCollateral(addr, poolID, coins)
+	sh.Handle(msg, ok)
+}
+
+func (sh *Helper) DeallocateCollateral(addr sdk.AccAddress, poolID uint64, amount int64, ok bool) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, amount))
+	msg := types.NewMsgDeallocateCollateral(addr, poolID, coins)
+	sh.Handle(msg, ok)
+}
+
 func (sh *Helper) CreatePool(addr, sponsorAddr sdk.AccAddress, nativeDeposit, shield, shieldLimit int64, sponsor, description string) {
 	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
 	depositCoins := types.MixedCoins{Native: sdk.NewCoins(sdk.NewInt64Coin(sh.denom, nativeDeposit))}
@@ -50,6 +62,13 @@ func (sh *Helper) CreatePool(addr, sponsorAddr sdk.AccAddress, nativeDeposit, sh
 	sh.Handle(msg, true)
 }
 
+func (sh *Helper) UpdatePool(addr sdk.AccAddress, poolID uint64, nativeDeposit, shield int64, ok bool) {
+	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
+	depositCoins := types.MixedCoins{Native: sdk.NewCoins(sdk.NewInt64Coin(sh.denom, nativeDeposit))}
+	msg := types.NewMsgUpdatePool(addr, shieldCoins, depositCoins, poolID, "", sdk.ZeroInt())
+	sh.Handle(msg, ok)
+}
+
 func (sh *Helper) PurchaseShield(purchaser sdk.AccAddress, shield int64, poolID uint64, ok bool) {
 	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
 	msg := types.NewMsgPurchaseShield(poolID, shieldCoins, "test_purchase", purchaser)
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewClaim creates an open claim with the loss secured.
+func NewClaim(proposalID, poolID, purchaseID uint64, purchaser sdk.AccAddress, loss sdk.Coins, submitTime time.Time) Claim {
+	return Claim{
+		ProposalId: proposalID,
+		PoolId:     poolID,
+		PurchaseId: purchaseID,
+		Purchaser:  purchaser.String(),
+		Status:     ClaimStatusOpen,
+		Loss:       loss,
+		Secured:    loss,
+		SubmitTime: submitTime,
+		UpdateTime: submitTime,
+	}
+}
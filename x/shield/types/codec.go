@@ -15,12 +15,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgUpdatePool{}, "shield/MsgUpdatePool", nil)
 	cdc.RegisterConcrete(MsgPausePool{}, "shield/MsgPausePool", nil)
 	cdc.RegisterConcrete(MsgResumePool{}, "shield/MsgResumePool", nil)
+	cdc.RegisterConcrete(MsgSetCoverageTerms{}, "shield/MsgSetCoverageTerms", nil)
+	cdc.RegisterConcrete(MsgSetCoveredAssets{}, "shield/MsgSetCoveredAssets", nil)
 	cdc.RegisterConcrete(MsgDepositCollateral{}, "shield/MsgDepositCollateral", nil)
 	cdc.RegisterConcrete(MsgWithdrawCollateral{}, "shield/MsgWithdrawCollateral", nil)
+	cdc.RegisterConcrete(MsgAllocateCollateral{}, "shield/MsgAllocateCollateral", nil)
+	cdc.RegisterConcrete(MsgDeallocateCollateral{}, "shield/MsgDeallocateCollateral", nil)
 	cdc.RegisterConcrete(MsgWithdrawRewards{}, "shield/MsgWithdrawRewards", nil)
+	cdc.RegisterConcrete(MsgSetAutoCompound{}, "shield/MsgSetAutoCompound", nil)
 	cdc.RegisterConcrete(MsgWithdrawForeignRewards{}, "shield/MsgWithdrawForeignRewards", nil)
 	cdc.RegisterConcrete(MsgClearPayouts{}, "shield/MsgClearPayouts", nil)
 	cdc.RegisterConcrete(ShieldClaimProposal{}, "shield/ShieldClaimProposal", nil)
+	cdc.RegisterConcrete(ShieldAdminUpdateProposal{}, "shield/ShieldAdminUpdateProposal", nil)
 	cdc.RegisterConcrete(MsgPurchaseShield{}, "shield/MsgPurchaseShield", nil)
 	cdc.RegisterConcrete(MsgWithdrawReimbursement{}, "shield/MsgWithdrawReimbursement", nil)
 	cdc.RegisterConcrete(MsgUpdateSponsor{}, "shield/MsgUpdateSponsor", nil)
@@ -35,9 +41,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdatePool{},
 		&MsgPausePool{},
 		&MsgResumePool{},
+		&MsgSetCoverageTerms{},
+		&MsgSetCoveredAssets{},
 		&MsgDepositCollateral{},
 		&MsgWithdrawCollateral{},
+		&MsgAllocateCollateral{},
+		&MsgDeallocateCollateral{},
 		&MsgWithdrawRewards{},
+		&MsgSetAutoCompound{},
 		&MsgWithdrawForeignRewards{},
 		&MsgClearPayouts{},
 		&MsgPurchaseShield{},
@@ -49,6 +60,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&ShieldClaimProposal{},
+		&ShieldAdminUpdateProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
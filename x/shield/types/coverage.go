@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCoverageTerms creates a new CoverageTerms object.
+func NewCoverageTerms(deductibleAmount sdk.Int, deductibleRate sdk.Dec, maxClaim sdk.Int, waitingPeriod time.Duration) CoverageTerms {
+	return CoverageTerms{
+		DeductibleAmount: deductibleAmount,
+		DeductibleRate:   deductibleRate,
+		MaxClaim:         maxClaim,
+		WaitingPeriod:    waitingPeriod,
+	}
+}
+
+// Validate checks the amounts, rate and period of the coverage terms.
+func (t CoverageTerms) Validate() error {
+	if t.DeductibleAmount.IsNil() || t.DeductibleAmount.IsNegative() {
+		return fmt.Errorf("deductible amount must be non-negative: %s", t.DeductibleAmount)
+	}
+	if t.DeductibleRate.IsNil() || t.DeductibleRate.IsNegative() || t.DeductibleRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("deductible rate must be between 0 and 1: %s", t.DeductibleRate)
+	}
+	if t.MaxClaim.IsNil() || t.MaxClaim.IsNegative() {
+		return fmt.Errorf("max claim must be non-negative: %s", t.MaxClaim)
+	}
+	if t.WaitingPeriod < 0 {
+		return fmt.Errorf("waiting period must be non-negative: %s", t.WaitingPeriod)
+	}
+	return nil
+}
+
+// Deductible returns the part of a loss that is not reimbursed,
+// which is the larger of the deductible amount and rate.
+func (t CoverageTerms) Deductible(loss sdk.Int) sdk.Int {
+	return sdk.MinInt(loss, sdk.MaxInt(t.DeductibleAmount, t.DeductibleRate.MulInt(loss).TruncateInt()))
+}
+
+// Payout returns the reimbursement of a loss after the deductible,
+// capped at the maximum claim if there is one.
+func (t CoverageTerms) Payout(loss sdk.Int) sdk.Int {
+	payout := loss.Sub(t.Deductible(loss))
+	if t.MaxClaim.IsPositive() {
+		payout = sdk.MinInt(payout, t.MaxClaim)
+	}
+	return payout
+}
+
+const (
+	// MaxCoveredAssets is the maximum number of assets a pool can cover.
+	MaxCoveredAssets = 100
+	// MaxCoveredAssetLength is the maximum length of a covered asset identifier.
+	MaxCoveredAssetLength = 128
+)
+
+// ValidateCoveredAssets checks that the covered assets of a pool are
+// non-empty, bounded and free of duplicates.
+func ValidateCoveredAssets(assets []string) error {
+	if len(assets) > MaxCoveredAssets {
+		return fmt.Errorf("too many covered assets: %d > %d", len(assets), MaxCoveredAssets)
+	}
+	seen := make(map[string]bool, len(assets))
+	for _, asset := range assets {
+		if strings.TrimSpace(asset) != asset || asset == "" {
+			return fmt.Errorf("invalid covered asset: %q", asset)
+		}
+		if len(asset) > MaxCoveredAssetLength {
+			return fmt.Errorf("covered asset exceeds %d characters: %s", MaxCoveredAssetLength, asset)
+		}
+		if seen[asset] {
+			return fmt.Errorf("duplicate covered asset: %s", asset)
+		}
+		seen[asset] = true
+	}
+	return nil
+}
@@ -3,7 +3,7 @@ package types
 import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 var (
-	ErrNotShieldAdmin             = sdkerrors.Register(ModuleName, 101, "not the shield admin account")
+	ErrNotShieldAdmin             = sdkerrors.Register(ModuleName, 101, "not a shield pool operator")
 	ErrNoDeposit                  = sdkerrors.Register(ModuleName, 102, "no coins given for initial deposit")
 	ErrNoShield                   = sdkerrors.Register(ModuleName, 103, "no coins given for shield")
 	ErrEmptySponsor               = sdkerrors.Register(ModuleName, 104, "no sponsor specified for a pool")
@@ -44,4 +44,18 @@ var (
 	ErrShieldAdminNotActive       = sdkerrors.Register(ModuleName, 139, "shield admin is not activated")
 	ErrPurchaseTooSmall           = sdkerrors.Register(ModuleName, 140, "purchase amount is too small")
 	ErrNotEnoughStaked            = sdkerrors.Register(ModuleName, 142, "not enough unlocked staking to be withdrawn")
+	ErrOverAllocate               = sdkerrors.Register(ModuleName, 143, "allocation exceeds available collateral")
+	ErrNoAllocationFound          = sdkerrors.Register(ModuleName, 144, "no allocation found for the pool and provider")
+	ErrOverDeallocate             = sdkerrors.Register(ModuleName, 145, "deallocation exceeds allocated collateral")
+	ErrAllocationInUse            = sdkerrors.Register(ModuleName, 146, "remaining allocation cannot cover the pool shield")
+	ErrNoVestedReimbursement      = sdkerrors.Register(ModuleName, 147, "no vested reimbursement to be withdrawn")
+	ErrNotPoolManager             = sdkerrors.Register(ModuleName, 148, "not a shield pool operator or a certified pool creator of the sponsor")
+	ErrPoolCreatorLimitExceeded   = sdkerrors.Register(ModuleName, 149, "pool exceeds the limits for certified pool creators")
+	ErrNoEpochSnapshot            = sdkerrors.Register(ModuleName, 150, "no epoch snapshot in the window")
+	ErrInvalidShieldRoles         = sdkerrors.Register(ModuleName, 151, "invalid shield roles")
+	ErrInvalidCoverageTerms       = sdkerrors.Register(ModuleName, 152, "invalid coverage terms")
+	ErrClaimInWaitingPeriod       = sdkerrors.Register(ModuleName, 153, "claim is within the waiting period of the purchase")
+	ErrLossWithinDeductible       = sdkerrors.Register(ModuleName, 154, "loss does not exceed the deductible of the pool")
+	ErrClaimNotFound              = sdkerrors.Register(ModuleName, 155, "claim not found")
+	ErrInvalidCoveredAssets       = sdkerrors.Register(ModuleName, 156, "invalid covered assets")
 )
@@ -2,6 +2,11 @@ package types
 
 const (
 	EventTypeCreateReimbursement = "create_reimbursement"
+	EventTypeSlashCollateral     = "slash_collateral"
+	EventTypePausePool           = "pause_pool"
+	EventTypeCompoundRewards     = "compound_rewards"
+	EventTypeUpdateShieldRoles   = "update_shield_roles"
+	EventTypeCompleteUnstaking   = "complete_unstaking"
 
 	AttributeKeyShield              = "shield"
 	AttributeKeyDeposit             = "deposit"
@@ -22,5 +27,18 @@ const (
 	AttributeKeyPurchaseDescription = "purchase_description"
 	AttributeKeyServiceFees         = "service_fees"
 	AttributeKeyProtectionEndTime   = "protection_end_time"
+	AttributeKeyValidator           = "validator"
+	AttributeKeyEnabled             = "enabled"
+	AttributeKeyCompounded          = "compounded"
+	AttributeKeyPoolOperators       = "pool_operators"
+	AttributeKeyPausers             = "pausers"
+	AttributeKeyClaimManagers       = "claim_managers"
+	AttributeKeyDeductibleAmount    = "deductible_amount"
+	AttributeKeyDeductibleRate      = "deductible_rate"
+	AttributeKeyMaxClaim            = "max_claim"
+	AttributeKeyWaitingPeriod       = "waiting_period"
+	AttributeKeyLoss                = "loss"
+	AttributeKeyCoveredAssets       = "covered_assets"
+	AttributeKeyPurchaser           = "purchaser"
 	AttributeValueCategory          = ModuleName
 )
@@ -12,6 +12,8 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -42,6 +44,7 @@ type StakingKeeper interface {
 	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
 	GetAllUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.UnbondingDelegation
 	GetUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.UnbondingDelegation, bool)
+	GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.UnbondingDelegation
 	SetUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
 	RemoveUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
 	GetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPairs []stakingtypes.DVPair)
@@ -59,6 +62,8 @@ type StakingKeeper interface {
 	DeleteValidatorByPowerIndex(ctx sdk.Context, validator stakingtypes.Validator)
 	RemoveDelegation(ctx sdk.Context, delegation stakingtypes.Delegation)
 	RemoveValidator(ctx sdk.Context, address sdk.ValAddress)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
 
 	BondDenom(sdk.Context) string
 	UnbondingTime(sdk.Context) time.Duration
@@ -85,3 +90,8 @@ type BankKeeper interface {
 type GovKeeper interface {
 	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
 }
+
+// CertKeeper defines the expected cert keeper.
+type CertKeeper interface {
+	IsCertified(ctx sdk.Context, requestContentType string, content string, certType string) bool
+}
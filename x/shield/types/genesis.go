@@ -10,12 +10,13 @@ import (
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(shieldAdmin sdk.AccAddress, nextPoolID, nextPurchaseID uint64, poolParams PoolParams,
+func NewGenesisState(nextPoolID, nextPurchaseID uint64, poolParams PoolParams,
 	claimProposalParams ClaimProposalParams, totalCollateral, totalWithdrawing, totalShield, totalClaimed sdk.Int, serviceFees, remainingServiceFees MixedDecCoins,
 	pools []Pool, providers []Provider, purchase []PurchaseList, withdraws []Withdraw, lastUpdateTime time.Time, sSRate sdk.Dec, globalStakingPool sdk.Int,
-	stakingPurchases []ShieldStaking, originalStaking []OriginalStaking, proposalIDReimbursementPairs []ProposalIDReimbursementPair) GenesisState {
+	stakingPurchases []ShieldStaking, originalStaking []OriginalStaking, proposalIDReimbursementPairs []ProposalIDReimbursementPair, allocations []Allocation,
+	rewardIndex, outstandingRewards MixedDecCoins, epochSnapshots []EpochSnapshot, shieldRoles ShieldRoles, claims []Claim,
+	unstakeCooldownPeriod time.Duration, stakingRewardIndex MixedDecCoins, unstakings []Unstaking, stakingRewardRate sdk.Dec) GenesisState {
 	return GenesisState{
-		ShieldAdmin:                  shieldAdmin.String(),
 		NextPoolId:                   nextPoolID,
 		NextPurchaseId:               nextPurchaseID,
 		PoolParams:                   poolParams,
@@ -36,24 +37,39 @@ func NewGenesisState(shieldAdmin sdk.AccAddress, nextPoolID, nextPurchaseID uint
 		StakeForShields:              stakingPurchases,
 		OriginalStakings:             originalStaking,
 		ProposalIDReimbursementPairs: proposalIDReimbursementPairs,
+		Allocations:                  allocations,
+		RewardIndex:                  rewardIndex,
+		OutstandingRewards:           outstandingRewards,
+		EpochSnapshots:               epochSnapshots,
+		ShieldRoles:                  shieldRoles,
+		Claims:                       claims,
+		UnstakeCooldownPeriod:        unstakeCooldownPeriod,
+		StakingRewardIndex:           stakingRewardIndex,
+		Unstakings:                   unstakings,
+		StakingRewardRate:            stakingRewardRate,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		NextPoolId:           uint64(1),
-		NextPurchaseId:       uint64(1),
-		PoolParams:           DefaultPoolParams(),
-		ClaimProposalParams:  DefaultClaimProposalParams(),
-		TotalCollateral:      sdk.ZeroInt(),
-		TotalWithdrawing:     sdk.ZeroInt(),
-		TotalShield:          sdk.ZeroInt(),
-		TotalClaimed:         sdk.ZeroInt(),
-		ServiceFees:          InitMixedDecCoins(),
-		RemainingServiceFees: InitMixedDecCoins(),
-		ShieldStakingRate:    sdk.NewDec(2),
-		LastUpdateTime:       time.Now(),
+		NextPoolId:            uint64(1),
+		NextPurchaseId:        uint64(1),
+		PoolParams:            DefaultPoolParams(),
+		ClaimProposalParams:   DefaultClaimProposalParams(),
+		TotalCollateral:       sdk.ZeroInt(),
+		TotalWithdrawing:      sdk.ZeroInt(),
+		TotalShield:           sdk.ZeroInt(),
+		TotalClaimed:          sdk.ZeroInt(),
+		ServiceFees:           InitMixedDecCoins(),
+		RemainingServiceFees:  InitMixedDecCoins(),
+		RewardIndex:           InitMixedDecCoins(),
+		OutstandingRewards:    InitMixedDecCoins(),
+		ShieldStakingRate:     sdk.NewDec(2),
+		UnstakeCooldownPeriod: DefaultUnstakeCooldownPeriod,
+		StakingRewardIndex:    InitMixedDecCoins(),
+		StakingRewardRate:     DefaultStakingRewardRate,
+		LastUpdateTime:        time.Now(),
 	}
 }
 
@@ -68,6 +84,36 @@ func ValidateGenesis(data GenesisState) error {
 	if err := validateClaimProposalParams(data.ClaimProposalParams); err != nil {
 		return fmt.Errorf("failed to validate %s claim proposal params: %w", ModuleName, err)
 	}
+	if err := validateStakingShieldRateParams(data.ShieldStakingRate); err != nil {
+		return fmt.Errorf("failed to validate %s staking shield rate: %w", ModuleName, err)
+	}
+	if err := validateUnstakeCooldownPeriod(data.UnstakeCooldownPeriod); err != nil {
+		return fmt.Errorf("failed to validate %s unstake cooldown period: %w", ModuleName, err)
+	}
+	if err := validateStakingRewardRate(data.StakingRewardRate); err != nil {
+		return fmt.Errorf("failed to validate %s staking reward rate: %w", ModuleName, err)
+	}
+	if data.ShieldAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(data.ShieldAdmin); err != nil {
+			return fmt.Errorf("failed to validate %s shield admin: %w", ModuleName, err)
+		}
+	}
+	if err := data.ShieldRoles.Validate(); err != nil {
+		return fmt.Errorf("failed to validate %s shield roles: %w", ModuleName, err)
+	}
+	if len(data.EpochSnapshots) > MaxEpochSnapshots {
+		return fmt.Errorf("failed to validate %s genesis state: more than %d epoch snapshots", ModuleName, MaxEpochSnapshots)
+	}
+	for i := 1; i < len(data.EpochSnapshots); i++ {
+		if data.EpochSnapshots[i].Epoch <= data.EpochSnapshots[i-1].Epoch {
+			return fmt.Errorf("failed to validate %s genesis state: epoch snapshots must be in ascending epoch order", ModuleName)
+		}
+	}
+	for _, claim := range data.Claims {
+		if _, err := sdk.AccAddressFromBech32(claim.Purchaser); err != nil {
+			return fmt.Errorf("failed to validate %s claim %d: %w", ModuleName, claim.ProposalId, err)
+		}
+	}
 
 	return nil
 }
@@ -53,6 +53,16 @@ type GenesisState struct {
 	StakeForShields              []ShieldStaking                        `protobuf:"bytes,19,rep,name=stake_for_shields,json=stakeForShields,proto3" json:"stake_for_shields" yaml:"stake_for_shields"`
 	OriginalStakings             []OriginalStaking                      `protobuf:"bytes,20,rep,name=original_stakings,json=originalStakings,proto3" json:"original_stakings" yaml:"original_stakings"`
 	ProposalIDReimbursementPairs []ProposalIDReimbursementPair          `protobuf:"bytes,21,rep,name=proposalID_reimbursement_pairs,json=proposalIDReimbursementPairs,proto3" json:"proposalID_reimbursement_pairs" yaml:"proposalID_reimbursement_pairs"`
+	Allocations                  []Allocation                           `protobuf:"bytes,22,rep,name=allocations,proto3" json:"allocations" yaml:"allocations"`
+	RewardIndex                  MixedDecCoins                          `protobuf:"bytes,23,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+	OutstandingRewards           MixedDecCoins                          `protobuf:"bytes,24,opt,name=outstanding_rewards,json=outstandingRewards,proto3" json:"outstanding_rewards" yaml:"outstanding_rewards"`
+	EpochSnapshots               []EpochSnapshot                        `protobuf:"bytes,25,rep,name=epoch_snapshots,json=epochSnapshots,proto3" json:"epoch_snapshots" yaml:"epoch_snapshots"`
+	ShieldRoles                  ShieldRoles                            `protobuf:"bytes,26,opt,name=shield_roles,json=shieldRoles,proto3" json:"shield_roles" yaml:"shield_roles"`
+	Claims                       []Claim                                `protobuf:"bytes,27,rep,name=claims,proto3" json:"claims" yaml:"claims"`
+	UnstakeCooldownPeriod        time.Duration                          `protobuf:"bytes,28,opt,name=unstake_cooldown_period,json=unstakeCooldownPeriod,proto3,stdduration" json:"unstake_cooldown_period" yaml:"unstake_cooldown_period"`
+	StakingRewardIndex           MixedDecCoins                          `protobuf:"bytes,29,opt,name=staking_reward_index,json=stakingRewardIndex,proto3" json:"staking_reward_index" yaml:"staking_reward_index"`
+	Unstakings                   []Unstaking                            `protobuf:"bytes,30,rep,name=unstakings,proto3" json:"unstakings" yaml:"unstakings"`
+	StakingRewardRate            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,31,opt,name=staking_reward_rate,json=stakingRewardRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_reward_rate" yaml:"staking_reward_rate"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -165,9 +175,11 @@ func (m *ProposalIDReimbursementPair) XXX_DiscardUnknown() {
 var xxx_messageInfo_ProposalIDReimbursementPair proto.InternalMessageInfo
 
 type Reimbursement struct {
-	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	Beneficiary string                                   `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty" yaml:"beneficiary"`
-	PayoutTime  time.Time                                `protobuf:"bytes,3,opt,name=payout_time,json=payoutTime,proto3,stdtime" json:"payout_time" yaml:"payout_time"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Beneficiary    string                                   `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty" yaml:"beneficiary"`
+	PayoutTime     time.Time                                `protobuf:"bytes,3,opt,name=payout_time,json=payoutTime,proto3,stdtime" json:"payout_time" yaml:"payout_time"`
+	VestingEndTime time.Time                                `protobuf:"bytes,4,opt,name=vesting_end_time,json=vestingEndTime,proto3,stdtime" json:"vesting_end_time" yaml:"vesting_end_time"`
+	Withdrawn      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn" yaml:"withdrawn"`
 }
 
 func (m *Reimbursement) Reset()         { *m = Reimbursement{} }
@@ -205,11 +217,13 @@ var xxx_messageInfo_Reimbursement proto.InternalMessageInfo
 
 // PoolParams defines the parameters for the shield pool.
 type PoolParams struct {
-	ProtectionPeriod  time.Duration                            `protobuf:"bytes,1,opt,name=protection_period,json=protectionPeriod,proto3,stdduration" json:"protection_period" yaml:"protection_period"`
-	ShieldFeesRate    github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=shield_fees_rate,json=shieldFeesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shield_fees_rate" yaml:"shield_fees_rate"`
-	WithdrawPeriod    time.Duration                            `protobuf:"bytes,3,opt,name=withdraw_period,json=withdrawPeriod,proto3,stdduration" json:"withdraw_period" yaml:"withdraw_period"`
-	PoolShieldLimit   github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=pool_shield_limit,json=poolShieldLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_shield_limit" yaml:"pool_shield_limit"`
-	MinShieldPurchase github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_shield_purchase,json=minShieldPurchase,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_shield_purchase"`
+	ProtectionPeriod       time.Duration                            `protobuf:"bytes,1,opt,name=protection_period,json=protectionPeriod,proto3,stdduration" json:"protection_period" yaml:"protection_period"`
+	ShieldFeesRate         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=shield_fees_rate,json=shieldFeesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shield_fees_rate" yaml:"shield_fees_rate"`
+	WithdrawPeriod         time.Duration                            `protobuf:"bytes,3,opt,name=withdraw_period,json=withdrawPeriod,proto3,stdduration" json:"withdraw_period" yaml:"withdraw_period"`
+	PoolShieldLimit        github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=pool_shield_limit,json=poolShieldLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_shield_limit" yaml:"pool_shield_limit"`
+	MinShieldPurchase      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_shield_purchase,json=minShieldPurchase,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_shield_purchase"`
+	PoolCreatorShieldLimit github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,6,opt,name=pool_creator_shield_limit,json=poolCreatorShieldLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_creator_shield_limit" yaml:"pool_creator_shield_limit"`
+	PoolCreatorMinFeesRate github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,7,opt,name=pool_creator_min_fees_rate,json=poolCreatorMinFeesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_creator_min_fees_rate" yaml:"pool_creator_min_fees_rate"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -247,11 +261,13 @@ var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
 // ClaimProposalParams defines the parameters for the shield claim proposals.
 type ClaimProposalParams struct {
-	ClaimPeriod  time.Duration                            `protobuf:"bytes,1,opt,name=claim_period,json=claimPeriod,proto3,stdduration" json:"claim_period" yaml:"claim_period"`
-	PayoutPeriod time.Duration                            `protobuf:"bytes,2,opt,name=payout_period,json=payoutPeriod,proto3,stdduration" json:"payout_period" yaml:"payout_period"`
-	MinDeposit   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
-	DepositRate  github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=deposit_rate,json=depositRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_rate" yaml:"deposit_rate"`
-	FeesRate     github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=fees_rate,json=feesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees_rate" yaml:"fees_rate"`
+	ClaimPeriod      time.Duration                            `protobuf:"bytes,1,opt,name=claim_period,json=claimPeriod,proto3,stdduration" json:"claim_period" yaml:"claim_period"`
+	PayoutPeriod     time.Duration                            `protobuf:"bytes,2,opt,name=payout_period,json=payoutPeriod,proto3,stdduration" json:"payout_period" yaml:"payout_period"`
+	MinDeposit       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+	DepositRate      github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=deposit_rate,json=depositRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_rate" yaml:"deposit_rate"`
+	FeesRate         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=fees_rate,json=feesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees_rate" yaml:"fees_rate"`
+	VestingPeriod    time.Duration                            `protobuf:"bytes,6,opt,name=vesting_period,json=vestingPeriod,proto3,stdduration" json:"vesting_period" yaml:"vesting_period"`
+	VestingThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=vesting_threshold,json=vestingThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting_threshold" yaml:"vesting_threshold"`
 }
 
 func (m *ClaimProposalParams) Reset()         { *m = ClaimProposalParams{} }
@@ -301,101 +317,129 @@ func init() {
 }
 
 var fileDescriptor_c089c09a119aaa04 = []byte{
-	// 1493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xe6, 0x47, 0xbf, 0xcd, 0xd8, 0x49, 0xec, 0x71, 0x9a, 0xee, 0x37, 0x0d, 0x76, 0x34,
-	0x6d, 0x21, 0x12, 0xaa, 0x4d, 0xda, 0x03, 0xd0, 0x0b, 0xc2, 0x49, 0x0b, 0x81, 0x22, 0xa2, 0x0d,
-	0xa8, 0x08, 0x84, 0x96, 0xb1, 0x77, 0xe2, 0x8c, 0xb2, 0xbb, 0xb3, 0xda, 0x19, 0xa7, 0x8d, 0xe8,
-	0x09, 0x09, 0x89, 0x63, 0x2f, 0x48, 0x70, 0xeb, 0x11, 0x21, 0xf1, 0x7f, 0x54, 0xe2, 0xd2, 0x0b,
-	0x08, 0x71, 0x48, 0x51, 0x7b, 0xe1, 0x9c, 0xbf, 0x00, 0xcd, 0x8f, 0xf5, 0xce, 0x26, 0x76, 0x5a,
-	0x4b, 0x3d, 0x25, 0xf3, 0xe6, 0xbd, 0xcf, 0x67, 0xde, 0x9b, 0xf7, 0xde, 0xbc, 0x35, 0xb8, 0xc2,
-	0xf7, 0x48, 0x2c, 0xfa, 0x2d, 0xbe, 0x47, 0x49, 0x18, 0xb4, 0x0e, 0xd6, 0x71, 0x98, 0xec, 0xe1,
-	0xf5, 0x56, 0x8f, 0xc4, 0x84, 0x53, 0xde, 0x4c, 0x52, 0x26, 0x18, 0x5c, 0xd2, 0x5a, 0x4d, 0xad,
-	0xd5, 0xcc, 0xb4, 0x96, 0x17, 0x7b, 0xac, 0xc7, 0x94, 0x4a, 0x4b, 0xfe, 0xa7, 0xb5, 0x97, 0xeb,
-	0x5d, 0xc6, 0x23, 0xc6, 0x5b, 0x1d, 0xcc, 0x49, 0xeb, 0x60, 0xbd, 0x43, 0x04, 0x5e, 0x6f, 0x75,
-	0x19, 0x8d, 0xcd, 0x7e, 0xa3, 0xc7, 0x58, 0x2f, 0x24, 0x2d, 0xb5, 0xea, 0xf4, 0x77, 0x5b, 0x82,
-	0x46, 0x84, 0x0b, 0x1c, 0x25, 0x19, 0xc0, 0x49, 0x85, 0xa0, 0x9f, 0x62, 0x41, 0x59, 0x06, 0x30,
-	0x9c, 0xf6, 0xf2, 0x08, 0x57, 0xcc, 0xa1, 0x95, 0x12, 0xfa, 0xa3, 0x0a, 0xca, 0x1f, 0x68, 0xdf,
-	0x76, 0x04, 0x16, 0x04, 0xde, 0x04, 0x65, 0xad, 0xe0, 0xe3, 0x20, 0xa2, 0xb1, 0xeb, 0xac, 0x3a,
-	0x6b, 0xb3, 0xed, 0x8b, 0xc7, 0x47, 0x8d, 0xda, 0x21, 0x8e, 0xc2, 0x9b, 0xc8, 0xde, 0x45, 0x5e,
-	0x49, 0x2f, 0xdf, 0x97, 0x2b, 0xf8, 0x2e, 0x28, 0xc7, 0xe4, 0xbe, 0xf0, 0x13, 0xc6, 0x42, 0x9f,
-	0x06, 0xee, 0xe4, 0xaa, 0xb3, 0x36, 0x6d, 0xdb, 0xda, 0xbb, 0xc8, 0x03, 0x72, 0xb9, 0xcd, 0x58,
-	0xb8, 0x15, 0xc0, 0x5b, 0xa0, 0xa2, 0x37, 0xfb, 0x69, 0x77, 0x0f, 0x73, 0x22, 0xcd, 0xa7, 0x94,
-	0xf9, 0xa5, 0xe3, 0xa3, 0xc6, 0x45, 0xdb, 0x3c, 0xd7, 0x40, 0xde, 0xbc, 0x82, 0x30, 0x92, 0xad,
-	0x00, 0xfa, 0xa0, 0xa4, 0xe0, 0x13, 0x9c, 0xe2, 0x88, 0xbb, 0xd3, 0xab, 0xce, 0x5a, 0xe9, 0x3a,
-	0x6a, 0x0e, 0xbf, 0xae, 0xa6, 0xe4, 0xde, 0x56, 0x9a, 0xed, 0xe5, 0xc7, 0x47, 0x8d, 0x89, 0xe3,
-	0xa3, 0x06, 0xd4, 0x4c, 0x16, 0x08, 0xf2, 0x40, 0x32, 0xd0, 0x83, 0xdf, 0x3b, 0xe0, 0x42, 0x37,
-	0xc4, 0x34, 0xf2, 0x93, 0x94, 0x25, 0x8c, 0xe3, 0x01, 0xd7, 0x8c, 0xe2, 0x7a, 0x73, 0x14, 0xd7,
-	0x86, 0x34, 0xda, 0x36, 0x36, 0x86, 0xf4, 0x8a, 0x21, 0x5d, 0xd1, 0xa4, 0x43, 0x71, 0x91, 0x57,
-	0xeb, 0x9e, 0x36, 0x85, 0x02, 0x54, 0x04, 0x13, 0x38, 0xf4, 0xbb, 0x2c, 0x0c, 0xb1, 0x20, 0x29,
-	0x0e, 0xdd, 0x73, 0xea, 0xaa, 0xb6, 0x24, 0xe8, 0xdf, 0x47, 0x8d, 0xd7, 0x7b, 0x54, 0xec, 0xf5,
-	0x3b, 0xcd, 0x2e, 0x8b, 0x5a, 0x26, 0x01, 0xf5, 0x9f, 0x6b, 0x3c, 0xd8, 0x6f, 0x89, 0xc3, 0x84,
-	0xf0, 0xe6, 0x56, 0x2c, 0xf2, 0xe8, 0x9e, 0xc4, 0x43, 0xde, 0x82, 0x12, 0x6d, 0x0c, 0x24, 0xf0,
-	0x1e, 0xa8, 0x6a, 0xad, 0x7b, 0x54, 0xec, 0x05, 0x29, 0xbe, 0x47, 0xe3, 0x9e, 0xfb, 0x3f, 0x45,
-	0xfb, 0xd1, 0xd8, 0xb4, 0xae, 0x4d, 0x6b, 0x01, 0x22, 0x4f, 0xbb, 0x76, 0x37, 0x17, 0xc1, 0x3d,
-	0x50, 0xd6, 0x7a, 0x3a, 0xac, 0xee, 0x79, 0xc5, 0x79, 0x6b, 0x6c, 0xce, 0x9a, 0xcd, 0xa9, 0xb1,
-	0x90, 0x57, 0x52, 0xcb, 0x1d, 0xb5, 0x82, 0xfb, 0x60, 0xce, 0x04, 0x42, 0x46, 0x9d, 0x04, 0xee,
-	0xac, 0xa2, 0xba, 0x3d, 0x36, 0xd5, 0x62, 0x21, 0xaa, 0x1a, 0x0c, 0x79, 0xda, 0x8d, 0x0d, 0xbd,
-	0x84, 0x04, 0x94, 0x39, 0x49, 0x0f, 0x68, 0x97, 0xf8, 0xbb, 0x84, 0x70, 0x17, 0xa8, 0x1c, 0xba,
-	0x3a, 0x2a, 0x87, 0x3e, 0xa1, 0xf7, 0x49, 0xb0, 0x49, 0xba, 0x1b, 0x8c, 0xc6, 0xbc, 0x7d, 0xc9,
-	0x64, 0x4f, 0x56, 0x97, 0x16, 0x90, 0xac, 0x4b, 0xbd, 0xbc, 0x4d, 0x08, 0x87, 0xdf, 0x39, 0x60,
-	0x29, 0x25, 0x11, 0xa6, 0x31, 0x8d, 0x7b, 0x7e, 0x81, 0xb1, 0x34, 0x0e, 0xe3, 0x55, 0xc3, 0xf8,
-	0x9a, 0x66, 0x1c, 0x0e, 0x89, 0xbc, 0xc5, 0xc1, 0xc6, 0x8e, 0x75, 0x88, 0x0f, 0xc1, 0x8c, 0xac,
-	0x23, 0xee, 0x96, 0x57, 0xa7, 0xd6, 0x4a, 0xd7, 0x57, 0xce, 0x2a, 0xca, 0xf6, 0xa2, 0x61, 0x2a,
-	0xe7, 0xe5, 0xc8, 0x91, 0xa7, 0x01, 0xe0, 0x17, 0x60, 0x36, 0x49, 0xd9, 0x01, 0x0d, 0x48, 0xca,
-	0xdd, 0x39, 0x85, 0xb6, 0x3a, 0x12, 0xcd, 0x28, 0xb6, 0x5d, 0x83, 0x58, 0x31, 0x88, 0x19, 0x00,
-	0xf2, 0x72, 0x30, 0x48, 0xc0, 0xfc, 0xa0, 0xbd, 0x84, 0x94, 0x0b, 0xee, 0xce, 0x2b, 0xf8, 0x2b,
-	0x23, 0xe1, 0x8d, 0xf6, 0x1d, 0xca, 0xc5, 0x29, 0x0a, 0xb3, 0xc7, 0x91, 0x37, 0x97, 0x58, 0x7a,
-	0xca, 0x81, 0x2c, 0xdf, 0xb9, 0xbb, 0x70, 0xb6, 0x03, 0x59, 0x15, 0x9c, 0x44, 0x1f, 0x00, 0x20,
-	0x2f, 0x07, 0x83, 0x14, 0x54, 0x42, 0xcc, 0x85, 0xdf, 0x4f, 0x02, 0x2c, 0x88, 0x2f, 0x1f, 0x12,
-	0xb7, 0xa2, 0xae, 0x78, 0xb9, 0xa9, 0x1f, 0x91, 0x66, 0xf6, 0x88, 0x34, 0x3f, 0xcb, 0x5e, 0x99,
-	0xf6, 0x65, 0x03, 0x6d, 0x1a, 0xc1, 0x49, 0x04, 0xf4, 0xf0, 0x69, 0xc3, 0xf1, 0xe6, 0xa5, 0xf8,
-	0x73, 0x25, 0x95, 0x96, 0xf0, 0x01, 0xa8, 0x99, 0xa7, 0x80, 0x0b, 0xbc, 0x2f, 0xb3, 0x20, 0xc5,
-	0x82, 0xb8, 0x55, 0x55, 0x2e, 0x77, 0xc6, 0x28, 0x97, 0x4d, 0xd2, 0x3d, 0x3e, 0x6a, 0x2c, 0x17,
-	0x5e, 0x17, 0x1b, 0x12, 0x79, 0x55, 0x2d, 0xdd, 0xd1, 0x42, 0x4f, 0x3e, 0x53, 0x0f, 0x40, 0xad,
-	0x17, 0xb2, 0x8e, 0xac, 0x62, 0xa3, 0x2a, 0x73, 0xc3, 0x85, 0x63, 0xb3, 0xeb, 0x62, 0x35, 0xec,
-	0x43, 0x20, 0x91, 0x57, 0xd5, 0x52, 0xc3, 0x2e, 0xd3, 0x13, 0x72, 0x50, 0x95, 0x3a, 0xc4, 0xdf,
-	0x65, 0xa9, 0x69, 0x23, 0xdc, 0xad, 0xa9, 0x8b, 0x1c, 0x59, 0x4a, 0x3b, 0xb6, 0x0f, 0xed, 0x55,
-	0x13, 0x72, 0xd3, 0x04, 0x4f, 0xa1, 0x21, 0x6f, 0x41, 0xc9, 0x6e, 0xb3, 0x54, 0x1b, 0x72, 0x78,
-	0x00, 0xaa, 0x2c, 0xa5, 0x3d, 0x1a, 0xe7, 0x27, 0xe4, 0xee, 0xa2, 0x22, 0x7d, 0x63, 0x14, 0xe9,
-	0xa7, 0xc6, 0x60, 0x04, 0xed, 0x29, 0x3c, 0xe4, 0x55, 0x58, 0xd1, 0x84, 0xc3, 0x5f, 0x1c, 0x50,
-	0xcf, 0x1e, 0xa5, 0xad, 0x4d, 0x3f, 0x25, 0x34, 0xea, 0xf4, 0x53, 0x4e, 0x22, 0x12, 0x0b, 0x3f,
-	0xc1, 0x34, 0xe5, 0xee, 0x05, 0x75, 0x8a, 0x1b, 0x67, 0x14, 0xa1, 0xb1, 0xf6, 0x6c, 0xe3, 0x6d,
-	0x4c, 0xd3, 0xf6, 0x35, 0x73, 0xa2, 0xab, 0x83, 0xba, 0x3c, 0x83, 0x08, 0x79, 0x2b, 0xc9, 0x68,
-	0x2c, 0x7e, 0xf3, 0xfc, 0x0f, 0x8f, 0x1a, 0x13, 0xff, 0x3e, 0x6a, 0x4c, 0xa0, 0xdf, 0x1c, 0xb0,
-	0x70, 0xc2, 0x79, 0xf8, 0x36, 0x28, 0xd9, 0xe3, 0x85, 0xa3, 0xc6, 0x8b, 0x25, 0xeb, 0xd1, 0xb7,
-	0x27, 0x0b, 0x90, 0xe4, 0x53, 0xc5, 0x5d, 0x70, 0x0e, 0x47, 0xac, 0x1f, 0x0b, 0x35, 0xd1, 0xcc,
-	0xb6, 0xdf, 0x1b, 0x3b, 0xbf, 0xe6, 0x34, 0x83, 0x46, 0x41, 0x9e, 0x81, 0xb3, 0xce, 0xfb, 0xbb,
-	0x03, 0x2e, 0x9d, 0x11, 0x26, 0x75, 0xf6, 0x6c, 0x30, 0x18, 0x7a, 0xf6, 0x7c, 0x53, 0x9e, 0x3d,
-	0x43, 0x0a, 0x20, 0x05, 0x73, 0x85, 0x40, 0x2a, 0x17, 0xce, 0x48, 0xd3, 0x02, 0x75, 0x7b, 0xc5,
-	0xdc, 0xce, 0x62, 0xd6, 0xf1, 0xad, 0x4d, 0xe4, 0x15, 0x91, 0x2d, 0x6f, 0x7e, 0x9c, 0x04, 0x73,
-	0x05, 0x20, 0xd8, 0x1d, 0x84, 0xd0, 0x51, 0xb9, 0xf2, 0xff, 0xa6, 0x8e, 0x54, 0x53, 0x0e, 0xc5,
-	0x4d, 0x33, 0x14, 0x37, 0xe5, 0x33, 0xd3, 0x7e, 0x4b, 0x72, 0xfe, 0xfa, 0xb4, 0xb1, 0xf6, 0x12,
-	0xd1, 0x55, 0xef, 0x52, 0x16, 0x4e, 0xf8, 0x0e, 0x28, 0x75, 0x48, 0x4c, 0x76, 0x69, 0x97, 0xe2,
-	0xf4, 0xd0, 0x5c, 0x96, 0x15, 0x24, 0x6b, 0x13, 0x79, 0xb6, 0x2a, 0xfc, 0x0a, 0x94, 0x12, 0x7c,
-	0xc8, 0xfa, 0x42, 0xb7, 0xcc, 0xa9, 0x17, 0xb6, 0xcc, 0xfa, 0x89, 0x79, 0x31, 0x37, 0xd6, 0xdd,
-	0x12, 0x68, 0x89, 0x34, 0xb0, 0xe2, 0xf2, 0xe7, 0x34, 0x00, 0xf9, 0xd0, 0x09, 0x43, 0x50, 0x95,
-	0xd0, 0xa4, 0x2b, 0x67, 0x79, 0x3f, 0x21, 0x29, 0x65, 0xfa, 0x6a, 0x65, 0x7c, 0x4e, 0x72, 0x6f,
-	0x9a, 0x99, 0x7f, 0x30, 0x35, 0xba, 0x83, 0x9b, 0x2f, 0x22, 0xa0, 0x9f, 0xe4, 0x01, 0x2a, 0xb9,
-	0x7c, 0x5b, 0x89, 0x21, 0x07, 0x15, 0xd3, 0x5d, 0xe5, 0x33, 0xad, 0xbb, 0xf5, 0xe4, 0xd8, 0x23,
-	0xa3, 0xee, 0xd6, 0x17, 0x0b, 0xdd, 0x7a, 0x80, 0x87, 0xbc, 0x79, 0x2d, 0x92, 0x2f, 0xbe, 0xea,
-	0xd3, 0xbb, 0x60, 0x21, 0x7b, 0x9d, 0x32, 0x07, 0xa7, 0x5e, 0xe4, 0x20, 0x32, 0x0e, 0x2e, 0x15,
-	0x5f, 0xba, 0x82, 0x7b, 0xf3, 0x99, 0xd4, 0x38, 0x77, 0x00, 0xaa, 0x6a, 0x66, 0x37, 0x27, 0x0a,
-	0x69, 0x44, 0x85, 0x1a, 0xff, 0xc7, 0x9b, 0x4c, 0xb5, 0x77, 0xae, 0xf5, 0x11, 0x60, 0x03, 0x22,
-	0x6f, 0x41, 0xca, 0x74, 0x43, 0xbe, 0x23, 0x25, 0xf0, 0x5b, 0x50, 0x8b, 0x68, 0x9c, 0x69, 0x65,
-	0x3d, 0xc3, 0x9d, 0x79, 0xf5, 0x49, 0x5e, 0x8d, 0x68, 0xac, 0x99, 0xb3, 0xa1, 0xc3, 0x4a, 0xac,
-	0x9f, 0xa7, 0x41, 0x6d, 0xc8, 0x17, 0x06, 0xfc, 0x1a, 0x94, 0xcd, 0x57, 0xc5, 0x4b, 0x26, 0x57,
-	0xa3, 0x38, 0x54, 0xda, 0xc6, 0x3a, 0xf0, 0x25, 0xfd, 0x35, 0xa2, 0xa3, 0xfe, 0x0d, 0x98, 0x33,
-	0x99, 0x6f, 0xf0, 0x27, 0x5f, 0x84, 0xbf, 0x5a, 0x6c, 0x28, 0x05, 0x6b, 0x4d, 0x50, 0xd6, 0x32,
-	0xc3, 0x10, 0x82, 0x92, 0x8c, 0x6f, 0x40, 0x12, 0xc6, 0xa9, 0x70, 0xa7, 0x5e, 0x7d, 0x5c, 0x41,
-	0x44, 0xe3, 0x4d, 0x0d, 0x2f, 0x3f, 0x33, 0x0c, 0x93, 0x2e, 0x8f, 0xe9, 0xb1, 0x3f, 0x33, 0x74,
-	0x02, 0x99, 0xe8, 0xd9, 0x58, 0xc8, 0x2b, 0x99, 0xa5, 0xaa, 0x0b, 0x1f, 0xcc, 0xe6, 0x55, 0x38,
-	0xa3, 0x68, 0xda, 0x63, 0xd3, 0x98, 0x51, 0xd0, 0x2a, 0xbf, 0xf3, 0xbb, 0xa6, 0xf0, 0xf2, 0xdc,
-	0x68, 0x7f, 0xfc, 0xf8, 0x59, 0xdd, 0x79, 0xf2, 0xac, 0xee, 0xfc, 0xf3, 0xac, 0xee, 0x3c, 0x7c,
-	0x5e, 0x9f, 0x78, 0xf2, 0xbc, 0x3e, 0xf1, 0xd7, 0xf3, 0xfa, 0xc4, 0x97, 0xeb, 0x36, 0x13, 0x49,
-	0x05, 0xdd, 0xdf, 0x65, 0xfd, 0x38, 0x50, 0x37, 0xd5, 0x32, 0xbf, 0x1e, 0xdc, 0xcf, 0x7e, 0x3f,
-	0x50, 0xc4, 0x9d, 0x73, 0xea, 0x4a, 0x6f, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x39, 0x9b,
-	0xd6, 0x28, 0x11, 0x00, 0x00,
+	// 1937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x24, 0x47,
+	0x19, 0x77, 0xaf, 0xd7, 0xce, 0xba, 0xc6, 0x8f, 0x99, 0x1a, 0x3f, 0xda, 0x8f, 0x9d, 0x99, 0xad,
+	0xdd, 0x05, 0x0b, 0x94, 0x19, 0x9c, 0x1c, 0x80, 0xbd, 0xa0, 0x8c, 0xbd, 0x0b, 0x06, 0x47, 0x58,
+	0xe5, 0x44, 0x41, 0x3c, 0x34, 0x69, 0x77, 0x97, 0x67, 0x4a, 0xdb, 0xd3, 0xd5, 0xea, 0xaa, 0xb1,
+	0x77, 0x61, 0x73, 0x41, 0x42, 0xe2, 0x82, 0xc8, 0x01, 0x10, 0xc7, 0x1c, 0x11, 0x12, 0xff, 0x47,
+	0x24, 0x2e, 0x39, 0x22, 0x0e, 0x0e, 0xda, 0xbd, 0x70, 0xde, 0x13, 0x37, 0xa2, 0x7a, 0xf4, 0x74,
+	0xf5, 0xbc, 0x9c, 0x56, 0x72, 0x9a, 0xa9, 0xaf, 0xbe, 0xef, 0xf7, 0xab, 0xfa, 0xea, 0x7b, 0x54,
+	0x35, 0x78, 0xc0, 0x7b, 0x24, 0x12, 0x83, 0x16, 0xef, 0x51, 0x12, 0x06, 0xad, 0xcb, 0x03, 0x2f,
+	0x8c, 0x7b, 0xde, 0x41, 0xab, 0x4b, 0x22, 0xc2, 0x29, 0x6f, 0xc6, 0x09, 0x13, 0x0c, 0x6e, 0x6a,
+	0xad, 0xa6, 0xd6, 0x6a, 0xa6, 0x5a, 0x3b, 0xeb, 0x5d, 0xd6, 0x65, 0x4a, 0xa5, 0x25, 0xff, 0x69,
+	0xed, 0x9d, 0x9a, 0xcf, 0x78, 0x9f, 0xf1, 0xd6, 0xb9, 0xc7, 0x49, 0xeb, 0xf2, 0xe0, 0x9c, 0x08,
+	0xef, 0xa0, 0xe5, 0x33, 0x1a, 0x99, 0xf9, 0x7a, 0x97, 0xb1, 0x6e, 0x48, 0x5a, 0x6a, 0x74, 0x3e,
+	0xb8, 0x68, 0x09, 0xda, 0x27, 0x5c, 0x78, 0xfd, 0x38, 0x05, 0x18, 0x55, 0x08, 0x06, 0x89, 0x27,
+	0x28, 0x4b, 0x01, 0x26, 0xd3, 0xde, 0x9f, 0xb2, 0x15, 0xb3, 0x68, 0xa5, 0x84, 0xfe, 0xb2, 0x03,
+	0x96, 0x7f, 0xa8, 0xf7, 0x76, 0x26, 0x3c, 0x41, 0xe0, 0x23, 0xb0, 0xac, 0x15, 0x3a, 0x5e, 0xd0,
+	0xa7, 0x91, 0xeb, 0x34, 0x9c, 0xfd, 0xa5, 0xf6, 0xd6, 0xeb, 0xeb, 0x7a, 0xf5, 0xb9, 0xd7, 0x0f,
+	0x1f, 0x21, 0x7b, 0x16, 0xe1, 0x92, 0x1e, 0xbe, 0x23, 0x47, 0xf0, 0xfb, 0x60, 0x39, 0x22, 0xcf,
+	0x44, 0x27, 0x66, 0x2c, 0xec, 0xd0, 0xc0, 0xbd, 0xd5, 0x70, 0xf6, 0x6f, 0xdb, 0xb6, 0xf6, 0x2c,
+	0xc2, 0x40, 0x0e, 0x4f, 0x19, 0x0b, 0x8f, 0x03, 0xf8, 0x18, 0x94, 0xf5, 0xe4, 0x20, 0xf1, 0x7b,
+	0x1e, 0x27, 0xd2, 0x7c, 0x5e, 0x99, 0xef, 0xbe, 0xbe, 0xae, 0x6f, 0xd9, 0xe6, 0x99, 0x06, 0xc2,
+	0xab, 0x0a, 0xc2, 0x48, 0x8e, 0x03, 0xd8, 0x01, 0x25, 0x05, 0x1f, 0x7b, 0x89, 0xd7, 0xe7, 0xee,
+	0xed, 0x86, 0xb3, 0x5f, 0x7a, 0x0b, 0x35, 0x27, 0x1f, 0x57, 0x53, 0x72, 0x9f, 0x2a, 0xcd, 0xf6,
+	0xce, 0xa7, 0xd7, 0xf5, 0xb9, 0xd7, 0xd7, 0x75, 0xa8, 0x99, 0x2c, 0x10, 0x84, 0x41, 0x3c, 0xd4,
+	0x83, 0xbf, 0x73, 0xc0, 0x86, 0x1f, 0x7a, 0xb4, 0xdf, 0x89, 0x13, 0x16, 0x33, 0xee, 0x0d, 0xb9,
+	0x16, 0x14, 0xd7, 0xb7, 0xa7, 0x71, 0x1d, 0x4a, 0xa3, 0x53, 0x63, 0x63, 0x48, 0x1f, 0x18, 0xd2,
+	0x3d, 0x4d, 0x3a, 0x11, 0x17, 0xe1, 0xaa, 0x3f, 0x6e, 0x0a, 0x05, 0x28, 0x0b, 0x26, 0xbc, 0xb0,
+	0xe3, 0xb3, 0x30, 0xf4, 0x04, 0x49, 0xbc, 0xd0, 0x5d, 0x54, 0x47, 0x75, 0x2c, 0x41, 0xff, 0x7d,
+	0x5d, 0xff, 0x46, 0x97, 0x8a, 0xde, 0xe0, 0xbc, 0xe9, 0xb3, 0x7e, 0xcb, 0x04, 0xa0, 0xfe, 0x79,
+	0x93, 0x07, 0x4f, 0x5b, 0xe2, 0x79, 0x4c, 0x78, 0xf3, 0x38, 0x12, 0x99, 0x77, 0x47, 0xf1, 0x10,
+	0x5e, 0x53, 0xa2, 0xc3, 0xa1, 0x04, 0x5e, 0x81, 0x8a, 0xd6, 0xba, 0xa2, 0xa2, 0x17, 0x24, 0xde,
+	0x15, 0x8d, 0xba, 0xee, 0x1b, 0x8a, 0xf6, 0xc7, 0x85, 0x69, 0x5d, 0x9b, 0xd6, 0x02, 0x44, 0x58,
+	0x6f, 0xed, 0x83, 0x4c, 0x04, 0x7b, 0x60, 0x59, 0xeb, 0x69, 0xb7, 0xba, 0x77, 0x14, 0xe7, 0xe3,
+	0xc2, 0x9c, 0x55, 0x9b, 0x53, 0x63, 0x21, 0x5c, 0x52, 0xc3, 0x33, 0x35, 0x82, 0x4f, 0xc1, 0x8a,
+	0x71, 0x84, 0xf4, 0x3a, 0x09, 0xdc, 0x25, 0x45, 0xf5, 0xa4, 0x30, 0xd5, 0x7a, 0xce, 0xab, 0x1a,
+	0x0c, 0x61, 0xbd, 0x8d, 0x43, 0x3d, 0x84, 0x04, 0x2c, 0x73, 0x92, 0x5c, 0x52, 0x9f, 0x74, 0x2e,
+	0x08, 0xe1, 0x2e, 0x50, 0x31, 0xf4, 0x70, 0x5a, 0x0c, 0xbd, 0x4b, 0x9f, 0x91, 0xe0, 0x88, 0xf8,
+	0x87, 0x8c, 0x46, 0xbc, 0xbd, 0x6b, 0xa2, 0x27, 0xcd, 0x4b, 0x0b, 0x48, 0xe6, 0xa5, 0x1e, 0x3e,
+	0x21, 0x84, 0xc3, 0xdf, 0x3a, 0x60, 0x33, 0x21, 0x7d, 0x8f, 0x46, 0x34, 0xea, 0x76, 0x72, 0x8c,
+	0xa5, 0x22, 0x8c, 0x0f, 0x0d, 0xe3, 0x5d, 0xcd, 0x38, 0x19, 0x12, 0xe1, 0xf5, 0xe1, 0xc4, 0x99,
+	0xb5, 0x88, 0x1f, 0x81, 0x05, 0x99, 0x47, 0xdc, 0x5d, 0x6e, 0xcc, 0xef, 0x97, 0xde, 0xda, 0x9b,
+	0x95, 0x94, 0xed, 0x75, 0xc3, 0xb4, 0x9c, 0xa5, 0x23, 0x47, 0x58, 0x03, 0xc0, 0x9f, 0x81, 0xa5,
+	0x38, 0x61, 0x97, 0x34, 0x20, 0x09, 0x77, 0x57, 0x14, 0x5a, 0x63, 0x2a, 0x9a, 0x51, 0x6c, 0xbb,
+	0x06, 0xb1, 0x6c, 0x10, 0x53, 0x00, 0x84, 0x33, 0x30, 0x48, 0xc0, 0xea, 0xb0, 0xbc, 0x84, 0x94,
+	0x0b, 0xee, 0xae, 0x2a, 0xf8, 0x07, 0x53, 0xe1, 0x8d, 0xf6, 0x09, 0xe5, 0x62, 0x8c, 0xc2, 0xcc,
+	0x71, 0x84, 0x57, 0x62, 0x4b, 0x4f, 0x6d, 0x20, 0x8d, 0x77, 0xee, 0xae, 0xcd, 0xde, 0x40, 0x9a,
+	0x05, 0xa3, 0xe8, 0x43, 0x00, 0x84, 0x33, 0x30, 0x48, 0x41, 0x39, 0xf4, 0xb8, 0xe8, 0x0c, 0xe2,
+	0xc0, 0x13, 0xa4, 0x23, 0x1b, 0x89, 0x5b, 0x56, 0x47, 0xbc, 0xd3, 0xd4, 0x4d, 0xa4, 0x99, 0x36,
+	0x91, 0xe6, 0x7b, 0x69, 0x97, 0x69, 0xdf, 0x37, 0xd0, 0xa6, 0x10, 0x8c, 0x22, 0xa0, 0x8f, 0x3f,
+	0xaf, 0x3b, 0x78, 0x55, 0x8a, 0xdf, 0x57, 0x52, 0x69, 0x09, 0x5f, 0x80, 0xaa, 0x69, 0x05, 0x5c,
+	0x78, 0x4f, 0x65, 0x14, 0x24, 0x9e, 0x20, 0x6e, 0x45, 0xa5, 0xcb, 0x49, 0x81, 0x74, 0x39, 0x22,
+	0xfe, 0xeb, 0xeb, 0xfa, 0x4e, 0xae, 0xbb, 0xd8, 0x90, 0x08, 0x57, 0xb4, 0xf4, 0x4c, 0x0b, 0xb1,
+	0x6c, 0x53, 0x2f, 0x40, 0xb5, 0x1b, 0xb2, 0x73, 0x99, 0xc5, 0x46, 0x55, 0xc6, 0x86, 0x0b, 0x0b,
+	0xb3, 0xeb, 0x64, 0x35, 0xec, 0x13, 0x20, 0x11, 0xae, 0x68, 0xa9, 0x61, 0x97, 0xe1, 0x09, 0x39,
+	0xa8, 0x48, 0x1d, 0xd2, 0xb9, 0x60, 0x89, 0x29, 0x23, 0xdc, 0xad, 0x36, 0xe6, 0x67, 0xa5, 0xd2,
+	0x99, 0xbd, 0x87, 0x76, 0xc3, 0xb8, 0xdc, 0x14, 0xc1, 0x31, 0x34, 0x84, 0xd7, 0x94, 0xec, 0x09,
+	0x4b, 0xb4, 0x21, 0x87, 0x97, 0xa0, 0xc2, 0x12, 0xda, 0xa5, 0x51, 0xb6, 0x42, 0xee, 0xae, 0x2b,
+	0xd2, 0x6f, 0x4e, 0x23, 0xfd, 0xa9, 0x31, 0x98, 0x42, 0x3b, 0x86, 0x87, 0x70, 0x99, 0xe5, 0x4d,
+	0x38, 0xfc, 0x9b, 0x03, 0x6a, 0x69, 0x53, 0x3a, 0x3e, 0xea, 0x24, 0x84, 0xf6, 0xcf, 0x07, 0x09,
+	0x27, 0x7d, 0x12, 0x89, 0x4e, 0xec, 0xd1, 0x84, 0xbb, 0x1b, 0x6a, 0x15, 0x6f, 0xcf, 0x48, 0x42,
+	0x63, 0x8d, 0x6d, 0xe3, 0x53, 0x8f, 0x26, 0xed, 0x37, 0xcd, 0x8a, 0x1e, 0x0e, 0xf3, 0x72, 0x06,
+	0x11, 0xc2, 0x7b, 0xf1, 0x74, 0x2c, 0x0e, 0x3f, 0x04, 0x25, 0x2f, 0x0c, 0x99, 0xaf, 0x2e, 0x47,
+	0xdc, 0xdd, 0x6c, 0xcc, 0xcf, 0x6a, 0xff, 0xef, 0x0c, 0x55, 0x47, 0xdb, 0xbf, 0x05, 0x82, 0xb0,
+	0x0d, 0x29, 0x2b, 0x76, 0x42, 0xae, 0xbc, 0x24, 0xe8, 0xd0, 0x28, 0x20, 0xcf, 0xdc, 0xad, 0xaf,
+	0x50, 0xb1, 0x6d, 0x20, 0x84, 0x4b, 0x7a, 0x78, 0x2c, 0x47, 0xf0, 0xd7, 0xa0, 0xca, 0x06, 0x82,
+	0x0b, 0x2f, 0x0a, 0x54, 0x1a, 0xa8, 0x29, 0xee, 0xba, 0x45, 0xd8, 0x90, 0x61, 0x33, 0xb1, 0x3d,
+	0x01, 0x0f, 0x61, 0x68, 0x49, 0xb1, 0x16, 0xc2, 0x08, 0xac, 0x91, 0x98, 0xf9, 0xbd, 0x0e, 0x8f,
+	0xbc, 0x98, 0xf7, 0x98, 0xe0, 0xee, 0xf6, 0xec, 0xd0, 0x7e, 0x2c, 0xd5, 0xcf, 0x8c, 0x76, 0xbb,
+	0x66, 0x78, 0x37, 0x35, 0xef, 0x08, 0x16, 0xc2, 0xab, 0xc4, 0x56, 0xe7, 0xd0, 0x1f, 0xde, 0x38,
+	0x13, 0x16, 0x12, 0xee, 0xee, 0xa8, 0x4d, 0xde, 0x9f, 0x9d, 0x47, 0x58, 0xaa, 0x8e, 0xb5, 0x40,
+	0x0b, 0x66, 0x78, 0x35, 0x55, 0x9a, 0xf0, 0x04, 0x2c, 0xaa, 0x1e, 0xcc, 0xdd, 0x5d, 0xb5, 0x97,
+	0xbb, 0x33, 0xef, 0x69, 0xed, 0x0d, 0x03, 0xbc, 0x62, 0xdd, 0xcc, 0x38, 0xc2, 0x06, 0x03, 0x7e,
+	0x04, 0xb6, 0x06, 0x91, 0xce, 0x59, 0x9f, 0xb1, 0x30, 0x60, 0x57, 0x51, 0x27, 0x26, 0x09, 0x65,
+	0x81, 0xbb, 0xa7, 0x56, 0xbf, 0x3d, 0x56, 0x6d, 0x8f, 0xcc, 0x95, 0xbd, 0xfd, 0x2d, 0x03, 0x5d,
+	0xd3, 0xd0, 0x53, 0x70, 0xd0, 0x5f, 0x65, 0xcd, 0xdd, 0x30, 0xb3, 0x87, 0x66, 0xf2, 0x54, 0xcd,
+	0xc1, 0x17, 0x60, 0x7d, 0x58, 0x20, 0xed, 0x60, 0xbc, 0x5b, 0x24, 0x3c, 0xd2, 0xa2, 0xbf, 0x9b,
+	0x55, 0xa0, 0x51, 0x40, 0x84, 0xa1, 0x11, 0x63, 0x2b, 0x36, 0x7f, 0x09, 0x80, 0x5e, 0x96, 0x2a,
+	0x40, 0x35, 0xe5, 0xce, 0x7b, 0xd3, 0x38, 0xdf, 0x4f, 0x35, 0xdb, 0xdb, 0x86, 0xaf, 0x62, 0xef,
+	0x5b, 0xd7, 0x1c, 0x0b, 0x4f, 0xb5, 0x95, 0xfc, 0x52, 0x54, 0x5b, 0xa9, 0x7f, 0xc5, 0xb6, 0x32,
+	0x0e, 0x29, 0xdb, 0x8a, 0xbd, 0x39, 0xd9, 0x56, 0x1e, 0xdd, 0xf9, 0xfd, 0x27, 0xf5, 0xb9, 0xff,
+	0x7e, 0x52, 0x9f, 0x43, 0xff, 0x70, 0xc0, 0xda, 0x48, 0xf5, 0x84, 0xdf, 0x05, 0x25, 0xfb, 0x7d,
+	0xe2, 0xa8, 0xf7, 0xc9, 0xa6, 0xf5, 0x6a, 0xb0, 0x9f, 0x26, 0x20, 0xce, 0x9e, 0x25, 0x1f, 0x80,
+	0x45, 0xaf, 0xcf, 0x06, 0x91, 0x50, 0x4f, 0xa2, 0xa5, 0xf6, 0x0f, 0x0a, 0x37, 0x28, 0x13, 0x88,
+	0x1a, 0x05, 0x61, 0x03, 0x67, 0xad, 0xf7, 0x9f, 0x0e, 0xd8, 0x9d, 0x51, 0x67, 0xd5, 0xda, 0xcd,
+	0xf4, 0xe4, 0xb5, 0x67, 0x93, 0x72, 0xed, 0x29, 0x52, 0x00, 0x29, 0x58, 0xc9, 0x55, 0x62, 0xf7,
+	0xd6, 0xec, 0x28, 0xcb, 0x51, 0xb7, 0xf7, 0xcc, 0xa9, 0xaf, 0xa7, 0x25, 0xcf, 0x9a, 0x44, 0x38,
+	0x8f, 0x6c, 0xed, 0xe6, 0xff, 0xf3, 0x60, 0x25, 0x07, 0x04, 0xfd, 0xa1, 0x0b, 0x1d, 0x15, 0x71,
+	0xdb, 0x4d, 0xed, 0xa9, 0xa6, 0x7c, 0x55, 0x37, 0xcd, 0xab, 0xba, 0x29, 0x43, 0xbb, 0xfd, 0x1d,
+	0xc9, 0xf9, 0xf7, 0xcf, 0xeb, 0xfb, 0x5f, 0xc2, 0xbb, 0xd2, 0x80, 0xa7, 0xee, 0x84, 0xdf, 0x03,
+	0xa5, 0x73, 0x12, 0x91, 0x0b, 0xea, 0x53, 0x2f, 0x79, 0x6e, 0x0e, 0xcb, 0x72, 0x92, 0x35, 0x89,
+	0xb0, 0xad, 0x0a, 0x7f, 0x01, 0x4a, 0xb1, 0xf7, 0x9c, 0x0d, 0x84, 0xbe, 0x73, 0xcd, 0xdf, 0x78,
+	0xe7, 0xaa, 0x8d, 0x3c, 0x38, 0x33, 0x63, 0x7d, 0xdd, 0x02, 0x5a, 0xa2, 0xae, 0x5a, 0x14, 0x94,
+	0x2f, 0x09, 0x17, 0x32, 0x80, 0x49, 0x14, 0x68, 0x86, 0xdb, 0x45, 0x6f, 0x75, 0xa3, 0x08, 0xe6,
+	0x56, 0x67, 0xc4, 0x8f, 0xa3, 0x40, 0x51, 0x7d, 0x94, 0x5d, 0x4d, 0x23, 0x77, 0xe1, 0x26, 0x4f,
+	0x1f, 0x4d, 0xbe, 0x93, 0x46, 0xa8, 0x90, 0xf7, 0x33, 0x46, 0x2b, 0x02, 0xfe, 0xb7, 0x08, 0x40,
+	0xf6, 0x3e, 0x87, 0x21, 0xa8, 0xc8, 0x2d, 0x12, 0x5f, 0xd6, 0xd0, 0xb4, 0xd6, 0x3a, 0x37, 0xd5,
+	0xda, 0x07, 0xf9, 0xeb, 0xce, 0x18, 0x82, 0xae, 0xb2, 0xe5, 0x4c, 0x6e, 0x0a, 0x2c, 0x07, 0x65,
+	0xd3, 0x4b, 0xe4, 0x8b, 0x46, 0x57, 0xa0, 0x5b, 0x85, 0x5f, 0xd7, 0xba, 0x02, 0x6d, 0xe5, 0x7a,
+	0xd3, 0x10, 0x0f, 0xe1, 0x55, 0x2d, 0x92, 0x8f, 0x23, 0x75, 0xa5, 0xbd, 0x00, 0x6b, 0xa9, 0x23,
+	0xd2, 0x0d, 0xce, 0xdf, 0xb4, 0x41, 0x94, 0xef, 0xb5, 0x23, 0xf6, 0x7a, 0x7b, 0xab, 0xa9, 0xd4,
+	0x6c, 0xee, 0x12, 0x54, 0xd4, 0xe7, 0x0d, 0xb3, 0xa2, 0x90, 0xf6, 0xa9, 0x70, 0x6f, 0x17, 0x7e,
+	0xc4, 0xeb, 0xdd, 0xb9, 0xd6, 0xf7, 0x12, 0x1b, 0x10, 0xe1, 0x35, 0x29, 0xd3, 0xcd, 0xfa, 0x44,
+	0x4a, 0xe0, 0x6f, 0x40, 0xb5, 0x4f, 0xa3, 0x54, 0x2b, 0xad, 0x8e, 0xee, 0xc2, 0xd7, 0x9f, 0xce,
+	0x95, 0x3e, 0x8d, 0x34, 0x73, 0xfa, 0x3e, 0x83, 0x7f, 0x70, 0xc0, 0xb6, 0x5a, 0xa4, 0x9f, 0x10,
+	0x4f, 0xb0, 0x24, 0xb7, 0x58, 0xf3, 0xe5, 0x04, 0x17, 0xae, 0xca, 0x0d, 0x6b, 0xf7, 0x93, 0x80,
+	0x11, 0xde, 0x94, 0x73, 0x87, 0x7a, 0xca, 0x76, 0xc6, 0x1f, 0x1d, 0xb0, 0x93, 0x33, 0x93, 0xae,
+	0xc9, 0x82, 0x4d, 0x7f, 0x53, 0x39, 0x2b, 0x7c, 0x1c, 0xf7, 0x26, 0x2c, 0x28, 0x87, 0x9c, 0x5f,
+	0xd1, 0xbb, 0x34, 0x4a, 0xc3, 0xcf, 0x4a, 0xbd, 0x3f, 0x2f, 0x82, 0xea, 0x84, 0xcf, 0x55, 0xf0,
+	0x57, 0x60, 0xd9, 0x7c, 0xa2, 0xfa, 0x92, 0xe9, 0x57, 0xcf, 0x5f, 0xcf, 0x6c, 0x63, 0x1d, 0x9a,
+	0x25, 0x25, 0x32, 0x71, 0xf9, 0x21, 0x58, 0x31, 0x55, 0xd0, 0xe0, 0xdf, 0xba, 0x09, 0xbf, 0x91,
+	0x6f, 0x2e, 0x39, 0x6b, 0x4d, 0xb0, 0xac, 0x65, 0x86, 0x21, 0x04, 0x25, 0xe9, 0x8c, 0x80, 0xc4,
+	0x8c, 0x53, 0xe1, 0xce, 0x7f, 0xfd, 0x91, 0x07, 0xfa, 0x34, 0x3a, 0xd2, 0xf0, 0xf2, 0x9b, 0x95,
+	0x61, 0xd2, 0x67, 0x7a, 0xbb, 0xf0, 0x37, 0x2b, 0x7d, 0xa6, 0xc6, 0x7b, 0x36, 0x16, 0xc2, 0x25,
+	0x33, 0x54, 0x95, 0xa3, 0x03, 0x96, 0xb2, 0xd0, 0x59, 0x50, 0x34, 0xed, 0xc2, 0x34, 0xa6, 0x86,
+	0x5b, 0x91, 0x72, 0xe7, 0x22, 0x2d, 0x4d, 0x3e, 0x48, 0xfb, 0x44, 0x7a, 0x36, 0x8b, 0x37, 0x9d,
+	0xcd, 0x3d, 0x73, 0x36, 0x1b, 0xf9, 0xee, 0x63, 0x1f, 0xce, 0x8a, 0x11, 0x9a, 0xd3, 0xf9, 0x93,
+	0x03, 0x2a, 0xa9, 0x9a, 0xe8, 0x25, 0x84, 0xf7, 0x58, 0x18, 0xb8, 0x6f, 0xdc, 0x74, 0x48, 0x27,
+	0xf9, 0x1a, 0x3f, 0x86, 0x50, 0xac, 0x17, 0xa5, 0x8d, 0xf6, 0xbd, 0xd4, 0x3c, 0xcb, 0x8b, 0xf6,
+	0x4f, 0x3e, 0x7d, 0x59, 0x73, 0x3e, 0x7b, 0x59, 0x73, 0xfe, 0xf3, 0xb2, 0xe6, 0x7c, 0xfc, 0xaa,
+	0x36, 0xf7, 0xd9, 0xab, 0xda, 0xdc, 0xbf, 0x5e, 0xd5, 0xe6, 0x7e, 0x7e, 0x60, 0xe3, 0x93, 0x44,
+	0xd0, 0xa7, 0x17, 0x6c, 0x10, 0x05, 0xca, 0x13, 0x2d, 0xf3, 0x19, 0xfe, 0x59, 0xfa, 0x21, 0x5e,
+	0xd1, 0x9d, 0x2f, 0x2a, 0x97, 0xbd, 0xfd, 0xc5, 0x00, 0x98, 0x68, 0x35, 0xf1, 0x71, 0x18, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -418,6 +462,140 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.StakingRewardRate.Size()
+		i -= size
+		if _, err := m.StakingRewardRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if len(m.Unstakings) > 0 {
+		for iNdEx := len(m.Unstakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unstakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	{
+		size, err := m.StakingRewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnstakeCooldownPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakeCooldownPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	{
+		size, err := m.ShieldRoles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if len(m.EpochSnapshots) > 0 {
+		for iNdEx := len(m.EpochSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	{
+		size, err := m.OutstandingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if len(m.ProposalIDReimbursementPairs) > 0 {
 		for iNdEx := len(m.ProposalIDReimbursementPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -490,12 +668,12 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i--
 	dAtA[i] = 0x8a
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
-	if err1 != nil {
-		return 0, err1
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x1
 	i--
@@ -752,12 +930,34 @@ func (m *Reimbursement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PayoutTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PayoutTime):])
-	if err7 != nil {
-		return 0, err7
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VestingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VestingEndTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n7
-	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i -= n12
+	i = encodeVarintGenesis(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PayoutTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PayoutTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGenesis(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.Beneficiary) > 0 {
@@ -804,6 +1004,26 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PoolCreatorMinFeesRate.Size()
+		i -= size
+		if _, err := m.PoolCreatorMinFeesRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PoolCreatorShieldLimit.Size()
+		i -= size
+		if _, err := m.PoolCreatorShieldLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.MinShieldPurchase) > 0 {
 		for iNdEx := len(m.MinShieldPurchase) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -828,12 +1048,12 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.WithdrawPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.WithdrawPeriod):])
-	if err8 != nil {
-		return 0, err8
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.WithdrawPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.WithdrawPeriod):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n8
-	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i -= n14
+	i = encodeVarintGenesis(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -846,12 +1066,12 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProtectionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProtectionPeriod):])
-	if err9 != nil {
-		return 0, err9
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProtectionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProtectionPeriod):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n9
-	i = encodeVarintGenesis(dAtA, i, uint64(n9))
+	i -= n15
+	i = encodeVarintGenesis(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -877,6 +1097,28 @@ func (m *ClaimProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingThreshold) > 0 {
+		for iNdEx := len(m.VestingThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingPeriod):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintGenesis(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.FeesRate.Size()
 		i -= size
@@ -911,20 +1153,20 @@ func (m *ClaimProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PayoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PayoutPeriod):])
-	if err10 != nil {
-		return 0, err10
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PayoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PayoutPeriod):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n10
-	i = encodeVarintGenesis(dAtA, i, uint64(n10))
+	i -= n17
+	i = encodeVarintGenesis(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x12
-	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ClaimPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ClaimPeriod):])
-	if err11 != nil {
-		return 0, err11
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ClaimPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ClaimPeriod):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n11
-	i = encodeVarintGenesis(dAtA, i, uint64(n11))
+	i -= n18
+	i = encodeVarintGenesis(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1021,6 +1263,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardIndex.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.OutstandingRewards.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.EpochSnapshots) > 0 {
+		for _, e := range m.EpochSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ShieldRoles.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakeCooldownPeriod)
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.StakingRewardIndex.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.Unstakings) > 0 {
+		for _, e := range m.Unstakings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.StakingRewardRate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1070,6 +1348,14 @@ func (m *Reimbursement) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PayoutTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VestingEndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1093,6 +1379,10 @@ func (m *PoolParams) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.PoolCreatorShieldLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PoolCreatorMinFeesRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1116,6 +1406,14 @@ func (m *ClaimProposalParams) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.FeesRate.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VestingThreshold) > 0 {
+		for _, e := range m.VestingThreshold {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1831,64 +2129,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OriginalStaking) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OriginalStaking: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OriginalStaking: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
-			m.PurchaseId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1898,16 +2143,404 @@ func (m *OriginalStaking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PurchaseId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, Allocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutstandingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochSnapshots = append(m.EpochSnapshots, EpochSnapshot{})
+			if err := m.EpochSnapshots[len(m.EpochSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShieldRoles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, Claim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeCooldownPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnstakeCooldownPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unstakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unstakings = append(m.Unstakings, Unstaking{})
+			if err := m.Unstakings[len(m.Unstakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewardRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewardRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OriginalStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OriginalStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OriginalStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+			}
+			m.PurchaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2194,6 +2827,73 @@ func (m *Reimbursement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VestingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2415,6 +3115,74 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreatorShieldLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCreatorShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreatorMinFeesRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCreatorMinFeesRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2636,6 +3404,73 @@ func (m *ClaimProposalParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingThreshold = append(m.VestingThreshold, types.Coin{})
+			if err := m.VestingThreshold[len(m.VestingThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
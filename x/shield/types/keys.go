@@ -45,12 +45,35 @@ var (
 	BlockServiceFeesKey         = []byte{0x12}
 	OriginalStakingKey          = []byte{0x13}
 	ReimbursementKey            = []byte{0x14}
+	AllocationKey               = []byte{0x15}
+	RewardIndexKey              = []byte{0x16}
+	OutstandingRewardsKey       = []byte{0x17}
+	ProviderAllocationKey       = []byte{0x18}
+	EpochSnapshotKey            = []byte{0x19}
+	ShieldRolesKey              = []byte{0x1A}
+	ClaimKey                    = []byte{0x1B}
+	PoolClaimKey                = []byte{0x1C}
+	PurchaserClaimKey           = []byte{0x1D}
+	StakingRewardIndexKey       = []byte{0x1E}
+	UnstakingQueueKey           = []byte{0x1F}
 )
 
 func GetTotalCollateralKey() []byte {
 	return TotalCollateralKey
 }
 
+func GetRewardIndexKey() []byte {
+	return RewardIndexKey
+}
+
+func GetStakingRewardIndexKey() []byte {
+	return StakingRewardIndexKey
+}
+
+func GetOutstandingRewardsKey() []byte {
+	return OutstandingRewardsKey
+}
+
 func GetTotalWithdrawingKey() []byte {
 	return TotalWithdrawingKey
 }
@@ -82,11 +105,17 @@ func GetPoolKey(id uint64) []byte {
 	return append(PoolKey, b...)
 }
 
-// GetShieldAdminKey gets the key for the shield admin.
+// GetShieldAdminKey gets the key for the legacy shield admin, which
+// is migrated to the shield roles.
 func GetShieldAdminKey() []byte {
 	return ShieldAdminKey
 }
 
+// GetShieldRolesKey gets the key for the shield roles.
+func GetShieldRolesKey() []byte {
+	return ShieldRolesKey
+}
+
 // GetNextPoolIDKey gets the key for the next pool ID.
 func GetNextPoolIDKey() []byte {
 	return NextPoolIDKey
@@ -116,6 +145,13 @@ func GetWithdrawCompletionTimeKey(timestamp time.Time) []byte {
 	return append(WithdrawQueueKey, bz...)
 }
 
+// GetUnstakingCompletionTimeKey gets an unstaking queue key,
+// which is obtained from the completion time.
+func GetUnstakingCompletionTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(UnstakingQueueKey, bz...)
+}
+
 // GetPurchaseExpirationTimeKey gets a withdraw queue key,
 // which is obtained from the expiration time.
 func GetPurchaseExpirationTimeKey(timestamp time.Time) []byte {
@@ -150,3 +186,57 @@ func GetReimbursementKey(proposalID uint64) []byte {
 	binary.LittleEndian.PutUint64(bz, proposalID)
 	return append(ReimbursementKey, bz...)
 }
+
+// GetAllocationKey gets the key for a provider's collateral allocation to a pool.
+func GetAllocationKey(poolID uint64, provider sdk.AccAddress) []byte {
+	return append(GetPoolAllocationsKey(poolID), provider.Bytes()...)
+}
+
+// GetPoolAllocationsKey gets the key prefix for all allocations to a pool.
+func GetPoolAllocationsKey(poolID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, poolID)
+	return append(AllocationKey, bz...)
+}
+
+// GetProviderAllocationsKey gets the key prefix for the allocation index of a provider.
+func GetProviderAllocationsKey(provider sdk.AccAddress) []byte {
+	return append(ProviderAllocationKey, provider.Bytes()...)
+}
+
+// GetProviderAllocationKey gets the key for a pool in the allocation index of a provider.
+func GetProviderAllocationKey(provider sdk.AccAddress, poolID uint64) []byte {
+	return append(GetProviderAllocationsKey(provider), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetEpochSnapshotKey gets the key for the snapshot of an epoch.
+// Epochs are encoded in big endian so that snapshots are iterated
+// in epoch order.
+func GetEpochSnapshotKey(epoch uint64) []byte {
+	return append(EpochSnapshotKey, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetClaimKey gets the key for the claim of a claim proposal.
+func GetClaimKey(proposalID uint64) []byte {
+	return append(ClaimKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetPoolClaimsKey gets the key prefix for the claim index of a pool.
+func GetPoolClaimsKey(poolID uint64) []byte {
+	return append(PoolClaimKey, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetPoolClaimKey gets the key for a claim in the claim index of a pool.
+func GetPoolClaimKey(poolID, proposalID uint64) []byte {
+	return append(GetPoolClaimsKey(poolID), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetPurchaserClaimsKey gets the key prefix for the claim index of a purchaser.
+func GetPurchaserClaimsKey(purchaser sdk.AccAddress) []byte {
+	return append(PurchaserClaimKey, purchaser.Bytes()...)
+}
+
+// GetPurchaserClaimKey gets the key for a claim in the claim index of a purchaser.
+func GetPurchaserClaimKey(purchaser sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetPurchaserClaimsKey(purchaser), sdk.Uint64ToBigEndian(proposalID)...)
+}
@@ -12,9 +12,14 @@ const (
 	TypeMsgUpdatePool             = "update_pool"
 	TypeMsgPausePool              = "pause_pool"
 	TypeMsgResumePool             = "resume_pool"
+	TypeMsgSetCoverageTerms       = "set_coverage_terms"
+	TypeMsgSetCoveredAssets       = "set_covered_assets"
 	TypeMsgDepositCollateral      = "deposit_collateral"
 	TypeMsgWithdrawCollateral     = "withdraw_collateral"
+	TypeMsgAllocateCollateral     = "allocate_collateral"
+	TypeMsgDeallocateCollateral   = "deallocate_collateral"
 	TypeMsgWithdrawRewards        = "withdraw_rewards"
+	TypeMsgSetAutoCompound        = "set_auto_compound"
 	TypeMsgWithdrawForeignRewards = "withdraw_foreign_rewards"
 	TypeMsgClearPayouts           = "clear_payouts"
 	TypeMsgPurchaseShield         = "purchase_shield"
@@ -71,7 +76,7 @@ func (msg MsgCreatePool) ValidateBasic() error {
 	if strings.TrimSpace(msg.Sponsor) == "" {
 		return ErrEmptySponsor
 	}
-	if !msg.Shield.IsValid() || msg.Shield.IsZero() {
+	if !msg.Shield.IsValid() {
 		return ErrNoShield
 	}
 	return nil
@@ -218,6 +223,102 @@ func (msg MsgResumePool) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetCoverageTerms creates a new MsgSetCoverageTerms instance.
+func NewMsgSetCoverageTerms(accAddr sdk.AccAddress, id uint64, terms CoverageTerms) *MsgSetCoverageTerms {
+	return &MsgSetCoverageTerms{
+		From:   accAddr.String(),
+		PoolId: id,
+		Terms:  terms,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetCoverageTerms) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetCoverageTerms) Type() string { return TypeMsgSetCoverageTerms }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetCoverageTerms) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetCoverageTerms) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetCoverageTerms) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return err
+	}
+	if from.Empty() {
+		return ErrEmptySender
+	}
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolID
+	}
+	if err := msg.Terms.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCoverageTerms, err.Error())
+	}
+	return nil
+}
+
+// NewMsgSetCoveredAssets creates a new MsgSetCoveredAssets instance.
+func NewMsgSetCoveredAssets(accAddr sdk.AccAddress, id uint64, assets []string) *MsgSetCoveredAssets {
+	return &MsgSetCoveredAssets{
+		From:   accAddr.String(),
+		PoolId: id,
+		Assets: assets,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetCoveredAssets) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetCoveredAssets) Type() string { return TypeMsgSetCoveredAssets }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetCoveredAssets) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetCoveredAssets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetCoveredAssets) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return err
+	}
+	if from.Empty() {
+		return ErrEmptySender
+	}
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolID
+	}
+	if err := ValidateCoveredAssets(msg.Assets); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCoveredAssets, err.Error())
+	}
+	return nil
+}
+
 // NewMsgDepositCollateral creates a new MsgDepositCollateral instance.
 func NewMsgDepositCollateral(sender sdk.AccAddress, collateral sdk.Coins) *MsgDepositCollateral {
 	return &MsgDepositCollateral{
@@ -308,6 +409,104 @@ func (msg MsgWithdrawCollateral) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgAllocateCollateral creates a new MsgAllocateCollateral instance.
+func NewMsgAllocateCollateral(sender sdk.AccAddress, poolID uint64, collateral sdk.Coins) *MsgAllocateCollateral {
+	return &MsgAllocateCollateral{
+		From:       sender.String(),
+		PoolId:     poolID,
+		Collateral: collateral,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) Type() string { return TypeMsgAllocateCollateral }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return err
+	}
+	if from.Empty() {
+		return ErrEmptySender
+	}
+
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolID
+	}
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Collateral amount: %s", msg.Collateral)
+	}
+	return nil
+}
+
+// NewMsgDeallocateCollateral creates a new MsgDeallocateCollateral instance.
+func NewMsgDeallocateCollateral(sender sdk.AccAddress, poolID uint64, collateral sdk.Coins) *MsgDeallocateCollateral {
+	return &MsgDeallocateCollateral{
+		From:       sender.String(),
+		PoolId:     poolID,
+		Collateral: collateral,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDeallocateCollateral) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDeallocateCollateral) Type() string { return TypeMsgDeallocateCollateral }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDeallocateCollateral) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgDeallocateCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDeallocateCollateral) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return err
+	}
+	if from.Empty() {
+		return ErrEmptySender
+	}
+
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolID
+	}
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Collateral amount: %s", msg.Collateral)
+	}
+	return nil
+}
+
 // NewMsgWithdrawRewards creates a new MsgWithdrawRewards instance.
 func NewMsgWithdrawRewards(sender sdk.AccAddress) *MsgWithdrawRewards {
 	return &MsgWithdrawRewards{
@@ -349,6 +548,48 @@ func (msg MsgWithdrawRewards) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound instance.
+func NewMsgSetAutoCompound(sender sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		From:    sender.String(),
+		Enabled: enabled,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return err
+	}
+	if from.Empty() {
+		return ErrEmptySender
+	}
+
+	return nil
+}
+
 // NewMsgWithdrawForeignRewards creates a new MsgWithdrawForeignRewards instance.
 func NewMsgWithdrawForeignRewards(sender sdk.AccAddress, denom, toAddr string) *MsgWithdrawForeignRewards {
 	return &MsgWithdrawForeignRewards{
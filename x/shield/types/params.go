@@ -19,15 +19,27 @@ var (
 	DefaultPoolShieldLimit   = sdk.NewDecWithPrec(50, 2)                                             // 50%
 	DefaultMinShieldPurchase = sdk.NewCoins(sdk.NewCoin(common.MicroCTKDenom, sdk.NewInt(50000000))) // 50 CTK
 
+	// default values for pools managed by certified pool creators
+	DefaultPoolCreatorShieldLimit = sdk.NewInt(100000000000)   // 100,000 CTK
+	DefaultPoolCreatorMinFeesRate = sdk.NewDecWithPrec(769, 5) // 0.769%
+
 	// default values for Shield claim proposal's parameters
 	DefaultClaimPeriod              = time.Hour * 24 * 21                                                    // 21 days
 	DefaultPayoutPeriod             = time.Hour * 24 * 56                                                    // 56 days
 	DefaultMinClaimProposalDeposit  = sdk.NewCoins(sdk.NewCoin(common.MicroCTKDenom, sdk.NewInt(100000000))) // 100 CTK
 	DefaultClaimProposalDepositRate = sdk.NewDecWithPrec(10, 2)                                              // 10%
 	DefaultClaimProposalFeesRate    = sdk.NewDecWithPrec(1, 2)                                               // 1%
+	DefaultVestingPeriod            = time.Duration(0)                                                       // no vesting
+	DefaultVestingThreshold         = sdk.Coins{}
 
 	// default value for staking-shield rate parameter
 	DefaultStakingShieldRate = sdk.NewDec(2)
+
+	// default value for staking purchases' unstake cooldown period parameter
+	DefaultUnstakeCooldownPeriod = time.Hour * 24 * 7 // 7 days
+
+	// default value for staking purchases' share of block service fees
+	DefaultStakingRewardRate = sdk.ZeroDec()
 )
 
 // parameter keys
@@ -35,6 +47,8 @@ var (
 	ParamStoreKeyPoolParams          = []byte("shieldpoolparams")
 	ParamStoreKeyClaimProposalParams = []byte("claimproposalparams")
 	ParamStoreKeyStakingShieldRate   = []byte("stakingshieldrateparams")
+	ParamStoreKeyUnstakeCooldown     = []byte("unstakecooldownperiod")
+	ParamStoreKeyStakingRewardRate   = []byte("stakingrewardrate")
 )
 
 // ParamKeyTable is the key declaration for parameters.
@@ -43,23 +57,29 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyPoolParams, PoolParams{}, validatePoolParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyClaimProposalParams, ClaimProposalParams{}, validateClaimProposalParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyStakingShieldRate, sdk.Dec{}, validateStakingShieldRateParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyUnstakeCooldown, time.Duration(0), validateUnstakeCooldownPeriod),
+		paramtypes.NewParamSetPair(ParamStoreKeyStakingRewardRate, sdk.Dec{}, validateStakingRewardRate),
 	)
 }
 
 // NewPoolParams creates a new PoolParams object.
-func NewPoolParams(protectionPeriod, withdrawPeriod time.Duration, shieldFeesRate sdk.Dec, poolShieldLimit sdk.Dec, minShieldPurchase sdk.Coins) PoolParams {
+func NewPoolParams(protectionPeriod, withdrawPeriod time.Duration, shieldFeesRate sdk.Dec, poolShieldLimit sdk.Dec, minShieldPurchase sdk.Coins,
+	poolCreatorShieldLimit sdk.Int, poolCreatorMinFeesRate sdk.Dec) PoolParams {
 	return PoolParams{
-		ProtectionPeriod:  protectionPeriod,
-		ShieldFeesRate:    shieldFeesRate,
-		WithdrawPeriod:    withdrawPeriod,
-		PoolShieldLimit:   poolShieldLimit,
-		MinShieldPurchase: minShieldPurchase,
+		ProtectionPeriod:       protectionPeriod,
+		ShieldFeesRate:         shieldFeesRate,
+		WithdrawPeriod:         withdrawPeriod,
+		PoolShieldLimit:        poolShieldLimit,
+		MinShieldPurchase:      minShieldPurchase,
+		PoolCreatorShieldLimit: poolCreatorShieldLimit,
+		PoolCreatorMinFeesRate: poolCreatorMinFeesRate,
 	}
 }
 
 // DefaultPoolParams returns a default PoolParams instance.
 func DefaultPoolParams() PoolParams {
-	return NewPoolParams(DefaultProtectionPeriod, DefaultWithdrawPeriod, DefaultShieldFeesRate, DefaultPoolShieldLimit, DefaultMinShieldPurchase)
+	return NewPoolParams(DefaultProtectionPeriod, DefaultWithdrawPeriod, DefaultShieldFeesRate, DefaultPoolShieldLimit, DefaultMinShieldPurchase,
+		DefaultPoolCreatorShieldLimit, DefaultPoolCreatorMinFeesRate)
 }
 
 func validatePoolParams(i interface{}) error {
@@ -72,6 +92,8 @@ func validatePoolParams(i interface{}) error {
 	withdrawPeriod := v.WithdrawPeriod
 	poolShieldLimit := v.PoolShieldLimit
 	minShieldPurchase := v.MinShieldPurchase
+	poolCreatorShieldLimit := v.PoolCreatorShieldLimit
+	poolCreatorMinFeesRate := v.PoolCreatorMinFeesRate
 
 	if protectionPeriod <= 0 {
 		return fmt.Errorf("protection period must be positive: %s", protectionPeriod)
@@ -88,25 +110,35 @@ func validatePoolParams(i interface{}) error {
 	if !minShieldPurchase.IsValid() {
 		return fmt.Errorf("minimum shield purchase must be a valid sdk.Coins, is %s", minShieldPurchase.String())
 	}
+	if poolCreatorShieldLimit.IsNil() || poolCreatorShieldLimit.IsNegative() {
+		return fmt.Errorf("pool creator shield limit must not be negative: %s", poolCreatorShieldLimit)
+	}
+	if poolCreatorMinFeesRate.IsNil() || poolCreatorMinFeesRate.IsNegative() || poolCreatorMinFeesRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("pool creator minimum fees rate should be positive and less or equal to one but is %s", poolCreatorMinFeesRate)
+	}
 
 	return nil
 }
 
 // NewClaimProposalParams creates a new ClaimProposalParams instance.
-func NewClaimProposalParams(claimPeriod, payoutPeriod time.Duration, minDeposit sdk.Coins, depositRate, feesRate sdk.Dec) ClaimProposalParams {
+func NewClaimProposalParams(claimPeriod, payoutPeriod time.Duration, minDeposit sdk.Coins, depositRate, feesRate sdk.Dec,
+	vestingPeriod time.Duration, vestingThreshold sdk.Coins) ClaimProposalParams {
 	return ClaimProposalParams{
-		ClaimPeriod:  claimPeriod,
-		PayoutPeriod: payoutPeriod,
-		MinDeposit:   minDeposit,
-		DepositRate:  depositRate,
-		FeesRate:     feesRate,
+		ClaimPeriod:      claimPeriod,
+		PayoutPeriod:     payoutPeriod,
+		MinDeposit:       minDeposit,
+		DepositRate:      depositRate,
+		FeesRate:         feesRate,
+		VestingPeriod:    vestingPeriod,
+		VestingThreshold: vestingThreshold,
 	}
 }
 
 // DefaultClaimProposalParams returns a default ClaimProposalParams instance.
 func DefaultClaimProposalParams() ClaimProposalParams {
 	return NewClaimProposalParams(DefaultClaimPeriod, DefaultPayoutPeriod,
-		DefaultMinClaimProposalDeposit, DefaultClaimProposalDepositRate, DefaultClaimProposalFeesRate)
+		DefaultMinClaimProposalDeposit, DefaultClaimProposalDepositRate, DefaultClaimProposalFeesRate,
+		DefaultVestingPeriod, DefaultVestingThreshold)
 }
 
 func validateClaimProposalParams(i interface{}) error {
@@ -119,6 +151,8 @@ func validateClaimProposalParams(i interface{}) error {
 	minDeposit := v.MinDeposit
 	depositRate := v.DepositRate
 	feesRate := v.FeesRate
+	vestingPeriod := v.VestingPeriod
+	vestingThreshold := v.VestingThreshold
 
 	if claimPeriod <= 0 {
 		return fmt.Errorf("claim period must be positive: %s", claimPeriod)
@@ -138,6 +172,13 @@ func validateClaimProposalParams(i interface{}) error {
 		return fmt.Errorf("fees rate should be positive and less or equal to one but is %s",
 			feesRate.String())
 	}
+	if vestingPeriod < 0 {
+		return fmt.Errorf("vesting period must not be negative: %s", vestingPeriod)
+	}
+	if !vestingThreshold.IsValid() {
+		return fmt.Errorf("vesting threshold must be a valid sdk.Coins amount, is %s",
+			vestingThreshold.String())
+	}
 
 	return nil
 }
@@ -152,8 +193,30 @@ func validateStakingShieldRateParams(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if v.LTE(sdk.ZeroDec()) {
-		return fmt.Errorf("staking shield rate should be greater than 0")
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("staking shield rate should be greater than 0: %s", v)
+	}
+	return nil
+}
+
+func validateUnstakeCooldownPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("unstake cooldown period must not be negative: %s", v)
+	}
+	return nil
+}
+
+func validateStakingRewardRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking reward rate should be between 0 and 1: %s", v)
 	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -14,14 +15,22 @@ import (
 const (
 	// ProposalTypeShieldClaim defines the type for a ShieldClaimProposal.
 	ProposalTypeShieldClaim = "ShieldClaim"
+
+	// ProposalTypeShieldAdminUpdate defines the type for a ShieldAdminUpdateProposal.
+	ProposalTypeShieldAdminUpdate = "ShieldAdminUpdate"
 )
 
-// Assert ShieldClaimProposal implements govTypes.Content at compile-time.
-var _ govTypes.Content = ShieldClaimProposal{}
+// Assert ShieldClaimProposal and ShieldAdminUpdateProposal implement govTypes.Content at compile-time.
+var (
+	_ govTypes.Content = ShieldClaimProposal{}
+	_ govTypes.Content = &ShieldAdminUpdateProposal{}
+)
 
 func init() {
 	govTypes.RegisterProposalType(ProposalTypeShieldClaim)
 	govTypes.RegisterProposalTypeCodec(ShieldClaimProposal{}, "shield/ShieldClaimProposal")
+	govTypes.RegisterProposalType(ProposalTypeShieldAdminUpdate)
+	govTypes.RegisterProposalTypeCodec(ShieldAdminUpdateProposal{}, "shield/ShieldAdminUpdateProposal")
 }
 
 // NewShieldClaimProposal creates a new shield claim proposal.
@@ -76,6 +85,52 @@ func (scp ShieldClaimProposal) String() string {
 	return b.String()
 }
 
+// NewShieldAdminUpdateProposal creates a new shield admin update proposal.
+func NewShieldAdminUpdateProposal(title, description string, roles ShieldRoles, proposer sdk.AccAddress) *ShieldAdminUpdateProposal {
+	return &ShieldAdminUpdateProposal{
+		Title:       title,
+		Description: description,
+		Roles:       roles,
+		Proposer:    proposer.String(),
+	}
+}
+
+// GetTitle returns the title of a shield admin update proposal.
+func (saup ShieldAdminUpdateProposal) GetTitle() string { return saup.Title }
+
+// GetDescription returns the description of a shield admin update proposal.
+func (saup ShieldAdminUpdateProposal) GetDescription() string { return saup.Description }
+
+// ProposalRoute returns the routing key of a shield admin update proposal.
+func (saup ShieldAdminUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a shield admin update proposal.
+func (saup ShieldAdminUpdateProposal) ProposalType() string { return ProposalTypeShieldAdminUpdate }
+
+// ValidateBasic runs basic stateless validity checks.
+func (saup ShieldAdminUpdateProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(&saup); err != nil {
+		return err
+	}
+	if err := saup.Roles.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidShieldRoles, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (saup ShieldAdminUpdateProposal) String() string {
+	return fmt.Sprintf(`Shield Admin Update Proposal:
+  Title:          %s
+  Description:    %s
+  Pool Operators: %s
+  Pausers:        %s
+  Claim Managers: %s
+  Proposer:       %s
+`, saup.Title, saup.Description, strings.Join(saup.Roles.PoolOperators, ", "),
+		strings.Join(saup.Roles.Pausers, ", "), strings.Join(saup.Roles.ClaimManagers, ", "), saup.Proposer)
+}
+
 // LockedCollateral defines the data type of locked collateral for a claim proposal.
 type LockedCollateral struct {
 	ProposalID uint64  `json:"proposal_id" yaml:"proposal_id"`
@@ -100,14 +155,43 @@ func NewUnbondingDelegation(delAddr, valAddr string, entry stakingTypes.Unbondin
 }
 
 // NewReimbursement returns a new Reimbursement instance.
-func NewReimbursement(amount sdk.Coins, beneficiary sdk.AccAddress, payoutTime time.Time) Reimbursement {
+func NewReimbursement(amount sdk.Coins, beneficiary sdk.AccAddress, payoutTime, vestingEndTime time.Time) Reimbursement {
 	return Reimbursement{
-		Amount:      amount,
-		Beneficiary: beneficiary.String(),
-		PayoutTime:  payoutTime,
+		Amount:         amount,
+		Beneficiary:    beneficiary.String(),
+		PayoutTime:     payoutTime,
+		VestingEndTime: vestingEndTime,
+		Withdrawn:      sdk.Coins{},
 	}
 }
 
+// VestedAmount returns the amount of the reimbursement vested by the
+// given time, which is released linearly from the payout time to the
+// vesting end time.
+func (r Reimbursement) VestedAmount(blockTime time.Time) sdk.Coins {
+	if r.PayoutTime.After(blockTime) {
+		return sdk.Coins{}
+	}
+	if !r.VestingEndTime.After(blockTime) {
+		return r.Amount
+	}
+
+	elapsed := sdk.NewDec(blockTime.Sub(r.PayoutTime).Nanoseconds())
+	ratio := elapsed.QuoInt64(r.VestingEndTime.Sub(r.PayoutTime).Nanoseconds())
+	vested := sdk.Coins{}
+	for _, coin := range r.Amount {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(ratio).TruncateInt()))
+	}
+	return vested
+}
+
+// WithdrawableAmount returns the vested amount of the reimbursement
+// that has not been withdrawn by the given time.
+func (r Reimbursement) WithdrawableAmount(blockTime time.Time) sdk.Coins {
+	withdrawable, _ := r.VestedAmount(blockTime).SafeSub(r.Withdrawn)
+	return withdrawable
+}
+
 // NewProposalIDReimbursementPair returns a new ProposalIDReimbursementPair instance.
 func NewProposalIDReimbursementPair(proposalID uint64, reimbursement Reimbursement) ProposalIDReimbursementPair {
 	return ProposalIDReimbursementPair{
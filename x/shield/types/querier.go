@@ -22,6 +22,11 @@ const (
 	QueryShieldStakingRate   = "shield_staking_rate"
 	QueryReimbursement       = "reimbursement"
 	QueryReimbursements      = "reimbursements"
+	QueryClaim               = "claim"
+	QueryPoolClaims          = "pool_claims"
+	QueryPurchaserClaims     = "purchaser_claims"
+	QueryStakingRewards      = "staking_rewards"
+	QueryUnstakings          = "unstakings"
 )
 
 type QueryResStatus struct {
@@ -7,23 +7,29 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1112,7 +1118,8 @@ func (m *QueryShieldStakingRateRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryShieldStakingRateRequest proto.InternalMessageInfo
 
 type QueryShieldStakingRateResponse struct {
-	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	Rate                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	UnstakeCooldownPeriod time.Duration                          `protobuf:"bytes,2,opt,name=unstake_cooldown_period,json=unstakeCooldownPeriod,proto3,stdduration" json:"unstake_cooldown_period" yaml:"unstake_cooldown_period"`
 }
 
 func (m *QueryShieldStakingRateResponse) Reset()         { *m = QueryShieldStakingRateResponse{} }
@@ -1148,6 +1155,13 @@ func (m *QueryShieldStakingRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryShieldStakingRateResponse proto.InternalMessageInfo
 
+func (m *QueryShieldStakingRateResponse) GetUnstakeCooldownPeriod() time.Duration {
+	if m != nil {
+		return m.UnstakeCooldownPeriod
+	}
+	return 0
+}
+
 type QueryReimbursementRequest struct {
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
@@ -1316,1579 +1330,2247 @@ func (m *QueryReimbursementsResponse) GetPairs() []ProposalIDReimbursementPair {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPoolRequest)(nil), "shentu.shield.v1alpha1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "shentu.shield.v1alpha1.QueryPoolResponse")
-	proto.RegisterType((*QuerySponsorRequest)(nil), "shentu.shield.v1alpha1.QuerySponsorRequest")
-	proto.RegisterType((*QuerySponsorResponse)(nil), "shentu.shield.v1alpha1.QuerySponsorResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "shentu.shield.v1alpha1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "shentu.shield.v1alpha1.QueryPoolsResponse")
-	proto.RegisterType((*QueryPurchaseListRequest)(nil), "shentu.shield.v1alpha1.QueryPurchaseListRequest")
-	proto.RegisterType((*QueryPurchaseListResponse)(nil), "shentu.shield.v1alpha1.QueryPurchaseListResponse")
-	proto.RegisterType((*QueryPurchaseListsRequest)(nil), "shentu.shield.v1alpha1.QueryPurchaseListsRequest")
-	proto.RegisterType((*QueryPurchaseListsResponse)(nil), "shentu.shield.v1alpha1.QueryPurchaseListsResponse")
-	proto.RegisterType((*QueryPurchasesRequest)(nil), "shentu.shield.v1alpha1.QueryPurchasesRequest")
-	proto.RegisterType((*QueryPurchasesResponse)(nil), "shentu.shield.v1alpha1.QueryPurchasesResponse")
-	proto.RegisterType((*QueryProviderRequest)(nil), "shentu.shield.v1alpha1.QueryProviderRequest")
-	proto.RegisterType((*QueryProviderResponse)(nil), "shentu.shield.v1alpha1.QueryProviderResponse")
-	proto.RegisterType((*QueryProvidersRequest)(nil), "shentu.shield.v1alpha1.QueryProvidersRequest")
-	proto.RegisterType((*QueryProvidersResponse)(nil), "shentu.shield.v1alpha1.QueryProvidersResponse")
-	proto.RegisterType((*QueryPoolParamsRequest)(nil), "shentu.shield.v1alpha1.QueryPoolParamsRequest")
-	proto.RegisterType((*QueryPoolParamsResponse)(nil), "shentu.shield.v1alpha1.QueryPoolParamsResponse")
-	proto.RegisterType((*QueryClaimParamsRequest)(nil), "shentu.shield.v1alpha1.QueryClaimParamsRequest")
-	proto.RegisterType((*QueryClaimParamsResponse)(nil), "shentu.shield.v1alpha1.QueryClaimParamsResponse")
-	proto.RegisterType((*QueryShieldStatusRequest)(nil), "shentu.shield.v1alpha1.QueryShieldStatusRequest")
-	proto.RegisterType((*QueryShieldStatusResponse)(nil), "shentu.shield.v1alpha1.QueryShieldStatusResponse")
-	proto.RegisterType((*QueryShieldStakingRequest)(nil), "shentu.shield.v1alpha1.QueryShieldStakingRequest")
-	proto.RegisterType((*QueryShieldStakingResponse)(nil), "shentu.shield.v1alpha1.QueryShieldStakingResponse")
-	proto.RegisterType((*QueryShieldStakingRateRequest)(nil), "shentu.shield.v1alpha1.QueryShieldStakingRateRequest")
-	proto.RegisterType((*QueryShieldStakingRateResponse)(nil), "shentu.shield.v1alpha1.QueryShieldStakingRateResponse")
-	proto.RegisterType((*QueryReimbursementRequest)(nil), "shentu.shield.v1alpha1.QueryReimbursementRequest")
-	proto.RegisterType((*QueryReimbursementResponse)(nil), "shentu.shield.v1alpha1.QueryReimbursementResponse")
-	proto.RegisterType((*QueryReimbursementsRequest)(nil), "shentu.shield.v1alpha1.QueryReimbursementsRequest")
-	proto.RegisterType((*QueryReimbursementsResponse)(nil), "shentu.shield.v1alpha1.QueryReimbursementsResponse")
+type QueryAllocationsRequest struct {
+	PoolId   uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("shentu/shield/v1alpha1/query.proto", fileDescriptor_1cb9aa5f07f44644)
+func (m *QueryAllocationsRequest) Reset()         { *m = QueryAllocationsRequest{} }
+func (m *QueryAllocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationsRequest) ProtoMessage()    {}
+func (*QueryAllocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{30}
 }
-
-var fileDescriptor_1cb9aa5f07f44644 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xd4, 0x46,
-	0x14, 0x8e, 0x21, 0x09, 0x64, 0x92, 0x50, 0x98, 0xa4, 0x64, 0x31, 0x61, 0x37, 0x4c, 0x48, 0x04,
-	0x84, 0xac, 0xd9, 0x44, 0x45, 0x55, 0x45, 0xab, 0x2a, 0xa4, 0x95, 0xc2, 0x8f, 0x36, 0x38, 0x87,
-	0x4a, 0x45, 0xea, 0x6a, 0xb2, 0x3b, 0xec, 0x5a, 0x78, 0x3d, 0xc6, 0xe3, 0x0d, 0xa0, 0x34, 0x17,
-	0xa4, 0x5e, 0xda, 0x0b, 0x52, 0x55, 0x55, 0x2a, 0x52, 0xef, 0xfd, 0x0b, 0x7a, 0xe9, 0xbd, 0x48,
-	0xbd, 0x20, 0xf5, 0x52, 0xf5, 0x10, 0x55, 0xd0, 0x63, 0x4f, 0xfc, 0x05, 0x95, 0x67, 0x9e, 0xbd,
-	0xf6, 0xc6, 0xde, 0xf5, 0x2a, 0x9c, 0xd6, 0x7e, 0xf3, 0xde, 0xfb, 0xbe, 0xf7, 0xe6, 0xd7, 0xe7,
-	0x45, 0x44, 0x34, 0x99, 0xe3, 0xb7, 0x0d, 0xd1, 0xb4, 0x98, 0x5d, 0x37, 0x76, 0x2a, 0xd4, 0x76,
-	0x9b, 0xb4, 0x62, 0x3c, 0x6c, 0x33, 0xef, 0x49, 0xd9, 0xf5, 0xb8, 0xcf, 0xf1, 0x69, 0xe5, 0x53,
-	0x56, 0x3e, 0xe5, 0xd0, 0x47, 0xbf, 0x5c, 0xe3, 0xa2, 0xc5, 0x85, 0xb1, 0x4d, 0x05, 0x53, 0x01,
-	0xc6, 0x4e, 0x65, 0x9b, 0xf9, 0xb4, 0x62, 0xb8, 0xb4, 0x61, 0x39, 0xd4, 0xb7, 0xb8, 0xa3, 0x72,
-	0xe8, 0xd3, 0x0d, 0xde, 0xe0, 0xf2, 0xd1, 0x08, 0x9e, 0xc0, 0x3a, 0xdb, 0xe0, 0xbc, 0x61, 0x33,
-	0x83, 0xba, 0x96, 0x41, 0x1d, 0x87, 0xfb, 0x32, 0x44, 0xc0, 0xe8, 0x7c, 0x06, 0x37, 0xe0, 0xa1,
-	0x9c, 0x2e, 0x64, 0x38, 0x35, 0x98, 0xc3, 0x84, 0x05, 0xa9, 0xc8, 0x12, 0x3a, 0x79, 0x37, 0x20,
-	0xb8, 0xc9, 0xb9, 0x6d, 0xb2, 0x87, 0x6d, 0x26, 0x7c, 0x3c, 0x83, 0x8e, 0xb9, 0x9c, 0xdb, 0x55,
-	0xab, 0x5e, 0xd0, 0xe6, 0xb4, 0x8b, 0xc3, 0xe6, 0x68, 0xf0, 0xba, 0x51, 0x27, 0xb7, 0xd0, 0xa9,
-	0x98, 0xb3, 0x70, 0xb9, 0x23, 0x18, 0xbe, 0x86, 0x86, 0x83, 0x61, 0xe9, 0x3a, 0xbe, 0x32, 0x5b,
-	0x4e, 0xef, 0x49, 0x39, 0x88, 0x59, 0x1b, 0x7e, 0xb1, 0x5f, 0x1a, 0x32, 0xa5, 0x3f, 0x31, 0xd0,
-	0x94, 0x4c, 0xb6, 0x15, 0xa4, 0xe1, 0x5e, 0x08, 0x5e, 0x40, 0xc7, 0x84, 0xb2, 0xc8, 0x8c, 0x63,
-	0x66, 0xf8, 0x4a, 0x36, 0xd1, 0x74, 0x32, 0x00, 0x08, 0xbc, 0x8f, 0x46, 0x82, 0x84, 0xa2, 0xa0,
-	0xcd, 0x1d, 0xcd, 0xc9, 0x40, 0x05, 0x90, 0xa9, 0x58, 0x3d, 0x02, 0x08, 0x90, 0xcf, 0x10, 0x8e,
-	0x1b, 0x0f, 0x0d, 0x72, 0x17, 0x15, 0x54, 0xbe, 0xb6, 0x57, 0x6b, 0x52, 0xc1, 0x6e, 0x5b, 0xc2,
-	0xef, 0xd7, 0x69, 0x3c, 0x8b, 0xc6, 0x5c, 0xf0, 0xf7, 0x0a, 0x47, 0x64, 0x1f, 0x3a, 0x06, 0x62,
-	0xa3, 0x33, 0x29, 0x29, 0x81, 0xe9, 0xe7, 0x68, 0x32, 0xf4, 0xac, 0xda, 0x96, 0xf0, 0x61, 0x62,
-	0x2e, 0x64, 0x32, 0x8e, 0x25, 0x01, 0xe6, 0x13, 0x6e, 0xcc, 0x46, 0xcc, 0x14, 0x34, 0x71, 0xc8,
-	0x0a, 0x38, 0xd2, 0xd3, 0x72, 0x42, 0x09, 0x77, 0xd1, 0x89, 0x44, 0x09, 0x61, 0xd7, 0x07, 0xa9,
-	0x61, 0x32, 0x5e, 0x83, 0x20, 0x33, 0xe8, 0xdd, 0x04, 0x60, 0x34, 0xdd, 0x5f, 0xa1, 0xd3, 0xdd,
-	0x03, 0xc0, 0x62, 0xbd, 0x53, 0x41, 0x48, 0x60, 0xae, 0x1f, 0x01, 0x00, 0xef, 0x04, 0x92, 0xab,
-	0xb0, 0x6a, 0x37, 0x3d, 0xbe, 0x63, 0xd5, 0x59, 0x7c, 0x9d, 0xd3, 0x7a, 0xdd, 0x63, 0x42, 0x84,
-	0xeb, 0x1c, 0x5e, 0xc9, 0xbd, 0x90, 0x6a, 0x14, 0x01, 0x84, 0xd6, 0xd0, 0x71, 0x17, 0x6c, 0x30,
-	0xa9, 0xd9, 0x7c, 0xc0, 0x0f, 0xf8, 0x44, 0x71, 0x9d, 0x3e, 0x80, 0xe1, 0x60, 0x1f, 0x3a, 0x03,
-	0xb1, 0x3e, 0x84, 0xc6, 0xbe, 0x7d, 0x48, 0xe2, 0x76, 0x02, 0x49, 0x21, 0xcc, 0xcf, 0xb9, 0xbd,
-	0x49, 0x3d, 0xda, 0x8a, 0x90, 0xef, 0xa1, 0x99, 0x03, 0x23, 0x00, 0xfd, 0x31, 0x1a, 0x75, 0xa5,
-	0x05, 0xea, 0x25, 0xbd, 0xb6, 0x9d, 0x8a, 0x05, 0x64, 0x88, 0x23, 0x67, 0x20, 0xf9, 0x0d, 0x9b,
-	0x5a, 0xad, 0x24, 0x2e, 0x83, 0x8d, 0x99, 0x18, 0x02, 0xe0, 0x8d, 0x2e, 0xe0, 0xa5, 0x2c, 0x60,
-	0x15, 0xec, 0x71, 0x97, 0x0b, 0x9a, 0xce, 0x40, 0x07, 0x98, 0x2d, 0x19, 0xb9, 0xe5, 0x53, 0xbf,
-	0x1d, 0x51, 0xf8, 0x76, 0x14, 0xf6, 0x56, 0x72, 0x10, 0x48, 0xf8, 0xe8, 0xa4, 0xcf, 0x7d, 0x6a,
-	0x57, 0x6b, 0xdc, 0xb6, 0xa9, 0xcf, 0x3c, 0xaa, 0x4e, 0xd9, 0xb1, 0xb5, 0x8d, 0x00, 0xe1, 0xef,
-	0xfd, 0xd2, 0x62, 0xc3, 0xf2, 0x9b, 0xed, 0xed, 0x72, 0x8d, 0xb7, 0x0c, 0xb8, 0x73, 0xd4, 0xcf,
-	0xb2, 0xa8, 0x3f, 0x30, 0xfc, 0x27, 0x2e, 0x13, 0xe5, 0x0d, 0xc7, 0x7f, 0xb3, 0x5f, 0x9a, 0x79,
-	0x42, 0x5b, 0xf6, 0x07, 0xa4, 0x3b, 0x1f, 0x31, 0xdf, 0x91, 0xa6, 0x1b, 0x91, 0x05, 0x37, 0xd1,
-	0x84, 0xf2, 0x52, 0xa5, 0xaa, 0xbd, 0xbb, 0xf6, 0xc9, 0xc0, 0x88, 0x53, 0x71, 0x44, 0x95, 0x8b,
-	0x98, 0xe3, 0xf2, 0x55, 0x55, 0x8b, 0x1f, 0xa1, 0x53, 0x6a, 0xf4, 0x91, 0xe5, 0x37, 0xeb, 0x1e,
-	0x7d, 0x64, 0x39, 0x8d, 0xc2, 0x51, 0x09, 0x77, 0x73, 0x60, 0xb8, 0x42, 0x1c, 0x2e, 0x96, 0x90,
-	0x98, 0xaa, 0x89, 0x5f, 0x74, 0x4c, 0xf8, 0x6b, 0x34, 0x5d, 0x6b, 0x7b, 0x1e, 0x73, 0xfc, 0xaa,
-	0x60, 0xde, 0x8e, 0x55, 0x63, 0xd5, 0xfb, 0x8c, 0x89, 0xc2, 0xb0, 0x9c, 0xeb, 0x85, 0xac, 0xb9,
-	0xbe, 0x63, 0x3d, 0x66, 0xf5, 0x75, 0x56, 0xbb, 0xc1, 0x2d, 0x47, 0xac, 0xcd, 0x07, 0x14, 0xdf,
-	0xec, 0x97, 0xce, 0x2a, 0xe0, 0xb4, 0x84, 0xc4, 0xc4, 0x60, 0xde, 0x52, 0xd6, 0x4f, 0x19, 0x13,
-	0xf8, 0xa9, 0x86, 0x4e, 0x7b, 0xac, 0x45, 0x2d, 0xc7, 0x72, 0x1a, 0x49, 0x02, 0x23, 0x83, 0x10,
-	0x58, 0x00, 0x02, 0xe7, 0x14, 0x81, 0xf4, 0x94, 0xc4, 0x9c, 0x8e, 0x06, 0xe2, 0x24, 0x9e, 0x69,
-	0x48, 0x6f, 0xd8, 0x7c, 0x3b, 0x9a, 0x9b, 0xaa, 0xf0, 0xe9, 0x83, 0x20, 0x5a, 0x5e, 0xe6, 0xa3,
-	0x72, 0x16, 0xb6, 0x06, 0x9e, 0x85, 0xf3, 0x8a, 0x4b, 0x76, 0x66, 0x62, 0xce, 0xa8, 0xc1, 0x68,
-	0xc5, 0x07, 0x43, 0x9b, 0x72, 0xa4, 0x7b, 0x2f, 0x04, 0x23, 0x87, 0xbc, 0x67, 0x5c, 0xb8, 0x67,
-	0xba, 0x72, 0xc2, 0x06, 0x33, 0xd1, 0x89, 0x24, 0x45, 0xd8, 0xed, 0x99, 0x13, 0x90, 0x48, 0x13,
-	0x5e, 0x34, 0x22, 0x6e, 0x24, 0x25, 0x74, 0x2e, 0x05, 0x91, 0xfa, 0x2c, 0xdc, 0xf3, 0x02, 0x15,
-	0xb3, 0x1c, 0xa2, 0xeb, 0x6f, 0xd8, 0xa3, 0x3e, 0x83, 0xbd, 0xfe, 0xe1, 0x00, 0x93, 0xb0, 0xce,
-	0x6a, 0x6f, 0xf6, 0x4b, 0xe3, 0xb0, 0x20, 0xa8, 0xcf, 0x88, 0x29, 0x53, 0x91, 0xeb, 0xd0, 0x5b,
-	0x93, 0x59, 0xad, 0xed, 0xb6, 0x27, 0x58, 0x8b, 0x39, 0x91, 0x0a, 0x29, 0xa1, 0x71, 0x17, 0x4e,
-	0xb0, 0x4e, 0x7f, 0x51, 0x68, 0xda, 0xa8, 0x47, 0xb7, 0x75, 0x57, 0x74, 0x44, 0x77, 0xd2, 0x8b,
-	0x0f, 0xf4, 0x6b, 0x62, 0x22, 0x4b, 0xd8, 0xc4, 0x44, 0x06, 0x32, 0x9b, 0x06, 0x18, 0x9d, 0x9a,
-	0x0e, 0x3a, 0x9b, 0x3a, 0x1a, 0x09, 0xa0, 0x11, 0x97, 0x5a, 0xd1, 0x5d, 0xb5, 0xda, 0xe3, 0xae,
-	0x52, 0x05, 0xae, 0x27, 0x12, 0x6d, 0x52, 0xcb, 0x8b, 0x14, 0x5c, 0x90, 0x67, 0xe5, 0xbf, 0x29,
-	0x34, 0x22, 0x01, 0xf1, 0x77, 0x1a, 0x1a, 0x0e, 0xd6, 0x2a, 0xbe, 0x98, 0x95, 0xb4, 0x5b, 0x4c,
-	0xeb, 0x97, 0x72, 0x78, 0x2a, 0xe2, 0xa4, 0xfc, 0xf4, 0xcf, 0x7f, 0xbf, 0x3f, 0x72, 0x11, 0x2f,
-	0x1a, 0x19, 0xd2, 0x3d, 0x58, 0xf2, 0xc6, 0x2e, 0xec, 0x83, 0x3d, 0xfc, 0xa3, 0x86, 0x8e, 0x81,
-	0x18, 0xc6, 0x4b, 0x3d, 0x61, 0x92, 0x1a, 0x5b, 0xbf, 0x92, 0xcf, 0x19, 0x68, 0x55, 0x24, 0xad,
-	0x25, 0x7c, 0x29, 0x8b, 0x16, 0x08, 0x74, 0x63, 0x17, 0x1e, 0xf6, 0xf0, 0x37, 0x1a, 0x1a, 0x91,
-	0xfa, 0x19, 0xf7, 0x2f, 0x3f, 0x9c, 0x56, 0xfd, 0x72, 0x1e, 0x57, 0xe0, 0xb4, 0x20, 0x39, 0x95,
-	0xf0, 0xb9, 0x5e, 0xad, 0x12, 0xf8, 0x77, 0x0d, 0x4d, 0xc4, 0xb5, 0x21, 0xbe, 0xda, 0x1b, 0xe3,
-	0xa0, 0x44, 0xd7, 0x2b, 0x03, 0x44, 0x00, 0x39, 0x53, 0x92, 0xbb, 0x8d, 0x6f, 0xe6, 0x9b, 0x47,
-	0x23, 0x3a, 0xae, 0x8c, 0xdd, 0xe8, 0xb1, 0x63, 0x95, 0x0a, 0x18, 0xff, 0xa1, 0xa1, 0xc9, 0x84,
-	0x58, 0xc6, 0xf9, 0x89, 0x45, 0x1d, 0x5e, 0x19, 0x24, 0x04, 0x8a, 0xd9, 0x92, 0xc5, 0xdc, 0xc1,
-	0xb7, 0xde, 0x5e, 0x31, 0x02, 0xff, 0xa0, 0xa1, 0xb1, 0x48, 0x70, 0xe3, 0xe5, 0x5c, 0xb4, 0xa2,
-	0x2a, 0xca, 0x79, 0xdd, 0xa1, 0x82, 0x4b, 0xb2, 0x82, 0x79, 0x7c, 0x3e, 0xb3, 0x82, 0x88, 0xc9,
-	0x73, 0x0d, 0x1d, 0x0f, 0x25, 0x2c, 0xee, 0xbd, 0x4b, 0xba, 0xf4, 0xbc, 0xbe, 0x9c, 0xd3, 0x1b,
-	0x48, 0xad, 0x48, 0x52, 0x57, 0xf0, 0xe5, 0x4c, 0x52, 0x10, 0x61, 0xec, 0xc2, 0x77, 0xc1, 0x9e,
-	0xea, 0x5a, 0x28, 0xa8, 0x71, 0x3e, 0xc0, 0xbc, 0x5d, 0xeb, 0x56, 0xfd, 0x39, 0xba, 0x16, 0x31,
-	0xf9, 0x49, 0x43, 0xa8, 0x23, 0xc0, 0x71, 0xb9, 0xef, 0x3e, 0x4e, 0xe8, 0x70, 0xdd, 0xc8, 0xed,
-	0x0f, 0xd4, 0x96, 0x24, 0xb5, 0x05, 0x3c, 0xdf, 0x6b, 0x49, 0x56, 0x95, 0xfc, 0xc6, 0x3f, 0x6b,
-	0x68, 0x3c, 0xa6, 0xf0, 0x71, 0x6f, 0xb4, 0x83, 0x9f, 0x09, 0xfa, 0xd5, 0xfc, 0x01, 0xc0, 0xef,
-	0x8a, 0xe4, 0xb7, 0x88, 0x2f, 0x64, 0xf1, 0xab, 0x05, 0x41, 0x21, 0xc1, 0xe7, 0x1a, 0x9a, 0x88,
-	0xcb, 0xff, 0x3e, 0x67, 0x54, 0xca, 0x67, 0x44, 0x9f, 0x33, 0x2a, 0xed, 0xdb, 0x82, 0x2c, 0x4a,
-	0x8e, 0x73, 0xb8, 0x98, 0x79, 0xa8, 0x2b, 0x32, 0xc1, 0xb9, 0x93, 0x50, 0x2a, 0x38, 0x27, 0x58,
-	0x4c, 0xbc, 0xf5, 0x39, 0x77, 0x52, 0xb5, 0xd9, 0x5b, 0x3a, 0x77, 0x92, 0xf2, 0x0e, 0xff, 0xaa,
-	0xa1, 0x53, 0x07, 0x74, 0x17, 0x7e, 0x6f, 0x00, 0x7a, 0x1d, 0x21, 0xa7, 0x5f, 0x1b, 0x34, 0x0c,
-	0x2a, 0x5b, 0x95, 0x95, 0x2d, 0xe3, 0x25, 0xa3, 0xe7, 0xdf, 0x78, 0x91, 0x6c, 0x0e, 0x04, 0x1c,
-	0xfe, 0x4d, 0x43, 0x93, 0x09, 0x99, 0xd2, 0x67, 0x1e, 0xd2, 0x84, 0x5e, 0x9f, 0x79, 0x48, 0x55,
-	0x77, 0x64, 0x5d, 0xb2, 0xfd, 0x08, 0x5f, 0xef, 0x71, 0x0e, 0x48, 0x19, 0x65, 0xec, 0xc6, 0x44,
-	0xe4, 0x9e, 0x91, 0x10, 0x74, 0xf8, 0x17, 0x0d, 0x9d, 0x48, 0xca, 0x35, 0x3c, 0x00, 0x99, 0x68,
-	0xa1, 0xaf, 0x0e, 0x14, 0x93, 0x57, 0x56, 0x25, 0xa8, 0x8a, 0xb5, 0x5b, 0x2f, 0x5e, 0x15, 0xb5,
-	0x97, 0xaf, 0x8a, 0xda, 0x3f, 0xaf, 0x8a, 0xda, 0xb3, 0xd7, 0xc5, 0xa1, 0x97, 0xaf, 0x8b, 0x43,
-	0x7f, 0xbd, 0x2e, 0x0e, 0x7d, 0x59, 0x89, 0x4b, 0x70, 0xe6, 0xf9, 0xd6, 0x83, 0xfb, 0xbc, 0xed,
-	0xd4, 0xe5, 0x7f, 0xb3, 0x61, 0xf2, 0xc7, 0x61, 0x7a, 0xa9, 0xc8, 0xb7, 0x47, 0xe5, 0xdf, 0xac,
-	0xab, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x79, 0xd1, 0x5e, 0x4f, 0x16, 0x00, 0x00,
+func (m *QueryAllocationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationsRequest.Merge(m, src)
+}
+func (m *QueryAllocationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAllocationsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error)
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	PurchaseList(ctx context.Context, in *QueryPurchaseListRequest, opts ...grpc.CallOption) (*QueryPurchaseListResponse, error)
-	PurchaseLists(ctx context.Context, in *QueryPurchaseListsRequest, opts ...grpc.CallOption) (*QueryPurchaseListsResponse, error)
-	Purchases(ctx context.Context, in *QueryPurchasesRequest, opts ...grpc.CallOption) (*QueryPurchasesResponse, error)
-	Provider(ctx context.Context, in *QueryProviderRequest, opts ...grpc.CallOption) (*QueryProviderResponse, error)
-	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
-	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
-	ClaimParams(ctx context.Context, in *QueryClaimParamsRequest, opts ...grpc.CallOption) (*QueryClaimParamsResponse, error)
-	ShieldStatus(ctx context.Context, in *QueryShieldStatusRequest, opts ...grpc.CallOption) (*QueryShieldStatusResponse, error)
-	ShieldStaking(ctx context.Context, in *QueryShieldStakingRequest, opts ...grpc.CallOption) (*QueryShieldStakingResponse, error)
-	ShieldStakingRate(ctx context.Context, in *QueryShieldStakingRateRequest, opts ...grpc.CallOption) (*QueryShieldStakingRateResponse, error)
-	Reimbursement(ctx context.Context, in *QueryReimbursementRequest, opts ...grpc.CallOption) (*QueryReimbursementResponse, error)
-	Reimbursements(ctx context.Context, in *QueryReimbursementsRequest, opts ...grpc.CallOption) (*QueryReimbursementsResponse, error)
+func (m *QueryAllocationsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllocationsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryAllocationsResponse struct {
+	Allocations []Allocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations"`
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllocationsResponse) Reset()         { *m = QueryAllocationsResponse{} }
+func (m *QueryAllocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationsResponse) ProtoMessage()    {}
+func (*QueryAllocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{31}
+}
+func (m *QueryAllocationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationsResponse.Merge(m, src)
+}
+func (m *QueryAllocationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error) {
-	out := new(QuerySponsorResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Sponsor", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllocationsResponse proto.InternalMessageInfo
+
+func (m *QueryAllocationsResponse) GetAllocations() []Allocation {
+	if m != nil {
+		return m.Allocations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryReimbursementVestingRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (c *queryClient) PurchaseList(ctx context.Context, in *QueryPurchaseListRequest, opts ...grpc.CallOption) (*QueryPurchaseListResponse, error) {
-	out := new(QueryPurchaseListResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PurchaseList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReimbursementVestingRequest) Reset()         { *m = QueryReimbursementVestingRequest{} }
+func (m *QueryReimbursementVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReimbursementVestingRequest) ProtoMessage()    {}
+func (*QueryReimbursementVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{32}
+}
+func (m *QueryReimbursementVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReimbursementVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReimbursementVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryReimbursementVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReimbursementVestingRequest.Merge(m, src)
+}
+func (m *QueryReimbursementVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReimbursementVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReimbursementVestingRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PurchaseLists(ctx context.Context, in *QueryPurchaseListsRequest, opts ...grpc.CallOption) (*QueryPurchaseListsResponse, error) {
-	out := new(QueryPurchaseListsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PurchaseLists", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReimbursementVestingRequest proto.InternalMessageInfo
+
+func (m *QueryReimbursementVestingRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Purchases(ctx context.Context, in *QueryPurchasesRequest, opts ...grpc.CallOption) (*QueryPurchasesResponse, error) {
-	out := new(QueryPurchasesResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Purchases", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryReimbursementVestingResponse struct {
+	Vested    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	Unvested  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
 }
 
-func (c *queryClient) Provider(ctx context.Context, in *QueryProviderRequest, opts ...grpc.CallOption) (*QueryProviderResponse, error) {
-	out := new(QueryProviderResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Provider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReimbursementVestingResponse) Reset()         { *m = QueryReimbursementVestingResponse{} }
+func (m *QueryReimbursementVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReimbursementVestingResponse) ProtoMessage()    {}
+func (*QueryReimbursementVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{33}
+}
+func (m *QueryReimbursementVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReimbursementVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReimbursementVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryReimbursementVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReimbursementVestingResponse.Merge(m, src)
+}
+func (m *QueryReimbursementVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReimbursementVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReimbursementVestingResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
-	out := new(QueryProvidersResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Providers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReimbursementVestingResponse proto.InternalMessageInfo
+
+func (m *QueryReimbursementVestingResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
-	out := new(QueryPoolParamsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PoolParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReimbursementVestingResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ClaimParams(ctx context.Context, in *QueryClaimParamsRequest, opts ...grpc.CallOption) (*QueryClaimParamsResponse, error) {
-	out := new(QueryClaimParamsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ClaimParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReimbursementVestingResponse) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ShieldStatus(ctx context.Context, in *QueryShieldStatusRequest, opts ...grpc.CallOption) (*QueryShieldStatusResponse, error) {
-	out := new(QueryShieldStatusResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryEpochSnapshotsRequest struct {
+	// start_epoch is the first epoch to return, inclusive.
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch to return, inclusive. Zero means no upper bound.
+	EndEpoch uint64 `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+}
+
+func (m *QueryEpochSnapshotsRequest) Reset()         { *m = QueryEpochSnapshotsRequest{} }
+func (m *QueryEpochSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSnapshotsRequest) ProtoMessage()    {}
+func (*QueryEpochSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{34}
+}
+func (m *QueryEpochSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSnapshotsRequest.Merge(m, src)
+}
+func (m *QueryEpochSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSnapshotsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ShieldStaking(ctx context.Context, in *QueryShieldStakingRequest, opts ...grpc.CallOption) (*QueryShieldStakingResponse, error) {
-	out := new(QueryShieldStakingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldStaking", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochSnapshotsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ShieldStakingRate(ctx context.Context, in *QueryShieldStakingRateRequest, opts ...grpc.CallOption) (*QueryShieldStakingRateResponse, error) {
-	out := new(QueryShieldStakingRateResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldStakingRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochSnapshotsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Reimbursement(ctx context.Context, in *QueryReimbursementRequest, opts ...grpc.CallOption) (*QueryReimbursementResponse, error) {
-	out := new(QueryReimbursementResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Reimbursement", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryEpochSnapshotsResponse struct {
+	Snapshots []EpochSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
 }
 
-func (c *queryClient) Reimbursements(ctx context.Context, in *QueryReimbursementsRequest, opts ...grpc.CallOption) (*QueryReimbursementsResponse, error) {
-	out := new(QueryReimbursementsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Reimbursements", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochSnapshotsResponse) Reset()         { *m = QueryEpochSnapshotsResponse{} }
+func (m *QueryEpochSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSnapshotsResponse) ProtoMessage()    {}
+func (*QueryEpochSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{35}
+}
+func (m *QueryEpochSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryEpochSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSnapshotsResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error)
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	PurchaseList(context.Context, *QueryPurchaseListRequest) (*QueryPurchaseListResponse, error)
-	PurchaseLists(context.Context, *QueryPurchaseListsRequest) (*QueryPurchaseListsResponse, error)
-	Purchases(context.Context, *QueryPurchasesRequest) (*QueryPurchasesResponse, error)
-	Provider(context.Context, *QueryProviderRequest) (*QueryProviderResponse, error)
-	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
-	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
-	ClaimParams(context.Context, *QueryClaimParamsRequest) (*QueryClaimParamsResponse, error)
-	ShieldStatus(context.Context, *QueryShieldStatusRequest) (*QueryShieldStatusResponse, error)
-	ShieldStaking(context.Context, *QueryShieldStakingRequest) (*QueryShieldStakingResponse, error)
-	ShieldStakingRate(context.Context, *QueryShieldStakingRateRequest) (*QueryShieldStakingRateResponse, error)
-	Reimbursement(context.Context, *QueryReimbursementRequest) (*QueryReimbursementResponse, error)
-	Reimbursements(context.Context, *QueryReimbursementsRequest) (*QueryReimbursementsResponse, error)
+var xxx_messageInfo_QueryEpochSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochSnapshotsResponse) GetSnapshots() []EpochSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryProviderYieldRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// window_epochs is the length of the trailing window in epochs. Zero means the default window.
+	WindowEpochs uint64 `protobuf:"varint,2,opt,name=window_epochs,json=windowEpochs,proto3" json:"window_epochs,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QueryProviderYieldRequest) Reset()         { *m = QueryProviderYieldRequest{} }
+func (m *QueryProviderYieldRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderYieldRequest) ProtoMessage()    {}
+func (*QueryProviderYieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{36}
 }
-func (*UnimplementedQueryServer) Sponsor(ctx context.Context, req *QuerySponsorRequest) (*QuerySponsorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sponsor not implemented")
+func (m *QueryProviderYieldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QueryProviderYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderYieldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PurchaseList(ctx context.Context, req *QueryPurchaseListRequest) (*QueryPurchaseListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurchaseList not implemented")
+func (m *QueryProviderYieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderYieldRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PurchaseLists(ctx context.Context, req *QueryPurchaseListsRequest) (*QueryPurchaseListsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurchaseLists not implemented")
+func (m *QueryProviderYieldRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Purchases(ctx context.Context, req *QueryPurchasesRequest) (*QueryPurchasesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Purchases not implemented")
+func (m *QueryProviderYieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderYieldRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Provider(ctx context.Context, req *QueryProviderRequest) (*QueryProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Provider not implemented")
+
+var xxx_messageInfo_QueryProviderYieldRequest proto.InternalMessageInfo
+
+func (m *QueryProviderYieldRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Providers(ctx context.Context, req *QueryProvidersRequest) (*QueryProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+
+func (m *QueryProviderYieldRequest) GetWindowEpochs() uint64 {
+	if m != nil {
+		return m.WindowEpochs
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+
+type QueryProviderYieldResponse struct {
+	// annualized_yield is the provider's window rewards over its collateral, annualized.
+	AnnualizedYield github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annualized_yield,json=annualizedYield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annualized_yield" yaml:"annualized_yield"`
+	// window_rewards is the estimated rewards earned by the provider's current positions over the window.
+	WindowRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=window_rewards,json=windowRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"window_rewards" yaml:"window_rewards"`
+	WindowStart   time.Time                                   `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+	Collateral    github_com_cosmos_cosmos_sdk_types.Int      `protobuf:"bytes,4,opt,name=collateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"collateral" yaml:"collateral"`
 }
-func (*UnimplementedQueryServer) ClaimParams(ctx context.Context, req *QueryClaimParamsRequest) (*QueryClaimParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimParams not implemented")
+
+func (m *QueryProviderYieldResponse) Reset()         { *m = QueryProviderYieldResponse{} }
+func (m *QueryProviderYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderYieldResponse) ProtoMessage()    {}
+func (*QueryProviderYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{37}
 }
-func (*UnimplementedQueryServer) ShieldStatus(ctx context.Context, req *QueryShieldStatusRequest) (*QueryShieldStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShieldStatus not implemented")
+func (m *QueryProviderYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ShieldStaking(ctx context.Context, req *QueryShieldStakingRequest) (*QueryShieldStakingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShieldStaking not implemented")
+func (m *QueryProviderYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ShieldStakingRate(ctx context.Context, req *QueryShieldStakingRateRequest) (*QueryShieldStakingRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShieldStakingRate not implemented")
+func (m *QueryProviderYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderYieldResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Reimbursement(ctx context.Context, req *QueryReimbursementRequest) (*QueryReimbursementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reimbursement not implemented")
+func (m *QueryProviderYieldResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Reimbursements(ctx context.Context, req *QueryReimbursementsRequest) (*QueryReimbursementsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reimbursements not implemented")
+func (m *QueryProviderYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderYieldResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryProviderYieldResponse proto.InternalMessageInfo
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+func (m *QueryProviderYieldResponse) GetWindowRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.WindowRewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Sponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySponsorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Sponsor(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Sponsor",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Sponsor(ctx, req.(*QuerySponsorRequest))
+func (m *QueryProviderYieldResponse) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPoolUtilizationRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func _Query_PurchaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPurchaseListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PurchaseList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/PurchaseList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PurchaseList(ctx, req.(*QueryPurchaseListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPoolUtilizationRequest) Reset()         { *m = QueryPoolUtilizationRequest{} }
+func (m *QueryPoolUtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolUtilizationRequest) ProtoMessage()    {}
+func (*QueryPoolUtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{38}
 }
-
-func _Query_PurchaseLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPurchaseListsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PurchaseLists(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/PurchaseLists",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PurchaseLists(ctx, req.(*QueryPurchaseListsRequest))
+func (m *QueryPoolUtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolUtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolUtilizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolUtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolUtilizationRequest.Merge(m, src)
+}
+func (m *QueryPoolUtilizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolUtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolUtilizationRequest.DiscardUnknown(m)
 }
 
-func _Query_Purchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPurchasesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Purchases(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Purchases",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Purchases(ctx, req.(*QueryPurchasesRequest))
+var xxx_messageInfo_QueryPoolUtilizationRequest proto.InternalMessageInfo
+
+func (m *QueryPoolUtilizationRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Provider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Provider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Provider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Provider(ctx, req.(*QueryProviderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPoolUtilizationResponse struct {
+	Shield      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=shield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shield" yaml:"shield"`
+	ShieldLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=shield_limit,json=shieldLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shield_limit" yaml:"shield_limit"`
+	// available_collateral is the collateral allocated to the pool that is not being withdrawn.
+	AvailableCollateral github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=available_collateral,json=availableCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"available_collateral" yaml:"available_collateral"`
+	// max_shield is the lesser of the shield limit and the pool's share of its available collateral.
+	MaxShield github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_shield,json=maxShield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_shield" yaml:"max_shield"`
+	// utilization is the ratio of the pool's shield to its max shield.
+	Utilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization" yaml:"utilization"`
 }
 
-func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Providers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Providers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Providers(ctx, req.(*QueryProvidersRequest))
+func (m *QueryPoolUtilizationResponse) Reset()         { *m = QueryPoolUtilizationResponse{} }
+func (m *QueryPoolUtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolUtilizationResponse) ProtoMessage()    {}
+func (*QueryPoolUtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{39}
+}
+func (m *QueryPoolUtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolUtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolUtilizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolUtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolUtilizationResponse.Merge(m, src)
+}
+func (m *QueryPoolUtilizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolUtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolUtilizationResponse.DiscardUnknown(m)
 }
 
-func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/PoolParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPoolUtilizationResponse proto.InternalMessageInfo
+
+type QueryAvailableShieldRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func _Query_ClaimParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/ClaimParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimParams(ctx, req.(*QueryClaimParamsRequest))
+func (m *QueryAvailableShieldRequest) Reset()         { *m = QueryAvailableShieldRequest{} }
+func (m *QueryAvailableShieldRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAvailableShieldRequest) ProtoMessage()    {}
+func (*QueryAvailableShieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{40}
+}
+func (m *QueryAvailableShieldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAvailableShieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAvailableShieldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAvailableShieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvailableShieldRequest.Merge(m, src)
+}
+func (m *QueryAvailableShieldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAvailableShieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvailableShieldRequest.DiscardUnknown(m)
 }
 
-func _Query_ShieldStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShieldStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShieldStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShieldStatus(ctx, req.(*QueryShieldStatusRequest))
+var xxx_messageInfo_QueryAvailableShieldRequest proto.InternalMessageInfo
+
+func (m *QueryAvailableShieldRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ShieldStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShieldStakingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShieldStaking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldStaking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShieldStaking(ctx, req.(*QueryShieldStakingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAvailableShieldResponse struct {
+	AvailableShield github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=available_shield,json=availableShield,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"available_shield" yaml:"available_shield"`
 }
 
-func _Query_ShieldStakingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShieldStakingRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShieldStakingRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldStakingRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShieldStakingRate(ctx, req.(*QueryShieldStakingRateRequest))
+func (m *QueryAvailableShieldResponse) Reset()         { *m = QueryAvailableShieldResponse{} }
+func (m *QueryAvailableShieldResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAvailableShieldResponse) ProtoMessage()    {}
+func (*QueryAvailableShieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{41}
+}
+func (m *QueryAvailableShieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAvailableShieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAvailableShieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAvailableShieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvailableShieldResponse.Merge(m, src)
+}
+func (m *QueryAvailableShieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAvailableShieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvailableShieldResponse.DiscardUnknown(m)
 }
 
-func _Query_Reimbursement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReimbursementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAvailableShieldResponse proto.InternalMessageInfo
+
+func (m *QueryAvailableShieldResponse) GetAvailableShield() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AvailableShield
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Reimbursement(ctx, in)
+	return nil
+}
+
+type QueryShieldRolesRequest struct {
+}
+
+func (m *QueryShieldRolesRequest) Reset()         { *m = QueryShieldRolesRequest{} }
+func (m *QueryShieldRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShieldRolesRequest) ProtoMessage()    {}
+func (*QueryShieldRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{42}
+}
+func (m *QueryShieldRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShieldRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShieldRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Reimbursement",
+}
+func (m *QueryShieldRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShieldRolesRequest.Merge(m, src)
+}
+func (m *QueryShieldRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShieldRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShieldRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShieldRolesRequest proto.InternalMessageInfo
+
+type QueryShieldRolesResponse struct {
+	Roles ShieldRoles `protobuf:"bytes,1,opt,name=roles,proto3" json:"roles" yaml:"roles"`
+}
+
+func (m *QueryShieldRolesResponse) Reset()         { *m = QueryShieldRolesResponse{} }
+func (m *QueryShieldRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShieldRolesResponse) ProtoMessage()    {}
+func (*QueryShieldRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{43}
+}
+func (m *QueryShieldRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShieldRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShieldRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Reimbursement(ctx, req.(*QueryReimbursementRequest))
+}
+func (m *QueryShieldRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShieldRolesResponse.Merge(m, src)
+}
+func (m *QueryShieldRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShieldRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShieldRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShieldRolesResponse proto.InternalMessageInfo
+
+func (m *QueryShieldRolesResponse) GetRoles() ShieldRoles {
+	if m != nil {
+		return m.Roles
 	}
-	return interceptor(ctx, in, info, handler)
+	return ShieldRoles{}
 }
 
-func _Query_Reimbursements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReimbursementsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryClaimRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryClaimRequest) Reset()         { *m = QueryClaimRequest{} }
+func (m *QueryClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRequest) ProtoMessage()    {}
+func (*QueryClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{44}
+}
+func (m *QueryClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Reimbursements(ctx, in)
+}
+func (m *QueryClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRequest.Merge(m, src)
+}
+func (m *QueryClaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimRequest proto.InternalMessageInfo
+
+func (m *QueryClaimRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.shield.v1alpha1.Query/Reimbursements",
+	return 0
+}
+
+type QueryClaimResponse struct {
+	Claim Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim"`
+}
+
+func (m *QueryClaimResponse) Reset()         { *m = QueryClaimResponse{} }
+func (m *QueryClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimResponse) ProtoMessage()    {}
+func (*QueryClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{45}
+}
+func (m *QueryClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Reimbursements(ctx, req.(*QueryReimbursementsRequest))
+}
+func (m *QueryClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimResponse.Merge(m, src)
+}
+func (m *QueryClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimResponse proto.InternalMessageInfo
+
+func (m *QueryClaimResponse) GetClaim() Claim {
+	if m != nil {
+		return m.Claim
 	}
-	return interceptor(ctx, in, info, handler)
+	return Claim{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "shentu.shield.v1alpha1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "Sponsor",
-			Handler:    _Query_Sponsor_Handler,
-		},
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "PurchaseList",
-			Handler:    _Query_PurchaseList_Handler,
-		},
-		{
-			MethodName: "PurchaseLists",
-			Handler:    _Query_PurchaseLists_Handler,
-		},
-		{
-			MethodName: "Purchases",
-			Handler:    _Query_Purchases_Handler,
-		},
-		{
-			MethodName: "Provider",
-			Handler:    _Query_Provider_Handler,
-		},
-		{
-			MethodName: "Providers",
-			Handler:    _Query_Providers_Handler,
-		},
-		{
-			MethodName: "PoolParams",
-			Handler:    _Query_PoolParams_Handler,
-		},
-		{
-			MethodName: "ClaimParams",
-			Handler:    _Query_ClaimParams_Handler,
-		},
-		{
-			MethodName: "ShieldStatus",
-			Handler:    _Query_ShieldStatus_Handler,
-		},
-		{
-			MethodName: "ShieldStaking",
-			Handler:    _Query_ShieldStaking_Handler,
-		},
-		{
-			MethodName: "ShieldStakingRate",
-			Handler:    _Query_ShieldStakingRate_Handler,
-		},
-		{
-			MethodName: "Reimbursement",
-			Handler:    _Query_Reimbursement_Handler,
-		},
-		{
-			MethodName: "Reimbursements",
-			Handler:    _Query_Reimbursements_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "shentu/shield/v1alpha1/query.proto",
+type QueryClaimsRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Purchaser string `protobuf:"bytes,2,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClaimsRequest) Reset()         { *m = QueryClaimsRequest{} }
+func (m *QueryClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimsRequest) ProtoMessage()    {}
+func (*QueryClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{46}
+}
+func (m *QueryClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimsRequest.Merge(m, src)
+}
+func (m *QueryClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClaimsRequest) GetPurchaser() string {
+	if m != nil {
+		return m.Purchaser
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimsResponse struct {
+	Claims []Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryClaimsResponse) Reset()         { *m = QueryClaimsResponse{} }
+func (m *QueryClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimsResponse) ProtoMessage()    {}
+func (*QueryClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{47}
+}
+func (m *QueryClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimsResponse.Merge(m, src)
+}
+func (m *QueryClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySponsorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimsResponse) GetClaims() []Claim {
+	if m != nil {
+		return m.Claims
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySponsorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStakingRewardsRequest struct {
+	Purchaser string `protobuf:"bytes,1,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
 }
 
-func (m *QuerySponsorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sponsor) > 0 {
-		i -= len(m.Sponsor)
-		copy(dAtA[i:], m.Sponsor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryStakingRewardsRequest) Reset()         { *m = QueryStakingRewardsRequest{} }
+func (m *QueryStakingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingRewardsRequest) ProtoMessage()    {}
+func (*QueryStakingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{48}
+}
+func (m *QueryStakingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStakingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingRewardsRequest.Merge(m, src)
+}
+func (m *QueryStakingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySponsorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStakingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryStakingRewardsRequest) GetPurchaser() string {
+	if m != nil {
+		return m.Purchaser
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySponsorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStakingRewardsResponse struct {
+	ShieldStakings []ShieldStaking `protobuf:"bytes,1,rep,name=shield_stakings,json=shieldStakings,proto3" json:"shield_stakings"`
+	Total          MixedDecCoins   `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
 }
 
-func (m *QuerySponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryStakingRewardsResponse) Reset()         { *m = QueryStakingRewardsResponse{} }
+func (m *QueryStakingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingRewardsResponse) ProtoMessage()    {}
+func (*QueryStakingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{49}
+}
+func (m *QueryStakingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStakingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingRewardsResponse.Merge(m, src)
+}
+func (m *QueryStakingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStakingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryStakingRewardsResponse) GetShieldStakings() []ShieldStaking {
+	if m != nil {
+		return m.ShieldStakings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStakingRewardsResponse) GetTotal() MixedDecCoins {
+	if m != nil {
+		return m.Total
+	}
+	return MixedDecCoins{}
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryUnstakingsRequest struct {
+	Purchaser string `protobuf:"bytes,1,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnstakingsRequest) Reset()         { *m = QueryUnstakingsRequest{} }
+func (m *QueryUnstakingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakingsRequest) ProtoMessage()    {}
+func (*QueryUnstakingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{50}
+}
+func (m *QueryUnstakingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryUnstakingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakingsRequest.Merge(m, src)
+}
+func (m *QueryUnstakingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryUnstakingsRequest proto.InternalMessageInfo
+
+func (m *QueryUnstakingsRequest) GetPurchaser() string {
+	if m != nil {
+		return m.Purchaser
+	}
+	return ""
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryUnstakingsResponse struct {
+	Unstakings []Unstaking `protobuf:"bytes,1,rep,name=unstakings,proto3" json:"unstakings"`
+}
+
+func (m *QueryUnstakingsResponse) Reset()         { *m = QueryUnstakingsResponse{} }
+func (m *QueryUnstakingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakingsResponse) ProtoMessage()    {}
+func (*QueryUnstakingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb9aa5f07f44644, []int{51}
+}
+func (m *QueryUnstakingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnstakingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakingsResponse.Merge(m, src)
+}
+func (m *QueryUnstakingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPurchaseListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnstakingsResponse proto.InternalMessageInfo
+
+func (m *QueryUnstakingsResponse) GetUnstakings() []Unstaking {
+	if m != nil {
+		return m.Unstakings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPurchaseListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryPoolRequest)(nil), "shentu.shield.v1alpha1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "shentu.shield.v1alpha1.QueryPoolResponse")
+	proto.RegisterType((*QuerySponsorRequest)(nil), "shentu.shield.v1alpha1.QuerySponsorRequest")
+	proto.RegisterType((*QuerySponsorResponse)(nil), "shentu.shield.v1alpha1.QuerySponsorResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "shentu.shield.v1alpha1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "shentu.shield.v1alpha1.QueryPoolsResponse")
+	proto.RegisterType((*QueryPurchaseListRequest)(nil), "shentu.shield.v1alpha1.QueryPurchaseListRequest")
+	proto.RegisterType((*QueryPurchaseListResponse)(nil), "shentu.shield.v1alpha1.QueryPurchaseListResponse")
+	proto.RegisterType((*QueryPurchaseListsRequest)(nil), "shentu.shield.v1alpha1.QueryPurchaseListsRequest")
+	proto.RegisterType((*QueryPurchaseListsResponse)(nil), "shentu.shield.v1alpha1.QueryPurchaseListsResponse")
+	proto.RegisterType((*QueryPurchasesRequest)(nil), "shentu.shield.v1alpha1.QueryPurchasesRequest")
+	proto.RegisterType((*QueryPurchasesResponse)(nil), "shentu.shield.v1alpha1.QueryPurchasesResponse")
+	proto.RegisterType((*QueryProviderRequest)(nil), "shentu.shield.v1alpha1.QueryProviderRequest")
+	proto.RegisterType((*QueryProviderResponse)(nil), "shentu.shield.v1alpha1.QueryProviderResponse")
+	proto.RegisterType((*QueryProvidersRequest)(nil), "shentu.shield.v1alpha1.QueryProvidersRequest")
+	proto.RegisterType((*QueryProvidersResponse)(nil), "shentu.shield.v1alpha1.QueryProvidersResponse")
+	proto.RegisterType((*QueryPoolParamsRequest)(nil), "shentu.shield.v1alpha1.QueryPoolParamsRequest")
+	proto.RegisterType((*QueryPoolParamsResponse)(nil), "shentu.shield.v1alpha1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryClaimParamsRequest)(nil), "shentu.shield.v1alpha1.QueryClaimParamsRequest")
+	proto.RegisterType((*QueryClaimParamsResponse)(nil), "shentu.shield.v1alpha1.QueryClaimParamsResponse")
+	proto.RegisterType((*QueryShieldStatusRequest)(nil), "shentu.shield.v1alpha1.QueryShieldStatusRequest")
+	proto.RegisterType((*QueryShieldStatusResponse)(nil), "shentu.shield.v1alpha1.QueryShieldStatusResponse")
+	proto.RegisterType((*QueryShieldStakingRequest)(nil), "shentu.shield.v1alpha1.QueryShieldStakingRequest")
+	proto.RegisterType((*QueryShieldStakingResponse)(nil), "shentu.shield.v1alpha1.QueryShieldStakingResponse")
+	proto.RegisterType((*QueryShieldStakingRateRequest)(nil), "shentu.shield.v1alpha1.QueryShieldStakingRateRequest")
+	proto.RegisterType((*QueryShieldStakingRateResponse)(nil), "shentu.shield.v1alpha1.QueryShieldStakingRateResponse")
+	proto.RegisterType((*QueryReimbursementRequest)(nil), "shentu.shield.v1alpha1.QueryReimbursementRequest")
+	proto.RegisterType((*QueryReimbursementResponse)(nil), "shentu.shield.v1alpha1.QueryReimbursementResponse")
+	proto.RegisterType((*QueryReimbursementsRequest)(nil), "shentu.shield.v1alpha1.QueryReimbursementsRequest")
+	proto.RegisterType((*QueryReimbursementsResponse)(nil), "shentu.shield.v1alpha1.QueryReimbursementsResponse")
+	proto.RegisterType((*QueryAllocationsRequest)(nil), "shentu.shield.v1alpha1.QueryAllocationsRequest")
+	proto.RegisterType((*QueryAllocationsResponse)(nil), "shentu.shield.v1alpha1.QueryAllocationsResponse")
+	proto.RegisterType((*QueryReimbursementVestingRequest)(nil), "shentu.shield.v1alpha1.QueryReimbursementVestingRequest")
+	proto.RegisterType((*QueryReimbursementVestingResponse)(nil), "shentu.shield.v1alpha1.QueryReimbursementVestingResponse")
+	proto.RegisterType((*QueryEpochSnapshotsRequest)(nil), "shentu.shield.v1alpha1.QueryEpochSnapshotsRequest")
+	proto.RegisterType((*QueryEpochSnapshotsResponse)(nil), "shentu.shield.v1alpha1.QueryEpochSnapshotsResponse")
+	proto.RegisterType((*QueryProviderYieldRequest)(nil), "shentu.shield.v1alpha1.QueryProviderYieldRequest")
+	proto.RegisterType((*QueryProviderYieldResponse)(nil), "shentu.shield.v1alpha1.QueryProviderYieldResponse")
+	proto.RegisterType((*QueryPoolUtilizationRequest)(nil), "shentu.shield.v1alpha1.QueryPoolUtilizationRequest")
+	proto.RegisterType((*QueryPoolUtilizationResponse)(nil), "shentu.shield.v1alpha1.QueryPoolUtilizationResponse")
+	proto.RegisterType((*QueryAvailableShieldRequest)(nil), "shentu.shield.v1alpha1.QueryAvailableShieldRequest")
+	proto.RegisterType((*QueryAvailableShieldResponse)(nil), "shentu.shield.v1alpha1.QueryAvailableShieldResponse")
+	proto.RegisterType((*QueryShieldRolesRequest)(nil), "shentu.shield.v1alpha1.QueryShieldRolesRequest")
+	proto.RegisterType((*QueryShieldRolesResponse)(nil), "shentu.shield.v1alpha1.QueryShieldRolesResponse")
+	proto.RegisterType((*QueryClaimRequest)(nil), "shentu.shield.v1alpha1.QueryClaimRequest")
+	proto.RegisterType((*QueryClaimResponse)(nil), "shentu.shield.v1alpha1.QueryClaimResponse")
+	proto.RegisterType((*QueryClaimsRequest)(nil), "shentu.shield.v1alpha1.QueryClaimsRequest")
+	proto.RegisterType((*QueryClaimsResponse)(nil), "shentu.shield.v1alpha1.QueryClaimsResponse")
+	proto.RegisterType((*QueryStakingRewardsRequest)(nil), "shentu.shield.v1alpha1.QueryStakingRewardsRequest")
+	proto.RegisterType((*QueryStakingRewardsResponse)(nil), "shentu.shield.v1alpha1.QueryStakingRewardsResponse")
+	proto.RegisterType((*QueryUnstakingsRequest)(nil), "shentu.shield.v1alpha1.QueryUnstakingsRequest")
+	proto.RegisterType((*QueryUnstakingsResponse)(nil), "shentu.shield.v1alpha1.QueryUnstakingsResponse")
 }
 
-func (m *QueryPurchaseListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Purchaser) > 0 {
-		i -= len(m.Purchaser)
-		copy(dAtA[i:], m.Purchaser)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("shentu/shield/v1alpha1/query.proto", fileDescriptor_1cb9aa5f07f44644)
 }
 
-func (m *QueryPurchaseListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_1cb9aa5f07f44644 = []byte{
+	// 2644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xf7, 0xda, 0x92, 0x62, 0x3d, 0xea, 0x23, 0x1e, 0xc9, 0x11, 0xbd, 0x96, 0x45, 0x7b, 0x14,
+	0xfb, 0x6f, 0x5b, 0x31, 0x57, 0x1f, 0xfe, 0xbb, 0x8e, 0xeb, 0xd4, 0x31, 0x25, 0xa7, 0xf0, 0x57,
+	0x22, 0xaf, 0x92, 0x06, 0x75, 0x80, 0x10, 0x2b, 0x72, 0x2c, 0x2d, 0x4c, 0xee, 0x32, 0x3b, 0x4b,
+	0xc9, 0x8e, 0x2b, 0xa0, 0x08, 0xd0, 0x43, 0xdb, 0x8b, 0x83, 0xa2, 0x68, 0xd1, 0xb4, 0xbd, 0xd7,
+	0x97, 0x06, 0x3d, 0xb4, 0x3d, 0xb4, 0x40, 0x6f, 0x0d, 0xd0, 0x4b, 0x80, 0x5e, 0xda, 0xa2, 0xb0,
+	0x0b, 0xbb, 0xb7, 0xde, 0x7c, 0x2f, 0x50, 0xec, 0xcc, 0xdb, 0x2f, 0x72, 0xc9, 0xdd, 0x8d, 0x7c,
+	0x12, 0xf7, 0xcd, 0xbc, 0xdf, 0xfb, 0xbd, 0x99, 0x37, 0x33, 0x6f, 0xde, 0x08, 0x28, 0xdf, 0x64,
+	0x96, 0xdb, 0xd6, 0xf8, 0xa6, 0xc9, 0x1a, 0x75, 0x6d, 0x6b, 0xc1, 0x68, 0xb4, 0x36, 0x8d, 0x05,
+	0xed, 0xa3, 0x36, 0x73, 0xee, 0x97, 0x5b, 0x8e, 0xed, 0xda, 0xe4, 0x15, 0xd9, 0xa7, 0x2c, 0xfb,
+	0x94, 0xfd, 0x3e, 0xea, 0xe9, 0x9a, 0xcd, 0x9b, 0x36, 0xd7, 0xd6, 0x0d, 0xce, 0xa4, 0x82, 0xb6,
+	0xb5, 0xb0, 0xce, 0x5c, 0x63, 0x41, 0x6b, 0x19, 0x1b, 0xa6, 0x65, 0xb8, 0xa6, 0x6d, 0x49, 0x0c,
+	0x75, 0x26, 0xda, 0xd7, 0xef, 0x55, 0xb3, 0x4d, 0xbf, 0x7d, 0x72, 0xc3, 0xde, 0xb0, 0xc5, 0x4f,
+	0xcd, 0xfb, 0x85, 0xd2, 0xe9, 0x0d, 0xdb, 0xde, 0x68, 0x30, 0xcd, 0x68, 0x99, 0x9a, 0x61, 0x59,
+	0xb6, 0x2b, 0x20, 0xb9, 0x8f, 0x89, 0xad, 0xe2, 0x6b, 0xbd, 0x7d, 0x47, 0xab, 0xb7, 0x9d, 0xa8,
+	0xcd, 0x52, 0x67, 0xbb, 0x6b, 0x36, 0x19, 0x77, 0x8d, 0x66, 0x0b, 0x3b, 0xcc, 0xf6, 0x70, 0x1e,
+	0x1d, 0x95, 0x9d, 0x5e, 0xed, 0xd1, 0x69, 0x83, 0x59, 0x8c, 0x9b, 0xc8, 0x85, 0xce, 0xc1, 0xcb,
+	0xb7, 0xbc, 0x11, 0x58, 0xb5, 0xed, 0x86, 0xce, 0x3e, 0x6a, 0x33, 0xee, 0x92, 0x29, 0x78, 0xa9,
+	0x65, 0xdb, 0x8d, 0xaa, 0x59, 0x2f, 0x2a, 0x47, 0x95, 0x93, 0x03, 0xfa, 0x90, 0xf7, 0x79, 0xb5,
+	0x4e, 0xaf, 0xc3, 0x81, 0x48, 0x67, 0xde, 0xb2, 0x2d, 0xce, 0xc8, 0x39, 0x18, 0xf0, 0x9a, 0x45,
+	0xd7, 0xc2, 0xe2, 0x74, 0x39, 0x79, 0xd0, 0xcb, 0x9e, 0x4e, 0x65, 0xe0, 0x8b, 0xc7, 0xa5, 0x3d,
+	0xba, 0xe8, 0x4f, 0x35, 0x98, 0x10, 0x60, 0x6b, 0x1e, 0x8c, 0xed, 0xf8, 0xc6, 0x8b, 0xf0, 0x12,
+	0x97, 0x12, 0x81, 0x38, 0xac, 0xfb, 0x9f, 0x74, 0x15, 0x26, 0xe3, 0x0a, 0x48, 0xe0, 0x3c, 0x0c,
+	0x7a, 0x80, 0xbc, 0xa8, 0x1c, 0xdd, 0x97, 0x91, 0x81, 0x54, 0xa0, 0x13, 0x11, 0x7f, 0x38, 0x12,
+	0xa0, 0x6f, 0x03, 0x89, 0x0a, 0x77, 0x6d, 0xe4, 0x16, 0x14, 0x25, 0x5e, 0xdb, 0xa9, 0x6d, 0x1a,
+	0x9c, 0xdd, 0x30, 0xb9, 0x9b, 0x36, 0xd2, 0x64, 0x1a, 0x86, 0x5b, 0xd8, 0xdf, 0x29, 0xee, 0x15,
+	0xe3, 0x10, 0x0a, 0x68, 0x03, 0x0e, 0x25, 0x40, 0x22, 0xd3, 0x77, 0x60, 0xd4, 0xef, 0x59, 0x6d,
+	0x98, 0xdc, 0xc5, 0x89, 0x79, 0xb5, 0x27, 0xe3, 0x08, 0x08, 0x32, 0x1f, 0x69, 0x45, 0x64, 0x54,
+	0x4f, 0xb0, 0xc6, 0x77, 0xe9, 0x81, 0x0d, 0x6a, 0x12, 0x26, 0xba, 0x70, 0x0b, 0xc6, 0x62, 0x2e,
+	0xf8, 0xa3, 0x9e, 0xc7, 0x87, 0xd1, 0xa8, 0x0f, 0x9c, 0x4e, 0xc1, 0xc1, 0x98, 0xc1, 0x60, 0xba,
+	0x3f, 0x84, 0x57, 0x3a, 0x1b, 0x90, 0xc5, 0x4a, 0xe8, 0x81, 0x4f, 0xe0, 0x68, 0x1a, 0x01, 0x34,
+	0x1e, 0x2a, 0xd2, 0x79, 0x8c, 0xda, 0x55, 0xc7, 0xde, 0x32, 0xeb, 0x2c, 0x1a, 0xe7, 0x46, 0xbd,
+	0xee, 0x30, 0xce, 0xfd, 0x38, 0xc7, 0x4f, 0xfa, 0x01, 0x1c, 0xec, 0xd0, 0x40, 0x42, 0x15, 0xd8,
+	0xdf, 0x42, 0x19, 0x4e, 0x6a, 0x6f, 0x3e, 0xd8, 0x0f, 0xf9, 0x04, 0x7a, 0xe1, 0x38, 0xa0, 0xa0,
+	0x7b, 0x1c, 0xc2, 0x86, 0xc8, 0x38, 0xf8, 0xc2, 0xd4, 0x71, 0x88, 0xdb, 0x0d, 0x15, 0x69, 0xd1,
+	0xc7, 0xb7, 0xed, 0xc6, 0xaa, 0xe1, 0x18, 0xcd, 0xc0, 0xf2, 0x07, 0x30, 0xd5, 0xd5, 0x82, 0xa6,
+	0xdf, 0x84, 0xa1, 0x96, 0x90, 0xa0, 0xbf, 0xb4, 0xdf, 0xb2, 0x93, 0xba, 0x68, 0x19, 0xf5, 0xe8,
+	0x21, 0x04, 0x5f, 0x6e, 0x18, 0x66, 0x33, 0x6e, 0x97, 0x41, 0xb1, 0xbb, 0x09, 0x0d, 0x5f, 0xed,
+	0x30, 0x3c, 0xd7, 0xcb, 0xb0, 0x54, 0x76, 0xec, 0x96, 0xcd, 0x8d, 0x64, 0x06, 0x2a, 0x9a, 0x59,
+	0x13, 0x9a, 0x6b, 0xae, 0xe1, 0xb6, 0x03, 0x0a, 0x3f, 0x18, 0x82, 0x43, 0x09, 0x8d, 0x48, 0xc2,
+	0x85, 0x97, 0x5d, 0xdb, 0x35, 0x1a, 0xd5, 0x9a, 0xdd, 0x68, 0x18, 0x2e, 0x73, 0x0c, 0xb9, 0xcb,
+	0x0e, 0x57, 0xae, 0x7a, 0x16, 0xfe, 0xf1, 0xb8, 0x74, 0x62, 0xc3, 0x74, 0x37, 0xdb, 0xeb, 0xe5,
+	0x9a, 0xdd, 0xd4, 0xf0, 0xa0, 0x92, 0x7f, 0xce, 0xf0, 0xfa, 0x5d, 0xcd, 0xbd, 0xdf, 0x62, 0xbc,
+	0x7c, 0xd5, 0x72, 0x9f, 0x3f, 0x2e, 0x4d, 0xdd, 0x37, 0x9a, 0x8d, 0x0b, 0xb4, 0x13, 0x8f, 0xea,
+	0xe3, 0x42, 0xb4, 0x1c, 0x48, 0xc8, 0x26, 0x8c, 0xc8, 0x5e, 0xd2, 0x55, 0xb9, 0x76, 0x2b, 0x57,
+	0x72, 0x5b, 0x9c, 0x88, 0x5a, 0x94, 0x58, 0x54, 0x2f, 0x88, 0x4f, 0xe9, 0x2d, 0xd9, 0x86, 0x03,
+	0xb2, 0x75, 0xdb, 0x74, 0x37, 0xeb, 0x8e, 0xb1, 0x6d, 0x5a, 0x1b, 0xc5, 0x7d, 0xc2, 0xdc, 0xb5,
+	0xdc, 0xe6, 0x8a, 0x51, 0x73, 0x11, 0x40, 0xaa, 0xcb, 0x41, 0x7c, 0x3f, 0x14, 0x91, 0xef, 0xc0,
+	0x64, 0xad, 0xed, 0x38, 0xcc, 0x72, 0xab, 0x9c, 0x39, 0x5b, 0x66, 0x8d, 0x55, 0xef, 0x30, 0xc6,
+	0x8b, 0x03, 0x62, 0xae, 0x8f, 0xf7, 0x9a, 0xeb, 0x9b, 0xe6, 0x3d, 0x56, 0x5f, 0x61, 0xb5, 0x65,
+	0xdb, 0xb4, 0x78, 0x65, 0xd6, 0xa3, 0xf8, 0xfc, 0x71, 0xe9, 0xb0, 0x34, 0x9c, 0x04, 0x48, 0x75,
+	0x82, 0xe2, 0x35, 0x29, 0x7d, 0x8b, 0x31, 0x4e, 0x3e, 0x51, 0xe0, 0x15, 0x87, 0x35, 0x0d, 0xd3,
+	0x32, 0xad, 0x8d, 0x38, 0x81, 0xc1, 0x3c, 0x04, 0x8e, 0x23, 0x81, 0x23, 0x92, 0x40, 0x32, 0x24,
+	0xd5, 0x27, 0x83, 0x86, 0x28, 0x89, 0x87, 0x0a, 0xa8, 0x1b, 0x0d, 0x7b, 0x3d, 0x98, 0x9b, 0x2a,
+	0x77, 0x8d, 0xbb, 0x9e, 0xb6, 0x38, 0xcc, 0x87, 0xc4, 0x2c, 0xac, 0xe5, 0x9e, 0x85, 0x63, 0x92,
+	0x4b, 0x6f, 0x64, 0xaa, 0x4f, 0xc9, 0xc6, 0x20, 0xe2, 0xbd, 0xa6, 0x55, 0xd1, 0xd2, 0xb9, 0x16,
+	0xbc, 0x96, 0x5d, 0x9e, 0x33, 0x2d, 0x50, 0x93, 0x30, 0x71, 0x81, 0xe9, 0x30, 0x16, 0xa7, 0x58,
+	0x54, 0xfa, 0x4f, 0x40, 0x0c, 0xc6, 0x3f, 0x68, 0x78, 0x54, 0x48, 0x4b, 0x70, 0x24, 0xc1, 0xa2,
+	0xe1, 0x32, 0x7f, 0xcd, 0x3f, 0x57, 0x60, 0xa6, 0x57, 0x8f, 0xe0, 0xfc, 0x1b, 0x70, 0x0c, 0x97,
+	0xe1, 0x62, 0x7f, 0x23, 0xc7, 0x2c, 0xac, 0xb0, 0xda, 0xf3, 0xc7, 0xa5, 0x02, 0x46, 0x84, 0xe1,
+	0x32, 0xaa, 0x0b, 0x28, 0xb2, 0x03, 0x53, 0x6d, 0xcb, 0xf3, 0x92, 0x55, 0x6b, 0xb6, 0xdd, 0xa8,
+	0xdb, 0xdb, 0x56, 0xb5, 0xc5, 0x1c, 0xd3, 0x96, 0x0b, 0xbc, 0xb0, 0x78, 0xa8, 0x2c, 0xb3, 0xce,
+	0xb2, 0x9f, 0x75, 0x96, 0x57, 0x30, 0x2b, 0xad, 0x9c, 0xc6, 0x40, 0x9b, 0x91, 0xb0, 0x3d, 0x70,
+	0xe8, 0x4f, 0x9f, 0x94, 0x14, 0xfd, 0x20, 0xb6, 0x2e, 0x63, 0xe3, 0xaa, 0x6c, 0xbb, 0x88, 0x73,
+	0xab, 0x33, 0xb3, 0xb9, 0xde, 0x76, 0x38, 0x6b, 0x32, 0x2b, 0xc8, 0x82, 0x4a, 0x50, 0x68, 0xe1,
+	0x0e, 0x1a, 0xce, 0x2f, 0xf8, 0xa2, 0xab, 0xf5, 0x20, 0x5b, 0xe8, 0xd0, 0x0e, 0x46, 0x6b, 0xd4,
+	0x89, 0x36, 0xa4, 0x4d, 0x62, 0x0c, 0xc5, 0x9f, 0xc4, 0x18, 0x02, 0x9d, 0x4e, 0x32, 0x18, 0xec,
+	0xda, 0x16, 0x1c, 0x4e, 0x6c, 0x0d, 0x12, 0xb0, 0xc1, 0x96, 0x61, 0x06, 0x67, 0xe5, 0x52, 0x9f,
+	0xb3, 0x52, 0x3a, 0xb8, 0x12, 0x03, 0x5a, 0x35, 0x4c, 0x27, 0xc8, 0x20, 0x3d, 0x1c, 0xfa, 0x36,
+	0x9e, 0x61, 0x97, 0x1b, 0x0d, 0xbb, 0x26, 0x6f, 0x12, 0xa9, 0xcb, 0x42, 0x8d, 0xe4, 0x0a, 0x72,
+	0x55, 0x04, 0xdf, 0xf4, 0x0e, 0x14, 0xbb, 0xf1, 0x90, 0xfc, 0x35, 0x28, 0x18, 0xa1, 0x18, 0x5d,
+	0xe8, 0x79, 0xec, 0x86, 0x08, 0xc8, 0x38, 0xaa, 0x4c, 0x97, 0xe1, 0x68, 0xf7, 0x38, 0x7d, 0x8b,
+	0x71, 0x37, 0xb2, 0xae, 0x53, 0xe7, 0xfe, 0x9f, 0x7b, 0xe1, 0x58, 0x1f, 0x14, 0xa4, 0x5d, 0x83,
+	0xa1, 0x2d, 0xc6, 0x5d, 0x56, 0x47, 0xc6, 0x87, 0xca, 0x72, 0x69, 0x94, 0xbd, 0x7b, 0x5b, 0x19,
+	0xef, 0x6d, 0x65, 0x6f, 0xdf, 0xac, 0xcc, 0x7b, 0x44, 0x1f, 0x3d, 0x29, 0x9d, 0xcc, 0xb0, 0x9c,
+	0x3c, 0x05, 0xae, 0x23, 0x34, 0xd9, 0x80, 0xfd, 0x6d, 0x0b, 0xcd, 0xec, 0x7d, 0xf1, 0x66, 0x02,
+	0x70, 0x62, 0xc2, 0xb0, 0x7f, 0x82, 0x59, 0xc5, 0x7d, 0x2f, 0xde, 0x52, 0x88, 0x4e, 0x6f, 0x63,
+	0xa4, 0x5f, 0x69, 0xd9, 0xb5, 0xcd, 0x35, 0xcb, 0x68, 0xf1, 0x4d, 0x3b, 0xcc, 0xee, 0x4b, 0x50,
+	0xe0, 0xae, 0xe1, 0xb8, 0x55, 0xe6, 0x35, 0xfb, 0xb3, 0x23, 0x44, 0x42, 0x81, 0x1c, 0x86, 0x61,
+	0x66, 0xd5, 0xb1, 0x79, 0xaf, 0x68, 0xde, 0xcf, 0xac, 0xba, 0x68, 0xa4, 0x9b, 0xb8, 0x4e, 0x3a,
+	0xb1, 0x83, 0x1c, 0x6b, 0x98, 0xfb, 0x42, 0x9c, 0xb6, 0x9e, 0x6b, 0x36, 0x06, 0xe1, 0x27, 0x97,
+	0x81, 0x36, 0xbd, 0xed, 0x5f, 0x51, 0x30, 0xc4, 0xbf, 0xed, 0x69, 0xa7, 0x66, 0xda, 0x64, 0x16,
+	0x46, 0xb7, 0x4d, 0xab, 0x6e, 0x6f, 0x4b, 0x07, 0x38, 0x7a, 0x30, 0x22, 0x85, 0xc2, 0x26, 0xa7,
+	0xff, 0xd9, 0x07, 0x6a, 0x12, 0x78, 0x98, 0xa4, 0x19, 0x96, 0xd5, 0x36, 0x1a, 0xe6, 0xc7, 0xac,
+	0x5e, 0xbd, 0xef, 0xb5, 0x7d, 0x85, 0x24, 0x4d, 0xee, 0xdb, 0x98, 0xa4, 0x75, 0xe2, 0x51, 0x7d,
+	0x3c, 0x14, 0x09, 0xeb, 0xe4, 0x53, 0x05, 0xc6, 0x90, 0xba, 0xc3, 0xb6, 0x0d, 0xa7, 0xce, 0x31,
+	0x22, 0xa7, 0x13, 0xe3, 0x04, 0x73, 0x86, 0xca, 0x0d, 0xdc, 0xc9, 0x0f, 0x4a, 0x43, 0x71, 0x04,
+	0xfa, 0xe8, 0x49, 0x69, 0x2e, 0x1b, 0x57, 0x19, 0x46, 0x38, 0x78, 0xba, 0x54, 0x27, 0x1f, 0x02,
+	0x0e, 0x5c, 0x55, 0x04, 0x88, 0xc8, 0xe4, 0x0a, 0x8b, 0x6a, 0xd7, 0xb9, 0xf2, 0xae, 0x5f, 0xcd,
+	0xa8, 0x94, 0x90, 0xce, 0x44, 0x8c, 0x8e, 0xd0, 0xa6, 0x0f, 0xbd, 0xd3, 0xa4, 0x20, 0x45, 0x6b,
+	0x9e, 0x84, 0xd4, 0x00, 0x22, 0x89, 0xf0, 0x80, 0x18, 0xe3, 0xe5, 0xdc, 0x19, 0xca, 0x01, 0x4c,
+	0xd7, 0x22, 0x29, 0x70, 0x04, 0x96, 0x9e, 0xc3, 0x98, 0xf5, 0x32, 0x92, 0xf7, 0x5c, 0xb3, 0x61,
+	0x7e, 0x2c, 0x36, 0xb3, 0xd4, 0xd2, 0xc8, 0xe7, 0x03, 0x30, 0x9d, 0xac, 0x88, 0x71, 0xf2, 0x3e,
+	0x0c, 0x61, 0x42, 0x2d, 0xa3, 0xe3, 0x52, 0x6e, 0xe6, 0xa3, 0x92, 0xb9, 0x9f, 0x4a, 0x23, 0x9c,
+	0x97, 0xaf, 0xcb, 0x5f, 0xd5, 0x86, 0xd9, 0x34, 0xdd, 0xdd, 0xe6, 0xeb, 0x51, 0x2c, 0xaa, 0x17,
+	0xe4, 0xe7, 0x0d, 0xef, 0x8b, 0x7c, 0x57, 0x81, 0x49, 0x63, 0xcb, 0x30, 0x1b, 0xc6, 0x7a, 0x83,
+	0x45, 0x2f, 0x25, 0x32, 0x67, 0xbf, 0x99, 0xdb, 0x24, 0xa6, 0xce, 0x49, 0x98, 0x54, 0x9f, 0x08,
+	0xc4, 0x91, 0xcb, 0xc9, 0x3a, 0x40, 0xd3, 0xb8, 0xe7, 0x5f, 0x4d, 0x76, 0x19, 0x03, 0x21, 0x12,
+	0xd5, 0x87, 0x9b, 0xc6, 0x3d, 0xbc, 0x96, 0xdc, 0x81, 0x42, 0x3b, 0x9c, 0x40, 0x91, 0x93, 0x0f,
+	0x57, 0x56, 0x72, 0x2f, 0x66, 0x22, 0x8d, 0x44, 0xa0, 0xa8, 0x1e, 0x05, 0x0e, 0x42, 0xed, 0xb2,
+	0xef, 0xa7, 0xb4, 0x9f, 0x1a, 0x6a, 0xbf, 0x51, 0x60, 0x3a, 0x59, 0x11, 0x43, 0xed, 0x53, 0x05,
+	0x5e, 0x0e, 0xc7, 0x34, 0x88, 0xba, 0x94, 0x63, 0xe4, 0x3a, 0x2e, 0xc6, 0xa9, 0xce, 0x49, 0xc1,
+	0x21, 0xca, 0x75, 0xc2, 0x8c, 0x1b, 0x71, 0x6e, 0xc1, 0x3d, 0x1c, 0xa9, 0xda, 0x8d, 0xb0, 0x02,
+	0x73, 0x17, 0x8a, 0xdd, 0x4d, 0x61, 0x2e, 0xe5, 0x78, 0x02, 0xcc, 0xe9, 0x66, 0xfb, 0x27, 0xe6,
+	0x42, 0xb7, 0x32, 0x89, 0x8e, 0x8c, 0x60, 0x16, 0xec, 0x09, 0xa9, 0x2e, 0x71, 0xe8, 0x59, 0x2c,
+	0xf9, 0x89, 0x7b, 0x7b, 0xe6, 0x24, 0xe4, 0x1d, 0x20, 0x51, 0x2d, 0x24, 0xf7, 0x3a, 0x0c, 0xd6,
+	0x3c, 0x01, 0x92, 0x3b, 0xd2, 0xb7, 0x46, 0xe0, 0xa7, 0x74, 0x42, 0x83, 0x5e, 0x8f, 0x02, 0xee,
+	0xb6, 0x98, 0xa6, 0xc3, 0x44, 0x0c, 0x0c, 0xe9, 0x7d, 0x1d, 0x86, 0x84, 0x31, 0xff, 0x70, 0xcd,
+	0xc4, 0x0f, 0x55, 0xe8, 0x05, 0xff, 0xe2, 0xe4, 0x5f, 0x99, 0xc4, 0x1e, 0xef, 0x13, 0x8d, 0xf1,
+	0x51, 0x3a, 0xf9, 0xfc, 0x56, 0x81, 0xc3, 0x89, 0xca, 0x48, 0xec, 0x5d, 0x18, 0x8f, 0x5f, 0xbb,
+	0x52, 0x8f, 0xff, 0xa4, 0x7b, 0xd7, 0x58, 0xec, 0xde, 0xc5, 0xc9, 0x65, 0x18, 0x14, 0x17, 0x7d,
+	0xbc, 0xcf, 0x64, 0xbc, 0x44, 0xe3, 0xac, 0x08, 0x4d, 0x7a, 0x0e, 0x6b, 0x54, 0xef, 0x59, 0x3e,
+	0xb1, 0x6c, 0x0e, 0xaf, 0xc3, 0x54, 0x97, 0x1e, 0xfa, 0xfa, 0x4d, 0x80, 0xb6, 0xd5, 0xe1, 0xe6,
+	0xb1, 0x5e, 0xd4, 0x02, 0x7d, 0xa4, 0x15, 0x51, 0x5d, 0xfc, 0x2f, 0x85, 0x41, 0x61, 0x84, 0xfc,
+	0x50, 0x81, 0x01, 0xef, 0x94, 0x21, 0x27, 0x7b, 0xe1, 0x74, 0x56, 0xf4, 0xd5, 0x53, 0x19, 0x7a,
+	0x4a, 0xc2, 0xb4, 0xfc, 0xc9, 0x5f, 0xff, 0xfd, 0xa3, 0xbd, 0x27, 0xc9, 0x09, 0xad, 0xc7, 0xfb,
+	0x81, 0x17, 0x92, 0xda, 0x03, 0x8c, 0xd3, 0x1d, 0xf2, 0x13, 0x05, 0x5e, 0xc2, 0x8a, 0x3c, 0x99,
+	0xeb, 0x6b, 0x26, 0x5e, 0xe8, 0x57, 0x5f, 0xcb, 0xd6, 0x19, 0x69, 0x2d, 0x08, 0x5a, 0x73, 0xe4,
+	0x54, 0x2f, 0x5a, 0xf8, 0x4a, 0xa0, 0x3d, 0xc0, 0x1f, 0x3b, 0xe4, 0x7b, 0x0a, 0x0c, 0x7a, 0xae,
+	0x71, 0x92, 0xee, 0xbe, 0x3f, 0xd1, 0xea, 0xe9, 0x2c, 0x5d, 0x91, 0xd3, 0x71, 0xc1, 0xa9, 0x44,
+	0x8e, 0xf4, 0x1b, 0x2a, 0x4e, 0xfe, 0xac, 0xc0, 0x48, 0xb4, 0x40, 0x4d, 0xe6, 0xfb, 0xdb, 0xe8,
+	0x7e, 0x27, 0x50, 0x17, 0x72, 0x68, 0x20, 0x39, 0x5d, 0x90, 0xbb, 0x41, 0xae, 0x65, 0x9b, 0x47,
+	0x2d, 0x88, 0x66, 0xed, 0x41, 0xf0, 0x73, 0x47, 0x8b, 0x95, 0xe1, 0xc9, 0x5f, 0x14, 0x18, 0x8d,
+	0x1a, 0xe3, 0x24, 0x3b, 0xb1, 0x60, 0x84, 0x17, 0xf3, 0xa8, 0xa0, 0x33, 0x6b, 0xc2, 0x99, 0x9b,
+	0xe4, 0xfa, 0x8b, 0x73, 0x86, 0x93, 0x1f, 0x2b, 0x30, 0xec, 0x9b, 0xe3, 0xe4, 0x4c, 0x26, 0x5a,
+	0x81, 0x17, 0xe5, 0xac, 0xdd, 0xd1, 0x83, 0x53, 0xc2, 0x83, 0x59, 0x72, 0xac, 0xa7, 0x07, 0x01,
+	0x93, 0xcf, 0x14, 0xd8, 0xef, 0xdf, 0x35, 0x48, 0xff, 0x55, 0xd2, 0xf1, 0xa8, 0xa0, 0x9e, 0xc9,
+	0xd8, 0x1b, 0x49, 0x2d, 0x0a, 0x52, 0xaf, 0x91, 0xd3, 0x3d, 0x49, 0xa1, 0x86, 0xf6, 0x00, 0xaf,
+	0x4c, 0x3b, 0x72, 0xd4, 0x50, 0x9c, 0x3a, 0x6a, 0x1d, 0x8f, 0x0c, 0x6a, 0x39, 0x6b, 0xf7, 0xcc,
+	0xa3, 0x16, 0x30, 0xf9, 0x99, 0x02, 0x10, 0xbe, 0x02, 0x90, 0x72, 0xea, 0x3a, 0x8e, 0x3d, 0x06,
+	0xa8, 0x5a, 0xe6, 0xfe, 0x48, 0x6d, 0x4e, 0x50, 0x3b, 0x4e, 0x66, 0xfb, 0x85, 0x64, 0x55, 0xbe,
+	0x01, 0x90, 0x5f, 0x2a, 0x50, 0x88, 0x3c, 0x33, 0x90, 0xfe, 0xd6, 0xba, 0xdf, 0x2a, 0xd4, 0xf9,
+	0xec, 0x0a, 0xc8, 0xef, 0x35, 0xc1, 0xef, 0x04, 0x79, 0xb5, 0x17, 0x3f, 0x71, 0xd0, 0xfb, 0x04,
+	0x3f, 0x53, 0x60, 0x24, 0xfa, 0x06, 0x91, 0xb2, 0x47, 0x25, 0xbc, 0x65, 0xa8, 0x0b, 0x39, 0x34,
+	0x90, 0xe3, 0x09, 0xc1, 0xf1, 0x28, 0x99, 0xe9, 0xb9, 0xa9, 0x4b, 0x32, 0xde, 0xbe, 0x13, 0x4b,
+	0x01, 0x48, 0x46, 0x63, 0x91, 0x0a, 0xb2, 0xba, 0x98, 0x47, 0xe5, 0x85, 0xee, 0x3b, 0xf1, 0x64,
+	0x87, 0xfc, 0x4e, 0x81, 0x03, 0x5d, 0xb5, 0x5f, 0xf2, 0xff, 0x39, 0xe8, 0x85, 0xd5, 0x64, 0xf5,
+	0x5c, 0x5e, 0x35, 0xf4, 0x6c, 0x49, 0x78, 0x76, 0x86, 0xcc, 0x69, 0x7d, 0xff, 0x97, 0x20, 0xa8,
+	0xdd, 0x8b, 0x22, 0xf2, 0x1f, 0x14, 0x18, 0x8d, 0x95, 0xe1, 0x52, 0xe6, 0x21, 0xa9, 0xda, 0xab,
+	0x2e, 0xe6, 0x51, 0x41, 0xb6, 0x2b, 0x82, 0xed, 0x37, 0xc8, 0xc5, 0x3e, 0xfb, 0x80, 0xc8, 0xd5,
+	0xb5, 0x07, 0x91, 0x44, 0x7e, 0x47, 0x8b, 0x55, 0x75, 0xc9, 0xaf, 0x14, 0x18, 0x8b, 0xe1, 0x73,
+	0x92, 0x83, 0x4c, 0x10, 0xe8, 0x4b, 0xb9, 0x74, 0xb2, 0xa6, 0x55, 0x4e, 0x9c, 0xd8, 0x2f, 0x14,
+	0x28, 0x44, 0xea, 0xb3, 0x29, 0x3b, 0x46, 0x77, 0x65, 0x58, 0x9d, 0xcf, 0xae, 0x90, 0x75, 0x47,
+	0x8b, 0xd4, 0x76, 0xc9, 0xdf, 0x15, 0x98, 0x4c, 0xaa, 0xc8, 0x92, 0xf3, 0xd9, 0x47, 0x27, 0x5e,
+	0x0a, 0x56, 0x5f, 0xff, 0x0a, 0x9a, 0x48, 0xfd, 0x86, 0xa0, 0xfe, 0x16, 0x59, 0xd9, 0x4d, 0x7c,
+	0x54, 0xb7, 0xd0, 0x85, 0x47, 0x0a, 0x8c, 0xc5, 0x6b, 0x96, 0x29, 0x71, 0x92, 0x58, 0x3c, 0x55,
+	0x97, 0x72, 0xe9, 0xa0, 0x27, 0x9a, 0xf0, 0xe4, 0x14, 0xf9, 0xbf, 0x5e, 0x9e, 0x88, 0x4a, 0x65,
+	0x35, 0x28, 0x7d, 0x92, 0xcf, 0xbd, 0x9c, 0x2c, 0x5a, 0x99, 0x4c, 0xcb, 0xc9, 0x12, 0x4a, 0xa4,
+	0xea, 0x62, 0x1e, 0x15, 0x64, 0x7a, 0x5e, 0x30, 0x5d, 0x24, 0xf3, 0xd9, 0x93, 0x07, 0x4d, 0x94,
+	0x33, 0xc9, 0xef, 0x15, 0x18, 0xef, 0x28, 0x93, 0x91, 0xa5, 0xd4, 0xf3, 0xb7, 0xbb, 0x1a, 0xa7,
+	0x9e, 0xcd, 0xa7, 0x84, 0xc4, 0x2f, 0x08, 0xe2, 0x67, 0xc9, 0x62, 0xc6, 0x4d, 0x3d, 0x52, 0xb3,
+	0x21, 0x7f, 0x54, 0x60, 0xbc, 0xa3, 0xec, 0x92, 0x42, 0x3d, 0xb9, 0xba, 0xa3, 0x9e, 0xcd, 0xa7,
+	0x84, 0xd4, 0x2f, 0x09, 0xea, 0xaf, 0x93, 0xaf, 0x65, 0xa4, 0xde, 0x59, 0xc4, 0xf1, 0x6e, 0x6b,
+	0x85, 0x48, 0xad, 0x24, 0x65, 0x5b, 0xe9, 0x2e, 0xd6, 0xa8, 0xf3, 0xd9, 0x15, 0xb2, 0xde, 0x92,
+	0x44, 0x61, 0x86, 0xfc, 0x5c, 0x81, 0x41, 0x91, 0xc7, 0xa4, 0xdc, 0xd6, 0xa2, 0x85, 0x1b, 0xf5,
+	0x74, 0x96, 0xae, 0xc8, 0xe3, 0xa2, 0xe0, 0x71, 0x8e, 0x9c, 0xcd, 0xb9, 0x47, 0x88, 0x3c, 0x89,
+	0x7c, 0x5f, 0x81, 0x21, 0x81, 0xc7, 0x49, 0x06, 0xa3, 0xc1, 0x70, 0xcd, 0x65, 0xea, 0x9b, 0x35,
+	0x1d, 0xaa, 0x49, 0x02, 0x7f, 0x52, 0x60, 0x2c, 0x5e, 0x5a, 0x49, 0xd9, 0x9f, 0x12, 0x8b, 0x38,
+	0xea, 0x52, 0x2e, 0x1d, 0xe4, 0x78, 0x45, 0x70, 0xbc, 0x44, 0xde, 0x48, 0xbb, 0xc7, 0x74, 0xa4,
+	0x40, 0x7e, 0x1a, 0x81, 0x7c, 0x7f, 0xad, 0x00, 0x84, 0xd5, 0x92, 0x94, 0x6c, 0xbd, 0xab, 0x1c,
+	0xa3, 0x6a, 0x99, 0xfb, 0x23, 0xed, 0x37, 0x05, 0xed, 0x0b, 0xe4, 0x7c, 0x3e, 0xda, 0x61, 0xfd,
+	0xa5, 0x72, 0xfd, 0x8b, 0xa7, 0x33, 0xca, 0x97, 0x4f, 0x67, 0x94, 0x7f, 0x3d, 0x9d, 0x51, 0x1e,
+	0x3e, 0x9b, 0xd9, 0xf3, 0xe5, 0xb3, 0x99, 0x3d, 0x7f, 0x7b, 0x36, 0xb3, 0xe7, 0xf6, 0x42, 0xb4,
+	0x2a, 0xca, 0x1c, 0xd7, 0xbc, 0x7b, 0xc7, 0x6e, 0x5b, 0x75, 0xb1, 0x61, 0xf8, 0xe6, 0xee, 0xf9,
+	0x06, 0x45, 0x91, 0x74, 0x7d, 0x48, 0x3c, 0x86, 0x2c, 0xfd, 0x6f, 0x00, 0xb5, 0xac, 0x9a, 0xaf,
+	0xc6, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error)
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	PurchaseList(ctx context.Context, in *QueryPurchaseListRequest, opts ...grpc.CallOption) (*QueryPurchaseListResponse, error)
+	PurchaseLists(ctx context.Context, in *QueryPurchaseListsRequest, opts ...grpc.CallOption) (*QueryPurchaseListsResponse, error)
+	Purchases(ctx context.Context, in *QueryPurchasesRequest, opts ...grpc.CallOption) (*QueryPurchasesResponse, error)
+	Provider(ctx context.Context, in *QueryProviderRequest, opts ...grpc.CallOption) (*QueryProviderResponse, error)
+	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
+	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	ClaimParams(ctx context.Context, in *QueryClaimParamsRequest, opts ...grpc.CallOption) (*QueryClaimParamsResponse, error)
+	ShieldStatus(ctx context.Context, in *QueryShieldStatusRequest, opts ...grpc.CallOption) (*QueryShieldStatusResponse, error)
+	ShieldStaking(ctx context.Context, in *QueryShieldStakingRequest, opts ...grpc.CallOption) (*QueryShieldStakingResponse, error)
+	ShieldStakingRate(ctx context.Context, in *QueryShieldStakingRateRequest, opts ...grpc.CallOption) (*QueryShieldStakingRateResponse, error)
+	Reimbursement(ctx context.Context, in *QueryReimbursementRequest, opts ...grpc.CallOption) (*QueryReimbursementResponse, error)
+	Reimbursements(ctx context.Context, in *QueryReimbursementsRequest, opts ...grpc.CallOption) (*QueryReimbursementsResponse, error)
+	Allocations(ctx context.Context, in *QueryAllocationsRequest, opts ...grpc.CallOption) (*QueryAllocationsResponse, error)
+	ReimbursementVesting(ctx context.Context, in *QueryReimbursementVestingRequest, opts ...grpc.CallOption) (*QueryReimbursementVestingResponse, error)
+	EpochSnapshots(ctx context.Context, in *QueryEpochSnapshotsRequest, opts ...grpc.CallOption) (*QueryEpochSnapshotsResponse, error)
+	ProviderYield(ctx context.Context, in *QueryProviderYieldRequest, opts ...grpc.CallOption) (*QueryProviderYieldResponse, error)
+	PoolUtilization(ctx context.Context, in *QueryPoolUtilizationRequest, opts ...grpc.CallOption) (*QueryPoolUtilizationResponse, error)
+	AvailableShield(ctx context.Context, in *QueryAvailableShieldRequest, opts ...grpc.CallOption) (*QueryAvailableShieldResponse, error)
+	ShieldRoles(ctx context.Context, in *QueryShieldRolesRequest, opts ...grpc.CallOption) (*QueryShieldRolesResponse, error)
+	Claim(ctx context.Context, in *QueryClaimRequest, opts ...grpc.CallOption) (*QueryClaimResponse, error)
+	Claims(ctx context.Context, in *QueryClaimsRequest, opts ...grpc.CallOption) (*QueryClaimsResponse, error)
+	StakingRewards(ctx context.Context, in *QueryStakingRewardsRequest, opts ...grpc.CallOption) (*QueryStakingRewardsResponse, error)
+	Unstakings(ctx context.Context, in *QueryUnstakingsRequest, opts ...grpc.CallOption) (*QueryUnstakingsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Pool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPurchaseListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error) {
+	out := new(QuerySponsorResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Sponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPurchaseListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PurchaseList.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPurchaseListsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PurchaseList(ctx context.Context, in *QueryPurchaseListRequest, opts ...grpc.CallOption) (*QueryPurchaseListResponse, error) {
+	out := new(QueryPurchaseListResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PurchaseList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPurchaseListsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PurchaseLists(ctx context.Context, in *QueryPurchaseListsRequest, opts ...grpc.CallOption) (*QueryPurchaseListsResponse, error) {
+	out := new(QueryPurchaseListsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PurchaseLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPurchaseListsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Purchaser) > 0 {
-		i -= len(m.Purchaser)
-		copy(dAtA[i:], m.Purchaser)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Purchases(ctx context.Context, in *QueryPurchasesRequest, opts ...grpc.CallOption) (*QueryPurchasesResponse, error) {
+	out := new(QueryPurchasesResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Purchases", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPurchaseListsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Provider(ctx context.Context, in *QueryProviderRequest, opts ...grpc.CallOption) (*QueryProviderResponse, error) {
+	out := new(QueryProviderResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Provider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPurchaseListsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPurchaseListsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PurchaseLists) > 0 {
-		for iNdEx := len(m.PurchaseLists) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PurchaseLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
+	out := new(QueryProvidersResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPurchasesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
+	out := new(QueryPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PoolParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPurchasesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPurchasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPurchasesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimParams(ctx context.Context, in *QueryClaimParamsRequest, opts ...grpc.CallOption) (*QueryClaimParamsResponse, error) {
+	out := new(QueryClaimParamsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ClaimParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPurchasesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPurchasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Purchases) > 0 {
-		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ShieldStatus(ctx context.Context, in *QueryShieldStatusRequest, opts ...grpc.CallOption) (*QueryShieldStatusResponse, error) {
+	out := new(QueryShieldStatusResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ShieldStaking(ctx context.Context, in *QueryShieldStakingRequest, opts ...grpc.CallOption) (*QueryShieldStakingResponse, error) {
+	out := new(QueryShieldStakingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldStaking", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ShieldStakingRate(ctx context.Context, in *QueryShieldStakingRateRequest, opts ...grpc.CallOption) (*QueryShieldStakingRateResponse, error) {
+	out := new(QueryShieldStakingRateResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldStakingRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Reimbursement(ctx context.Context, in *QueryReimbursementRequest, opts ...grpc.CallOption) (*QueryReimbursementResponse, error) {
+	out := new(QueryReimbursementResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Reimbursement", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Reimbursements(ctx context.Context, in *QueryReimbursementsRequest, opts ...grpc.CallOption) (*QueryReimbursementsResponse, error) {
+	out := new(QueryReimbursementsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Reimbursements", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Allocations(ctx context.Context, in *QueryAllocationsRequest, opts ...grpc.CallOption) (*QueryAllocationsResponse, error) {
+	out := new(QueryAllocationsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Allocations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ReimbursementVesting(ctx context.Context, in *QueryReimbursementVestingRequest, opts ...grpc.CallOption) (*QueryReimbursementVestingResponse, error) {
+	out := new(QueryReimbursementVestingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ReimbursementVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) EpochSnapshots(ctx context.Context, in *QueryEpochSnapshotsRequest, opts ...grpc.CallOption) (*QueryEpochSnapshotsResponse, error) {
+	out := new(QueryEpochSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/EpochSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProviderYield(ctx context.Context, in *QueryProviderYieldRequest, opts ...grpc.CallOption) (*QueryProviderYieldResponse, error) {
+	out := new(QueryProviderYieldResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ProviderYield", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolUtilization(ctx context.Context, in *QueryPoolUtilizationRequest, opts ...grpc.CallOption) (*QueryPoolUtilizationResponse, error) {
+	out := new(QueryPoolUtilizationResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/PoolUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AvailableShield(ctx context.Context, in *QueryAvailableShieldRequest, opts ...grpc.CallOption) (*QueryAvailableShieldResponse, error) {
+	out := new(QueryAvailableShieldResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/AvailableShield", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ShieldRoles(ctx context.Context, in *QueryShieldRolesRequest, opts ...grpc.CallOption) (*QueryShieldRolesResponse, error) {
+	out := new(QueryShieldRolesResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/ShieldRoles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Claim(ctx context.Context, in *QueryClaimRequest, opts ...grpc.CallOption) (*QueryClaimResponse, error) {
+	out := new(QueryClaimResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) Claims(ctx context.Context, in *QueryClaimsRequest, opts ...grpc.CallOption) (*QueryClaimsResponse, error) {
+	out := new(QueryClaimsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Claims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StakingRewards(ctx context.Context, in *QueryStakingRewardsRequest, opts ...grpc.CallOption) (*QueryStakingRewardsResponse, error) {
+	out := new(QueryStakingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/StakingRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Unstakings(ctx context.Context, in *QueryUnstakingsRequest, opts ...grpc.CallOption) (*QueryUnstakingsResponse, error) {
+	out := new(QueryUnstakingsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Query/Unstakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error)
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	PurchaseList(context.Context, *QueryPurchaseListRequest) (*QueryPurchaseListResponse, error)
+	PurchaseLists(context.Context, *QueryPurchaseListsRequest) (*QueryPurchaseListsResponse, error)
+	Purchases(context.Context, *QueryPurchasesRequest) (*QueryPurchasesResponse, error)
+	Provider(context.Context, *QueryProviderRequest) (*QueryProviderResponse, error)
+	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
+	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	ClaimParams(context.Context, *QueryClaimParamsRequest) (*QueryClaimParamsResponse, error)
+	ShieldStatus(context.Context, *QueryShieldStatusRequest) (*QueryShieldStatusResponse, error)
+	ShieldStaking(context.Context, *QueryShieldStakingRequest) (*QueryShieldStakingResponse, error)
+	ShieldStakingRate(context.Context, *QueryShieldStakingRateRequest) (*QueryShieldStakingRateResponse, error)
+	Reimbursement(context.Context, *QueryReimbursementRequest) (*QueryReimbursementResponse, error)
+	Reimbursements(context.Context, *QueryReimbursementsRequest) (*QueryReimbursementsResponse, error)
+	Allocations(context.Context, *QueryAllocationsRequest) (*QueryAllocationsResponse, error)
+	ReimbursementVesting(context.Context, *QueryReimbursementVestingRequest) (*QueryReimbursementVestingResponse, error)
+	EpochSnapshots(context.Context, *QueryEpochSnapshotsRequest) (*QueryEpochSnapshotsResponse, error)
+	ProviderYield(context.Context, *QueryProviderYieldRequest) (*QueryProviderYieldResponse, error)
+	PoolUtilization(context.Context, *QueryPoolUtilizationRequest) (*QueryPoolUtilizationResponse, error)
+	AvailableShield(context.Context, *QueryAvailableShieldRequest) (*QueryAvailableShieldResponse, error)
+	ShieldRoles(context.Context, *QueryShieldRolesRequest) (*QueryShieldRolesResponse, error)
+	Claim(context.Context, *QueryClaimRequest) (*QueryClaimResponse, error)
+	Claims(context.Context, *QueryClaimsRequest) (*QueryClaimsResponse, error)
+	StakingRewards(context.Context, *QueryStakingRewardsRequest) (*QueryStakingRewardsResponse, error)
+	Unstakings(context.Context, *QueryUnstakingsRequest) (*QueryUnstakingsResponse, error)
 }
 
-func (m *QueryClaimParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryClaimParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) Sponsor(ctx context.Context, req *QuerySponsorRequest) (*QuerySponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsor not implemented")
+}
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) PurchaseList(ctx context.Context, req *QueryPurchaseListRequest) (*QueryPurchaseListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseList not implemented")
+}
+func (*UnimplementedQueryServer) PurchaseLists(ctx context.Context, req *QueryPurchaseListsRequest) (*QueryPurchaseListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseLists not implemented")
+}
+func (*UnimplementedQueryServer) Purchases(ctx context.Context, req *QueryPurchasesRequest) (*QueryPurchasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purchases not implemented")
+}
+func (*UnimplementedQueryServer) Provider(ctx context.Context, req *QueryProviderRequest) (*QueryProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provider not implemented")
+}
+func (*UnimplementedQueryServer) Providers(ctx context.Context, req *QueryProvidersRequest) (*QueryProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+}
+func (*UnimplementedQueryServer) ClaimParams(ctx context.Context, req *QueryClaimParamsRequest) (*QueryClaimParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimParams not implemented")
+}
+func (*UnimplementedQueryServer) ShieldStatus(ctx context.Context, req *QueryShieldStatusRequest) (*QueryShieldStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShieldStatus not implemented")
+}
+func (*UnimplementedQueryServer) ShieldStaking(ctx context.Context, req *QueryShieldStakingRequest) (*QueryShieldStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShieldStaking not implemented")
+}
+func (*UnimplementedQueryServer) ShieldStakingRate(ctx context.Context, req *QueryShieldStakingRateRequest) (*QueryShieldStakingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShieldStakingRate not implemented")
+}
+func (*UnimplementedQueryServer) Reimbursement(ctx context.Context, req *QueryReimbursementRequest) (*QueryReimbursementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reimbursement not implemented")
+}
+func (*UnimplementedQueryServer) Reimbursements(ctx context.Context, req *QueryReimbursementsRequest) (*QueryReimbursementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reimbursements not implemented")
+}
+func (*UnimplementedQueryServer) Allocations(ctx context.Context, req *QueryAllocationsRequest) (*QueryAllocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allocations not implemented")
+}
+func (*UnimplementedQueryServer) ReimbursementVesting(ctx context.Context, req *QueryReimbursementVestingRequest) (*QueryReimbursementVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReimbursementVesting not implemented")
+}
+func (*UnimplementedQueryServer) EpochSnapshots(ctx context.Context, req *QueryEpochSnapshotsRequest) (*QueryEpochSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) ProviderYield(ctx context.Context, req *QueryProviderYieldRequest) (*QueryProviderYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderYield not implemented")
+}
+func (*UnimplementedQueryServer) PoolUtilization(ctx context.Context, req *QueryPoolUtilizationRequest) (*QueryPoolUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUtilization not implemented")
+}
+func (*UnimplementedQueryServer) AvailableShield(ctx context.Context, req *QueryAvailableShieldRequest) (*QueryAvailableShieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableShield not implemented")
+}
+func (*UnimplementedQueryServer) ShieldRoles(ctx context.Context, req *QueryShieldRolesRequest) (*QueryShieldRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShieldRoles not implemented")
+}
+func (*UnimplementedQueryServer) Claim(ctx context.Context, req *QueryClaimRequest) (*QueryClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (*UnimplementedQueryServer) Claims(ctx context.Context, req *QueryClaimsRequest) (*QueryClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claims not implemented")
+}
+func (*UnimplementedQueryServer) StakingRewards(ctx context.Context, req *QueryStakingRewardsRequest) (*QueryStakingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingRewards not implemented")
+}
+func (*UnimplementedQueryServer) Unstakings(ctx context.Context, req *QueryUnstakingsRequest) (*QueryUnstakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstakings not implemented")
 }
 
-func (m *QueryClaimParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryClaimParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Sponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Sponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsor(ctx, req.(*QuerySponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PurchaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchaseListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PurchaseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/PurchaseList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PurchaseList(ctx, req.(*QueryPurchaseListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PurchaseLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchaseListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PurchaseLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/PurchaseLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PurchaseLists(ctx, req.(*QueryPurchaseListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_Purchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Purchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Purchases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Purchases(ctx, req.(*QueryPurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Provider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Provider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Provider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Provider(ctx, req.(*QueryProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Providers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*QueryProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GlobalShieldStakingPool.Size()
-		i -= size
-		if _, err := m.GlobalShieldStakingPool.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.RemainingServiceFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolParams(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.CurrentServiceFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/PoolParams",
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TotalWithdrawing.Size()
-		i -= size
-		if _, err := m.TotalWithdrawing.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalShield.Size()
-		i -= size
-		if _, err := m.TotalShield.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalCollateral.Size()
-		i -= size
-		if _, err := m.TotalCollateral.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimParams(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ClaimParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimParams(ctx, req.(*QueryClaimParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ShieldStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShieldStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ShieldStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShieldStatus(ctx, req.(*QueryShieldStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ShieldStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShieldStakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShieldStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShieldStaking(ctx, req.(*QueryShieldStakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Purchaser) > 0 {
-		i -= len(m.Purchaser)
-		copy(dAtA[i:], m.Purchaser)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ShieldStakingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShieldStakingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).ShieldStakingRate(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldStakingRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShieldStakingRate(ctx, req.(*QueryShieldStakingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Reimbursement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReimbursementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Reimbursement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Reimbursement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reimbursement(ctx, req.(*QueryReimbursementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Reimbursements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReimbursementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reimbursements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Reimbursements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reimbursements(ctx, req.(*QueryReimbursementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ShieldStaking.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Allocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Allocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Allocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allocations(ctx, req.(*QueryAllocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ReimbursementVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReimbursementVestingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ReimbursementVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ReimbursementVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReimbursementVesting(ctx, req.(*QueryReimbursementVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EpochSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/EpochSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSnapshots(ctx, req.(*QueryEpochSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_ProviderYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ProviderYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderYield(ctx, req.(*QueryProviderYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolUtilizationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/PoolUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolUtilization(ctx, req.(*QueryPoolUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AvailableShield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvailableShieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvailableShield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/AvailableShield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvailableShield(ctx, req.(*QueryAvailableShieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShieldStakingRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_ShieldRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShieldRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ShieldRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/ShieldRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShieldRoles(ctx, req.(*QueryShieldRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReimbursementRequest) Marshal() (dAtA []byte, err error) {
+func _Query_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claim(ctx, req.(*QueryClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Claims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Claims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claims(ctx, req.(*QueryClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/StakingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingRewards(ctx, req.(*QueryStakingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unstakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnstakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unstakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Query/Unstakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unstakings(ctx, req.(*QueryUnstakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shentu.shield.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "Sponsor",
+			Handler:    _Query_Sponsor_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "PurchaseList",
+			Handler:    _Query_PurchaseList_Handler,
+		},
+		{
+			MethodName: "PurchaseLists",
+			Handler:    _Query_PurchaseLists_Handler,
+		},
+		{
+			MethodName: "Purchases",
+			Handler:    _Query_Purchases_Handler,
+		},
+		{
+			MethodName: "Provider",
+			Handler:    _Query_Provider_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
+		{
+			MethodName: "PoolParams",
+			Handler:    _Query_PoolParams_Handler,
+		},
+		{
+			MethodName: "ClaimParams",
+			Handler:    _Query_ClaimParams_Handler,
+		},
+		{
+			MethodName: "ShieldStatus",
+			Handler:    _Query_ShieldStatus_Handler,
+		},
+		{
+			MethodName: "ShieldStaking",
+			Handler:    _Query_ShieldStaking_Handler,
+		},
+		{
+			MethodName: "ShieldStakingRate",
+			Handler:    _Query_ShieldStakingRate_Handler,
+		},
+		{
+			MethodName: "Reimbursement",
+			Handler:    _Query_Reimbursement_Handler,
+		},
+		{
+			MethodName: "Reimbursements",
+			Handler:    _Query_Reimbursements_Handler,
+		},
+		{
+			MethodName: "Allocations",
+			Handler:    _Query_Allocations_Handler,
+		},
+		{
+			MethodName: "ReimbursementVesting",
+			Handler:    _Query_ReimbursementVesting_Handler,
+		},
+		{
+			MethodName: "EpochSnapshots",
+			Handler:    _Query_EpochSnapshots_Handler,
+		},
+		{
+			MethodName: "ProviderYield",
+			Handler:    _Query_ProviderYield_Handler,
+		},
+		{
+			MethodName: "PoolUtilization",
+			Handler:    _Query_PoolUtilization_Handler,
+		},
+		{
+			MethodName: "AvailableShield",
+			Handler:    _Query_AvailableShield_Handler,
+		},
+		{
+			MethodName: "ShieldRoles",
+			Handler:    _Query_ShieldRoles_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Query_Claim_Handler,
+		},
+		{
+			MethodName: "Claims",
+			Handler:    _Query_Claims_Handler,
+		},
+		{
+			MethodName: "StakingRewards",
+			Handler:    _Query_StakingRewards_Handler,
+		},
+		{
+			MethodName: "Unstakings",
+			Handler:    _Query_Unstakings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shentu/shield/v1alpha1/query.proto",
+}
+
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2898,25 +3580,25 @@ func (m *QueryReimbursementRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReimbursementRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReimbursementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReimbursementResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2926,18 +3608,18 @@ func (m *QueryReimbursementResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReimbursementResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReimbursementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Reimbursement.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2949,7 +3631,7 @@ func (m *QueryReimbursementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReimbursementsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySponsorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2959,20 +3641,27 @@ func (m *QueryReimbursementsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReimbursementsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySponsorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReimbursementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySponsorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReimbursementsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySponsorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2982,20 +3671,20 @@ func (m *QueryReimbursementsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReimbursementsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySponsorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReimbursementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3009,393 +3698,4661 @@ func (m *QueryReimbursementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySponsorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sponsor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySponsorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPurchaseListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryPurchaseListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPurchaseListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPurchaseListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPurchaseListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPurchaseListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PurchaseList.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPurchaseListsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPurchaseListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPurchaseListsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPurchaseListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PurchaseLists) > 0 {
-		for _, e := range m.PurchaseLists {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PurchaseList.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPurchasesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPurchaseListsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPurchasesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Purchases) > 0 {
-		for _, e := range m.Purchases {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryPurchaseListsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPurchaseListsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPurchaseListsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryPurchaseListsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPurchaseListsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PurchaseLists) > 0 {
+		for iNdEx := len(m.PurchaseLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PurchaseLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPurchasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPurchasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPurchasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPurchasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryShieldStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryPurchasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShieldStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPurchasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalCollateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalShield.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalWithdrawing.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentServiceFees.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.RemainingServiceFees.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.GlobalShieldStakingPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryShieldStakingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryShieldStakingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ShieldStaking.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShieldStakingRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryShieldStakingRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReimbursementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReimbursementResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Reimbursement.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReimbursementsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReimbursementsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GlobalShieldStakingPool.Size()
+		i -= size
+		if _, err := m.GlobalShieldStakingPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.RemainingServiceFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.CurrentServiceFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalWithdrawing.Size()
+		i -= size
+		if _, err := m.TotalWithdrawing.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalShield.Size()
+		i -= size
+		if _, err := m.TotalShield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalCollateral.Size()
+		i -= size
+		if _, err := m.TotalCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldStakingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldStakingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldStakingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ShieldStaking.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldStakingRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldStakingRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldStakingRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldStakingRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldStakingRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldStakingRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnstakeCooldownPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakeCooldownPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReimbursementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReimbursementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReimbursementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReimbursementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReimbursementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReimbursementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reimbursement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReimbursementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReimbursementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReimbursementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReimbursementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReimbursementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReimbursementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReimbursementVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReimbursementVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReimbursementVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReimbursementVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReimbursementVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReimbursementVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderYieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderYieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderYieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Collateral.Size()
+		i -= size
+		if _, err := m.Collateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.WindowRewards) > 0 {
+		for iNdEx := len(m.WindowRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.AnnualizedYield.Size()
+		i -= size
+		if _, err := m.AnnualizedYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolUtilizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolUtilizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolUtilizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolUtilizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolUtilizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolUtilizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxShield.Size()
+		i -= size
+		if _, err := m.MaxShield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AvailableCollateral.Size()
+		i -= size
+		if _, err := m.AvailableCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ShieldLimit.Size()
+		i -= size
+		if _, err := m.ShieldLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Shield.Size()
+		i -= size
+		if _, err := m.Shield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvailableShieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvailableShieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvailableShieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvailableShieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvailableShieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvailableShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvailableShield) > 0 {
+		for iNdEx := len(m.AvailableShield) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvailableShield[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShieldRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShieldRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShieldRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ShieldStakings) > 0 {
+		for iNdEx := len(m.ShieldStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShieldStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unstakings) > 0 {
+		for iNdEx := len(m.Unstakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unstakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPurchaseListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPurchaseListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PurchaseList.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPurchaseListsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPurchaseListsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PurchaseLists) > 0 {
+		for _, e := range m.PurchaseLists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPurchasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPurchasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClaimParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShieldStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShieldStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalShield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalWithdrawing.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentServiceFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingServiceFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GlobalShieldStakingPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShieldStakingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShieldStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShieldStaking.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShieldStakingRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShieldStakingRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakeCooldownPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReimbursementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryReimbursementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reimbursement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReimbursementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReimbursementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllocationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReimbursementVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryReimbursementVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryEpochSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderYieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEpochs))
+	}
+	return n
+}
+
+func (m *QueryProviderYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualizedYield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.WindowRewards) > 0 {
+		for _, e := range m.WindowRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolUtilizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolUtilizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ShieldLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AvailableCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxShield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Utilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAvailableShieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryAvailableShieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvailableShield) > 0 {
+		for _, e := range m.AvailableShield {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryShieldRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShieldRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Roles.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Claim.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShieldStakings) > 0 {
+		for _, e := range m.ShieldStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnstakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnstakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unstakings) > 0 {
+		for _, e := range m.Unstakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchaseListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchaseListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchaseListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchaseListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchaseListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchaseListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PurchaseList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchaseListsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchaseListsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchaseListsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchaseListsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchaseListsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchaseListsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseLists = append(m.PurchaseLists, PurchaseList{})
+			if err := m.PurchaseLists[len(m.PurchaseLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, Purchase{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, Provider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShieldStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShieldStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWithdrawing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWithdrawing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentServiceFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingServiceFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalShieldStakingPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalShieldStakingPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShieldStakingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldStakingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldStakingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShieldStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldStaking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShieldStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShieldStakingRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldStakingRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldStakingRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShieldStakingRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldStakingRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldStakingRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeCooldownPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnstakeCooldownPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReimbursementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3418,17 +8375,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReimbursementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReimbursementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.PoolId = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3438,7 +8395,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3467,7 +8424,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReimbursementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,15 +8447,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReimbursementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReimbursementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reimbursement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3525,7 +8482,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reimbursement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3553,7 +8510,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReimbursementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3576,17 +8533,70 @@ func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySponsorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReimbursementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySponsorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReimbursementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReimbursementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReimbursementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReimbursementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3596,23 +8606,25 @@ func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			m.Pairs = append(m.Pairs, ProposalIDReimbursementPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3638,7 +8650,7 @@ func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3661,17 +8673,36 @@ func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySponsorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3681,25 +8712,23 @@ func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3725,7 +8754,7 @@ func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3748,12 +8777,46 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, Allocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3778,7 +8841,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReimbursementVestingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3801,17 +8864,17 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReimbursementVestingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReimbursementVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3821,26 +8884,11 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3865,7 +8913,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPurchaseListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReimbursementVestingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3888,17 +8936,17 @@ func (m *QueryPurchaseListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPurchaseListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReimbursementVestingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPurchaseListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReimbursementVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3908,16 +8956,31 @@ func (m *QueryPurchaseListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3927,80 +8990,29 @@ func (m *QueryPurchaseListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPurchaseListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPurchaseListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPurchaseListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4027,7 +9039,8 @@ func (m *QueryPurchaseListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PurchaseList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4055,7 +9068,7 @@ func (m *QueryPurchaseListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPurchaseListsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochSnapshotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4078,17 +9091,17 @@ func (m *QueryPurchaseListsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPurchaseListsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochSnapshotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPurchaseListsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			m.PoolId = 0
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4098,16 +9111,16 @@ func (m *QueryPurchaseListsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.StartEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
 			}
-			var stringLen uint64
+			m.EndEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4117,24 +9130,11 @@ func (m *QueryPurchaseListsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4159,7 +9159,7 @@ func (m *QueryPurchaseListsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPurchaseListsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4182,15 +9182,15 @@ func (m *QueryPurchaseListsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPurchaseListsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochSnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPurchaseListsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseLists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4217,8 +9217,8 @@ func (m *QueryPurchaseListsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PurchaseLists = append(m.PurchaseLists, PurchaseList{})
-			if err := m.PurchaseLists[len(m.PurchaseLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, EpochSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4246,7 +9246,7 @@ func (m *QueryPurchaseListsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPurchasesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderYieldRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,12 +9269,63 @@ func (m *QueryPurchasesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPurchasesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderYieldRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPurchasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderYieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEpochs", wireType)
+			}
+			m.WindowEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4299,7 +9350,7 @@ func (m *QueryPurchasesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPurchasesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderYieldResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4322,17 +9373,17 @@ func (m *QueryPurchasesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPurchasesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderYieldResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPurchasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualizedYield", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4342,84 +9393,65 @@ func (m *QueryPurchasesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purchases = append(m.Purchases, Purchase{})
-			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnnualizedYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowRewards", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WindowRewards = append(m.WindowRewards, types.DecCoin{})
+			if err := m.WindowRewards[len(m.WindowRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4429,82 +9461,30 @@ func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4514,22 +9494,23 @@ func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4557,7 +9538,7 @@ func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolUtilizationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4580,12 +9561,31 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolUtilizationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolUtilizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4610,7 +9610,7 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolUtilizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4633,17 +9633,17 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolUtilizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolUtilizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4653,137 +9653,133 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, Provider{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableCollateral", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.AvailableCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaxShield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4793,22 +9789,23 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4836,7 +9833,7 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAvailableShieldRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4859,12 +9856,31 @@ func (m *QueryClaimParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAvailableShieldRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAvailableShieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4889,7 +9905,7 @@ func (m *QueryClaimParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAvailableShieldResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4912,15 +9928,15 @@ func (m *QueryClaimParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAvailableShieldResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAvailableShieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableShield", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4947,7 +9963,8 @@ func (m *QueryClaimParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AvailableShield = append(m.AvailableShield, types.Coin{})
+			if err := m.AvailableShield[len(m.AvailableShield)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4975,7 +9992,7 @@ func (m *QueryClaimParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShieldStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryShieldRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4998,10 +10015,10 @@ func (m *QueryShieldStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShieldStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShieldRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShieldStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShieldRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5026,210 +10043,42 @@ func (m *QueryShieldStatusRequest) Unmarshal(dAtA []byte) error {
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
-	return nil
-}
-func (m *QueryShieldStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShieldStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShieldStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShield", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalShield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalWithdrawing", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalWithdrawing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentServiceFees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingServiceFees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+	return nil
+}
+func (m *QueryShieldRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShieldRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShieldRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalShieldStakingPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5239,23 +10088,22 @@ func (m *QueryShieldStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalShieldStakingPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5283,7 +10131,7 @@ func (m *QueryShieldStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShieldStakingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5306,36 +10154,17 @@ func (m *QueryShieldStakingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShieldStakingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShieldStakingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5345,24 +10174,11 @@ func (m *QueryShieldStakingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5387,7 +10203,7 @@ func (m *QueryShieldStakingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShieldStakingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5410,15 +10226,15 @@ func (m *QueryShieldStakingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShieldStakingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShieldStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShieldStaking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5445,7 +10261,7 @@ func (m *QueryShieldStakingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShieldStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5473,7 +10289,7 @@ func (m *QueryShieldStakingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShieldStakingRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5496,12 +10312,63 @@ func (m *QueryShieldStakingRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShieldStakingRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShieldStakingRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5526,7 +10393,7 @@ func (m *QueryShieldStakingRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShieldStakingRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5549,17 +10416,17 @@ func (m *QueryShieldStakingRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShieldStakingRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShieldStakingRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5569,23 +10436,23 @@ func (m *QueryShieldStakingRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claims = append(m.Claims, Claim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5613,7 +10480,7 @@ func (m *QueryShieldStakingRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReimbursementRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5636,17 +10503,17 @@ func (m *QueryReimbursementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReimbursementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReimbursementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5656,11 +10523,24 @@ func (m *QueryReimbursementRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5685,7 +10565,7 @@ func (m *QueryReimbursementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReimbursementResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5708,15 +10588,15 @@ func (m *QueryReimbursementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReimbursementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReimbursementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reimbursement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldStakings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5743,7 +10623,41 @@ func (m *QueryReimbursementResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reimbursement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShieldStakings = append(m.ShieldStakings, ShieldStaking{})
+			if err := m.ShieldStakings[len(m.ShieldStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5771,7 +10685,7 @@ func (m *QueryReimbursementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReimbursementsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnstakingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5794,12 +10708,44 @@ func (m *QueryReimbursementsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReimbursementsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnstakingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReimbursementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnstakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5824,7 +10770,7 @@ func (m *QueryReimbursementsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReimbursementsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnstakingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5847,15 +10793,15 @@ func (m *QueryReimbursementsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReimbursementsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnstakingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReimbursementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnstakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unstakings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5882,8 +10828,8 @@ func (m *QueryReimbursementsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, ProposalIDReimbursementPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unstakings = append(m.Unstakings, Unstaking{})
+			if err := m.Unstakings[len(m.Unstakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewShieldRoles creates a new ShieldRoles object.
+func NewShieldRoles(poolOperators, pausers, claimManagers []string) ShieldRoles {
+	return ShieldRoles{
+		PoolOperators: poolOperators,
+		Pausers:       pausers,
+		ClaimManagers: claimManagers,
+	}
+}
+
+// WithAdmin returns a copy of the roles where the admin address is
+// granted every role.
+func (r ShieldRoles) WithAdmin(admin sdk.AccAddress) ShieldRoles {
+	if admin.Empty() {
+		return r
+	}
+	grant := func(addrs []string) []string {
+		if containsAddress(addrs, admin) {
+			return addrs
+		}
+		return append(append([]string{}, addrs...), admin.String())
+	}
+	return NewShieldRoles(grant(r.PoolOperators), grant(r.Pausers), grant(r.ClaimManagers))
+}
+
+// IsPoolOperator returns true if the address holds the pool operator role.
+func (r ShieldRoles) IsPoolOperator(addr sdk.AccAddress) bool {
+	return containsAddress(r.PoolOperators, addr)
+}
+
+// IsPauser returns true if the address holds the pauser role.
+func (r ShieldRoles) IsPauser(addr sdk.AccAddress) bool {
+	return containsAddress(r.Pausers, addr)
+}
+
+// IsClaimManager returns true if the address holds the claim manager role.
+func (r ShieldRoles) IsClaimManager(addr sdk.AccAddress) bool {
+	return containsAddress(r.ClaimManagers, addr)
+}
+
+// Validate checks that every role holder is a valid address
+// listed at most once per role.
+func (r ShieldRoles) Validate() error {
+	if err := validateRoleAddresses("pool operator", r.PoolOperators); err != nil {
+		return err
+	}
+	if err := validateRoleAddresses("pauser", r.Pausers); err != nil {
+		return err
+	}
+	return validateRoleAddresses("claim manager", r.ClaimManagers)
+}
+
+func validateRoleAddresses(role string, addrs []string) error {
+	seen := make(map[string]bool)
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid %s address %s: %w", role, addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate %s address %s", role, addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+func containsAddress(addrs []string, addr sdk.AccAddress) bool {
+	if addr.Empty() {
+		return false
+	}
+	for _, a := range addrs {
+		if a == addr.String() {
+			return true
+		}
+	}
+	return false
+}
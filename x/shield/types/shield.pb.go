@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +30,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ClaimStatus enumerates the statuses of a Shield claim.
+type ClaimStatus int32
+
+const (
+	ClaimStatusNil      ClaimStatus = 0
+	ClaimStatusOpen     ClaimStatus = 1
+	ClaimStatusApproved ClaimStatus = 2
+	ClaimStatusRejected ClaimStatus = 3
+	ClaimStatusVetoed   ClaimStatus = 4
+	ClaimStatusPaid     ClaimStatus = 5
+)
+
+var ClaimStatus_name = map[int32]string{
+	0: "CLAIM_STATUS_UNSPECIFIED",
+	1: "CLAIM_STATUS_OPEN",
+	2: "CLAIM_STATUS_APPROVED",
+	3: "CLAIM_STATUS_REJECTED",
+	4: "CLAIM_STATUS_VETOED",
+	5: "CLAIM_STATUS_PAID",
+}
+
+var ClaimStatus_value = map[string]int32{
+	"CLAIM_STATUS_UNSPECIFIED": 0,
+	"CLAIM_STATUS_OPEN":        1,
+	"CLAIM_STATUS_APPROVED":    2,
+	"CLAIM_STATUS_REJECTED":    3,
+	"CLAIM_STATUS_VETOED":      4,
+	"CLAIM_STATUS_PAID":        5,
+}
+
+func (x ClaimStatus) String() string {
+	return proto.EnumName(ClaimStatus_name, int32(x))
+}
+
+func (ClaimStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{0}
+}
+
 // MixedCoins defines the struct for mixed coins with native and foreign coins.
 type MixedCoins struct {
 	Native  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=native,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"native"`
@@ -116,6 +155,19 @@ type Pool struct {
 	ShieldLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=shield_limit,json=shieldLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shield_limit" yaml:"shield_limit"`
 	Active      bool                                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty" yaml:"active"`
 	Shield      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=shield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shield" yaml:"shield"`
+	// Allocation is the total amount of collaterals allocated to the pool.
+	Allocation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=allocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allocation" yaml:"allocation"`
+	// ServiceFees is the service fees of the pool's unexpired purchases.
+	ServiceFees MixedDecCoins `protobuf:"bytes,9,opt,name=service_fees,json=serviceFees,proto3" json:"service_fees" yaml:"service_fees"`
+	// RewardIndex is the cumulative service fees distributed per unit of allocation.
+	RewardIndex MixedDecCoins `protobuf:"bytes,10,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+	// FeesCollected is the cumulative service fees distributed to the pool's providers.
+	FeesCollected MixedDecCoins `protobuf:"bytes,11,opt,name=fees_collected,json=feesCollected,proto3" json:"fees_collected" yaml:"fees_collected"`
+	// CoverageTerms is the optional claim terms of the pool's purchases.
+	CoverageTerms *CoverageTerms `protobuf:"bytes,12,opt,name=coverage_terms,json=coverageTerms,proto3" json:"coverage_terms,omitempty" yaml:"coverage_terms"`
+	// CoveredAssets is the list of assets protected by the pool, e.g.
+	// CVM contract addresses or external chain contract identifiers.
+	CoveredAssets []string `protobuf:"bytes,13,rep,name=covered_assets,json=coveredAssets,proto3" json:"covered_assets,omitempty" yaml:"covered_assets"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -151,6 +203,96 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// CoverageTerms defines the terms under which claims against a pool are reimbursed.
+type CoverageTerms struct {
+	// DeductibleAmount is the fixed amount of a loss not reimbursed.
+	DeductibleAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=deductible_amount,json=deductibleAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deductible_amount" yaml:"deductible_amount"`
+	// DeductibleRate is the ratio of a loss not reimbursed. The larger of the two deductibles applies.
+	DeductibleRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=deductible_rate,json=deductibleRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deductible_rate" yaml:"deductible_rate"`
+	// MaxClaim is the maximum reimbursement per incident. Zero means no maximum.
+	MaxClaim github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_claim,json=maxClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_claim" yaml:"max_claim"`
+	// WaitingPeriod is the time after a purchase before claims against it are allowed.
+	WaitingPeriod time.Duration `protobuf:"bytes,4,opt,name=waiting_period,json=waitingPeriod,proto3,stdduration" json:"waiting_period" yaml:"waiting_period"`
+}
+
+func (m *CoverageTerms) Reset()         { *m = CoverageTerms{} }
+func (m *CoverageTerms) String() string { return proto.CompactTextString(m) }
+func (*CoverageTerms) ProtoMessage()    {}
+func (*CoverageTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{3}
+}
+func (m *CoverageTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoverageTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoverageTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoverageTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverageTerms.Merge(m, src)
+}
+func (m *CoverageTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoverageTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverageTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverageTerms proto.InternalMessageInfo
+
+// Allocation records the amount of a provider's collaterals backing a pool.
+type Allocation struct {
+	// PoolID is the id of the backed pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// Provider is the address of the provider.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" yaml:"provider"`
+	// Amount is the amount of collaterals allocated to the pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// RewardIndex is the pool's reward index when the allocation's rewards were last settled.
+	RewardIndex MixedDecCoins `protobuf:"bytes,4,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+}
+
+func (m *Allocation) Reset()         { *m = Allocation{} }
+func (m *Allocation) String() string { return proto.CompactTextString(m) }
+func (*Allocation) ProtoMessage()    {}
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{4}
+}
+func (m *Allocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allocation.Merge(m, src)
+}
+func (m *Allocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allocation proto.InternalMessageInfo
+
 // Purchase record an individual purchase.
 type Purchase struct {
 	// PurchaseID is the purchase_id.
@@ -165,13 +307,15 @@ type Purchase struct {
 	Shield github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=shield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shield" yaml:"shield"`
 	// ServiceFees is the service fees paid by this purchase.
 	ServiceFees MixedDecCoins `protobuf:"bytes,6,opt,name=service_fees,json=serviceFees,proto3" json:"service_fees" yaml:"service_fees"`
+	// PurchaseTime is the time when the shield was purchased.
+	PurchaseTime time.Time `protobuf:"bytes,7,opt,name=purchase_time,json=purchaseTime,proto3,stdtime" json:"purchase_time" yaml:"purchase_time"`
 }
 
 func (m *Purchase) Reset()         { *m = Purchase{} }
 func (m *Purchase) String() string { return proto.CompactTextString(m) }
 func (*Purchase) ProtoMessage()    {}
 func (*Purchase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{3}
+	return fileDescriptor_d5263cf0ba18829d, []int{5}
 }
 func (m *Purchase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +358,7 @@ func (m *PurchaseList) Reset()         { *m = PurchaseList{} }
 func (m *PurchaseList) String() string { return proto.CompactTextString(m) }
 func (*PurchaseList) ProtoMessage()    {}
 func (*PurchaseList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{4}
+	return fileDescriptor_d5263cf0ba18829d, []int{6}
 }
 func (m *PurchaseList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,13 +403,17 @@ type Provider struct {
 	Withdrawing github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=withdrawing,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"withdrawing" yaml:"withdrawing"`
 	// Rewards is the pooling rewards to be collected.
 	Rewards MixedDecCoins `protobuf:"bytes,6,opt,name=rewards,proto3" json:"rewards" yaml:"rewards"`
+	// RewardIndex is the global reward index when the provider's rewards were last settled.
+	RewardIndex MixedDecCoins `protobuf:"bytes,7,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+	// AutoCompound means native rewards are added to collateral at every epoch.
+	AutoCompound bool `protobuf:"varint,8,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty" yaml:"auto_compound"`
 }
 
 func (m *Provider) Reset()         { *m = Provider{} }
 func (m *Provider) String() string { return proto.CompactTextString(m) }
 func (*Provider) ProtoMessage()    {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{5}
+	return fileDescriptor_d5263cf0ba18829d, []int{7}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +454,7 @@ func (m *PoolPurchaser) Reset()         { *m = PoolPurchaser{} }
 func (m *PoolPurchaser) String() string { return proto.CompactTextString(m) }
 func (*PoolPurchaser) ProtoMessage()    {}
 func (*PoolPurchaser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{6}
+	return fileDescriptor_d5263cf0ba18829d, []int{8}
 }
 func (m *PoolPurchaser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +492,7 @@ func (m *PoolPurchaserPairs) Reset()         { *m = PoolPurchaserPairs{} }
 func (m *PoolPurchaserPairs) String() string { return proto.CompactTextString(m) }
 func (*PoolPurchaserPairs) ProtoMessage()    {}
 func (*PoolPurchaserPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{7}
+	return fileDescriptor_d5263cf0ba18829d, []int{9}
 }
 func (m *PoolPurchaserPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +542,7 @@ func (m *Withdraw) Reset()         { *m = Withdraw{} }
 func (m *Withdraw) String() string { return proto.CompactTextString(m) }
 func (*Withdraw) ProtoMessage()    {}
 func (*Withdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{8}
+	return fileDescriptor_d5263cf0ba18829d, []int{10}
 }
 func (m *Withdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +580,7 @@ func (m *Withdraws) Reset()         { *m = Withdraws{} }
 func (m *Withdraws) String() string { return proto.CompactTextString(m) }
 func (*Withdraws) ProtoMessage()    {}
 func (*Withdraws) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{9}
+	return fileDescriptor_d5263cf0ba18829d, []int{11}
 }
 func (m *Withdraws) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,13 +621,17 @@ type ShieldStaking struct {
 	Purchaser         string                                 `protobuf:"bytes,2,opt,name=purchaser,proto3" json:"purchaser,omitempty" yaml:"purchaser"`
 	Amount            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
 	WithdrawRequested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=withdraw_requested,json=withdrawRequested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"withdraw_requested" yaml:"withdraw_requested"`
+	// RewardIndex is the staking reward index at the last settlement of rewards.
+	RewardIndex MixedDecCoins `protobuf:"bytes,5,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+	// Rewards is the settled block rewards not yet paid out.
+	Rewards MixedDecCoins `protobuf:"bytes,6,opt,name=rewards,proto3" json:"rewards" yaml:"rewards"`
 }
 
 func (m *ShieldStaking) Reset()         { *m = ShieldStaking{} }
 func (m *ShieldStaking) String() string { return proto.CompactTextString(m) }
 func (*ShieldStaking) ProtoMessage()    {}
 func (*ShieldStaking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{10}
+	return fileDescriptor_d5263cf0ba18829d, []int{12}
 }
 func (m *ShieldStaking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +660,93 @@ func (m *ShieldStaking) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShieldStaking proto.InternalMessageInfo
 
+// Unstaking stores an ongoing unstaking of a staking purchase.
+type Unstaking struct {
+	PoolId    uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Purchaser string                                 `protobuf:"bytes,2,opt,name=purchaser,proto3" json:"purchaser,omitempty" yaml:"purchaser"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// CompletionTime is the time when the unstaked amount is returned to the purchaser.
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *Unstaking) Reset()         { *m = Unstaking{} }
+func (m *Unstaking) String() string { return proto.CompactTextString(m) }
+func (*Unstaking) ProtoMessage()    {}
+func (*Unstaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{13}
+}
+func (m *Unstaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unstaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Unstaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Unstaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unstaking.Merge(m, src)
+}
+func (m *Unstaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unstaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unstaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unstaking proto.InternalMessageInfo
+
+// Unstakings defines an array of Unstaking objects.
+type Unstakings struct {
+	Unstakings []Unstaking `protobuf:"bytes,1,rep,name=unstakings,proto3" json:"unstakings"`
+}
+
+func (m *Unstakings) Reset()         { *m = Unstakings{} }
+func (m *Unstakings) String() string { return proto.CompactTextString(m) }
+func (*Unstakings) ProtoMessage()    {}
+func (*Unstakings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{14}
+}
+func (m *Unstakings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unstakings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Unstakings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Unstakings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unstakings.Merge(m, src)
+}
+func (m *Unstakings) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unstakings) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unstakings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unstakings proto.InternalMessageInfo
+
+func (m *Unstakings) GetUnstakings() []Unstaking {
+	if m != nil {
+		return m.Unstakings
+	}
+	return nil
+}
+
 type LastUpdateTime struct {
 	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty" yaml:"time"`
 }
@@ -516,7 +755,7 @@ func (m *LastUpdateTime) Reset()         { *m = LastUpdateTime{} }
 func (m *LastUpdateTime) String() string { return proto.CompactTextString(m) }
 func (*LastUpdateTime) ProtoMessage()    {}
 func (*LastUpdateTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{11}
+	return fileDescriptor_d5263cf0ba18829d, []int{15}
 }
 func (m *LastUpdateTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +797,7 @@ type ShieldClaimProposal struct {
 func (m *ShieldClaimProposal) Reset()      { *m = ShieldClaimProposal{} }
 func (*ShieldClaimProposal) ProtoMessage() {}
 func (*ShieldClaimProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5263cf0ba18829d, []int{12}
+	return fileDescriptor_d5263cf0ba18829d, []int{16}
 }
 func (m *ShieldClaimProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,10 +826,243 @@ func (m *ShieldClaimProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShieldClaimProposal proto.InternalMessageInfo
 
+// PoolSnapshot records the state of a pool at an epoch boundary.
+type PoolSnapshot struct {
+	PoolId     uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Active     bool                                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty" yaml:"active"`
+	Shield     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shield" yaml:"shield"`
+	Allocation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=allocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allocation" yaml:"allocation"`
+	// Utilization is the ratio of the pool's shield to its allocation.
+	Utilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization" yaml:"utilization"`
+	// FeesCollected is the cumulative service fees distributed to the pool's providers.
+	FeesCollected MixedDecCoins `protobuf:"bytes,6,opt,name=fees_collected,json=feesCollected,proto3" json:"fees_collected" yaml:"fees_collected"`
+	RewardIndex   MixedDecCoins `protobuf:"bytes,7,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+}
+
+func (m *PoolSnapshot) Reset()         { *m = PoolSnapshot{} }
+func (m *PoolSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolSnapshot) ProtoMessage()    {}
+func (*PoolSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{17}
+}
+func (m *PoolSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSnapshot.Merge(m, src)
+}
+func (m *PoolSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSnapshot proto.InternalMessageInfo
+
+// EpochSnapshot records the state of all pools and global totals at an epoch boundary.
+type EpochSnapshot struct {
+	Epoch             uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	Height            int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time              time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Pools             []PoolSnapshot                         `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TotalCollateral   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_collateral,json=totalCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_collateral" yaml:"total_collateral"`
+	TotalShield       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=total_shield,json=totalShield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shield" yaml:"total_shield"`
+	TotalClaimed      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_claimed,json=totalClaimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_claimed" yaml:"total_claimed"`
+	GlobalStakingPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=global_staking_pool,json=globalStakingPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_staking_pool" yaml:"global_staking_pool"`
+	// RewardIndex is the global reward index of service fees distributed to all providers.
+	RewardIndex MixedDecCoins `protobuf:"bytes,9,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index" yaml:"reward_index"`
+}
+
+func (m *EpochSnapshot) Reset()         { *m = EpochSnapshot{} }
+func (m *EpochSnapshot) String() string { return proto.CompactTextString(m) }
+func (*EpochSnapshot) ProtoMessage()    {}
+func (*EpochSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{18}
+}
+func (m *EpochSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSnapshot.Merge(m, src)
+}
+func (m *EpochSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSnapshot proto.InternalMessageInfo
+
+// ShieldRoles defines the sets of addresses holding each Shield admin role.
+type ShieldRoles struct {
+	// PoolOperators can create, update, pause and resume any pool and update pool sponsors.
+	PoolOperators []string `protobuf:"bytes,1,rep,name=pool_operators,json=poolOperators,proto3" json:"pool_operators,omitempty" yaml:"pool_operators"`
+	// Pausers can pause any pool.
+	Pausers []string `protobuf:"bytes,2,rep,name=pausers,proto3" json:"pausers,omitempty" yaml:"pausers"`
+	// ClaimManagers can submit assessments of shield claim proposals.
+	ClaimManagers []string `protobuf:"bytes,3,rep,name=claim_managers,json=claimManagers,proto3" json:"claim_managers,omitempty" yaml:"claim_managers"`
+}
+
+func (m *ShieldRoles) Reset()         { *m = ShieldRoles{} }
+func (m *ShieldRoles) String() string { return proto.CompactTextString(m) }
+func (*ShieldRoles) ProtoMessage()    {}
+func (*ShieldRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{19}
+}
+func (m *ShieldRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShieldRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShieldRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShieldRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShieldRoles.Merge(m, src)
+}
+func (m *ShieldRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShieldRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShieldRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShieldRoles proto.InternalMessageInfo
+
+// ShieldAdminUpdateProposal replaces the sets of addresses holding the Shield admin roles.
+type ShieldAdminUpdateProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Roles       ShieldRoles `protobuf:"bytes,3,opt,name=roles,proto3" json:"roles" yaml:"roles"`
+	Proposer    string      `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty" yaml:"proposer"`
+}
+
+func (m *ShieldAdminUpdateProposal) Reset()      { *m = ShieldAdminUpdateProposal{} }
+func (*ShieldAdminUpdateProposal) ProtoMessage() {}
+func (*ShieldAdminUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{20}
+}
+func (m *ShieldAdminUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShieldAdminUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShieldAdminUpdateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShieldAdminUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShieldAdminUpdateProposal.Merge(m, src)
+}
+func (m *ShieldAdminUpdateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShieldAdminUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShieldAdminUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShieldAdminUpdateProposal proto.InternalMessageInfo
+
+// Claim records the lifecycle of a Shield claim proposal.
+type Claim struct {
+	ProposalId uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	PoolId     uint64                                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	PurchaseId uint64                                   `protobuf:"varint,3,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty" yaml:"purchase_id"`
+	Purchaser  string                                   `protobuf:"bytes,4,opt,name=purchaser,proto3" json:"purchaser,omitempty" yaml:"purchaser"`
+	Status     ClaimStatus                              `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.shield.v1alpha1.ClaimStatus" json:"status,omitempty" yaml:"status"`
+	Loss       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=loss,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"loss" yaml:"loss"`
+	// Secured is the amount of collaterals secured for the claim.
+	Secured github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=secured,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"secured" yaml:"secured"`
+	// Restored is the amount of shield restored to the purchase after the claim is rejected.
+	Restored github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=restored,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"restored" yaml:"restored"`
+	// Released is the amount of secured collaterals released without being paid out.
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released" yaml:"released"`
+	// Payout is the reimbursement of the approved claim.
+	Payout     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=payout,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payout" yaml:"payout"`
+	SubmitTime time.Time                                `protobuf:"bytes,11,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
+	// UpdateTime is the time of the last status change.
+	UpdateTime time.Time `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time" yaml:"update_time"`
+}
+
+func (m *Claim) Reset()         { *m = Claim{} }
+func (m *Claim) String() string { return proto.CompactTextString(m) }
+func (*Claim) ProtoMessage()    {}
+func (*Claim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5263cf0ba18829d, []int{21}
+}
+func (m *Claim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Claim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Claim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Claim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Claim.Merge(m, src)
+}
+func (m *Claim) XXX_Size() int {
+	return m.Size()
+}
+func (m *Claim) XXX_DiscardUnknown() {
+	xxx_messageInfo_Claim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Claim proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("shentu.shield.v1alpha1.ClaimStatus", ClaimStatus_name, ClaimStatus_value)
 	proto.RegisterType((*MixedCoins)(nil), "shentu.shield.v1alpha1.MixedCoins")
 	proto.RegisterType((*MixedDecCoins)(nil), "shentu.shield.v1alpha1.MixedDecCoins")
 	proto.RegisterType((*Pool)(nil), "shentu.shield.v1alpha1.Pool")
+	proto.RegisterType((*CoverageTerms)(nil), "shentu.shield.v1alpha1.CoverageTerms")
+	proto.RegisterType((*Allocation)(nil), "shentu.shield.v1alpha1.Allocation")
 	proto.RegisterType((*Purchase)(nil), "shentu.shield.v1alpha1.Purchase")
 	proto.RegisterType((*PurchaseList)(nil), "shentu.shield.v1alpha1.PurchaseList")
 	proto.RegisterType((*Provider)(nil), "shentu.shield.v1alpha1.Provider")
@@ -599,8 +1071,15 @@ func init() {
 	proto.RegisterType((*Withdraw)(nil), "shentu.shield.v1alpha1.Withdraw")
 	proto.RegisterType((*Withdraws)(nil), "shentu.shield.v1alpha1.Withdraws")
 	proto.RegisterType((*ShieldStaking)(nil), "shentu.shield.v1alpha1.ShieldStaking")
+	proto.RegisterType((*Unstaking)(nil), "shentu.shield.v1alpha1.Unstaking")
+	proto.RegisterType((*Unstakings)(nil), "shentu.shield.v1alpha1.Unstakings")
 	proto.RegisterType((*LastUpdateTime)(nil), "shentu.shield.v1alpha1.LastUpdateTime")
 	proto.RegisterType((*ShieldClaimProposal)(nil), "shentu.shield.v1alpha1.ShieldClaimProposal")
+	proto.RegisterType((*PoolSnapshot)(nil), "shentu.shield.v1alpha1.PoolSnapshot")
+	proto.RegisterType((*EpochSnapshot)(nil), "shentu.shield.v1alpha1.EpochSnapshot")
+	proto.RegisterType((*ShieldRoles)(nil), "shentu.shield.v1alpha1.ShieldRoles")
+	proto.RegisterType((*ShieldAdminUpdateProposal)(nil), "shentu.shield.v1alpha1.ShieldAdminUpdateProposal")
+	proto.RegisterType((*Claim)(nil), "shentu.shield.v1alpha1.Claim")
 }
 
 func init() {
@@ -608,87 +1087,160 @@ func init() {
 }
 
 var fileDescriptor_d5263cf0ba18829d = []byte{
-	// 1272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc6, 0xae, 0x93, 0x8c, 0xe3, 0xb6, 0x99, 0x54, 0xc1, 0x0d, 0xe0, 0x8d, 0x06, 0x51,
-	0x05, 0x15, 0xbc, 0x24, 0x3d, 0x80, 0x7a, 0xa9, 0xea, 0xb4, 0x48, 0x51, 0x83, 0x14, 0xa6, 0xa0,
-	0x48, 0x5c, 0xac, 0xc9, 0xce, 0xc4, 0x1e, 0x65, 0xbd, 0xb3, 0xec, 0xac, 0xd3, 0x3f, 0x67, 0x0e,
-	0x1c, 0x7b, 0xe4, 0x84, 0x7a, 0xe6, 0x5b, 0xc0, 0xa9, 0x42, 0x42, 0xf4, 0x88, 0x38, 0xb8, 0x28,
-	0xbd, 0x70, 0xc5, 0x9f, 0x00, 0xcd, 0xec, 0x8c, 0x77, 0x6c, 0x52, 0x25, 0xae, 0xda, 0x93, 0x67,
-	0xf6, 0xfd, 0xf9, 0xbd, 0x37, 0xef, 0xf7, 0xde, 0x8c, 0xc1, 0x07, 0xb2, 0xc7, 0xe2, 0x6c, 0x10,
-	0xc8, 0x1e, 0x67, 0x11, 0x0d, 0x8e, 0x37, 0x49, 0x94, 0xf4, 0xc8, 0xa6, 0xd9, 0xb7, 0x92, 0x54,
-	0x64, 0x02, 0xae, 0xe6, 0x4a, 0x2d, 0xf3, 0xd1, 0x2a, 0xad, 0x5d, 0xe9, 0x8a, 0xae, 0xd0, 0x2a,
-	0x81, 0x5a, 0xe5, 0xda, 0x6b, 0xcd, 0x50, 0xc8, 0xbe, 0x90, 0xc1, 0x01, 0x91, 0x2c, 0x38, 0xde,
-	0x3c, 0x60, 0x19, 0xd9, 0x0c, 0x42, 0xc1, 0x63, 0x23, 0xf7, 0xbb, 0x42, 0x74, 0x23, 0x16, 0xe8,
-	0xdd, 0xc1, 0xe0, 0x30, 0xc8, 0x78, 0x9f, 0xc9, 0x8c, 0xf4, 0x13, 0xa3, 0x70, 0xaa, 0x5b, 0x74,
-	0xe2, 0x01, 0xf0, 0x25, 0x7f, 0xc8, 0xe8, 0xb6, 0xe0, 0xb1, 0x84, 0x21, 0xa8, 0xc6, 0x24, 0xe3,
-	0xc7, 0xac, 0xe1, 0xad, 0x97, 0x37, 0x6a, 0x5b, 0x57, 0x5b, 0x39, 0x6c, 0x4b, 0xc1, 0xb6, 0x0c,
-	0x6c, 0x4b, 0xe9, 0xb6, 0x3f, 0x7d, 0x36, 0xf4, 0x4b, 0x3f, 0xbf, 0xf0, 0x37, 0xba, 0x3c, 0xeb,
-	0x0d, 0x0e, 0x5a, 0xa1, 0xe8, 0x07, 0x26, 0xc6, 0xfc, 0xe7, 0x13, 0x49, 0x8f, 0x82, 0xec, 0x51,
-	0xc2, 0xa4, 0x36, 0x90, 0xd8, 0xb8, 0x86, 0x0c, 0xcc, 0x1f, 0x8a, 0x94, 0xf1, 0x6e, 0xdc, 0x98,
-	0x7b, 0xf3, 0x28, 0xd6, 0xf7, 0xcd, 0x85, 0x1f, 0x9e, 0xfa, 0xa5, 0x7f, 0x9e, 0xfa, 0x25, 0xf4,
-	0xaf, 0x07, 0xea, 0x3a, 0xc9, 0x3b, 0x2c, 0xcc, 0xf3, 0xe4, 0x53, 0x79, 0xbe, 0x77, 0x6a, 0x04,
-	0x46, 0xbd, 0x7d, 0xc3, 0x04, 0x71, 0xfd, 0x1c, 0x41, 0x58, 0x88, 0x71, 0xb6, 0x47, 0xd3, 0xd9,
-	0xbe, 0x05, 0xac, 0x53, 0x72, 0xfe, 0xa5, 0x0c, 0x2a, 0x7b, 0x42, 0x44, 0xf0, 0x7d, 0x30, 0xc7,
-	0x69, 0xc3, 0x5b, 0xf7, 0x36, 0x2a, 0xed, 0xfa, 0x68, 0xe8, 0x2f, 0x3e, 0x22, 0xfd, 0xe8, 0x26,
-	0xe2, 0x14, 0xe1, 0x39, 0x4e, 0xe1, 0xe7, 0xa0, 0x46, 0x99, 0x0c, 0x53, 0x9e, 0x64, 0x5c, 0xa8,
-	0x10, 0xbd, 0x8d, 0xc5, 0xf6, 0xea, 0x68, 0xe8, 0xc3, 0x5c, 0xcf, 0x11, 0x22, 0xec, 0xaa, 0xc2,
-	0x8f, 0xc1, 0xbc, 0x4c, 0x44, 0x2c, 0x45, 0xda, 0x28, 0x6b, 0x2b, 0x38, 0x1a, 0xfa, 0x17, 0x73,
-	0x2b, 0x23, 0x40, 0xd8, 0xaa, 0xc0, 0x9b, 0x60, 0xc9, 0x2c, 0x3b, 0x84, 0xd2, 0xb4, 0x51, 0xd1,
-	0x26, 0xef, 0x8c, 0x86, 0xfe, 0xca, 0x84, 0x89, 0x96, 0x22, 0x5c, 0x33, 0xdb, 0xdb, 0x94, 0xa6,
-	0xb0, 0x07, 0x96, 0xf2, 0x26, 0xe9, 0x44, 0xbc, 0xcf, 0xb3, 0xc6, 0x05, 0x6d, 0x7b, 0x57, 0x9d,
-	0xd4, 0x5f, 0x43, 0xff, 0xda, 0x39, 0x4e, 0x6a, 0x27, 0xce, 0x1c, 0x24, 0xc7, 0x97, 0x42, 0xd2,
-	0xdb, 0x5d, 0xb5, 0x83, 0x1f, 0x81, 0x2a, 0x09, 0x35, 0x2f, 0xaa, 0xeb, 0xde, 0xc6, 0x42, 0x7b,
-	0x79, 0x34, 0xf4, 0xeb, 0xb9, 0x55, 0xfe, 0x1d, 0x61, 0xa3, 0x00, 0xf7, 0x41, 0x35, 0xb7, 0x6c,
-	0xcc, 0xeb, 0x70, 0x6e, 0xcd, 0x1c, 0x4e, 0xdd, 0x0d, 0x07, 0x61, 0xe3, 0xce, 0xa9, 0xe1, 0x4f,
-	0x15, 0xb0, 0xb0, 0x37, 0x48, 0xc3, 0x1e, 0x91, 0x0c, 0x7e, 0x06, 0x6a, 0x89, 0x59, 0x77, 0xc6,
-	0x05, 0x75, 0x0a, 0xe5, 0x08, 0x11, 0x06, 0x76, 0xb7, 0x43, 0x61, 0x0a, 0x56, 0x54, 0xaf, 0xb3,
-	0x50, 0x55, 0xad, 0xc3, 0x62, 0xda, 0x51, 0xa3, 0x41, 0x57, 0xba, 0xb6, 0xb5, 0xd6, 0xca, 0xe7,
-	0x46, 0xcb, 0xce, 0x8d, 0xd6, 0xd7, 0x76, 0x6e, 0xb4, 0xaf, 0xa9, 0x8c, 0x46, 0x43, 0x7f, 0xcd,
-	0x00, 0xfc, 0xdf, 0x09, 0x7a, 0xf2, 0xc2, 0xf7, 0xf0, 0x72, 0x21, 0xb9, 0x1b, 0x53, 0x65, 0x0f,
-	0x09, 0xa8, 0x53, 0x16, 0x31, 0xad, 0xac, 0xd1, 0xca, 0x67, 0xa2, 0xad, 0x1b, 0xb4, 0x2b, 0x96,
-	0x77, 0x8e, 0x79, 0x8e, 0xb3, 0x64, 0xbf, 0x69, 0x88, 0x29, 0xe2, 0x56, 0xce, 0x4f, 0xdc, 0xa2,
-	0x72, 0x17, 0xde, 0x68, 0xe5, 0x20, 0x03, 0x4b, 0x92, 0xa5, 0xc7, 0x3c, 0x64, 0x9d, 0x43, 0xc6,
-	0xa4, 0xe6, 0x50, 0x6d, 0xeb, 0xc3, 0xd6, 0xe9, 0x83, 0xbe, 0x35, 0x31, 0x92, 0xda, 0xef, 0x9a,
-	0xfc, 0x2d, 0x49, 0x1d, 0x47, 0x8a, 0xa4, 0xf9, 0xf6, 0x0b, 0xc6, 0xa4, 0x43, 0x90, 0xdf, 0x3d,
-	0xb0, 0x64, 0x09, 0xb2, 0xcb, 0x65, 0x06, 0xaf, 0x83, 0xf9, 0x44, 0x88, 0xa8, 0x20, 0x88, 0xd3,
-	0x93, 0x46, 0x80, 0x70, 0x55, 0xad, 0x76, 0x28, 0xdc, 0x02, 0x8b, 0x96, 0x26, 0xa9, 0x69, 0xfc,
-	0x2b, 0xa3, 0xa1, 0x7f, 0x79, 0x92, 0x4f, 0x29, 0xc2, 0x85, 0x1a, 0xc4, 0x60, 0x9e, 0xc5, 0x59,
-	0xca, 0x99, 0x6c, 0x94, 0xf5, 0x34, 0x5b, 0x7f, 0x55, 0x76, 0x36, 0xae, 0xf6, 0xaa, 0x49, 0xcc,
-	0x84, 0x61, 0xcc, 0x11, 0xb6, 0x8e, 0x9c, 0x7c, 0x7e, 0x55, 0x84, 0x4f, 0xc5, 0x31, 0xa7, 0x2c,
-	0x55, 0xf3, 0x45, 0xcd, 0x02, 0x26, 0xa5, 0xce, 0x65, 0x62, 0xbe, 0x18, 0x01, 0xc2, 0x56, 0x05,
-	0xc6, 0x60, 0x59, 0xd1, 0xa3, 0x4b, 0x34, 0x69, 0x0e, 0x44, 0x4c, 0x19, 0x35, 0x49, 0xdd, 0x9e,
-	0xb9, 0xbe, 0x97, 0xc6, 0x8c, 0xd7, 0xa1, 0x20, 0x7c, 0xb9, 0xf0, 0xdd, 0xd6, 0xae, 0x61, 0x08,
-	0x40, 0x28, 0xa2, 0x88, 0x64, 0x2c, 0x25, 0x91, 0x19, 0x80, 0xdb, 0x33, 0x03, 0x2d, 0xe7, 0x40,
-	0x85, 0x27, 0x84, 0x1d, 0xb7, 0x6a, 0xf0, 0x65, 0x22, 0x23, 0x51, 0x27, 0x12, 0xe1, 0x11, 0xa3,
-	0x86, 0xe4, 0xaf, 0x3d, 0xf8, 0x5c, 0x5f, 0x08, 0xd7, 0xf4, 0x76, 0x57, 0xef, 0xe0, 0x21, 0xa8,
-	0x3d, 0xe0, 0x59, 0x8f, 0xa6, 0xe4, 0x01, 0x8f, 0xbb, 0xa6, 0x31, 0xee, 0xcc, 0x0c, 0x64, 0x7a,
-	0xcf, 0x71, 0x85, 0xb0, 0xeb, 0x18, 0xee, 0x83, 0xf9, 0x94, 0x3d, 0x20, 0x29, 0x9d, 0xb1, 0x3b,
-	0xa6, 0x48, 0x64, 0x7c, 0x20, 0x6c, 0xbd, 0x39, 0x24, 0x7a, 0x0c, 0xea, 0xea, 0xe2, 0xdb, 0x1b,
-	0x73, 0xf6, 0x6d, 0x37, 0x85, 0x83, 0xbd, 0x0f, 0xe0, 0x04, 0xf6, 0x1e, 0xe1, 0xa9, 0x84, 0xb7,
-	0xc1, 0x85, 0x44, 0x2d, 0xcc, 0x63, 0xe3, 0x95, 0x29, 0x4f, 0x98, 0xb6, 0x2b, 0x2a, 0x65, 0x9c,
-	0x5b, 0xa2, 0xef, 0xe7, 0xc0, 0xc2, 0xbe, 0x39, 0xc7, 0x19, 0x3b, 0x63, 0x1f, 0x54, 0x49, 0x5f,
-	0x0c, 0xe2, 0xcc, 0xa4, 0xf3, 0xda, 0xe3, 0x2e, 0xf7, 0xa2, 0x6e, 0x40, 0xbd, 0x80, 0x5d, 0x70,
-	0x29, 0x14, 0xfd, 0x64, 0xb6, 0x31, 0x8f, 0x4c, 0x21, 0x57, 0x2d, 0xf3, 0x27, 0x1c, 0xe4, 0x83,
-	0xfe, 0x62, 0xf1, 0x55, 0x19, 0x3a, 0xe7, 0xfb, 0x15, 0x58, 0xb4, 0xa7, 0x20, 0xe1, 0x1d, 0xb0,
-	0x68, 0xa9, 0x65, 0x8f, 0xf6, 0x95, 0xd3, 0xc8, 0x5a, 0x99, 0x53, 0x2d, 0x0c, 0xd1, 0x1f, 0x73,
-	0xa0, 0x7e, 0x5f, 0x6b, 0xdf, 0xcf, 0xc8, 0x91, 0xe2, 0xe8, 0x5b, 0x1f, 0xa2, 0x45, 0x45, 0xca,
-	0x6f, 0xb6, 0x22, 0x8f, 0x01, 0xb4, 0x89, 0x75, 0x52, 0xf6, 0xdd, 0x80, 0xc9, 0x6c, 0x3c, 0x35,
-	0xee, 0xcd, 0x0c, 0x72, 0x75, 0xb2, 0x99, 0x0b, 0x8f, 0x08, 0x2f, 0xdb, 0x8f, 0xd8, 0x7e, 0x73,
-	0x8a, 0xd4, 0x01, 0x17, 0x77, 0x89, 0xcc, 0xbe, 0x49, 0x28, 0xc9, 0x98, 0xbe, 0xab, 0xb7, 0x41,
-	0x45, 0xd3, 0xc3, 0x3b, 0x93, 0x1e, 0x2b, 0xa3, 0xa1, 0x5f, 0x33, 0xd3, 0x6a, 0xcc, 0x07, 0x6d,
-	0xec, 0x00, 0xfc, 0x56, 0x06, 0x2b, 0x79, 0xc9, 0xb6, 0x23, 0xc2, 0xfb, 0x7b, 0xa9, 0x48, 0x84,
-	0x24, 0x91, 0x7e, 0x22, 0x99, 0xf5, 0xe9, 0x4f, 0xa4, 0x42, 0xa8, 0x9e, 0x48, 0x66, 0xb7, 0x43,
-	0xdd, 0x8a, 0xcf, 0x9d, 0x59, 0xf1, 0xa9, 0x87, 0x58, 0xf9, 0xdc, 0x0f, 0xb1, 0x18, 0x54, 0x22,
-	0x21, 0x65, 0xa3, 0x72, 0xd6, 0x9f, 0x9e, 0x5b, 0xa6, 0x47, 0xcc, 0x41, 0x28, 0x23, 0x34, 0xd3,
-	0x7f, 0x20, 0x8d, 0x03, 0x03, 0xb0, 0xc0, 0xd4, 0xfd, 0x15, 0x87, 0xcc, 0x0c, 0xf4, 0x95, 0xe2,
-	0x6e, 0xb3, 0x12, 0x84, 0xc7, 0x4a, 0xd3, 0x4f, 0xaa, 0xea, 0xf9, 0x9f, 0x54, 0x01, 0x58, 0xc8,
-	0x8f, 0x93, 0xa5, 0xe6, 0x39, 0xbc, 0x32, 0x71, 0x8d, 0x6a, 0x09, 0xc2, 0x63, 0xa5, 0xbc, 0x98,
-	0x3f, 0x3e, 0xf5, 0x4b, 0xed, 0x7b, 0xcf, 0x4e, 0x9a, 0xde, 0xf3, 0x93, 0xa6, 0xf7, 0xf7, 0x49,
-	0xd3, 0x7b, 0xf2, 0xb2, 0x59, 0x7a, 0xfe, 0xb2, 0x59, 0xfa, 0xf3, 0x65, 0xb3, 0xf4, 0xed, 0xa6,
-	0x9b, 0x2f, 0x4b, 0x33, 0x7e, 0x74, 0x28, 0x06, 0x31, 0xd5, 0xb7, 0x70, 0x60, 0xfe, 0x61, 0x3f,
-	0xb4, 0xff, 0xb1, 0x75, 0xfa, 0x07, 0x55, 0x4d, 0xa9, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x96, 0xf0, 0x22, 0xc7, 0x81, 0x0f, 0x00, 0x00,
+	// 2446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0x3f, 0x44, 0x91, 0x43, 0x52, 0x1f, 0x23, 0xc7, 0x5e, 0xab, 0x8d, 0xc8, 0x4c, 0x5a,
+	0x43, 0x4d, 0x52, 0x32, 0x76, 0x0e, 0x2d, 0x02, 0x14, 0x29, 0x49, 0x31, 0xa9, 0x12, 0xd9, 0x62,
+	0x47, 0xb2, 0x0d, 0xb4, 0x87, 0xc5, 0x6a, 0x77, 0x44, 0x6d, 0xb5, 0xdc, 0xdd, 0xec, 0x0e, 0x25,
+	0xdb, 0xe8, 0xa5, 0x40, 0x0f, 0x81, 0xd1, 0xa2, 0x39, 0xe6, 0x62, 0x20, 0x40, 0x6f, 0x3d, 0xb7,
+	0x40, 0xfb, 0x07, 0x14, 0x48, 0x5b, 0x14, 0xc8, 0xb1, 0xe8, 0x41, 0x29, 0xec, 0x4b, 0xd0, 0x5b,
+	0xf5, 0x17, 0x14, 0xf3, 0x45, 0xce, 0x52, 0x52, 0xa4, 0x85, 0xcd, 0xa0, 0x3d, 0x69, 0x67, 0xe6,
+	0xbd, 0xf7, 0x9b, 0xf7, 0xe6, 0xbd, 0x37, 0xef, 0x0d, 0x05, 0x5e, 0x8d, 0xf7, 0x89, 0x4f, 0x87,
+	0xcd, 0x78, 0xdf, 0x25, 0x9e, 0xd3, 0x3c, 0xbc, 0x69, 0x79, 0xe1, 0xbe, 0x75, 0x53, 0x8e, 0x1b,
+	0x61, 0x14, 0xd0, 0x00, 0x5e, 0x15, 0x44, 0x0d, 0x39, 0xa9, 0x88, 0x56, 0xae, 0xf4, 0x83, 0x7e,
+	0xc0, 0x49, 0x9a, 0xec, 0x4b, 0x50, 0xaf, 0xac, 0xda, 0x41, 0x3c, 0x08, 0xe2, 0xe6, 0xae, 0x15,
+	0x93, 0xe6, 0xe1, 0xcd, 0x5d, 0x42, 0xad, 0x9b, 0x4d, 0x3b, 0x70, 0x7d, 0xb5, 0xde, 0x0f, 0x82,
+	0xbe, 0x47, 0x9a, 0x7c, 0xb4, 0x3b, 0xdc, 0x6b, 0x3a, 0xc3, 0xc8, 0xa2, 0x6e, 0xa0, 0xd6, 0x6b,
+	0x93, 0xeb, 0xd4, 0x1d, 0x90, 0x98, 0x5a, 0x83, 0x50, 0x12, 0x9c, 0x09, 0x8b, 0x9e, 0x66, 0x00,
+	0xb8, 0xed, 0x3e, 0x20, 0x4e, 0x27, 0x70, 0xfd, 0x18, 0xda, 0xa0, 0xe0, 0x5b, 0xd4, 0x3d, 0x24,
+	0x46, 0xa6, 0x9e, 0x5b, 0x2b, 0xdf, 0xba, 0xde, 0x10, 0xdb, 0x6a, 0xb0, 0x6d, 0x35, 0xe4, 0xb6,
+	0x1a, 0x8c, 0xb6, 0xfd, 0xe6, 0x67, 0xc7, 0xb5, 0x99, 0xdf, 0x7d, 0x51, 0x5b, 0xeb, 0xbb, 0x74,
+	0x7f, 0xb8, 0xdb, 0xb0, 0x83, 0x41, 0x53, 0xea, 0x20, 0xfe, 0x7c, 0x37, 0x76, 0x0e, 0x9a, 0xf4,
+	0x61, 0x48, 0x62, 0xce, 0x10, 0x63, 0x29, 0x1a, 0x12, 0x30, 0xb7, 0x17, 0x44, 0xc4, 0xed, 0xfb,
+	0x46, 0xf6, 0xc5, 0xa3, 0x28, 0xd9, 0x6f, 0x17, 0x3f, 0xfa, 0xb4, 0x36, 0xf3, 0xe5, 0xa7, 0xb5,
+	0x19, 0xf4, 0x9f, 0x0c, 0xa8, 0x72, 0x25, 0xd7, 0x89, 0x2d, 0xf4, 0x74, 0x27, 0xf4, 0xfc, 0xe6,
+	0x99, 0x3b, 0x90, 0xe4, 0xed, 0xb7, 0xe4, 0x26, 0x5e, 0xbf, 0xc4, 0x26, 0x14, 0xc4, 0x48, 0xdb,
+	0x83, 0x49, 0x6d, 0xa7, 0x80, 0x75, 0x86, 0xce, 0xbf, 0x2a, 0x82, 0x7c, 0x2f, 0x08, 0x3c, 0xf8,
+	0x32, 0xc8, 0xba, 0x8e, 0x91, 0xa9, 0x67, 0xd6, 0xf2, 0xed, 0xea, 0xc9, 0x71, 0xad, 0xf4, 0xd0,
+	0x1a, 0x78, 0x6f, 0x23, 0xd7, 0x41, 0x38, 0xeb, 0x3a, 0xf0, 0xfb, 0xa0, 0xec, 0x90, 0xd8, 0x8e,
+	0xdc, 0x90, 0x39, 0x93, 0x91, 0xad, 0x67, 0xd6, 0x4a, 0xed, 0xab, 0x27, 0xc7, 0x35, 0x28, 0xe8,
+	0xb4, 0x45, 0x84, 0x75, 0x52, 0xf8, 0x06, 0x98, 0x8b, 0xc3, 0xc0, 0x8f, 0x83, 0xc8, 0xc8, 0x71,
+	0x2e, 0x78, 0x72, 0x5c, 0x9b, 0x17, 0x5c, 0x72, 0x01, 0x61, 0x45, 0x02, 0xdf, 0x06, 0x15, 0xf9,
+	0x69, 0x5a, 0x8e, 0x13, 0x19, 0x79, 0xce, 0x72, 0xed, 0xe4, 0xb8, 0xb6, 0x9c, 0x60, 0xe1, 0xab,
+	0x08, 0x97, 0xe5, 0xb0, 0xe5, 0x38, 0x11, 0xdc, 0x07, 0x15, 0x11, 0x44, 0xa6, 0xe7, 0x0e, 0x5c,
+	0x6a, 0xcc, 0x72, 0xde, 0x2e, 0xb3, 0xd4, 0x3f, 0x8f, 0x6b, 0x37, 0x2e, 0x61, 0xa9, 0x0d, 0x9f,
+	0x6a, 0x48, 0x9a, 0x2c, 0x86, 0xc4, 0x87, 0x9b, 0x6c, 0x04, 0xbf, 0x03, 0x0a, 0x96, 0xcd, 0xfd,
+	0xa2, 0x50, 0xcf, 0xac, 0x15, 0xdb, 0x4b, 0x27, 0xc7, 0xb5, 0xaa, 0xe0, 0x12, 0xf3, 0x08, 0x4b,
+	0x02, 0x78, 0x1f, 0x14, 0x04, 0xa7, 0x31, 0xc7, 0xb7, 0xf3, 0x4e, 0xea, 0xed, 0x54, 0xf5, 0xed,
+	0x20, 0x2c, 0xc5, 0x41, 0x1b, 0x00, 0xcb, 0xf3, 0x02, 0x9b, 0x47, 0xb7, 0x51, 0xe4, 0xc2, 0x3b,
+	0xa9, 0x85, 0x2f, 0xc9, 0x5d, 0x8f, 0x24, 0x21, 0xac, 0x89, 0x85, 0x04, 0x54, 0x62, 0x12, 0x1d,
+	0xba, 0x36, 0x31, 0xf7, 0x08, 0x89, 0x8d, 0x52, 0x3d, 0xb3, 0x56, 0xbe, 0xf5, 0xed, 0xc6, 0xd9,
+	0x39, 0xab, 0x91, 0x88, 0x9e, 0xf6, 0x37, 0xd8, 0x6e, 0x34, 0x7b, 0x6a, 0x82, 0x98, 0x3d, 0xc5,
+	0xf0, 0x5d, 0x42, 0x62, 0x06, 0x13, 0x91, 0x23, 0x2b, 0x72, 0x4c, 0xd7, 0x77, 0xc8, 0x03, 0x03,
+	0x3c, 0x07, 0x8c, 0x2e, 0x08, 0xe1, 0xb2, 0x18, 0x6e, 0xb0, 0x11, 0x3c, 0x00, 0xf3, 0x0c, 0xdc,
+	0xb4, 0x03, 0xcf, 0x23, 0x36, 0x25, 0x8e, 0x51, 0x4e, 0x03, 0xf4, 0xb2, 0x04, 0x7a, 0x49, 0x00,
+	0x25, 0x45, 0x21, 0x5c, 0x65, 0x13, 0x1d, 0x35, 0x86, 0x7d, 0x30, 0x6f, 0x07, 0x87, 0x24, 0xb2,
+	0xfa, 0xc4, 0xa4, 0x24, 0x1a, 0xc4, 0x46, 0xe5, 0xab, 0xc1, 0x3a, 0x92, 0x7a, 0x87, 0x11, 0xb7,
+	0xaf, 0x8f, 0x81, 0x92, 0x62, 0x10, 0xae, 0xda, 0x3a, 0x25, 0xfc, 0xa1, 0x04, 0x22, 0x8e, 0x69,
+	0xc5, 0x31, 0xa1, 0xb1, 0x51, 0xad, 0xe7, 0xd6, 0x4a, 0xa7, 0x24, 0x8c, 0xd6, 0x95, 0x04, 0xe2,
+	0xb4, 0xf8, 0x58, 0x4b, 0x07, 0x7f, 0xcb, 0x81, 0x6a, 0x62, 0x1f, 0xf0, 0x08, 0x2c, 0x39, 0xc4,
+	0x19, 0xda, 0xd4, 0xdd, 0xf5, 0x88, 0x69, 0x0d, 0x82, 0xa1, 0x4f, 0x79, 0x9a, 0x28, 0xb5, 0xdf,
+	0x4f, 0xed, 0x6d, 0x86, 0x4a, 0x16, 0x13, 0x02, 0x11, 0x5e, 0x1c, 0xcf, 0xb5, 0xf8, 0x14, 0xfc,
+	0x10, 0x2c, 0x68, 0x74, 0x91, 0x45, 0x89, 0xcc, 0x3a, 0x3f, 0x4a, 0x01, 0xbb, 0x4e, 0xec, 0x93,
+	0xe3, 0xda, 0xd5, 0x53, 0xb0, 0x4c, 0x1c, 0xc2, 0xf3, 0xe3, 0x19, 0x6c, 0x51, 0x02, 0x4d, 0x50,
+	0x1a, 0x58, 0x0f, 0x4c, 0xdb, 0xb3, 0xdc, 0x81, 0x4c, 0x56, 0xed, 0xd4, 0x3a, 0x2e, 0x0a, 0xb0,
+	0x91, 0x20, 0x84, 0x8b, 0x03, 0xeb, 0x41, 0x87, 0x7d, 0x42, 0x1b, 0xcc, 0x1f, 0x59, 0x2e, 0x75,
+	0xfd, 0xbe, 0x19, 0x92, 0xc8, 0x0d, 0x1c, 0x9e, 0xdf, 0xd8, 0xcd, 0x26, 0xae, 0xe5, 0x86, 0xba,
+	0x96, 0x1b, 0xeb, 0xf2, 0xda, 0x6e, 0xbf, 0x92, 0x74, 0xba, 0x24, 0x3b, 0xfa, 0xe4, 0x8b, 0x5a,
+	0x06, 0x57, 0xe5, 0x64, 0x8f, 0xcf, 0x69, 0xa7, 0xf9, 0xfb, 0x2c, 0x00, 0xad, 0x71, 0x30, 0xbf,
+	0x0e, 0xe6, 0xc2, 0x20, 0xf0, 0xcc, 0x51, 0x9e, 0xd7, 0x32, 0xb1, 0x5c, 0x40, 0xb8, 0xc0, 0xbe,
+	0x36, 0x1c, 0xd8, 0x04, 0xc5, 0x30, 0x0a, 0x0e, 0x5d, 0x87, 0x44, 0xd2, 0xee, 0xcb, 0x27, 0xc7,
+	0xb5, 0x05, 0x49, 0x2d, 0x57, 0x10, 0x1e, 0x11, 0xb1, 0x44, 0x27, 0xbd, 0x23, 0xf7, 0x7c, 0x89,
+	0x4e, 0xb9, 0x84, 0x14, 0x77, 0x2a, 0x39, 0xe4, 0xa7, 0x92, 0x1c, 0x34, 0xb3, 0xfd, 0x62, 0x16,
+	0x14, 0x7b, 0xc3, 0xc8, 0xde, 0xb7, 0x62, 0x02, 0xbf, 0x07, 0xca, 0xa1, 0xfc, 0x1e, 0x1b, 0x4e,
+	0xbb, 0xf8, 0xb4, 0x45, 0x84, 0x81, 0x1a, 0x6d, 0x38, 0x30, 0x02, 0xcb, 0xec, 0x34, 0x89, 0xcd,
+	0x6c, 0x6f, 0x12, 0xdf, 0x31, 0x59, 0xa9, 0xc5, 0x6d, 0x59, 0xbe, 0xb5, 0x72, 0xea, 0xc0, 0x77,
+	0x54, 0x1d, 0xd6, 0xbe, 0x21, 0xb7, 0xbc, 0x32, 0xb2, 0xf5, 0xa4, 0x10, 0xf4, 0x31, 0x3b, 0xf6,
+	0xa5, 0xf1, 0x4a, 0xd7, 0x77, 0x18, 0x3f, 0xb4, 0x40, 0xd5, 0x21, 0x1e, 0xe1, 0xc4, 0x1c, 0x2d,
+	0x77, 0x21, 0x5a, 0x5d, 0xa2, 0x5d, 0x51, 0x31, 0xa2, 0xb1, 0x0b, 0x9c, 0x8a, 0x9a, 0xe3, 0x10,
+	0x13, 0x85, 0x40, 0xfe, 0xf2, 0x85, 0xc0, 0xf8, 0x26, 0x9c, 0x7d, 0xb1, 0x37, 0xe1, 0xe4, 0x25,
+	0x55, 0x98, 0xce, 0x25, 0x65, 0x81, 0xea, 0xe8, 0xb0, 0xb9, 0x71, 0xe7, 0xd2, 0x1a, 0x37, 0xc1,
+	0x2e, 0x8d, 0xab, 0xe6, 0x18, 0x93, 0xe6, 0x83, 0x7f, 0xcf, 0x80, 0x8a, 0xf2, 0xc1, 0x4d, 0x37,
+	0xa6, 0xe9, 0x82, 0xf7, 0x16, 0x28, 0x29, 0xb9, 0x2a, 0x7a, 0xaf, 0x8c, 0x53, 0xd3, 0x68, 0x09,
+	0xe1, 0x31, 0x19, 0xc4, 0x60, 0x8e, 0xf8, 0x34, 0x72, 0x49, 0x6c, 0xe4, 0x78, 0x01, 0x5a, 0x3f,
+	0xcf, 0x80, 0x6a, 0x5f, 0xed, 0xab, 0x52, 0x3d, 0xb9, 0x0d, 0xc9, 0x8e, 0xb0, 0x12, 0xa4, 0xe9,
+	0xf3, 0x25, 0x8b, 0x29, 0x95, 0x2a, 0xde, 0x00, 0x73, 0xac, 0x7c, 0x23, 0x71, 0x2c, 0x6f, 0x12,
+	0x4d, 0x17, 0xb9, 0x80, 0xb0, 0x22, 0x81, 0x3e, 0xbb, 0x81, 0x3c, 0xd2, 0xe7, 0x49, 0xcc, 0xdc,
+	0x0d, 0x7c, 0x87, 0x38, 0x52, 0xa9, 0x56, 0x6a, 0x17, 0x3a, 0x95, 0xc0, 0x16, 0xc7, 0xb2, 0xdb,
+	0x5c, 0x34, 0x2b, 0xac, 0xd8, 0xad, 0x6e, 0x51, 0x12, 0x59, 0x9e, 0x91, 0x7b, 0xbe, 0xc2, 0x6a,
+	0x2c, 0x09, 0x61, 0x4d, 0x2c, 0xab, 0x55, 0x69, 0x40, 0x2d, 0xcf, 0xf4, 0x02, 0xfb, 0x80, 0x38,
+	0x46, 0xfe, 0xf9, 0x6a, 0x55, 0x5d, 0x16, 0xc2, 0x65, 0x3e, 0xdc, 0xe4, 0x23, 0xb8, 0x07, 0xca,
+	0x47, 0x2e, 0xdd, 0x77, 0x22, 0xeb, 0xc8, 0xf5, 0xfb, 0x32, 0xf6, 0xd6, 0x53, 0x03, 0xc9, 0xf0,
+	0xd6, 0x44, 0x21, 0xac, 0x0b, 0x86, 0xf7, 0xc1, 0x9c, 0x48, 0xa7, 0x29, 0x03, 0x70, 0xc2, 0x89,
+	0xa4, 0x0c, 0x84, 0x95, 0xb4, 0x53, 0xf9, 0x7f, 0x6e, 0x3a, 0xc5, 0xe1, 0x0f, 0x40, 0xd5, 0x1a,
+	0xd2, 0xc0, 0xb4, 0x83, 0x41, 0x18, 0x0c, 0x7d, 0x87, 0x97, 0xd4, 0xc5, 0xb6, 0x31, 0x0e, 0xdf,
+	0xc4, 0x32, 0xc2, 0x15, 0x36, 0xee, 0xc8, 0xa1, 0xe6, 0xea, 0x8f, 0x40, 0x95, 0x75, 0x54, 0xbd,
+	0x51, 0x64, 0x4d, 0x3b, 0x74, 0x35, 0xec, 0xfb, 0x00, 0x26, 0xb0, 0x7b, 0x96, 0x1b, 0xc5, 0xb0,
+	0x05, 0x66, 0x43, 0xf6, 0x21, 0xbb, 0xd8, 0x73, 0x4d, 0x97, 0x60, 0x6d, 0xe7, 0x99, 0xe9, 0xb0,
+	0xe0, 0x44, 0xbf, 0xcc, 0x82, 0xe2, 0x7d, 0x79, 0xda, 0x29, 0xe3, 0x77, 0x5c, 0x18, 0x64, 0x5f,
+	0x6c, 0x61, 0xd0, 0x07, 0x0b, 0xec, 0x34, 0xd2, 0xdd, 0x77, 0x48, 0x3a, 0xc4, 0x55, 0x15, 0x9f,
+	0x09, 0x01, 0x22, 0x29, 0xcf, 0x8f, 0x67, 0x27, 0xd2, 0xf2, 0x8f, 0x41, 0x49, 0x59, 0x21, 0x86,
+	0xeb, 0xa0, 0xa4, 0x02, 0x40, 0x99, 0xf6, 0xdc, 0x9c, 0xa9, 0xb8, 0xa4, 0x55, 0xc7, 0x8c, 0xe8,
+	0x37, 0x79, 0x50, 0xdd, 0xe6, 0xd4, 0xdb, 0xd4, 0x3a, 0x60, 0x91, 0x34, 0xf5, 0x54, 0x3f, 0xb5,
+	0x52, 0xed, 0x11, 0x80, 0x4a, 0x31, 0x33, 0x22, 0x1f, 0x0e, 0x49, 0x4c, 0x47, 0xb9, 0xed, 0x83,
+	0xd4, 0x20, 0xd7, 0x93, 0x29, 0x67, 0x2c, 0x11, 0xe1, 0x25, 0x35, 0x89, 0xd5, 0xdc, 0xa9, 0x34,
+	0x31, 0x3b, 0x9d, 0x34, 0x31, 0xad, 0x34, 0xa7, 0x39, 0xd9, 0x1f, 0xb3, 0xa0, 0x74, 0xd7, 0x8f,
+	0xff, 0xef, 0xbd, 0xe1, 0x8c, 0xf8, 0xcc, 0x4f, 0x39, 0x3e, 0xef, 0x02, 0x30, 0xb2, 0x5c, 0x0c,
+	0xdf, 0x03, 0x60, 0x38, 0x1a, 0xc9, 0x08, 0x7d, 0xe5, 0xbc, 0xe3, 0x1a, 0xf1, 0xc9, 0x10, 0xd5,
+	0x58, 0x91, 0x09, 0xe6, 0x37, 0xad, 0x98, 0xde, 0x0d, 0x1d, 0x8b, 0xf2, 0x4a, 0x0d, 0x76, 0x40,
+	0x9e, 0x2b, 0x94, 0xb9, 0x50, 0x21, 0xd6, 0x36, 0x95, 0xe5, 0x2d, 0x3d, 0xd2, 0x20, 0x4f, 0x93,
+	0xfb, 0xfe, 0x6b, 0x0e, 0x2c, 0x8b, 0x24, 0xc0, 0x1b, 0xc5, 0x5e, 0x14, 0x84, 0x41, 0x6c, 0x79,
+	0xbc, 0xfb, 0x90, 0xdf, 0x67, 0x77, 0x1f, 0xe3, 0x45, 0xd6, 0x7d, 0xc8, 0xd1, 0x86, 0xa3, 0x7b,
+	0x4d, 0xf6, 0x42, 0xaf, 0x99, 0xe8, 0x71, 0x72, 0x97, 0xee, 0x71, 0x7c, 0x90, 0xf7, 0x82, 0x38,
+	0x36, 0xf2, 0x17, 0xbd, 0xcf, 0xbe, 0x23, 0x4f, 0x55, 0x1a, 0x82, 0x31, 0xa1, 0x54, 0xcf, 0xb5,
+	0x1c, 0x87, 0x35, 0xa5, 0x84, 0xd5, 0x6d, 0xbe, 0x4d, 0x64, 0x21, 0xa3, 0x35, 0xa5, 0x6a, 0x05,
+	0xe1, 0x11, 0xd1, 0x64, 0xb7, 0x52, 0xb8, 0x7c, 0xb7, 0x22, 0xfa, 0xdf, 0x30, 0x60, 0x81, 0x34,
+	0x77, 0x46, 0xff, 0xcb, 0x57, 0x44, 0xff, 0xcb, 0x3f, 0xc5, 0x61, 0x7e, 0xc2, 0x0e, 0xf3, 0xdf,
+	0x79, 0x50, 0x61, 0x57, 0xe9, 0xb6, 0x6f, 0x85, 0xf1, 0x7e, 0x90, 0xb2, 0x76, 0x1f, 0xbf, 0x2d,
+	0x66, 0x2f, 0xff, 0xb6, 0x98, 0x9b, 0xe6, 0xdb, 0x62, 0x7e, 0x3a, 0x6f, 0x8b, 0x7b, 0xa0, 0x3c,
+	0xa4, 0xae, 0xe7, 0x3e, 0x12, 0x28, 0xe9, 0x0b, 0x53, 0xf1, 0xb8, 0x23, 0x4f, 0x52, 0x13, 0x85,
+	0xb0, 0x2e, 0xf8, 0x8c, 0x57, 0xbf, 0xc2, 0xf4, 0x5e, 0xfd, 0xbe, 0x9e, 0x62, 0x55, 0xcb, 0x1c,
+	0x7f, 0x2a, 0x80, 0x6a, 0x37, 0x0c, 0xec, 0xfd, 0x91, 0xb7, 0xdd, 0x00, 0xb3, 0x84, 0x4d, 0x48,
+	0x5f, 0x5b, 0x3c, 0x39, 0xae, 0x55, 0x64, 0x84, 0xb0, 0x69, 0x84, 0xc5, 0x32, 0x73, 0xb4, 0x7d,
+	0xe2, 0xf6, 0xf7, 0x45, 0x5d, 0x96, 0xd3, 0x1d, 0x4d, 0xcc, 0x23, 0x2c, 0x09, 0xe0, 0x7b, 0x32,
+	0xdb, 0x5d, 0x5c, 0x5e, 0x5d, 0x4b, 0x06, 0xfa, 0x44, 0xc6, 0x83, 0x3d, 0x30, 0xcb, 0xdc, 0x5c,
+	0x65, 0x8c, 0x6f, 0x7d, 0x55, 0x25, 0xaa, 0x14, 0x6a, 0x5f, 0x91, 0x32, 0x2b, 0xe3, 0x88, 0x89,
+	0x11, 0x16, 0x82, 0x20, 0x05, 0x8b, 0xa2, 0xf9, 0xd1, 0x7a, 0x36, 0xe1, 0x4a, 0x1b, 0xa9, 0x1d,
+	0xf6, 0x9a, 0xde, 0x4c, 0xe9, 0x9d, 0xdb, 0x02, 0x9f, 0xea, 0x9c, 0xd1, 0xbe, 0xc9, 0xf8, 0x2b,
+	0xbc, 0x88, 0xf6, 0x4d, 0x45, 0xa1, 0x68, 0xdf, 0xc4, 0x75, 0x00, 0x0f, 0x40, 0x55, 0xee, 0x87,
+	0x5d, 0x0c, 0x44, 0xfd, 0x8c, 0xf0, 0x6e, 0x6a, 0xa8, 0x2b, 0x09, 0xe5, 0x84, 0x30, 0x84, 0x85,
+	0x1a, 0x1d, 0x31, 0x84, 0x3f, 0x07, 0xcb, 0x7d, 0x2f, 0xd8, 0x65, 0x7b, 0x11, 0x57, 0x9f, 0xc9,
+	0x8c, 0x2c, 0x7f, 0x5c, 0xd8, 0x4c, 0x0d, 0x29, 0x5f, 0xb0, 0xce, 0x10, 0x89, 0xf0, 0x92, 0x98,
+	0x95, 0x35, 0x2f, 0xff, 0x09, 0x6a, 0x32, 0x76, 0x4a, 0xd3, 0x8e, 0x9d, 0xbf, 0x64, 0x40, 0x59,
+	0x98, 0x19, 0x07, 0x1e, 0xe1, 0x2f, 0xe9, 0x3c, 0x1d, 0x07, 0x21, 0x89, 0x2c, 0x1a, 0xc8, 0x86,
+	0x29, 0xf1, 0x92, 0x9e, 0x5c, 0x47, 0xb8, 0xca, 0x26, 0xb6, 0xd4, 0x98, 0x75, 0x46, 0xa1, 0x35,
+	0x8c, 0x49, 0x14, 0xf3, 0x5f, 0xf1, 0x12, 0x9d, 0x91, 0x5c, 0x40, 0x58, 0x91, 0x30, 0x3c, 0x7e,
+	0x10, 0xe6, 0xc0, 0xf2, 0xad, 0x3e, 0x89, 0xc4, 0xcb, 0x4b, 0x02, 0x2f, 0xb9, 0xce, 0x5e, 0xee,
+	0xd9, 0xc4, 0x6d, 0x39, 0xd6, 0x74, 0xf9, 0x75, 0x16, 0x5c, 0x17, 0xba, 0xb4, 0x9c, 0x81, 0xeb,
+	0x8b, 0x52, 0x65, 0x54, 0x47, 0xdc, 0x00, 0xb3, 0xd4, 0xa5, 0x1e, 0x91, 0xfd, 0x9a, 0x96, 0x13,
+	0xf8, 0x34, 0xc2, 0x62, 0xf9, 0x39, 0x7e, 0xe6, 0xdb, 0x02, 0xb3, 0x11, 0x33, 0xa2, 0xcc, 0x11,
+	0xaf, 0x9e, 0x77, 0x6a, 0x9a, 0xbd, 0x27, 0x03, 0x9b, 0xf3, 0x23, 0x2c, 0xe4, 0x24, 0x2e, 0xe0,
+	0xfc, 0x65, 0x2e, 0xe0, 0x8a, 0xba, 0x80, 0xb9, 0x3d, 0xfe, 0x5c, 0x04, 0xb3, 0xe2, 0xd1, 0xfd,
+	0x7f, 0xbc, 0x86, 0x4a, 0x94, 0xec, 0xf9, 0xcb, 0x95, 0xec, 0x77, 0x40, 0x21, 0xa6, 0x16, 0x1d,
+	0xc6, 0x3c, 0xd5, 0xcd, 0x9f, 0x6f, 0x6d, 0x6e, 0x81, 0x6d, 0x4e, 0xaa, 0xe7, 0x77, 0xc1, 0xcc,
+	0xee, 0x7b, 0xfe, 0x31, 0xaa, 0xe3, 0x0a, 0x5f, 0x53, 0x1d, 0x77, 0x04, 0xe6, 0x62, 0x62, 0x0f,
+	0x23, 0x9e, 0xce, 0x2e, 0x80, 0x6c, 0x27, 0x1b, 0x27, 0xc9, 0x97, 0x0e, 0x55, 0xa1, 0xc1, 0x47,
+	0xa0, 0x18, 0x91, 0x98, 0x06, 0x0c, 0xb9, 0x78, 0x11, 0x72, 0x47, 0x22, 0x2f, 0xa8, 0x94, 0x22,
+	0x18, 0xd3, 0x41, 0x8f, 0xf0, 0x04, 0xb6, 0x47, 0xac, 0x98, 0x38, 0x46, 0x29, 0x35, 0xb6, 0x60,
+	0x4c, 0x8d, 0x2d, 0xd8, 0x20, 0x05, 0x85, 0xd0, 0x7a, 0x18, 0x0c, 0xa9, 0x01, 0x2e, 0x42, 0x6e,
+	0x49, 0xe4, 0xaa, 0xca, 0x5a, 0x8c, 0x2d, 0x1d, 0xae, 0xc4, 0x82, 0x3f, 0x05, 0xe5, 0x78, 0xb8,
+	0x3b, 0x70, 0xa9, 0x68, 0xfe, 0xca, 0x17, 0x56, 0x0f, 0xab, 0x12, 0x5b, 0xc6, 0x8c, 0xc6, 0x2c,
+	0x8a, 0x08, 0x20, 0x66, 0x18, 0x03, 0x13, 0x3e, 0xe4, 0x49, 0x4e, 0x08, 0xaf, 0xa4, 0x15, 0xae,
+	0x31, 0x4b, 0xe1, 0xc3, 0x51, 0x7b, 0x37, 0xce, 0xab, 0xaf, 0xfd, 0x21, 0x0b, 0xca, 0x5a, 0x14,
+	0xc1, 0x37, 0x81, 0xd1, 0xd9, 0x6c, 0x6d, 0xdc, 0x36, 0xb7, 0x77, 0x5a, 0x3b, 0x77, 0xb7, 0xcd,
+	0xbb, 0x77, 0xb6, 0x7b, 0xdd, 0xce, 0xc6, 0xbb, 0x1b, 0xdd, 0xf5, 0xc5, 0x99, 0x15, 0xf8, 0xf8,
+	0x49, 0x7d, 0x5e, 0x23, 0xbf, 0xe3, 0x7a, 0xf0, 0x35, 0xb0, 0x94, 0xe0, 0xd8, 0xea, 0x75, 0xef,
+	0x2c, 0x66, 0x56, 0x96, 0x1f, 0x3f, 0xa9, 0x2f, 0x68, 0xa4, 0x5b, 0x21, 0xf1, 0xe1, 0x2d, 0xf0,
+	0x52, 0x82, 0xb6, 0xd5, 0xeb, 0xe1, 0xad, 0x7b, 0xdd, 0xf5, 0xc5, 0xec, 0xca, 0xb5, 0xc7, 0x4f,
+	0xea, 0xcb, 0x1a, 0x7d, 0x2b, 0x64, 0x8f, 0xd8, 0xc4, 0x39, 0xc5, 0x83, 0xbb, 0xef, 0x77, 0x3b,
+	0x3b, 0xdd, 0xf5, 0xc5, 0xdc, 0x29, 0x1e, 0x4c, 0x7e, 0x26, 0xca, 0xd4, 0x06, 0x58, 0x4e, 0xf0,
+	0xdc, 0xeb, 0xee, 0x6c, 0x75, 0xd7, 0x17, 0xf3, 0x2b, 0x2f, 0x3d, 0x7e, 0x52, 0x5f, 0xd2, 0x38,
+	0xee, 0x11, 0x1a, 0x10, 0xe7, 0x94, 0x0e, 0xbd, 0xd6, 0xc6, 0xfa, 0xe2, 0xec, 0x29, 0x1d, 0x7a,
+	0x96, 0xeb, 0xac, 0xe4, 0x3f, 0xfa, 0xed, 0xea, 0x4c, 0xfb, 0x83, 0xcf, 0x9e, 0xae, 0x66, 0x3e,
+	0x7f, 0xba, 0x9a, 0xf9, 0xd7, 0xd3, 0xd5, 0xcc, 0xc7, 0xcf, 0x56, 0x67, 0x3e, 0x7f, 0xb6, 0x3a,
+	0xf3, 0x8f, 0x67, 0xab, 0x33, 0x3f, 0xb9, 0xa9, 0xfb, 0x11, 0x89, 0xa8, 0x7b, 0xb0, 0xc7, 0x5e,
+	0x50, 0x79, 0xb1, 0xde, 0x94, 0xff, 0x31, 0xf5, 0x40, 0xfd, 0xcf, 0x14, 0x77, 0xab, 0xdd, 0x02,
+	0x3f, 0xce, 0xb7, 0xfe, 0x3b, 0x00, 0xb4, 0xac, 0x9c, 0x1f, 0x51, 0x25, 0x00, 0x00,
 }
 
 func (m *MixedCoins) Marshal() (dAtA []byte, err error) {
@@ -813,40 +1365,101 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Shield.Size()
-		i -= size
-		if _, err := m.Shield.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.CoveredAssets) > 0 {
+		for iNdEx := len(m.CoveredAssets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoveredAssets[iNdEx])
+			copy(dAtA[i:], m.CoveredAssets[iNdEx])
+			i = encodeVarintShield(dAtA, i, uint64(len(m.CoveredAssets[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
 		}
-		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.CoverageTerms != nil {
+		{
+			size, err := m.CoverageTerms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintShield(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x62
 	}
 	{
-		size := m.ShieldLimit.Size()
-		i -= size
-		if _, err := m.ShieldLimit.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.FeesCollected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.SponsorAddr) > 0 {
-		i -= len(m.SponsorAddr)
-		copy(dAtA[i:], m.SponsorAddr)
-		i = encodeVarintShield(dAtA, i, uint64(len(m.SponsorAddr)))
+	dAtA[i] = 0x5a
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.ServiceFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Allocation.Size()
+		i -= size
+		if _, err := m.Allocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Shield.Size()
+		i -= size
+		if _, err := m.Shield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ShieldLimit.Size()
+		i -= size
+		if _, err := m.ShieldLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.SponsorAddr) > 0 {
+		i -= len(m.SponsorAddr)
+		copy(dAtA[i:], m.SponsorAddr)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.SponsorAddr)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -872,6 +1485,122 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CoverageTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoverageTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoverageTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.WaitingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.WaitingPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintShield(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxClaim.Size()
+		i -= size
+		if _, err := m.MaxClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DeductibleRate.Size()
+		i -= size
+		if _, err := m.DeductibleRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DeductibleAmount.Size()
+		i -= size
+		if _, err := m.DeductibleAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Allocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Purchase) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -892,6 +1621,14 @@ func (m *Purchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PurchaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PurchaseTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintShield(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
 	{
 		size, err := m.ServiceFees.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -919,20 +1656,20 @@ func (m *Purchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DeletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DeletionTime):])
-	if err2 != nil {
-		return 0, err2
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DeletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DeletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n2
-	i = encodeVarintShield(dAtA, i, uint64(n2))
+	i -= n9
+	i = encodeVarintShield(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x1a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ProtectionEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ProtectionEndTime):])
-	if err3 != nil {
-		return 0, err3
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ProtectionEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ProtectionEndTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n3
-	i = encodeVarintShield(dAtA, i, uint64(n3))
+	i -= n10
+	i = encodeVarintShield(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.PurchaseId != 0 {
@@ -1012,6 +1749,26 @@ func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1164,12 +1921,12 @@ func (m *Withdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err5 != nil {
-		return 0, err5
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n5
-	i = encodeVarintShield(dAtA, i, uint64(n5))
+	i -= n13
+	i = encodeVarintShield(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -1249,6 +2006,26 @@ func (m *ShieldStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.WithdrawRequested.Size()
 		i -= size
@@ -1284,7 +2061,7 @@ func (m *ShieldStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LastUpdateTime) Marshal() (dAtA []byte, err error) {
+func (m *Unstaking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1294,30 +2071,50 @@ func (m *LastUpdateTime) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LastUpdateTime) MarshalTo(dAtA []byte) (int, error) {
+func (m *Unstaking) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LastUpdateTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Unstaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Time != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err6 != nil {
-			return 0, err6
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintShield(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i -= n6
-		i = encodeVarintShield(dAtA, i, uint64(n6))
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Purchaser)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ShieldClaimProposal) Marshal() (dAtA []byte, err error) {
+func (m *Unstakings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1327,41 +2124,20 @@ func (m *ShieldClaimProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ShieldClaimProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *Unstakings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShieldClaimProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Unstakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintShield(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintShield(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Evidence) > 0 {
-		i -= len(m.Evidence)
-		copy(dAtA[i:], m.Evidence)
-		i = encodeVarintShield(dAtA, i, uint64(len(m.Evidence)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Loss) > 0 {
-		for iNdEx := len(m.Loss) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Unstakings) > 0 {
+		for iNdEx := len(m.Unstakings) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Loss[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Unstakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1369,12 +2145,103 @@ func (m *ShieldClaimProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintShield(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	if m.PurchaseId != 0 {
-		i = encodeVarintShield(dAtA, i, uint64(m.PurchaseId))
-		i--
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdateTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdateTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdateTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintShield(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShieldClaimProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShieldClaimProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShieldClaimProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Loss) > 0 {
+		for iNdEx := len(m.Loss) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loss[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PurchaseId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.PurchaseId))
+		i--
 		dAtA[i] = 0x18
 	}
 	if m.PoolId != 0 {
@@ -1390,298 +2257,2963 @@ func (m *ShieldClaimProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintShield(dAtA []byte, offset int, v uint64) int {
-	offset -= sovShield(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MixedCoins) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Native) > 0 {
-		for _, e := range m.Native {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	if len(m.Foreign) > 0 {
-		for _, e := range m.Foreign {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeesCollected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MixedDecCoins) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.Native) > 0 {
-		for _, e := range m.Native {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Allocation.Size()
+		i -= size
+		if _, err := m.Allocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shield.Size()
+		i -= size
+		if _, err := m.Shield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	if len(m.Foreign) > 0 {
-		for _, e := range m.Foreign {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovShield(uint64(m.Id))
+	{
+		size, err := m.RewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalStakingPool.Size()
+		i -= size
+		if _, err := m.GlobalStakingPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	l = len(m.Sponsor)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalClaimed.Size()
+		i -= size
+		if _, err := m.TotalClaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	l = len(m.SponsorAddr)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalShield.Size()
+		i -= size
+		if _, err := m.TotalShield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	l = m.ShieldLimit.Size()
-	n += 1 + l + sovShield(uint64(l))
-	if m.Active {
-		n += 2
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalCollateral.Size()
+		i -= size
+		if _, err := m.TotalCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	l = m.Shield.Size()
-	n += 1 + l + sovShield(uint64(l))
-	return n
-}
-
-func (m *Purchase) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	if m.PurchaseId != 0 {
-		n += 1 + sovShield(uint64(m.PurchaseId))
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err21 != nil {
+		return 0, err21
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ProtectionEndTime)
-	n += 1 + l + sovShield(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DeletionTime)
-	n += 1 + l + sovShield(uint64(l))
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	i -= n21
+	i = encodeVarintShield(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Shield.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = m.ServiceFees.Size()
-	n += 1 + l + sovShield(uint64(l))
-	return n
+	if m.Epoch != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PurchaseList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovShield(uint64(m.PoolId))
-	}
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
-	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
-		}
+func (m *ShieldRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Provider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
-	}
-	l = m.DelegationBonded.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = m.Collateral.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = m.TotalLocked.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = m.Withdrawing.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = m.Rewards.Size()
-	n += 1 + l + sovShield(uint64(l))
-	return n
+func (m *ShieldRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolPurchaser) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShieldRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovShield(uint64(m.PoolId))
-	}
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	if len(m.ClaimManagers) > 0 {
+		for iNdEx := len(m.ClaimManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimManagers[iNdEx])
+			copy(dAtA[i:], m.ClaimManagers[iNdEx])
+			i = encodeVarintShield(dAtA, i, uint64(len(m.ClaimManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
-}
-
-func (m *PoolPurchaserPairs) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintShield(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
+	if len(m.PoolOperators) > 0 {
+		for iNdEx := len(m.PoolOperators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PoolOperators[iNdEx])
+			copy(dAtA[i:], m.PoolOperators[iNdEx])
+			i = encodeVarintShield(dAtA, i, uint64(len(m.PoolOperators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Withdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+func (m *ShieldAdminUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovShield(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Withdraws) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Withdraws) > 0 {
-		for _, e := range m.Withdraws {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
-		}
-	}
-	return n
+func (m *ShieldAdminUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShieldStaking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShieldAdminUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovShield(uint64(m.PoolId))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintShield(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovShield(uint64(l))
-	l = m.WithdrawRequested.Size()
-	n += 1 + l + sovShield(uint64(l))
-	return n
-}
-
-func (m *LastUpdateTime) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Time != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovShield(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ShieldClaimProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Claim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovShield(uint64(m.ProposalId))
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err23 != nil {
+		return 0, err23
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovShield(uint64(m.PoolId))
+	i -= n23
+	i = encodeVarintShield(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x62
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err24 != nil {
+		return 0, err24
 	}
-	if m.PurchaseId != 0 {
-		n += 1 + sovShield(uint64(m.PurchaseId))
+	i -= n24
+	i = encodeVarintShield(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Payout) > 0 {
+		for iNdEx := len(m.Payout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if len(m.Loss) > 0 {
-		for _, e := range m.Loss {
-			l = e.Size()
-			n += 1 + l + sovShield(uint64(l))
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	l = len(m.Evidence)
+	if len(m.Restored) > 0 {
+		for iNdEx := len(m.Restored) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restored[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Secured) > 0 {
+		for iNdEx := len(m.Secured) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secured[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Loss) > 0 {
+		for iNdEx := len(m.Loss) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loss[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShield(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintShield(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PurchaseId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.PurchaseId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintShield(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintShield(dAtA []byte, offset int, v uint64) int {
+	offset -= sovShield(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MixedCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Native) > 0 {
+		for _, e := range m.Native {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Foreign) > 0 {
+		for _, e := range m.Foreign {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MixedDecCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Native) > 0 {
+		for _, e := range m.Native {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Foreign) > 0 {
+		for _, e := range m.Foreign {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovShield(uint64(m.Id))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = len(m.Sponsor)
 	if l > 0 {
 		n += 1 + l + sovShield(uint64(l))
 	}
+	l = len(m.SponsorAddr)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.ShieldLimit.Size()
+	n += 1 + l + sovShield(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	l = m.Shield.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Allocation.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.ServiceFees.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.RewardIndex.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.FeesCollected.Size()
+	n += 1 + l + sovShield(uint64(l))
+	if m.CoverageTerms != nil {
+		l = m.CoverageTerms.Size()
+		n += 1 + l + sovShield(uint64(l))
+	}
+	if len(m.CoveredAssets) > 0 {
+		for _, s := range m.CoveredAssets {
+			l = len(s)
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoverageTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeductibleAmount.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.DeductibleRate.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.MaxClaim.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.WaitingPeriod)
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *Allocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.RewardIndex.Size()
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *Purchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PurchaseId != 0 {
+		n += 1 + sovShield(uint64(m.PurchaseId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ProtectionEndTime)
+	n += 1 + l + sovShield(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DeletionTime)
+	n += 1 + l + sovShield(uint64(l))
 	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovShield(uint64(l))
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovShield(uint64(l))
+	l = m.Shield.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.ServiceFees.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PurchaseTime)
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *PurchaseList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Provider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.DelegationBonded.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.TotalLocked.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Withdrawing.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Rewards.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.RewardIndex.Size()
+	n += 1 + l + sovShield(uint64(l))
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func (m *PoolPurchaser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolPurchaserPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Withdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *Withdraws) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdraws) > 0 {
+		for _, e := range m.Withdraws {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShieldStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.WithdrawRequested.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.RewardIndex.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Rewards.Size()
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *Unstaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *Unstakings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unstakings) > 0 {
+		for _, e := range m.Unstakings {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastUpdateTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovShield(uint64(l))
+	}
+	return n
+}
+
+func (m *ShieldClaimProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovShield(uint64(m.ProposalId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	if m.PurchaseId != 0 {
+		n += 1 + sovShield(uint64(m.PurchaseId))
+	}
+	if len(m.Loss) > 0 {
+		for _, e := range m.Loss {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.Shield.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Allocation.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.Utilization.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.FeesCollected.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.RewardIndex.Size()
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *EpochSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovShield(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovShield(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovShield(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	l = m.TotalCollateral.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.TotalShield.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.GlobalStakingPool.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = m.RewardIndex.Size()
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func (m *ShieldRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolOperators) > 0 {
+		for _, s := range m.PoolOperators {
+			l = len(s)
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.ClaimManagers) > 0 {
+		for _, s := range m.ClaimManagers {
+			l = len(s)
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShieldAdminUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	l = m.Roles.Size()
+	n += 1 + l + sovShield(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	return n
+}
+
+func (m *Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovShield(uint64(m.ProposalId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovShield(uint64(m.PoolId))
+	}
+	if m.PurchaseId != 0 {
+		n += 1 + sovShield(uint64(m.PurchaseId))
+	}
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovShield(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovShield(uint64(m.Status))
+	}
+	if len(m.Loss) > 0 {
+		for _, e := range m.Loss {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Secured) > 0 {
+		for _, e := range m.Secured {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Restored) > 0 {
+		for _, e := range m.Restored {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	if len(m.Payout) > 0 {
+		for _, e := range m.Payout {
+			l = e.Size()
+			n += 1 + l + sovShield(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovShield(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovShield(uint64(l))
+	return n
+}
+
+func sovShield(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozShield(x uint64) (n int) {
+	return sovShield(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MixedCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MixedCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MixedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Native = append(m.Native, types.Coin{})
+			if err := m.Native[len(m.Native)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foreign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Foreign = append(m.Foreign, types.Coin{})
+			if err := m.Foreign[len(m.Foreign)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MixedDecCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MixedDecCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MixedDecCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Native = append(m.Native, types.DecCoin{})
+			if err := m.Native[len(m.Native)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foreign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Foreign = append(m.Foreign, types.DecCoin{})
+			if err := m.Foreign[len(m.Foreign)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesCollected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverageTerms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoverageTerms == nil {
+				m.CoverageTerms = &CoverageTerms{}
+			}
+			if err := m.CoverageTerms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoveredAssets = append(m.CoveredAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoverageTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoverageTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoverageTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeductibleAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeductibleAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeductibleRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeductibleRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.WaitingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Purchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+			}
+			m.PurchaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectionEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ProtectionEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DeletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PurchaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Purchase{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Provider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *PoolPurchaser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPurchaser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPurchaser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovShield(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozShield(x uint64) (n int) {
-	return sovShield(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PoolPurchaserPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPurchaserPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPurchaserPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, PoolPurchaser{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MixedCoins) Unmarshal(dAtA []byte) error {
+func (m *Withdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,17 +5236,49 @@ func (m *MixedCoins) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MixedCoins: wiretype end group for non-group")
+			return fmt.Errorf("proto: Withdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MixedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Withdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -1724,29 +5288,29 @@ func (m *MixedCoins) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Native = append(m.Native, types.Coin{})
-			if err := m.Native[len(m.Native)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Foreign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1773,8 +5337,7 @@ func (m *MixedCoins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Foreign = append(m.Foreign, types.Coin{})
-			if err := m.Foreign[len(m.Foreign)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1802,7 +5365,7 @@ func (m *MixedCoins) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MixedDecCoins) Unmarshal(dAtA []byte) error {
+func (m *Withdraws) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,49 +5388,15 @@ func (m *MixedDecCoins) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MixedDecCoins: wiretype end group for non-group")
+			return fmt.Errorf("proto: Withdraws: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MixedDecCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Withdraws: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowShield
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthShield
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthShield
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Native = append(m.Native, types.DecCoin{})
-			if err := m.Native[len(m.Native)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Foreign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1894,8 +5423,8 @@ func (m *MixedDecCoins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Foreign = append(m.Foreign, types.DecCoin{})
-			if err := m.Foreign[len(m.Foreign)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Withdraws = append(m.Withdraws, Withdraw{})
+			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1923,7 +5452,7 @@ func (m *MixedDecCoins) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,17 +5475,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShieldStaking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShieldStaking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -1966,14 +5495,14 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2001,11 +5530,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2033,11 +5562,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SponsorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawRequested", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2065,11 +5596,151 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SponsorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.WithdrawRequested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unstaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unstaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unstaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2097,15 +5768,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2115,17 +5784,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2135,23 +5818,22 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2179,7 +5861,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Purchase) Unmarshal(dAtA []byte) error {
+func (m *Unstakings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2202,34 +5884,15 @@ func (m *Purchase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Purchase: wiretype end group for non-group")
+			return fmt.Errorf("proto: Unstakings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Purchase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Unstakings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
-			}
-			m.PurchaseId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowShield
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PurchaseId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtectionEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unstakings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2256,13 +5919,67 @@ func (m *Purchase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ProtectionEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Unstakings = append(m.Unstakings, Unstaking{})
+			if err := m.Unstakings[len(m.Unstakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastUpdateTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdateTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdateTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2289,15 +6006,71 @@ func (m *Purchase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DeletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShield(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthShield
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShield
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShieldClaimProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShieldClaimProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2307,29 +6080,16 @@ func (m *Purchase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthShield
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthShield
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2339,29 +6099,33 @@ func (m *Purchase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthShield
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthShield
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
 			}
-			if err := m.Shield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PurchaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loss", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2381,75 +6145,23 @@ func (m *Purchase) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthShield
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipShield(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthShield
-			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PurchaseList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowShield
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Loss = append(m.Loss, types.Coin{})
+			if err := m.Loss[len(m.Loss)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PurchaseList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurchaseList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2459,14 +6171,27 @@ func (m *PurchaseList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2494,13 +6219,13 @@ func (m *PurchaseList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2510,25 +6235,23 @@ func (m *PurchaseList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, Purchase{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2554,7 +6277,7 @@ func (m *PurchaseList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *PoolSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,17 +6300,17 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2597,27 +6320,34 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthShield
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthShield
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Active = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2645,13 +6375,13 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegationBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2679,13 +6409,13 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2713,15 +6443,15 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2731,29 +6461,28 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Withdrawing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeesCollected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2780,7 +6509,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2808,7 +6537,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolPurchaser) Unmarshal(dAtA []byte) error {
+func (m *EpochSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2831,17 +6560,17 @@ func (m *PoolPurchaser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolPurchaser: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolPurchaser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.PoolId = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2851,16 +6580,16 @@ func (m *PoolPurchaser) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -2870,80 +6599,47 @@ func (m *PoolPurchaser) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthShield
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthShield
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipShield(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolPurchaserPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowShield
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolPurchaserPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolPurchaserPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2970,67 +6666,14 @@ func (m *PoolPurchaserPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, PoolPurchaser{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, PoolSnapshot{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipShield(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthShield
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthShield
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Withdraw) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowShield
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Withdraw: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Withdraw: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3058,11 +6701,13 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShield", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3090,15 +6735,15 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalShield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -3108,81 +6753,63 @@ func (m *Withdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipShield(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalStakingPool", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthShield
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthShield
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Withdraws) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowShield
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GlobalStakingPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Withdraws: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Withdraws: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3209,8 +6836,7 @@ func (m *Withdraws) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdraws = append(m.Withdraws, Withdraw{})
-			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3238,7 +6864,7 @@ func (m *Withdraws) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
+func (m *ShieldRoles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3261,34 +6887,15 @@ func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShieldStaking: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShieldRoles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShieldStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShieldRoles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowShield
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolOperators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3316,11 +6923,11 @@ func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			m.PoolOperators = append(m.PoolOperators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3348,13 +6955,11 @@ func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimManagers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,9 +6987,7 @@ func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawRequested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClaimManagers = append(m.ClaimManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3410,7 +7013,7 @@ func (m *ShieldStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdateTime) Unmarshal(dAtA []byte) error {
+func (m *ShieldAdminUpdateProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3420,28 +7023,92 @@ func (m *LastUpdateTime) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowShield
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShieldAdminUpdateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShieldAdminUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdateTime: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdateTime: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3468,13 +7135,42 @@ func (m *LastUpdateTime) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShield(dAtA[iNdEx:])
@@ -3499,7 +7195,7 @@ func (m *LastUpdateTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
+func (m *Claim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3522,10 +7218,10 @@ func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShieldClaimProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShieldClaimProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3586,6 +7282,57 @@ func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClaimStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Loss", wireType)
 			}
@@ -3619,11 +7366,11 @@ func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secured", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -3633,29 +7380,31 @@ func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidence = string(dAtA[iNdEx:postIndex])
+			m.Secured = append(m.Secured, types.Coin{})
+			if err := m.Secured[len(m.Secured)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restored", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -3665,29 +7414,31 @@ func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Restored = append(m.Restored, types.Coin{})
+			if err := m.Restored[len(m.Restored)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShield
@@ -3697,23 +7448,125 @@ func (m *ShieldClaimProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthShield
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthShield
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = append(m.Payout, types.Coin{})
+			if err := m.Payout[len(m.Payout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShield
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShield
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShield
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEpochSnapshots is the number of most recent epoch snapshots kept
+// in the store. Older snapshots are pruned as new ones are recorded.
+const MaxEpochSnapshots = 104
+
+// DefaultYieldWindowEpochs is the default number of trailing epochs
+// over which provider yields are estimated.
+const DefaultYieldWindowEpochs = 4
+
+// NewPoolSnapshot creates a snapshot of a pool.
+func NewPoolSnapshot(pool Pool) PoolSnapshot {
+	utilization := sdk.ZeroDec()
+	if pool.Allocation.IsPositive() {
+		utilization = pool.Shield.ToDec().Quo(pool.Allocation.ToDec())
+	}
+	return PoolSnapshot{
+		PoolId:        pool.Id,
+		Active:        pool.Active,
+		Shield:        pool.Shield,
+		Allocation:    pool.Allocation,
+		Utilization:   utilization,
+		FeesCollected: pool.FeesCollected,
+		RewardIndex:   pool.RewardIndex,
+	}
+}
+
+// NewEpochSnapshot creates a new epoch snapshot.
+func NewEpochSnapshot(epoch uint64, height int64, time time.Time, pools []PoolSnapshot,
+	totalCollateral, totalShield, totalClaimed, globalStakingPool sdk.Int, rewardIndex MixedDecCoins) EpochSnapshot {
+	return EpochSnapshot{
+		Epoch:             epoch,
+		Height:            height,
+		Time:              time,
+		Pools:             pools,
+		TotalCollateral:   totalCollateral,
+		TotalShield:       totalShield,
+		TotalClaimed:      totalClaimed,
+		GlobalStakingPool: globalStakingPool,
+		RewardIndex:       rewardIndex,
+	}
+}
@@ -339,6 +339,158 @@ func (m *MsgResumePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResumePoolResponse proto.InternalMessageInfo
 
+// MsgSetCoverageTerms defines the attributes of setting the coverage terms of a shield pool.
+type MsgSetCoverageTerms struct {
+	From   string        `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	PoolId uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Terms  CoverageTerms `protobuf:"bytes,3,opt,name=terms,proto3" json:"terms" yaml:"terms"`
+}
+
+func (m *MsgSetCoverageTerms) Reset()         { *m = MsgSetCoverageTerms{} }
+func (m *MsgSetCoverageTerms) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCoverageTerms) ProtoMessage()    {}
+func (*MsgSetCoverageTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{8}
+}
+func (m *MsgSetCoverageTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCoverageTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCoverageTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCoverageTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCoverageTerms.Merge(m, src)
+}
+func (m *MsgSetCoverageTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCoverageTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCoverageTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCoverageTerms proto.InternalMessageInfo
+
+type MsgSetCoverageTermsResponse struct {
+}
+
+func (m *MsgSetCoverageTermsResponse) Reset()         { *m = MsgSetCoverageTermsResponse{} }
+func (m *MsgSetCoverageTermsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCoverageTermsResponse) ProtoMessage()    {}
+func (*MsgSetCoverageTermsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{9}
+}
+func (m *MsgSetCoverageTermsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCoverageTermsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCoverageTermsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCoverageTermsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCoverageTermsResponse.Merge(m, src)
+}
+func (m *MsgSetCoverageTermsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCoverageTermsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCoverageTermsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCoverageTermsResponse proto.InternalMessageInfo
+
+// MsgSetCoveredAssets defines the attributes of setting the covered assets of a shield pool.
+type MsgSetCoveredAssets struct {
+	From   string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	PoolId uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Assets []string `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty" yaml:"assets"`
+}
+
+func (m *MsgSetCoveredAssets) Reset()         { *m = MsgSetCoveredAssets{} }
+func (m *MsgSetCoveredAssets) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCoveredAssets) ProtoMessage()    {}
+func (*MsgSetCoveredAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{10}
+}
+func (m *MsgSetCoveredAssets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCoveredAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCoveredAssets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCoveredAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCoveredAssets.Merge(m, src)
+}
+func (m *MsgSetCoveredAssets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCoveredAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCoveredAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCoveredAssets proto.InternalMessageInfo
+
+type MsgSetCoveredAssetsResponse struct {
+}
+
+func (m *MsgSetCoveredAssetsResponse) Reset()         { *m = MsgSetCoveredAssetsResponse{} }
+func (m *MsgSetCoveredAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCoveredAssetsResponse) ProtoMessage()    {}
+func (*MsgSetCoveredAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{11}
+}
+func (m *MsgSetCoveredAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCoveredAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCoveredAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCoveredAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCoveredAssetsResponse.Merge(m, src)
+}
+func (m *MsgSetCoveredAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCoveredAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCoveredAssetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCoveredAssetsResponse proto.InternalMessageInfo
+
 // MsgDepositCollateral defines the attributes of a depositing collaterals.
 type MsgDepositCollateral struct {
 	From       string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
@@ -349,7 +501,7 @@ func (m *MsgDepositCollateral) Reset()         { *m = MsgDepositCollateral{} }
 func (m *MsgDepositCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositCollateral) ProtoMessage()    {}
 func (*MsgDepositCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{8}
+	return fileDescriptor_e048a9056d0d0343, []int{12}
 }
 func (m *MsgDepositCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +537,7 @@ func (m *MsgDepositCollateralResponse) Reset()         { *m = MsgDepositCollater
 func (m *MsgDepositCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositCollateralResponse) ProtoMessage()    {}
 func (*MsgDepositCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{9}
+	return fileDescriptor_e048a9056d0d0343, []int{13}
 }
 func (m *MsgDepositCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +576,7 @@ func (m *MsgWithdrawCollateral) Reset()         { *m = MsgWithdrawCollateral{} }
 func (m *MsgWithdrawCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawCollateral) ProtoMessage()    {}
 func (*MsgWithdrawCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{10}
+	return fileDescriptor_e048a9056d0d0343, []int{14}
 }
 func (m *MsgWithdrawCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +612,7 @@ func (m *MsgWithdrawCollateralResponse) Reset()         { *m = MsgWithdrawCollat
 func (m *MsgWithdrawCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawCollateralResponse) ProtoMessage()    {}
 func (*MsgWithdrawCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{11}
+	return fileDescriptor_e048a9056d0d0343, []int{15}
 }
 func (m *MsgWithdrawCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,6 +641,158 @@ func (m *MsgWithdrawCollateralResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawCollateralResponse proto.InternalMessageInfo
 
+// MsgAllocateCollateral defines the attributes of allocating collaterals to a pool.
+type MsgAllocateCollateral struct {
+	From       string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	PoolId     uint64                                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Collateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+}
+
+func (m *MsgAllocateCollateral) Reset()         { *m = MsgAllocateCollateral{} }
+func (m *MsgAllocateCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgAllocateCollateral) ProtoMessage()    {}
+func (*MsgAllocateCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{16}
+}
+func (m *MsgAllocateCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllocateCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllocateCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAllocateCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllocateCollateral.Merge(m, src)
+}
+func (m *MsgAllocateCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllocateCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllocateCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllocateCollateral proto.InternalMessageInfo
+
+type MsgAllocateCollateralResponse struct {
+}
+
+func (m *MsgAllocateCollateralResponse) Reset()         { *m = MsgAllocateCollateralResponse{} }
+func (m *MsgAllocateCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAllocateCollateralResponse) ProtoMessage()    {}
+func (*MsgAllocateCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{17}
+}
+func (m *MsgAllocateCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllocateCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllocateCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAllocateCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllocateCollateralResponse.Merge(m, src)
+}
+func (m *MsgAllocateCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllocateCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllocateCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllocateCollateralResponse proto.InternalMessageInfo
+
+// MsgDeallocateCollateral defines the attributes of deallocating collaterals from a pool.
+type MsgDeallocateCollateral struct {
+	From       string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	PoolId     uint64                                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Collateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+}
+
+func (m *MsgDeallocateCollateral) Reset()         { *m = MsgDeallocateCollateral{} }
+func (m *MsgDeallocateCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgDeallocateCollateral) ProtoMessage()    {}
+func (*MsgDeallocateCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{18}
+}
+func (m *MsgDeallocateCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeallocateCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeallocateCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeallocateCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeallocateCollateral.Merge(m, src)
+}
+func (m *MsgDeallocateCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeallocateCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeallocateCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeallocateCollateral proto.InternalMessageInfo
+
+type MsgDeallocateCollateralResponse struct {
+}
+
+func (m *MsgDeallocateCollateralResponse) Reset()         { *m = MsgDeallocateCollateralResponse{} }
+func (m *MsgDeallocateCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeallocateCollateralResponse) ProtoMessage()    {}
+func (*MsgDeallocateCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{19}
+}
+func (m *MsgDeallocateCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeallocateCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeallocateCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeallocateCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeallocateCollateralResponse.Merge(m, src)
+}
+func (m *MsgDeallocateCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeallocateCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeallocateCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeallocateCollateralResponse proto.InternalMessageInfo
+
 // MsgWithdrawForeignRewards defines attribute of withdraw rewards transaction.
 type MsgWithdrawRewards struct {
 	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
@@ -498,7 +802,7 @@ func (m *MsgWithdrawRewards) Reset()         { *m = MsgWithdrawRewards{} }
 func (m *MsgWithdrawRewards) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawRewards) ProtoMessage()    {}
 func (*MsgWithdrawRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{12}
+	return fileDescriptor_e048a9056d0d0343, []int{20}
 }
 func (m *MsgWithdrawRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +838,7 @@ func (m *MsgWithdrawRewardsResponse) Reset()         { *m = MsgWithdrawRewardsRe
 func (m *MsgWithdrawRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawRewardsResponse) ProtoMessage()    {}
 func (*MsgWithdrawRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{13}
+	return fileDescriptor_e048a9056d0d0343, []int{21}
 }
 func (m *MsgWithdrawRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,6 +867,81 @@ func (m *MsgWithdrawRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawRewardsResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound defines attributes of an auto-compound setting transaction.
+type MsgSetAutoCompound struct {
+	From    string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{22}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e048a9056d0d0343, []int{23}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 // MsgWithdrawForeignRewards defines attributes of withdraw foreign rewards transaction.
 type MsgWithdrawForeignRewards struct {
 	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
@@ -574,7 +953,7 @@ func (m *MsgWithdrawForeignRewards) Reset()         { *m = MsgWithdrawForeignRew
 func (m *MsgWithdrawForeignRewards) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawForeignRewards) ProtoMessage()    {}
 func (*MsgWithdrawForeignRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{14}
+	return fileDescriptor_e048a9056d0d0343, []int{24}
 }
 func (m *MsgWithdrawForeignRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +989,7 @@ func (m *MsgWithdrawForeignRewardsResponse) Reset()         { *m = MsgWithdrawFo
 func (m *MsgWithdrawForeignRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawForeignRewardsResponse) ProtoMessage()    {}
 func (*MsgWithdrawForeignRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{15}
+	return fileDescriptor_e048a9056d0d0343, []int{25}
 }
 func (m *MsgWithdrawForeignRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +1028,7 @@ func (m *MsgClearPayouts) Reset()         { *m = MsgClearPayouts{} }
 func (m *MsgClearPayouts) String() string { return proto.CompactTextString(m) }
 func (*MsgClearPayouts) ProtoMessage()    {}
 func (*MsgClearPayouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{16}
+	return fileDescriptor_e048a9056d0d0343, []int{26}
 }
 func (m *MsgClearPayouts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +1064,7 @@ func (m *MsgClearPayoutsResponse) Reset()         { *m = MsgClearPayoutsResponse
 func (m *MsgClearPayoutsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClearPayoutsResponse) ProtoMessage()    {}
 func (*MsgClearPayoutsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{17}
+	return fileDescriptor_e048a9056d0d0343, []int{27}
 }
 func (m *MsgClearPayoutsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +1105,7 @@ func (m *MsgPurchaseShield) Reset()         { *m = MsgPurchaseShield{} }
 func (m *MsgPurchaseShield) String() string { return proto.CompactTextString(m) }
 func (*MsgPurchaseShield) ProtoMessage()    {}
 func (*MsgPurchaseShield) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{18}
+	return fileDescriptor_e048a9056d0d0343, []int{28}
 }
 func (m *MsgPurchaseShield) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +1141,7 @@ func (m *MsgPurchaseShieldResponse) Reset()         { *m = MsgPurchaseShieldResp
 func (m *MsgPurchaseShieldResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPurchaseShieldResponse) ProtoMessage()    {}
 func (*MsgPurchaseShieldResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{19}
+	return fileDescriptor_e048a9056d0d0343, []int{29}
 }
 func (m *MsgPurchaseShieldResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -801,7 +1180,7 @@ func (m *MsgWithdrawReimbursement) Reset()         { *m = MsgWithdrawReimburseme
 func (m *MsgWithdrawReimbursement) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawReimbursement) ProtoMessage()    {}
 func (*MsgWithdrawReimbursement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{20}
+	return fileDescriptor_e048a9056d0d0343, []int{30}
 }
 func (m *MsgWithdrawReimbursement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +1216,7 @@ func (m *MsgWithdrawReimbursementResponse) Reset()         { *m = MsgWithdrawRei
 func (m *MsgWithdrawReimbursementResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawReimbursementResponse) ProtoMessage()    {}
 func (*MsgWithdrawReimbursementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{21}
+	return fileDescriptor_e048a9056d0d0343, []int{31}
 }
 func (m *MsgWithdrawReimbursementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1258,7 @@ func (m *MsgStakeForShield) Reset()         { *m = MsgStakeForShield{} }
 func (m *MsgStakeForShield) String() string { return proto.CompactTextString(m) }
 func (*MsgStakeForShield) ProtoMessage()    {}
 func (*MsgStakeForShield) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{22}
+	return fileDescriptor_e048a9056d0d0343, []int{32}
 }
 func (m *MsgStakeForShield) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +1294,7 @@ func (m *MsgStakeForShieldResponse) Reset()         { *m = MsgStakeForShieldResp
 func (m *MsgStakeForShieldResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStakeForShieldResponse) ProtoMessage()    {}
 func (*MsgStakeForShieldResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{23}
+	return fileDescriptor_e048a9056d0d0343, []int{33}
 }
 func (m *MsgStakeForShieldResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1334,7 @@ func (m *MsgUnstakeFromShield) Reset()         { *m = MsgUnstakeFromShield{} }
 func (m *MsgUnstakeFromShield) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakeFromShield) ProtoMessage()    {}
 func (*MsgUnstakeFromShield) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{24}
+	return fileDescriptor_e048a9056d0d0343, []int{34}
 }
 func (m *MsgUnstakeFromShield) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1370,7 @@ func (m *MsgUnstakeFromShieldResponse) Reset()         { *m = MsgUnstakeFromShie
 func (m *MsgUnstakeFromShieldResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakeFromShieldResponse) ProtoMessage()    {}
 func (*MsgUnstakeFromShieldResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{25}
+	return fileDescriptor_e048a9056d0d0343, []int{35}
 }
 func (m *MsgUnstakeFromShieldResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1411,7 @@ func (m *MsgUpdateSponsor) Reset()         { *m = MsgUpdateSponsor{} }
 func (m *MsgUpdateSponsor) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSponsor) ProtoMessage()    {}
 func (*MsgUpdateSponsor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{26}
+	return fileDescriptor_e048a9056d0d0343, []int{36}
 }
 func (m *MsgUpdateSponsor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1447,7 @@ func (m *MsgUpdateSponsorResponse) Reset()         { *m = MsgUpdateSponsorRespon
 func (m *MsgUpdateSponsorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSponsorResponse) ProtoMessage()    {}
 func (*MsgUpdateSponsorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e048a9056d0d0343, []int{27}
+	return fileDescriptor_e048a9056d0d0343, []int{37}
 }
 func (m *MsgUpdateSponsorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,12 +1485,22 @@ func init() {
 	proto.RegisterType((*MsgPausePoolResponse)(nil), "shentu.shield.v1alpha1.MsgPausePoolResponse")
 	proto.RegisterType((*MsgResumePool)(nil), "shentu.shield.v1alpha1.MsgResumePool")
 	proto.RegisterType((*MsgResumePoolResponse)(nil), "shentu.shield.v1alpha1.MsgResumePoolResponse")
+	proto.RegisterType((*MsgSetCoverageTerms)(nil), "shentu.shield.v1alpha1.MsgSetCoverageTerms")
+	proto.RegisterType((*MsgSetCoverageTermsResponse)(nil), "shentu.shield.v1alpha1.MsgSetCoverageTermsResponse")
+	proto.RegisterType((*MsgSetCoveredAssets)(nil), "shentu.shield.v1alpha1.MsgSetCoveredAssets")
+	proto.RegisterType((*MsgSetCoveredAssetsResponse)(nil), "shentu.shield.v1alpha1.MsgSetCoveredAssetsResponse")
 	proto.RegisterType((*MsgDepositCollateral)(nil), "shentu.shield.v1alpha1.MsgDepositCollateral")
 	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "shentu.shield.v1alpha1.MsgDepositCollateralResponse")
 	proto.RegisterType((*MsgWithdrawCollateral)(nil), "shentu.shield.v1alpha1.MsgWithdrawCollateral")
 	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "shentu.shield.v1alpha1.MsgWithdrawCollateralResponse")
+	proto.RegisterType((*MsgAllocateCollateral)(nil), "shentu.shield.v1alpha1.MsgAllocateCollateral")
+	proto.RegisterType((*MsgAllocateCollateralResponse)(nil), "shentu.shield.v1alpha1.MsgAllocateCollateralResponse")
+	proto.RegisterType((*MsgDeallocateCollateral)(nil), "shentu.shield.v1alpha1.MsgDeallocateCollateral")
+	proto.RegisterType((*MsgDeallocateCollateralResponse)(nil), "shentu.shield.v1alpha1.MsgDeallocateCollateralResponse")
 	proto.RegisterType((*MsgWithdrawRewards)(nil), "shentu.shield.v1alpha1.MsgWithdrawRewards")
 	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "shentu.shield.v1alpha1.MsgWithdrawRewardsResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "shentu.shield.v1alpha1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "shentu.shield.v1alpha1.MsgSetAutoCompoundResponse")
 	proto.RegisterType((*MsgWithdrawForeignRewards)(nil), "shentu.shield.v1alpha1.MsgWithdrawForeignRewards")
 	proto.RegisterType((*MsgWithdrawForeignRewardsResponse)(nil), "shentu.shield.v1alpha1.MsgWithdrawForeignRewardsResponse")
 	proto.RegisterType((*MsgClearPayouts)(nil), "shentu.shield.v1alpha1.MsgClearPayouts")
@@ -1131,81 +1520,97 @@ func init() {
 func init() { proto.RegisterFile("shentu/shield/v1alpha1/tx.proto", fileDescriptor_e048a9056d0d0343) }
 
 var fileDescriptor_e048a9056d0d0343 = []byte{
-	// 1178 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdc, 0x54,
-	0x10, 0x5f, 0x67, 0xd3, 0x0d, 0x99, 0xdd, 0x36, 0xad, 0x69, 0x93, 0x8d, 0x5b, 0xd6, 0xc1, 0x81,
-	0x92, 0x42, 0x6b, 0x67, 0x03, 0x88, 0x92, 0x1b, 0x09, 0x8a, 0x14, 0x41, 0xa4, 0xe0, 0x50, 0x21,
-	0x71, 0x89, 0xbc, 0xeb, 0x97, 0x5d, 0xb3, 0x6b, 0x3f, 0xe3, 0xe7, 0xcd, 0x07, 0x47, 0x90, 0x10,
-	0x27, 0xc4, 0x7f, 0x40, 0xcf, 0x1c, 0xe1, 0xc4, 0x91, 0x5b, 0x8f, 0xbd, 0x20, 0x10, 0x87, 0x05,
-	0x25, 0x17, 0x8e, 0x28, 0x7f, 0x01, 0xf2, 0xb3, 0xfd, 0xf6, 0x79, 0x3f, 0x1c, 0x1b, 0xb5, 0xa8,
-	0x48, 0x9c, 0xba, 0xee, 0xfc, 0x66, 0xe6, 0x37, 0x1f, 0x9e, 0x99, 0x18, 0x64, 0xd2, 0x46, 0x8e,
-	0xdf, 0xd3, 0x48, 0xdb, 0x42, 0x5d, 0x53, 0x3b, 0xac, 0x1b, 0x5d, 0xb7, 0x6d, 0xd4, 0x35, 0xff,
-	0x58, 0x75, 0x3d, 0xec, 0x63, 0x71, 0x3e, 0x04, 0xa8, 0x21, 0x40, 0x8d, 0x01, 0xd2, 0xf5, 0x16,
-	0x6e, 0x61, 0x0a, 0xd1, 0x82, 0x5f, 0x21, 0x5a, 0xaa, 0x35, 0x31, 0xb1, 0x31, 0xd1, 0x1a, 0x06,
-	0x41, 0xda, 0x61, 0xbd, 0x81, 0x7c, 0xa3, 0xae, 0x35, 0xb1, 0xe5, 0x44, 0xf2, 0xe5, 0x09, 0xee,
-	0x22, 0xeb, 0x14, 0xa4, 0xfc, 0x55, 0x84, 0xcb, 0x3b, 0xa4, 0xb5, 0xe9, 0x21, 0xc3, 0x47, 0xbb,
-	0x18, 0x77, 0xc5, 0x65, 0x98, 0x3e, 0xf0, 0xb0, 0x5d, 0x15, 0x96, 0x84, 0x95, 0xd9, 0x8d, 0xb9,
-	0xf3, 0xbe, 0x5c, 0x3e, 0x31, 0xec, 0xee, 0xba, 0x12, 0xfc, 0xaf, 0xa2, 0x53, 0xa1, 0xd8, 0x84,
-	0x52, 0x68, 0xa6, 0x3a, 0xb5, 0x54, 0x5c, 0x29, 0xaf, 0x2d, 0xaa, 0x21, 0x19, 0x35, 0x20, 0xa3,
-	0x46, 0x64, 0xd4, 0x4d, 0x6c, 0x39, 0x1b, 0xab, 0x8f, 0xfa, 0x72, 0xe1, 0xbb, 0xdf, 0xe5, 0x95,
-	0x96, 0xe5, 0xb7, 0x7b, 0x0d, 0xb5, 0x89, 0x6d, 0x2d, 0x62, 0x1e, 0xfe, 0x73, 0x8f, 0x98, 0x1d,
-	0xcd, 0x3f, 0x71, 0x11, 0xa1, 0x0a, 0x44, 0x8f, 0x4c, 0x8b, 0x1f, 0xc2, 0x8c, 0x89, 0x5c, 0x4c,
-	0x2c, 0xbf, 0x5a, 0x5c, 0x12, 0x56, 0xca, 0x6b, 0x8a, 0x3a, 0x3e, 0x41, 0xea, 0x8e, 0x75, 0x8c,
-	0x4c, 0xaa, 0xbc, 0x31, 0x1f, 0xb8, 0x3b, 0xef, 0xcb, 0x57, 0x42, 0xd2, 0x91, 0x01, 0x45, 0x8f,
-	0x4d, 0x89, 0x77, 0x61, 0x86, 0xb8, 0xd8, 0x21, 0xd8, 0xab, 0x4e, 0xd3, 0x10, 0xc5, 0x01, 0x3a,
-	0x12, 0x28, 0x7a, 0x0c, 0x11, 0xd7, 0xa1, 0x12, 0xfd, 0xdc, 0x37, 0x4c, 0xd3, 0xab, 0x5e, 0xa2,
-	0x2a, 0x0b, 0xe7, 0x7d, 0xf9, 0xf9, 0x84, 0x0a, 0x95, 0x2a, 0x7a, 0x39, 0x7a, 0x7c, 0xc7, 0x34,
-	0x3d, 0xf1, 0x3e, 0x94, 0x4d, 0x44, 0x9a, 0x9e, 0xe5, 0xfa, 0x16, 0x76, 0xaa, 0x25, 0xaa, 0x3a,
-	0x7f, 0xde, 0x97, 0xc5, 0x98, 0x1b, 0x13, 0x2a, 0x3a, 0x0f, 0x15, 0x3f, 0x80, 0x4a, 0x18, 0xe2,
-	0x7e, 0xd7, 0xb2, 0x2d, 0xbf, 0x3a, 0x43, 0x55, 0xd5, 0x20, 0xb4, 0xdf, 0xfa, 0xf2, 0xed, 0x0c,
-	0x99, 0xdc, 0x76, 0x7c, 0xbd, 0x1c, 0xda, 0x78, 0x3f, 0x30, 0xb1, 0xfe, 0xdc, 0x57, 0x0f, 0xe5,
-	0xc2, 0x9f, 0x0f, 0xe5, 0x82, 0xb2, 0x00, 0x37, 0x12, 0x15, 0xd7, 0x11, 0x25, 0x8d, 0x94, 0x9f,
-	0xc2, 0x5e, 0x78, 0xe0, 0x9a, 0xcf, 0x5e, 0x2f, 0x34, 0xa0, 0x42, 0x90, 0x77, 0x68, 0x35, 0xd1,
-	0xfe, 0x01, 0x42, 0x24, 0x47, 0x43, 0xdc, 0x8c, 0x1a, 0x22, 0xae, 0x17, 0x67, 0x25, 0xa8, 0x57,
-	0xf8, 0xb8, 0x85, 0x10, 0x11, 0x5f, 0x83, 0x19, 0x17, 0xe3, 0xee, 0xbe, 0x65, 0xd2, 0xce, 0x98,
-	0xe6, 0x3b, 0x23, 0x12, 0x28, 0x7a, 0x29, 0xf8, 0xb5, 0x6d, 0x0e, 0x17, 0xf7, 0xd2, 0x3f, 0x2f,
-	0x6e, 0xe9, 0xc9, 0x17, 0x77, 0x50, 0x42, 0x56, 0xdc, 0x4f, 0xa0, 0xb2, 0x43, 0x5a, 0xbb, 0x46,
-	0x8f, 0xe4, 0x28, 0x2d, 0x97, 0x91, 0xa9, 0x8b, 0x32, 0xc2, 0x91, 0x98, 0x87, 0xeb, 0xbc, 0x2f,
-	0xc6, 0xa1, 0x43, 0xfb, 0x4b, 0x47, 0xa4, 0x67, 0x3f, 0x7d, 0x12, 0x61, 0x26, 0x06, 0xce, 0x18,
-	0x8b, 0xef, 0x05, 0x4a, 0xef, 0xdd, 0x70, 0x1e, 0x6c, 0xe2, 0x6e, 0xd7, 0xf0, 0x91, 0x67, 0x64,
-	0x64, 0xd3, 0x01, 0x68, 0x32, 0x95, 0xa7, 0xd1, 0xf1, 0x9c, 0x79, 0x2e, 0x9a, 0x1a, 0xdc, 0x1a,
-	0xc7, 0x99, 0x05, 0xf5, 0x83, 0x40, 0xc3, 0xfd, 0xc8, 0xf2, 0xdb, 0xa6, 0x67, 0x1c, 0xfd, 0x47,
-	0xa2, 0x92, 0xe1, 0x85, 0xb1, 0xa4, 0x59, 0x58, 0x9b, 0x20, 0x72, 0x00, 0x1d, 0x1d, 0x19, 0x9e,
-	0x49, 0x32, 0x85, 0xc4, 0x79, 0xb9, 0x05, 0xd2, 0xa8, 0x11, 0xe6, 0xe2, 0x5b, 0x01, 0x16, 0x39,
-	0xf1, 0x16, 0xf6, 0x90, 0xd5, 0x72, 0xf2, 0xb8, 0x12, 0x6f, 0xc3, 0x25, 0x13, 0x39, 0xd8, 0xa6,
-	0xfd, 0x39, 0xbb, 0x71, 0xf5, 0xbc, 0x2f, 0x57, 0xe2, 0x29, 0xe0, 0x04, 0xb0, 0x50, 0x1c, 0x74,
-	0xb2, 0x8f, 0xc3, 0x3d, 0x52, 0x1c, 0x5e, 0x3d, 0x91, 0x40, 0xd1, 0x4b, 0x3e, 0x0e, 0xb6, 0x07,
-	0xc7, 0x7f, 0x19, 0x5e, 0x9c, 0x48, 0x90, 0x85, 0xd1, 0x86, 0xb9, 0x60, 0xaa, 0x77, 0x91, 0xe1,
-	0xed, 0x1a, 0x27, 0xb8, 0xe7, 0x3f, 0x59, 0xee, 0x1c, 0x9d, 0x45, 0x58, 0x18, 0xf2, 0xc4, 0x48,
-	0x7c, 0x3d, 0x05, 0xd7, 0x82, 0x37, 0xbf, 0xe7, 0x35, 0xdb, 0x06, 0x41, 0x7b, 0xe1, 0xec, 0xe6,
-	0x5e, 0x60, 0xe1, 0xc2, 0xb9, 0xfa, 0xaf, 0x6c, 0x93, 0xa1, 0xe1, 0x5d, 0xcc, 0x3e, 0xbc, 0xe3,
-	0x9c, 0x4e, 0x67, 0x6b, 0xbd, 0x9b, 0xb4, 0xb7, 0x92, 0xf9, 0x60, 0xd9, 0xfa, 0x5c, 0x80, 0x6a,
-	0xa2, 0x31, 0x2d, 0xbb, 0xd1, 0xf3, 0x08, 0xb2, 0x91, 0xe3, 0x8b, 0x6f, 0x41, 0xd9, 0xf5, 0xb0,
-	0x8b, 0x89, 0xc1, 0x25, 0x8e, 0xa3, 0xc8, 0x09, 0x15, 0x1d, 0xe2, 0xa7, 0x6d, 0x93, 0x31, 0x9c,
-	0xca, 0xc6, 0x50, 0x81, 0xa5, 0x49, 0x1c, 0x86, 0xcb, 0xba, 0xe7, 0x1b, 0x1d, 0xb4, 0x85, 0xbd,
-	0xff, 0xcb, 0x1a, 0x96, 0x35, 0x99, 0x0f, 0x96, 0xad, 0x5f, 0xc2, 0xfd, 0xf2, 0xc0, 0x21, 0x54,
-	0xee, 0x61, 0xfb, 0x99, 0x4d, 0x58, 0x1c, 0x76, 0x31, 0x5b, 0xd8, 0xe1, 0x12, 0x1a, 0x09, 0x8c,
-	0x45, 0xfe, 0xb3, 0x00, 0x57, 0xd9, 0xf5, 0xb1, 0x17, 0x5d, 0xd0, 0xb9, 0xa2, 0xbe, 0x33, 0x38,
-	0xce, 0x27, 0xf4, 0xef, 0xc4, 0xcb, 0xbc, 0x98, 0xe3, 0x32, 0xcf, 0x59, 0x6e, 0x89, 0xbe, 0xa7,
-	0x89, 0xb0, 0xe2, 0x98, 0xd7, 0x7e, 0xac, 0x40, 0x71, 0x87, 0xb4, 0xc4, 0x06, 0x00, 0xf7, 0x47,
-	0xd4, 0xcb, 0x13, 0x0f, 0x53, 0xfe, 0xf2, 0x96, 0xee, 0x65, 0x82, 0xc5, 0xbe, 0x02, 0x1f, 0xdc,
-	0x71, 0x9e, 0xe6, 0x63, 0x00, 0x4b, 0xf5, 0x31, 0x7a, 0x27, 0x8a, 0xfb, 0x30, 0x3b, 0x38, 0x12,
-	0x5f, 0x4a, 0xd1, 0x65, 0x28, 0xe9, 0x6e, 0x16, 0x14, 0x1f, 0x04, 0x77, 0x01, 0xa6, 0x05, 0x31,
-	0x80, 0xa5, 0x06, 0x31, 0x7a, 0xe2, 0x89, 0x47, 0x70, 0x6d, 0xf4, 0xbc, 0x4b, 0xa3, 0x39, 0x82,
-	0x96, 0xde, 0xc8, 0x83, 0x66, 0x8e, 0x3f, 0x03, 0x71, 0xcc, 0x09, 0x96, 0xc6, 0x7e, 0x14, 0x2e,
-	0xbd, 0x99, 0x0b, 0xce, 0x7c, 0x7f, 0x0a, 0x73, 0xc3, 0x87, 0xd2, 0xab, 0x19, 0x2c, 0x45, 0x58,
-	0x69, 0x2d, 0x3b, 0x96, 0xb9, 0xfc, 0x52, 0x80, 0xf9, 0x09, 0x87, 0x53, 0x3d, 0x83, 0xb9, 0xa4,
-	0x8a, 0xf4, 0x76, 0x6e, 0x15, 0x46, 0xa4, 0x0d, 0x95, 0xc4, 0xe9, 0xf3, 0x4a, 0xda, 0x8b, 0xc5,
-	0x01, 0x25, 0x2d, 0x23, 0x90, 0x79, 0x72, 0xe0, 0xca, 0xd0, 0x79, 0x73, 0x27, 0xad, 0xfd, 0x13,
-	0x50, 0xa9, 0x9e, 0x19, 0xca, 0xfc, 0x7d, 0x21, 0xc0, 0x8d, 0xf1, 0x17, 0xc2, 0x6a, 0xa6, 0x82,
-	0x71, 0x1a, 0xd2, 0xfd, 0xbc, 0x1a, 0x8c, 0x45, 0x07, 0x2e, 0x27, 0xa7, 0xfa, 0xca, 0x85, 0x53,
-	0x25, 0x42, 0x4a, 0xab, 0x59, 0x91, 0x7c, 0x8a, 0x87, 0x4e, 0x8d, 0xb4, 0x14, 0x27, 0xa1, 0xa9,
-	0x29, 0x1e, 0xbf, 0xb0, 0x83, 0x69, 0x31, 0xba, 0xac, 0xd3, 0xa6, 0xc5, 0x08, 0x3a, 0x75, 0x5a,
-	0x4c, 0xdc, 0x97, 0x1b, 0xef, 0x3d, 0x3a, 0xad, 0x09, 0x8f, 0x4f, 0x6b, 0xc2, 0x1f, 0xa7, 0x35,
-	0xe1, 0x9b, 0xb3, 0x5a, 0xe1, 0xf1, 0x59, 0xad, 0xf0, 0xeb, 0x59, 0xad, 0xf0, 0x71, 0x9d, 0x5f,
-	0xe5, 0xc8, 0xf3, 0xad, 0xce, 0x01, 0xee, 0x39, 0xa6, 0x11, 0x1c, 0x2b, 0x5a, 0xf4, 0x5d, 0xef,
-	0x38, 0xfe, 0xb2, 0x47, 0x37, 0x7b, 0xa3, 0x44, 0x3f, 0xe8, 0xbd, 0xfe, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x5c, 0x73, 0x83, 0x24, 0x66, 0x14, 0x00, 0x00,
+	// 1430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0xc6, 0x89, 0x43, 0x9e, 0xc3, 0x8f, 0x2c, 0x21, 0x71, 0x16, 0xf0, 0x86, 0xa5, 0xa5,
+	0xe1, 0x47, 0x6c, 0x1c, 0x40, 0x50, 0x6e, 0x71, 0x2a, 0x24, 0xd4, 0x46, 0x82, 0x0d, 0xa8, 0x52,
+	0x2f, 0xd1, 0xda, 0x3b, 0xd8, 0x5b, 0xdb, 0x3b, 0x66, 0x67, 0x1c, 0xa0, 0xa7, 0xaa, 0x95, 0xaa,
+	0x9e, 0xaa, 0xde, 0x7a, 0xa9, 0x54, 0xce, 0x3d, 0xb6, 0x7f, 0x41, 0xa5, 0x1e, 0x38, 0x72, 0xa9,
+	0x5a, 0xf5, 0xe0, 0xa2, 0x70, 0xe9, 0xb1, 0xca, 0x5f, 0x50, 0xed, 0xce, 0xee, 0x78, 0x76, 0xd7,
+	0x5e, 0x76, 0x2b, 0x40, 0xb4, 0xea, 0x09, 0xdb, 0xf3, 0xbd, 0xf7, 0xbe, 0xef, 0xbd, 0xb7, 0xc3,
+	0x7b, 0x59, 0x50, 0x49, 0x0b, 0xd9, 0xb4, 0x5f, 0x21, 0x2d, 0x0b, 0x75, 0xcc, 0xca, 0x6e, 0xd5,
+	0xe8, 0xf4, 0x5a, 0x46, 0xb5, 0x42, 0x1f, 0x96, 0x7b, 0x0e, 0xa6, 0x58, 0x5e, 0x64, 0x80, 0x32,
+	0x03, 0x94, 0x03, 0x80, 0xb2, 0xd0, 0xc4, 0x4d, 0xec, 0x41, 0x2a, 0xee, 0x27, 0x86, 0x56, 0x4a,
+	0x0d, 0x4c, 0xba, 0x98, 0x54, 0xea, 0x06, 0x41, 0x95, 0xdd, 0x6a, 0x1d, 0x51, 0xa3, 0x5a, 0x69,
+	0x60, 0xcb, 0xf6, 0xcf, 0x4f, 0x8f, 0x09, 0xe7, 0x7b, 0xf7, 0x40, 0xda, 0x5f, 0x39, 0x38, 0xb8,
+	0x45, 0x9a, 0x9b, 0x0e, 0x32, 0x28, 0xba, 0x85, 0x71, 0x47, 0x3e, 0x0d, 0x53, 0xf7, 0x1c, 0xdc,
+	0x2d, 0x4a, 0x2b, 0xd2, 0xea, 0x6c, 0xed, 0xf0, 0xfe, 0x40, 0x2d, 0x3c, 0x32, 0xba, 0x9d, 0xeb,
+	0x9a, 0xfb, 0xab, 0xa6, 0x7b, 0x87, 0x72, 0x03, 0xf2, 0xcc, 0x4d, 0x71, 0x72, 0x25, 0xb7, 0x5a,
+	0x58, 0x5f, 0x2e, 0x33, 0x32, 0x65, 0x97, 0x4c, 0xd9, 0x27, 0x53, 0xde, 0xc4, 0x96, 0x5d, 0xbb,
+	0xf8, 0x64, 0xa0, 0x4e, 0x7c, 0xff, 0x87, 0xba, 0xda, 0xb4, 0x68, 0xab, 0x5f, 0x2f, 0x37, 0x70,
+	0xb7, 0xe2, 0x33, 0x67, 0xff, 0xac, 0x11, 0xb3, 0x5d, 0xa1, 0x8f, 0x7a, 0x88, 0x78, 0x06, 0x44,
+	0xf7, 0x5d, 0xcb, 0x77, 0x60, 0xc6, 0x44, 0x3d, 0x4c, 0x2c, 0x5a, 0xcc, 0xad, 0x48, 0xab, 0x85,
+	0x75, 0xad, 0x3c, 0x3a, 0x41, 0xe5, 0x2d, 0xeb, 0x21, 0x32, 0x3d, 0xe3, 0xda, 0xa2, 0x1b, 0x6e,
+	0x7f, 0xa0, 0x1e, 0x62, 0xa4, 0x7d, 0x07, 0x9a, 0x1e, 0xb8, 0x92, 0x2f, 0xc0, 0x0c, 0xe9, 0x61,
+	0x9b, 0x60, 0xa7, 0x38, 0xe5, 0x49, 0x94, 0x87, 0x68, 0xff, 0x40, 0xd3, 0x03, 0x88, 0x7c, 0x1d,
+	0xe6, 0xfc, 0x8f, 0x3b, 0x86, 0x69, 0x3a, 0xc5, 0x69, 0xcf, 0x64, 0x69, 0x7f, 0xa0, 0x1e, 0x0d,
+	0x99, 0x78, 0xa7, 0x9a, 0x5e, 0xf0, 0xbf, 0x6e, 0x98, 0xa6, 0x23, 0x5f, 0x83, 0x82, 0x89, 0x48,
+	0xc3, 0xb1, 0x7a, 0xd4, 0xc2, 0x76, 0x31, 0xef, 0x99, 0x2e, 0xee, 0x0f, 0x54, 0x39, 0xe0, 0xc6,
+	0x0f, 0x35, 0x5d, 0x84, 0xca, 0xb7, 0x61, 0x8e, 0x49, 0xdc, 0xe9, 0x58, 0x5d, 0x8b, 0x16, 0x67,
+	0x3c, 0xd3, 0xb2, 0x2b, 0xed, 0xf7, 0x81, 0x7a, 0x26, 0x45, 0x26, 0x6f, 0xda, 0x54, 0x2f, 0x30,
+	0x1f, 0x1f, 0xb8, 0x2e, 0xae, 0x1f, 0xf8, 0xf2, 0xb1, 0x3a, 0xf1, 0xe7, 0x63, 0x75, 0x42, 0x5b,
+	0x82, 0x63, 0xa1, 0x8a, 0xeb, 0xc8, 0x23, 0x8d, 0xb4, 0x9f, 0x58, 0x2f, 0xdc, 0xed, 0x99, 0x6f,
+	0x5e, 0x2f, 0xd4, 0x61, 0x8e, 0x20, 0x67, 0xd7, 0x6a, 0xa0, 0x9d, 0x7b, 0x08, 0x91, 0x0c, 0x0d,
+	0x71, 0xdc, 0x6f, 0x88, 0xa0, 0x5e, 0x82, 0x17, 0xb7, 0x5e, 0xec, 0xeb, 0x0d, 0x84, 0x88, 0x7c,
+	0x1e, 0x66, 0x7a, 0x18, 0x77, 0x76, 0x2c, 0xd3, 0xeb, 0x8c, 0x29, 0xb1, 0x33, 0xfc, 0x03, 0x4d,
+	0xcf, 0xbb, 0x9f, 0x6e, 0x9a, 0xd1, 0xe2, 0x4e, 0xff, 0xf3, 0xe2, 0xe6, 0x5f, 0x7e, 0x71, 0x87,
+	0x25, 0xe4, 0xc5, 0xfd, 0x18, 0xe6, 0xb6, 0x48, 0xf3, 0x96, 0xd1, 0x27, 0x19, 0x4a, 0x2b, 0x64,
+	0x64, 0xf2, 0x45, 0x19, 0x11, 0x48, 0x2c, 0xc2, 0x82, 0x18, 0x8b, 0x73, 0x68, 0x7b, 0xfd, 0xa5,
+	0x23, 0xd2, 0xef, 0xbe, 0x7a, 0x12, 0x2c, 0x13, 0xc3, 0x60, 0x9c, 0xc5, 0xcf, 0x12, 0x1c, 0xdd,
+	0x22, 0xcd, 0x6d, 0x44, 0x37, 0xf1, 0x2e, 0x72, 0x8c, 0x26, 0xba, 0x83, 0x9c, 0x2e, 0x79, 0xf9,
+	0x64, 0xe4, 0xdb, 0x30, 0x4d, 0x5d, 0xd7, 0x7e, 0xb7, 0xbe, 0x3d, 0xae, 0x5b, 0x43, 0x3c, 0x6a,
+	0x0b, 0x7e, 0xc3, 0xce, 0x31, 0xaf, 0x9e, 0x07, 0x4d, 0x67, 0x9e, 0x04, 0x7d, 0x27, 0xe1, 0xf8,
+	0x08, 0x15, 0x5c, 0xe5, 0xb7, 0x61, 0x95, 0xc8, 0xdc, 0x20, 0x04, 0xd1, 0x57, 0xa1, 0xf2, 0x2c,
+	0xe4, 0x0d, 0xcf, 0x77, 0x31, 0xb7, 0x92, 0x5b, 0x9d, 0xad, 0xcd, 0xef, 0x0f, 0xd4, 0x83, 0x0c,
+	0xcb, 0x7e, 0xd7, 0x74, 0x1f, 0x30, 0x96, 0x7d, 0xc0, 0x8e, 0xb3, 0xff, 0x41, 0xf2, 0x5a, 0xe8,
+	0x3d, 0x76, 0x67, 0x6f, 0xe2, 0x4e, 0xc7, 0xa0, 0xc8, 0x31, 0x52, 0x76, 0x4c, 0x1b, 0xa0, 0xc1,
+	0x4d, 0x5e, 0xc5, 0xad, 0x24, 0xb8, 0x17, 0x34, 0x95, 0xe0, 0xc4, 0x28, 0xce, 0x5c, 0xd4, 0x8f,
+	0x92, 0xd7, 0x92, 0x1f, 0x5a, 0xb4, 0x65, 0x3a, 0xc6, 0x83, 0x7f, 0x89, 0x2a, 0x15, 0x4e, 0x8e,
+	0x24, 0xcd, 0x65, 0x3d, 0x63, 0xb2, 0x36, 0x3a, 0x1d, 0xdc, 0x30, 0x28, 0xca, 0x2a, 0x2b, 0x53,
+	0xaf, 0x85, 0x73, 0x90, 0x7b, 0xbd, 0x39, 0x88, 0x2b, 0xe4, 0x39, 0xd8, 0x93, 0x60, 0xc9, 0xab,
+	0xbd, 0xf1, 0x1f, 0xce, 0xc2, 0x29, 0x50, 0xc7, 0x68, 0xe4, 0x79, 0xd8, 0x04, 0x59, 0x68, 0x16,
+	0x1d, 0x3d, 0x30, 0x1c, 0x33, 0xdd, 0x9d, 0x23, 0xc4, 0x39, 0x01, 0x4a, 0xdc, 0x09, 0x0f, 0x71,
+	0xdf, 0x0b, 0xb1, 0x8d, 0xe8, 0x46, 0x9f, 0xe2, 0x4d, 0xdc, 0xed, 0xe1, 0xbe, 0x6d, 0xa6, 0x4b,
+	0xf2, 0x05, 0x98, 0x41, 0xb6, 0x51, 0xef, 0x20, 0x96, 0xe4, 0x03, 0x62, 0x92, 0xfd, 0x03, 0x4d,
+	0x0f, 0x20, 0x31, 0x42, 0x91, 0x90, 0x9c, 0xd0, 0x77, 0x12, 0x2c, 0x0b, 0x7c, 0x6f, 0x60, 0x07,
+	0x59, 0x4d, 0x3b, 0x8b, 0x76, 0xf9, 0x0c, 0x4c, 0x9b, 0xc8, 0xc6, 0x5d, 0x8f, 0xd6, 0x6c, 0xed,
+	0xc8, 0xf0, 0xf6, 0xf7, 0x7e, 0xd6, 0x74, 0x76, 0xec, 0x76, 0x09, 0xc5, 0x6c, 0x10, 0xcd, 0x45,
+	0x67, 0x57, 0xff, 0x40, 0xd3, 0xf3, 0x14, 0xbb, 0xe3, 0xa7, 0xc0, 0xff, 0x34, 0x9c, 0x1a, 0x4b,
+	0x90, 0xcb, 0x68, 0xc1, 0x61, 0x77, 0x2c, 0xec, 0x20, 0xc3, 0xb9, 0x65, 0x3c, 0xc2, 0x7d, 0xfa,
+	0x72, 0xb9, 0x0b, 0x74, 0x96, 0x61, 0x29, 0x12, 0x89, 0x93, 0xf8, 0x6a, 0x12, 0xe6, 0xdd, 0xd1,
+	0xa1, 0xef, 0x34, 0x5a, 0x06, 0x41, 0xdb, 0x6c, 0xf8, 0x13, 0x1e, 0x0e, 0xe9, 0x85, 0x0f, 0xc7,
+	0x6b, 0x19, 0x47, 0x23, 0xd3, 0x5f, 0x2e, 0xfd, 0xf4, 0x17, 0xe4, 0x74, 0x2a, 0xdd, 0xb3, 0x70,
+	0x1c, 0x96, 0x63, 0xf9, 0xe0, 0xd9, 0xfa, 0x4c, 0x82, 0x62, 0xe8, 0x49, 0xb1, 0xba, 0xf5, 0xbe,
+	0x43, 0x50, 0x17, 0xd9, 0x54, 0xbe, 0x0a, 0x85, 0x9e, 0x83, 0x7b, 0x98, 0x18, 0x42, 0xe2, 0x04,
+	0x8a, 0xc2, 0xa1, 0xa6, 0x43, 0xf0, 0xed, 0xe6, 0xf0, 0x51, 0x9a, 0x4c, 0xc7, 0x50, 0x83, 0x95,
+	0x71, 0x1c, 0xa2, 0x65, 0xdd, 0xa6, 0x46, 0x1b, 0xdd, 0xc0, 0xce, 0xff, 0x65, 0x65, 0x65, 0x0d,
+	0xe7, 0x83, 0x67, 0xeb, 0x57, 0x36, 0xfc, 0xdc, 0xb5, 0x89, 0x77, 0xee, 0xe0, 0xee, 0x1b, 0x9b,
+	0xb0, 0x40, 0x76, 0x2e, 0x9d, 0x6c, 0x36, 0x21, 0xc5, 0x84, 0x71, 0xe5, 0xbf, 0x48, 0x70, 0x84,
+	0xaf, 0x2f, 0xdb, 0xfe, 0x0a, 0x9e, 0x49, 0xf5, 0xd9, 0xe1, 0x76, 0x3f, 0xa6, 0x7f, 0xc7, 0xae,
+	0xf6, 0xb9, 0x0c, 0xab, 0x7d, 0xc6, 0x72, 0x2b, 0x50, 0x8c, 0xca, 0x0a, 0x34, 0xaf, 0x7f, 0x33,
+	0x0f, 0xb9, 0x2d, 0xd2, 0x94, 0xeb, 0x00, 0xc2, 0x5f, 0x61, 0xc6, 0xee, 0x0a, 0xa1, 0xd5, 0x5d,
+	0x59, 0x4b, 0x05, 0x0b, 0x62, 0xb9, 0x31, 0x84, 0xed, 0x3e, 0x29, 0xc6, 0x10, 0xa6, 0xac, 0xa5,
+	0x82, 0xf1, 0x18, 0x3b, 0x30, 0x3b, 0xdc, 0x32, 0xdf, 0x4a, 0xb0, 0xe5, 0x28, 0xe5, 0x42, 0x1a,
+	0x94, 0x28, 0x42, 0x58, 0x21, 0x93, 0x44, 0x0c, 0x61, 0xca, 0x5a, 0x2a, 0x18, 0x8f, 0x41, 0xe1,
+	0x48, 0x6c, 0x3f, 0x3c, 0x9f, 0xe0, 0x22, 0x0a, 0x56, 0x2e, 0x65, 0x00, 0x8f, 0x8a, 0xca, 0xf7,
+	0xb5, 0x34, 0x51, 0x03, 0xb0, 0x72, 0x29, 0x03, 0x98, 0x47, 0x7d, 0x00, 0xf3, 0xf1, 0x3d, 0x2b,
+	0xa9, 0x24, 0x31, 0xb4, 0x72, 0x39, 0x0b, 0x9a, 0x07, 0xfe, 0x04, 0xe4, 0x11, 0xbb, 0x50, 0x52,
+	0xa5, 0xe2, 0x70, 0xe5, 0x4a, 0x26, 0xb8, 0x18, 0x7b, 0xc4, 0xc2, 0x92, 0x14, 0x3b, 0x0e, 0x57,
+	0xae, 0x64, 0x82, 0xf3, 0xd8, 0x9f, 0x4a, 0xb0, 0x30, 0x72, 0x53, 0xa8, 0x24, 0xa6, 0x31, 0x6e,
+	0xa0, 0x5c, 0xcd, 0x68, 0xc0, 0x29, 0xdc, 0x87, 0xc3, 0xd1, 0x21, 0xfd, 0x5c, 0x8a, 0x44, 0xfa,
+	0x58, 0x65, 0x3d, 0x3d, 0x56, 0x0c, 0x19, 0x1d, 0xda, 0xcf, 0x25, 0xb7, 0xab, 0x88, 0x55, 0xd6,
+	0xd3, 0x63, 0x79, 0xc8, 0x2f, 0x24, 0x58, 0x1c, 0x33, 0x96, 0x57, 0x53, 0x28, 0x08, 0x9b, 0x28,
+	0xef, 0x66, 0x36, 0xe1, 0x44, 0x5a, 0x30, 0x17, 0x1a, 0xac, 0xdf, 0x49, 0xba, 0xb6, 0x05, 0xa0,
+	0x52, 0x49, 0x09, 0xe4, 0x91, 0x6c, 0x38, 0x14, 0x19, 0x9e, 0xcf, 0x26, 0x5d, 0xae, 0x21, 0xa8,
+	0x52, 0x4d, 0x0d, 0xe5, 0xf1, 0x3e, 0x97, 0xe0, 0xd8, 0xe8, 0xf9, 0xf3, 0x62, 0xaa, 0x1e, 0x11,
+	0x2c, 0x94, 0x6b, 0x59, 0x2d, 0x38, 0x8b, 0x36, 0x1c, 0x0c, 0xcf, 0x0c, 0xab, 0x2f, 0xfc, 0x3f,
+	0xcb, 0x47, 0x2a, 0x17, 0xd3, 0x22, 0xc5, 0x14, 0x47, 0x06, 0xd9, 0xa4, 0x14, 0x87, 0xa1, 0x4a,
+	0x35, 0x35, 0x54, 0xbc, 0x9f, 0xe3, 0xa3, 0x60, 0xd2, 0xfd, 0x1c, 0x43, 0x2b, 0x97, 0xb3, 0xa0,
+	0x83, 0xc0, 0xb5, 0xf7, 0x9f, 0xec, 0x95, 0xa4, 0xa7, 0x7b, 0x25, 0xe9, 0xd9, 0x5e, 0x49, 0xfa,
+	0xfa, 0x79, 0x69, 0xe2, 0xe9, 0xf3, 0xd2, 0xc4, 0x6f, 0xcf, 0x4b, 0x13, 0x1f, 0x55, 0xc5, 0x41,
+	0x11, 0x39, 0xd4, 0x6a, 0xdf, 0x73, 0x1f, 0x3c, 0xc3, 0x1d, 0x85, 0x2b, 0xfe, 0x6b, 0xa7, 0x87,
+	0xc1, 0x8b, 0x27, 0x6f, 0x6e, 0xac, 0xe7, 0xbd, 0xf7, 0x4d, 0x97, 0xfe, 0x1e, 0x00, 0x56, 0x94,
+	0x8c, 0x46, 0x05, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1224,9 +1629,14 @@ type MsgClient interface {
 	UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error)
 	PausePool(ctx context.Context, in *MsgPausePool, opts ...grpc.CallOption) (*MsgPausePoolResponse, error)
 	ResumePool(ctx context.Context, in *MsgResumePool, opts ...grpc.CallOption) (*MsgResumePoolResponse, error)
+	SetCoverageTerms(ctx context.Context, in *MsgSetCoverageTerms, opts ...grpc.CallOption) (*MsgSetCoverageTermsResponse, error)
+	SetCoveredAssets(ctx context.Context, in *MsgSetCoveredAssets, opts ...grpc.CallOption) (*MsgSetCoveredAssetsResponse, error)
 	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
 	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	AllocateCollateral(ctx context.Context, in *MsgAllocateCollateral, opts ...grpc.CallOption) (*MsgAllocateCollateralResponse, error)
+	DeallocateCollateral(ctx context.Context, in *MsgDeallocateCollateral, opts ...grpc.CallOption) (*MsgDeallocateCollateralResponse, error)
 	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 	WithdrawForeignRewards(ctx context.Context, in *MsgWithdrawForeignRewards, opts ...grpc.CallOption) (*MsgWithdrawForeignRewardsResponse, error)
 	ClearPayouts(ctx context.Context, in *MsgClearPayouts, opts ...grpc.CallOption) (*MsgClearPayoutsResponse, error)
 	PurchaseShield(ctx context.Context, in *MsgPurchaseShield, opts ...grpc.CallOption) (*MsgPurchaseShieldResponse, error)
@@ -1280,16 +1690,34 @@ func (c *msgClient) ResumePool(ctx context.Context, in *MsgResumePool, opts ...g
 	return out, nil
 }
 
-func (c *msgClient) DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
-	out := new(MsgDepositCollateralResponse)
-	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/DepositCollateral", in, out, opts...)
+func (c *msgClient) SetCoverageTerms(ctx context.Context, in *MsgSetCoverageTerms, opts ...grpc.CallOption) (*MsgSetCoverageTermsResponse, error) {
+	out := new(MsgSetCoverageTermsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/SetCoverageTerms", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error) {
+func (c *msgClient) SetCoveredAssets(ctx context.Context, in *MsgSetCoveredAssets, opts ...grpc.CallOption) (*MsgSetCoveredAssetsResponse, error) {
+	out := new(MsgSetCoveredAssetsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/SetCoveredAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
+	out := new(MsgDepositCollateralResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/DepositCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error) {
 	out := new(MsgWithdrawCollateralResponse)
 	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/WithdrawCollateral", in, out, opts...)
 	if err != nil {
@@ -1298,6 +1726,24 @@ func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawColla
 	return out, nil
 }
 
+func (c *msgClient) AllocateCollateral(ctx context.Context, in *MsgAllocateCollateral, opts ...grpc.CallOption) (*MsgAllocateCollateralResponse, error) {
+	out := new(MsgAllocateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/AllocateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeallocateCollateral(ctx context.Context, in *MsgDeallocateCollateral, opts ...grpc.CallOption) (*MsgDeallocateCollateralResponse, error) {
+	out := new(MsgDeallocateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/DeallocateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
 	out := new(MsgWithdrawRewardsResponse)
 	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/WithdrawRewards", in, out, opts...)
@@ -1307,6 +1753,15 @@ func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards,
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawForeignRewards(ctx context.Context, in *MsgWithdrawForeignRewards, opts ...grpc.CallOption) (*MsgWithdrawForeignRewardsResponse, error) {
 	out := new(MsgWithdrawForeignRewardsResponse)
 	err := c.cc.Invoke(ctx, "/shentu.shield.v1alpha1.Msg/WithdrawForeignRewards", in, out, opts...)
@@ -1376,9 +1831,14 @@ type MsgServer interface {
 	UpdatePool(context.Context, *MsgUpdatePool) (*MsgUpdatePoolResponse, error)
 	PausePool(context.Context, *MsgPausePool) (*MsgPausePoolResponse, error)
 	ResumePool(context.Context, *MsgResumePool) (*MsgResumePoolResponse, error)
+	SetCoverageTerms(context.Context, *MsgSetCoverageTerms) (*MsgSetCoverageTermsResponse, error)
+	SetCoveredAssets(context.Context, *MsgSetCoveredAssets) (*MsgSetCoveredAssetsResponse, error)
 	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
 	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	AllocateCollateral(context.Context, *MsgAllocateCollateral) (*MsgAllocateCollateralResponse, error)
+	DeallocateCollateral(context.Context, *MsgDeallocateCollateral) (*MsgDeallocateCollateralResponse, error)
 	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 	WithdrawForeignRewards(context.Context, *MsgWithdrawForeignRewards) (*MsgWithdrawForeignRewardsResponse, error)
 	ClearPayouts(context.Context, *MsgClearPayouts) (*MsgClearPayoutsResponse, error)
 	PurchaseShield(context.Context, *MsgPurchaseShield) (*MsgPurchaseShieldResponse, error)
@@ -1404,15 +1864,30 @@ func (*UnimplementedMsgServer) PausePool(ctx context.Context, req *MsgPausePool)
 func (*UnimplementedMsgServer) ResumePool(ctx context.Context, req *MsgResumePool) (*MsgResumePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResumePool not implemented")
 }
+func (*UnimplementedMsgServer) SetCoverageTerms(ctx context.Context, req *MsgSetCoverageTerms) (*MsgSetCoverageTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoverageTerms not implemented")
+}
+func (*UnimplementedMsgServer) SetCoveredAssets(ctx context.Context, req *MsgSetCoveredAssets) (*MsgSetCoveredAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoveredAssets not implemented")
+}
 func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDepositCollateral) (*MsgDepositCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositCollateral not implemented")
 }
 func (*UnimplementedMsgServer) WithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
 }
+func (*UnimplementedMsgServer) AllocateCollateral(ctx context.Context, req *MsgAllocateCollateral) (*MsgAllocateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateCollateral not implemented")
+}
+func (*UnimplementedMsgServer) DeallocateCollateral(ctx context.Context, req *MsgDeallocateCollateral) (*MsgDeallocateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeallocateCollateral not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawForeignRewards(ctx context.Context, req *MsgWithdrawForeignRewards) (*MsgWithdrawForeignRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawForeignRewards not implemented")
 }
@@ -1511,6 +1986,42 @@ func _Msg_ResumePool_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCoverageTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCoverageTerms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCoverageTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Msg/SetCoverageTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCoverageTerms(ctx, req.(*MsgSetCoverageTerms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCoveredAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCoveredAssets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCoveredAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Msg/SetCoveredAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCoveredAssets(ctx, req.(*MsgSetCoveredAssets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_DepositCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDepositCollateral)
 	if err := dec(in); err != nil {
@@ -1547,6 +2058,42 @@ func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AllocateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Msg/AllocateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateCollateral(ctx, req.(*MsgAllocateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeallocateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeallocateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeallocateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Msg/DeallocateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeallocateCollateral(ctx, req.(*MsgDeallocateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawRewards)
 	if err := dec(in); err != nil {
@@ -1565,6 +2112,24 @@ func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.shield.v1alpha1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawForeignRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawForeignRewards)
 	if err := dec(in); err != nil {
@@ -1711,6 +2276,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResumePool",
 			Handler:    _Msg_ResumePool_Handler,
 		},
+		{
+			MethodName: "SetCoverageTerms",
+			Handler:    _Msg_SetCoverageTerms_Handler,
+		},
+		{
+			MethodName: "SetCoveredAssets",
+			Handler:    _Msg_SetCoveredAssets_Handler,
+		},
 		{
 			MethodName: "DepositCollateral",
 			Handler:    _Msg_DepositCollateral_Handler,
@@ -1719,10 +2292,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCollateral",
 			Handler:    _Msg_WithdrawCollateral_Handler,
 		},
+		{
+			MethodName: "AllocateCollateral",
+			Handler:    _Msg_AllocateCollateral_Handler,
+		},
+		{
+			MethodName: "DeallocateCollateral",
+			Handler:    _Msg_DeallocateCollateral_Handler,
+		},
 		{
 			MethodName: "WithdrawRewards",
 			Handler:    _Msg_WithdrawRewards_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 		{
 			MethodName: "WithdrawForeignRewards",
 			Handler:    _Msg_WithdrawForeignRewards_Handler,
@@ -2079,7 +2664,7 @@ func (m *MsgResumePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetCoverageTerms) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2089,29 +2674,30 @@ func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetCoverageTerms) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetCoverageTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Collateral) > 0 {
-		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.Terms.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
@@ -2123,7 +2709,7 @@ func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetCoverageTermsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2133,12 +2719,12 @@ func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetCoverageTermsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetCoverageTermsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2146,7 +2732,7 @@ func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawCollateral) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetCoveredAssets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2156,30 +2742,30 @@ func (m *MsgWithdrawCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetCoveredAssets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetCoveredAssets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Collateral) > 0 {
-		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Assets[iNdEx])
+			copy(dAtA[i:], m.Assets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Assets[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
@@ -2190,7 +2776,7 @@ func (m *MsgWithdrawCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetCoveredAssetsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2200,12 +2786,12 @@ func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetCoveredAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetCoveredAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2213,7 +2799,7 @@ func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2223,16 +2809,30 @@ func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
@@ -2243,7 +2843,7 @@ func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2253,12 +2853,12 @@ func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2266,7 +2866,7 @@ func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawForeignRewards) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2276,29 +2876,29 @@ func (m *MsgWithdrawForeignRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawForeignRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawForeignRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ToAddr) > 0 {
-		i -= len(m.ToAddr)
-		copy(dAtA[i:], m.ToAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
@@ -2310,7 +2910,7 @@ func (m *MsgWithdrawForeignRewards) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawForeignRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2320,12 +2920,12 @@ func (m *MsgWithdrawForeignRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawForeignRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawForeignRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2333,7 +2933,7 @@ func (m *MsgWithdrawForeignRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClearPayouts) Marshal() (dAtA []byte, err error) {
+func (m *MsgAllocateCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2343,22 +2943,34 @@ func (m *MsgClearPayouts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClearPayouts) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAllocateCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClearPayouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAllocateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
@@ -2370,7 +2982,7 @@ func (m *MsgClearPayouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClearPayoutsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAllocateCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2380,12 +2992,12 @@ func (m *MsgClearPayoutsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClearPayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAllocateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClearPayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAllocateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2393,7 +3005,7 @@ func (m *MsgClearPayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPurchaseShield) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeallocateCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2403,34 +3015,20 @@ func (m *MsgPurchaseShield) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPurchaseShield) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeallocateCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPurchaseShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeallocateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Shield) > 0 {
-		for iNdEx := len(m.Shield) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Shield[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2438,18 +3036,25 @@ func (m *MsgPurchaseShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPurchaseShieldResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeallocateCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2459,12 +3064,12 @@ func (m *MsgPurchaseShieldResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPurchaseShieldResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeallocateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPurchaseShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeallocateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2472,7 +3077,7 @@ func (m *MsgPurchaseShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawReimbursement) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2482,12 +3087,12 @@ func (m *MsgWithdrawReimbursement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawReimbursement) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawReimbursement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2497,17 +3102,12 @@ func (m *MsgWithdrawReimbursement) MarshalToSizedBuffer(dAtA []byte) (int, error
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawReimbursementResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2517,12 +3117,12 @@ func (m *MsgWithdrawReimbursementResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawReimbursementResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawReimbursementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2530,7 +3130,7 @@ func (m *MsgWithdrawReimbursementResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeForShield) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2540,53 +3140,104 @@ func (m *MsgStakeForShield) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStakeForShield) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakeForShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawForeignRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawForeignRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawForeignRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddr) > 0 {
+		i -= len(m.ToAddr)
+		copy(dAtA[i:], m.ToAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddr)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Shield) > 0 {
-		for iNdEx := len(m.Shield) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shield[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeForShieldResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawForeignRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2596,12 +3247,12 @@ func (m *MsgStakeForShieldResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStakeForShieldResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawForeignRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakeForShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawForeignRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2609,7 +3260,7 @@ func (m *MsgStakeForShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeFromShield) Marshal() (dAtA []byte, err error) {
+func (m *MsgClearPayouts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2619,12 +3270,72 @@ func (m *MsgUnstakeFromShield) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakeFromShield) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClearPayouts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakeFromShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClearPayouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearPayoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseShield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseShield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2634,6 +3345,13 @@ func (m *MsgUnstakeFromShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Shield) > 0 {
@@ -2658,7 +3376,7 @@ func (m *MsgUnstakeFromShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeFromShieldResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPurchaseShieldResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2668,12 +3386,12 @@ func (m *MsgUnstakeFromShieldResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakeFromShieldResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPurchaseShieldResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakeFromShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPurchaseShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2681,7 +3399,7 @@ func (m *MsgUnstakeFromShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSponsor) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawReimbursement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2691,12 +3409,12 @@ func (m *MsgUpdateSponsor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateSponsor) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawReimbursement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawReimbursement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2706,31 +3424,17 @@ func (m *MsgUpdateSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SponsorAddr) > 0 {
-		i -= len(m.SponsorAddr)
-		copy(dAtA[i:], m.SponsorAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SponsorAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sponsor) > 0 {
-		i -= len(m.Sponsor)
-		copy(dAtA[i:], m.Sponsor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSponsorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawReimbursementResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2740,7 +3444,230 @@ func (m *MsgUpdateSponsorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawReimbursementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawReimbursementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeForShield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeForShield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeForShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shield) > 0 {
+		for iNdEx := len(m.Shield) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shield[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeForShieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeForShieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeForShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeFromShield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeFromShield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeFromShield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shield) > 0 {
+		for iNdEx := len(m.Shield) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shield[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeFromShieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeFromShieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeFromShieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SponsorAddr) > 0 {
+		i -= len(m.SponsorAddr)
+		copy(dAtA[i:], m.SponsorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SponsorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2897,7 +3824,7 @@ func (m *MsgResumePoolResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDepositCollateral) Size() (n int) {
+func (m *MsgSetCoverageTerms) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2907,16 +3834,15 @@ func (m *MsgDepositCollateral) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Collateral) > 0 {
-		for _, e := range m.Collateral {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
 	}
+	l = m.Terms.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgDepositCollateralResponse) Size() (n int) {
+func (m *MsgSetCoverageTermsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2925,7 +3851,7 @@ func (m *MsgDepositCollateralResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWithdrawCollateral) Size() (n int) {
+func (m *MsgSetCoveredAssets) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2935,16 +3861,19 @@ func (m *MsgWithdrawCollateral) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Collateral) > 0 {
-		for _, e := range m.Collateral {
-			l = e.Size()
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.Assets) > 0 {
+		for _, s := range m.Assets {
+			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *MsgWithdrawCollateralResponse) Size() (n int) {
+func (m *MsgSetCoveredAssetsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2953,7 +3882,7 @@ func (m *MsgWithdrawCollateralResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWithdrawRewards) Size() (n int) {
+func (m *MsgDepositCollateral) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2963,10 +3892,16 @@ func (m *MsgWithdrawRewards) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgWithdrawRewardsResponse) Size() (n int) {
+func (m *MsgDepositCollateralResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2975,7 +3910,7 @@ func (m *MsgWithdrawRewardsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWithdrawForeignRewards) Size() (n int) {
+func (m *MsgWithdrawCollateral) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2985,8 +3920,145 @@ func (m *MsgWithdrawForeignRewards) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Denom)
-	if l > 0 {
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAllocateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAllocateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeallocateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeallocateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawForeignRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.ToAddr)
@@ -3328,7 +4400,722 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shield = append(m.Shield, types.Coin{})
+			if err := m.Shield[len(m.Shield)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPausePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPausePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPausePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPausePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPausePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3338,27 +5125,120 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sponsor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCoverageTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCoverageTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCoverageTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SponsorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3386,13 +5266,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SponsorAddr = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3402,29 +5282,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3434,23 +5301,22 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Terms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3478,7 +5344,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCoverageTermsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3501,10 +5367,10 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCoverageTermsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCoverageTermsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3531,7 +5397,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCoveredAssets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3554,10 +5420,10 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCoveredAssets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCoveredAssets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3593,10 +5459,10 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shield", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3606,31 +5472,16 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Shield = append(m.Shield, types.Coin{})
-			if err := m.Shield[len(m.Shield)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3640,47 +5491,133 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCoveredAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCoveredAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCoveredAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ServiceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3708,13 +5645,13 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShieldLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3724,23 +5661,23 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShieldLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3768,7 +5705,7 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3791,10 +5728,10 @@ func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3821,7 +5758,7 @@ func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3844,10 +5781,10 @@ func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPausePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPausePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3883,10 +5820,10 @@ func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3896,11 +5833,26 @@ func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3925,7 +5877,7 @@ func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPausePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3948,10 +5900,10 @@ func (m *MsgPausePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPausePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPausePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3978,7 +5930,7 @@ func (m *MsgPausePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResumePool) Unmarshal(dAtA []byte) error {
+func (m *MsgAllocateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4001,10 +5953,10 @@ func (m *MsgResumePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResumePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAllocateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResumePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAllocateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4058,6 +6010,40 @@ func (m *MsgResumePool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4082,7 +6068,7 @@ func (m *MsgResumePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResumePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAllocateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4105,10 +6091,10 @@ func (m *MsgResumePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResumePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAllocateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResumePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAllocateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4135,7 +6121,7 @@ func (m *MsgResumePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgDeallocateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4158,10 +6144,10 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeallocateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeallocateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4197,6 +6183,25 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
@@ -4254,7 +6259,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeallocateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4277,10 +6282,10 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeallocateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeallocateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4307,7 +6312,7 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4330,10 +6335,10 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4368,40 +6373,6 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Collateral = append(m.Collateral, types.Coin{})
-			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4426,7 +6397,7 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4449,10 +6420,10 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4479,7 +6450,7 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4502,10 +6473,10 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4540,6 +6511,26 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4564,7 +6555,7 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4587,10 +6578,10 @@ func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
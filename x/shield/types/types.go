@@ -9,13 +9,27 @@ import (
 // NewPool creates a new project pool.
 func NewPool(id uint64, description, sponsor string, sponsorAddress sdk.AccAddress, shieldLimit sdk.Int, shield sdk.Int) Pool {
 	return Pool{
-		Id:          id,
-		Description: description,
-		Sponsor:     sponsor,
-		SponsorAddr: sponsorAddress.String(),
-		ShieldLimit: shieldLimit,
-		Active:      true,
-		Shield:      shield,
+		Id:            id,
+		Description:   description,
+		Sponsor:       sponsor,
+		SponsorAddr:   sponsorAddress.String(),
+		ShieldLimit:   shieldLimit,
+		Active:        true,
+		Shield:        shield,
+		Allocation:    sdk.ZeroInt(),
+		ServiceFees:   InitMixedDecCoins(),
+		RewardIndex:   InitMixedDecCoins(),
+		FeesCollected: InitMixedDecCoins(),
+	}
+}
+
+// NewAllocation creates a new allocation object.
+func NewAllocation(poolID uint64, provider sdk.AccAddress, amount sdk.Int, rewardIndex MixedDecCoins) Allocation {
+	return Allocation{
+		PoolId:      poolID,
+		Provider:    provider.String(),
+		Amount:      amount,
+		RewardIndex: rewardIndex,
 	}
 }
 
@@ -31,9 +45,10 @@ func NewProvider(addr sdk.AccAddress) Provider {
 }
 
 // NewPurchase creates a new purchase object.
-func NewPurchase(purchaseID uint64, protectionEndTime, deletionTime time.Time, description string, shield sdk.Int, serviceFees MixedDecCoins) Purchase {
+func NewPurchase(purchaseID uint64, purchaseTime, protectionEndTime, deletionTime time.Time, description string, shield sdk.Int, serviceFees MixedDecCoins) Purchase {
 	return Purchase{
 		PurchaseId:        purchaseID,
+		PurchaseTime:      purchaseTime,
 		ProtectionEndTime: protectionEndTime,
 		DeletionTime:      deletionTime,
 		Description:       description,
@@ -60,11 +75,23 @@ func NewWithdraw(addr sdk.AccAddress, amount sdk.Int, completionTime time.Time)
 	}
 }
 
-func NewShieldStaking(poolID uint64, purchaser sdk.AccAddress, amount sdk.Int) ShieldStaking {
+func NewShieldStaking(poolID uint64, purchaser sdk.AccAddress, amount sdk.Int, rewardIndex MixedDecCoins) ShieldStaking {
 	return ShieldStaking{
 		PoolId:            poolID,
 		Purchaser:         purchaser.String(),
 		Amount:            amount,
 		WithdrawRequested: sdk.NewInt(0),
+		RewardIndex:       rewardIndex,
+		Rewards:           InitMixedDecCoins(),
+	}
+}
+
+// NewUnstaking creates a new Unstaking object.
+func NewUnstaking(poolID uint64, purchaser sdk.AccAddress, amount sdk.Int, completionTime time.Time) Unstaking {
+	return Unstaking{
+		PoolId:         poolID,
+		Purchaser:      purchaser.String(),
+		Amount:         amount,
+		CompletionTime: completionTime,
 	}
 }